@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/server"
+	"github.com/pinbrain/gophkeeper/internal/server/backup"
+	"github.com/pinbrain/gophkeeper/internal/server/config"
+	"github.com/spf13/cobra"
+)
+
+// backupCmd возвращает команду cobra для создания резервной копии хранилища и блоков файлов сервера.
+func backupCmd() *cobra.Command {
+	var path string
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Создать резервную копию",
+		Long: "Записать пользователей, организации, данные и блоки файлов в сжатый архив, зашифрованный " +
+			"ключом резервного копирования (BackupKey). Копию следует создавать при остановленном сервере",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := config.InitConfig()
+			if err != nil {
+				return err
+			}
+			logger, err := logger.NewLogger(cfg.LogLevel)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
+			defer cancel()
+			cmd.SilenceUsage = true
+
+			file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return fmt.Errorf("failed to create backup file: %w", err)
+			}
+			summary, err := server.Backup(ctx, cfg, file, logger)
+			if closeErr := file.Close(); err == nil && closeErr != nil {
+				err = fmt.Errorf("failed to write backup file: %w", closeErr)
+			}
+			if err != nil {
+				_ = os.Remove(path)
+				return err
+			}
+			printSummary(summary)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&path, "path", "p", "", "файл резервной копии (не должен существовать)")
+	_ = cmd.MarkFlagRequired("path")
+	return cmd
+}
+
+// restoreCmd возвращает команду cobra для восстановления резервной копии в пустое хранилище.
+func restoreCmd() *cobra.Command {
+	var path string
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Восстановить резервную копию",
+		Long: "Проверить целостность резервной копии и загрузить ее в пустое хранилище и хранилище блоков " +
+			"файлов, указанные в конфигурации сервера. Сервер должен использовать тот же мастер ключ",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := config.InitConfig()
+			if err != nil {
+				return err
+			}
+			logger, err := logger.NewLogger(cfg.LogLevel)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
+			defer cancel()
+			cmd.SilenceUsage = true
+
+			file, err := os.Open(path)
+			if err != nil {
+				return fmt.Errorf("failed to open backup file: %w", err)
+			}
+			defer file.Close()
+			summary, err := server.Restore(ctx, cfg, file, logger)
+			if err != nil {
+				return err
+			}
+			printSummary(summary)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&path, "path", "p", "", "файл резервной копии")
+	_ = cmd.MarkFlagRequired("path")
+	return cmd
+}
+
+// printSummary выводит количество объектов резервной копии.
+func printSummary(summary *backup.Summary) {
+	fmt.Printf("Пользователей: %d; организаций: %d; папок: %d; меток: %d; данных: %d; предыдущих версий: %d; "+
+		"доступов: %d; событий аудита: %d; блоков файлов: %d\n",
+		summary.Users, summary.Orgs, summary.Folders, summary.Tags, summary.Items, summary.Revisions,
+		summary.Shares, summary.AuditEvents, summary.Chunks)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"os/signal"
 	"syscall"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/pinbrain/gophkeeper/internal/server"
 	"github.com/pinbrain/gophkeeper/internal/server/config"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -22,6 +24,23 @@ const (
 )
 
 func main() {
+	rootCmd := &cobra.Command{
+		Use:   "server",
+		Short: "Сервер менеджера паролей",
+		Long:  "Запускает gRPC сервер менеджера паролей",
+		Args:  cobra.NoArgs,
+		Run: func(_ *cobra.Command, _ []string) {
+			runServer()
+		},
+	}
+	rootCmd.AddCommand(migrateCmd(), backupCmd(), restoreCmd())
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// runServer запускает сервер и блокируется до его остановки.
+func runServer() {
 	rootCtx, cancelCtx := signal.NotifyContext(
 		context.Background(),
 		syscall.SIGTERM,
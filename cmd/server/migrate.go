@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pinbrain/gophkeeper/internal/server"
+	"github.com/pinbrain/gophkeeper/internal/server/config"
+	"github.com/pinbrain/gophkeeper/internal/storage/migrate"
+	"github.com/spf13/cobra"
+)
+
+// migrateCmd возвращает команду cobra для управления миграциями БД.
+// Используются миграции, встроенные в бинарный файл сервера, и DSN из конфигурации сервера.
+func migrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Миграции БД",
+		Long:  "Управление миграциями БД хранилища, указанного в конфигурации сервера",
+	}
+	cmd.AddCommand(
+		migrateSubCmd(migrate.Up, "Применить все новые миграции"),
+		migrateSubCmd(migrate.Down, "Откатить последнюю примененную миграцию"),
+		migrateSubCmd(migrate.Status, "Показать состояние всех миграций"),
+		migrateSubCmd(migrate.Redo, "Откатить и заново применить последнюю миграцию"),
+		migrateSubCmd(migrate.Version, "Показать текущую версию схемы БД"),
+	)
+	return cmd
+}
+
+// migrateSubCmd возвращает команду cobra для выполнения одной команды миграций.
+func migrateSubCmd(command migrate.Command, short string) *cobra.Command {
+	return &cobra.Command{
+		Use:   string(command),
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg, err := config.InitConfig()
+			if err != nil {
+				return err
+			}
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT)
+			defer cancel()
+			cmd.SilenceUsage = true
+			return server.Migrate(ctx, cfg.DSN, command, os.Stdout)
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// AttachFileCmd возвращает команду cobra для прикрепления файла к данным.
+func (c *CLI) AttachFileCmd(ctx context.Context) *cobra.Command {
+	var id, file, comment string
+	cmd := &cobra.Command{
+		Use:   "attach",
+		Short: "Прикрепить файл",
+		Long: "Прикрепить файл к данным по id. Вложение удаляется в корзину и восстанавливается " +
+			"вместе с данными",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			attachmentID, err := c.service.AttachFile(ctx, id, file, comment)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Файл прикреплен, id: %s\n", attachmentID)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id данных")
+	cmd.Flags().StringVarP(&file, "path", "p", "", "файл")
+	cmd.Flags().StringVarP(&comment, "comment", "c", "", "комментарий")
+	_ = cmd.MarkFlagRequired("id")
+	_ = cmd.MarkFlagRequired("path")
+	return cmd
+}
+
+// AttachmentsCmd возвращает команду cobra для вывода файлов, прикрепленных к данным.
+func (c *CLI) AttachmentsCmd(ctx context.Context) *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:   "attachments",
+		Short: "Вложения",
+		Long:  "Показать файлы, прикрепленные к данным. Загрузить вложение можно командой get по его id",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			attachments, err := c.service.ListAttachments(ctx, id)
+			if err != nil {
+				return err
+			}
+			if len(attachments) == 0 {
+				fmt.Println("Вложений нет")
+				return nil
+			}
+			for _, attachment := range attachments {
+				fmt.Printf("id: %s; Файл: %s; Размер: %s; Добавлен: %s\n", attachment.ID,
+					attachment.Meta.Name, formatBytes(attachment.Size), attachment.CreatedAt.Local().Format(time.DateTime))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id данных")
+	_ = cmd.MarkFlagRequired("id")
+	return cmd
+}
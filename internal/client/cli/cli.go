@@ -3,6 +3,8 @@ package cli
 import (
 	"context"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/pinbrain/gophkeeper/internal/client/config"
 	"github.com/pinbrain/gophkeeper/internal/model"
@@ -13,23 +15,67 @@ import (
 type Service interface {
 	UserService
 	VaultService
+	OrgService
 }
 
 // UserService описывает методы для работы с регистрацией и аутентификацией.
 type UserService interface {
 	Register(ctx context.Context, login, password string) (token string, err error)
 	Login(ctx context.Context, login, password string) (token string, err error)
+	GetAuditLog(ctx context.Context) ([]model.AuditEvent, error)
+	DeleteAccount(ctx context.Context, password string) error
 }
 
 // VaultService описывает методы для работы с данными.
 type VaultService interface {
-	AddPassword(ctx context.Context, data string, meta model.PasswordMeta) error
-	AddText(ctx context.Context, data string, meta model.TextMeta) error
-	AddBankCard(ctx context.Context, data model.BankCardData, meta model.BankCardMeta) error
-	AddFile(ctx context.Context, file string, comment string) error
+	AddPassword(ctx context.Context, data string, meta model.PasswordMeta, expiresIn time.Duration) error
+	AddText(ctx context.Context, data string, meta model.TextMeta, expiresIn time.Duration) error
+	AddBankCard(ctx context.Context, data model.BankCardData, meta model.BankCardMeta, expiresIn time.Duration) error
+	AddFile(ctx context.Context, file string, comment string, expiresIn time.Duration) error
+	UpdatePassword(ctx context.Context, id string, revision int64, data string, meta model.PasswordMeta) (int64, error)
+	UpdateText(ctx context.Context, id string, revision int64, data string, meta model.TextMeta) (int64, error)
+	UpdateBankCard(
+		ctx context.Context, id string, revision int64, data model.BankCardData, meta model.BankCardMeta,
+	) (int64, error)
+	UpdateFile(ctx context.Context, id string, revision int64, file string, comment string) (int64, error)
+	AttachFile(ctx context.Context, id string, file string, comment string) (string, error)
+	ListAttachments(ctx context.Context, id string) ([]model.AttachmentInfo, error)
 	GetData(ctx context.Context, id string) (model.DataType, any, error)
-	GetAllByType(ctx context.Context, dataType model.DataType) ([]model.ItemInfo, error)
+	ListItems(ctx context.Context, query model.ItemsQuery, pageToken string) ([]model.ItemInfo, string, error)
+	SearchItems(ctx context.Context, query string, prefix bool, exact bool) ([]model.ItemInfo, error)
+	RecentItems(ctx context.Context, limit int) ([]model.ItemInfo, error)
 	DeleteData(ctx context.Context, id string) error
+	GetDataHistory(ctx context.Context, id string) ([]model.RevisionInfo, error)
+	GetDataRevision(ctx context.Context, id string, revision int64) (model.DataType, any, error)
+	RestoreData(ctx context.Context, id string, revision int64) error
+	GetTrash(ctx context.Context) ([]model.TrashItemInfo, error)
+	RestoreFromTrash(ctx context.Context, id string) error
+	EmptyTrash(ctx context.Context) (int64, error)
+	GetUsage(ctx context.Context) (*model.UsageInfo, error)
+	CreateFolder(ctx context.Context, name string, parentID string) (string, error)
+	ListFolders(ctx context.Context) ([]model.Folder, error)
+	DeleteFolder(ctx context.Context, id string) error
+	MoveItem(ctx context.Context, id string, folderID string) error
+	TagItem(ctx context.Context, id string, tag string) error
+	UntagItem(ctx context.Context, id string, tag string) error
+	ListTags(ctx context.Context) ([]model.Tag, error)
+	SetFavorite(ctx context.Context, id string, favorite bool) error
+	ShareItem(ctx context.Context, id string, login string, write bool) error
+	RevokeShare(ctx context.Context, id string, login string) error
+	ListShared(ctx context.Context) (*model.SharedItems, error)
+	ExportVault(ctx context.Context, w io.Writer) (int, error)
+	ImportVault(ctx context.Context, r io.Reader) (*model.ImportResult, error)
+}
+
+// OrgService описывает методы для работы с организациями.
+type OrgService interface {
+	CreateOrg(ctx context.Context, name string) (string, error)
+	ListOrgs(ctx context.Context) ([]model.OrgInfo, error)
+	DeleteOrg(ctx context.Context, id string) error
+	AddOrgMember(ctx context.Context, id string, login string, role model.OrgRole) error
+	SetOrgMemberRole(ctx context.Context, id string, login string, role model.OrgRole) error
+	RemoveOrgMember(ctx context.Context, id string, login string) error
+	ListOrgMembers(ctx context.Context, id string) ([]model.OrgMember, error)
 }
 
 // CLI описывает структуру cli приложения.
@@ -51,12 +97,13 @@ func NewCLI(ctx context.Context, service Service) *CLI {
 		userCMD: &cobra.Command{
 			Use:   "user",
 			Short: "Команды аутентификации",
-			Long:  "Команды создания нового пользователя, аутентификации по логину и паролю",
+			Long: "Команды создания нового пользователя, аутентификации по логину и паролю, " +
+				"просмотра журнала аудита и удаления аккаунта",
 		},
 		vaultCMD: &cobra.Command{
 			Use:   "vault",
 			Short: "Команды для работы с хранилищем",
-			Long:  "Команды для работы с хранилищем - добавление, удаление, загрузка данных",
+			Long:  "Команды для работы с хранилищем - добавление, удаление, загрузка и упорядочивание данных",
 		},
 	}
 
@@ -73,17 +120,40 @@ func NewCLI(ctx context.Context, service Service) *CLI {
 	cli.userCMD.AddCommand(
 		cli.RegisterCmd(ctx),
 		cli.LoginCmd(ctx),
+		cli.AuditCmd(ctx),
+		cli.DeleteAccountCmd(ctx),
 	)
 
 	cli.vaultCMD.AddCommand(
 		cli.GetDataCmd(ctx),
 		cli.GetAllByTypeCmd(ctx),
+		cli.SearchCmd(ctx),
+		cli.RecentCmd(ctx),
 		cli.AddDataCmd(ctx),
+		cli.UpdateDataCmd(ctx),
 		cli.DeleteDataCmd(ctx),
+		cli.HistoryCmd(ctx),
+		cli.RestoreDataCmd(ctx),
+		cli.TrashCmd(ctx),
+		cli.UsageCmd(ctx),
+		cli.FolderCmd(ctx),
+		cli.MoveItemCmd(ctx),
+		cli.TagItemCmd(ctx),
+		cli.UntagItemCmd(ctx),
+		cli.TagsCmd(ctx),
+		cli.PinItemCmd(ctx),
+		cli.UnpinItemCmd(ctx),
+		cli.ShareCmd(ctx),
+		cli.SharedCmd(ctx),
+		cli.AttachFileCmd(ctx),
+		cli.AttachmentsCmd(ctx),
+		cli.ExportCmd(ctx),
+		cli.ImportCmd(ctx),
 	)
 
 	cli.rootCMD.AddCommand(cli.userCMD)
 	cli.rootCMD.AddCommand(cli.vaultCMD)
+	cli.rootCMD.AddCommand(cli.OrgCmd(ctx))
 	cli.rootCMD.AddCommand(aboutCMD)
 
 	return cli
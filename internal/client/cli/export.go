@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// ExportCmd возвращает команду cobra для выгрузки всех данных в файл.
+func (c *CLI) ExportCmd(ctx context.Context) *cobra.Command {
+	var path string
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Выгрузить все данные",
+		Long: "Выгрузить все данные из хранилища в файл одним запросом. Данные в файле не зашифрованы, " +
+			"файл доступен только текущему пользователю системы",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return fmt.Errorf("не удалось создать файл: %w", err)
+			}
+			count, err := c.service.ExportVault(ctx, file)
+			if closeErr := file.Close(); err == nil && closeErr != nil {
+				err = fmt.Errorf("не удалось записать файл: %w", closeErr)
+			}
+			if err != nil {
+				_ = os.Remove(path)
+				return err
+			}
+			fmt.Printf("Выгружено данных: %d\n", count)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&path, "path", "p", "", "файл для выгрузки (не должен существовать)")
+	_ = cmd.MarkFlagRequired("path")
+	return cmd
+}
+
+// ImportCmd возвращает команду cobra для загрузки данных из файла выгрузки.
+func (c *CLI) ImportCmd(ctx context.Context) *cobra.Command {
+	var path string
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Загрузить данные из выгрузки",
+		Long: "Загрузить в хранилище данные из файла, созданного командой export. Данные сохраняются " +
+			"как новые; если хотя бы одни данные не могут быть загружены, не сохраняется ничего",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			file, err := os.Open(path)
+			if err != nil {
+				return fmt.Errorf("не удалось прочитать файл: %w", err)
+			}
+			defer file.Close()
+			result, err := c.service.ImportVault(ctx, file)
+			if err != nil {
+				return err
+			}
+			if len(result.Failures) > 0 {
+				for _, failure := range result.Failures {
+					fmt.Printf("Запись %d; id: %s; Ошибка: %s\n", failure.Index+1, failure.ID, failure.Error)
+				}
+				return errors.New("данные не загружены")
+			}
+			fmt.Printf("Загружено данных: %d\n", result.Imported)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&path, "path", "p", "", "файл выгрузки")
+	_ = cmd.MarkFlagRequired("path")
+	return cmd
+}
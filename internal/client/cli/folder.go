@@ -0,0 +1,225 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// FolderCmd возвращает команду cobra для работы с папками.
+func (c *CLI) FolderCmd(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "folder",
+		Short: "Папки",
+		Long:  "Показать дерево папок; создать или удалить папку",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			folders, err := c.service.ListFolders(ctx)
+			if err != nil {
+				return err
+			}
+			if len(folders) == 0 {
+				fmt.Println("Папок нет")
+				return nil
+			}
+			printFolders(folders)
+			return nil
+		},
+	}
+
+	cmd.AddCommand(
+		c.CreateFolderCmd(ctx),
+		c.DeleteFolderCmd(ctx),
+	)
+
+	return cmd
+}
+
+// printFolders выводит дерево папок: вложенные папки выводятся с отступом под родительской.
+func printFolders(folders []model.Folder) {
+	children := make(map[string][]model.Folder)
+	for _, folder := range folders {
+		children[folder.ParentID] = append(children[folder.ParentID], folder)
+	}
+	var printLevel func(parentID string, depth int)
+	printLevel = func(parentID string, depth int) {
+		for _, folder := range children[parentID] {
+			fmt.Printf("%sid: %s; Название: %s\n", strings.Repeat("  ", depth), folder.ID, folder.Name)
+			printLevel(folder.ID, depth+1)
+		}
+	}
+	printLevel("", 0)
+}
+
+// CreateFolderCmd возвращает команду cobra для создания папки.
+func (c *CLI) CreateFolderCmd(ctx context.Context) *cobra.Command {
+	var name, parentID string
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Создать папку",
+		Long:  "Создать папку верхнего уровня или вложенную в другую папку",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			id, err := c.service.CreateFolder(ctx, name, parentID)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Папка создана, id: %s\n", id)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&name, "name", "n", "", "название папки")
+	cmd.Flags().StringVar(&parentID, "parent", "", "id родительской папки")
+	_ = cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+// DeleteFolderCmd возвращает команду cobra для удаления папки.
+func (c *CLI) DeleteFolderCmd(ctx context.Context) *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Удалить папку",
+		Long:  "Удалить папку по id. Данные и вложенные папки переходят в ее родительскую папку",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.DeleteFolder(ctx, id); err != nil {
+				return err
+			}
+			fmt.Println("Папка удалена")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id папки для удаления")
+	_ = cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+// MoveItemCmd возвращает команду cobra для перемещения данных в папку.
+func (c *CLI) MoveItemCmd(ctx context.Context) *cobra.Command {
+	var id, folderID string
+	cmd := &cobra.Command{
+		Use:   "move",
+		Short: "Переместить в папку",
+		Long:  "Переместить данные в папку; без флага --folder данные убираются из папки",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.MoveItem(ctx, id, folderID); err != nil {
+				return err
+			}
+			fmt.Println("Данные перемещены")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id данных")
+	cmd.Flags().StringVar(&folderID, "folder", "", "id папки")
+	_ = cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+// TagItemCmd возвращает команду cobra для добавления метки данным.
+func (c *CLI) TagItemCmd(ctx context.Context) *cobra.Command {
+	var id, tag string
+	cmd := &cobra.Command{
+		Use:   "tag",
+		Short: "Добавить метку",
+		Long:  "Добавить данным метку. Метки не различаются по регистру",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.TagItem(ctx, id, tag); err != nil {
+				return err
+			}
+			fmt.Println("Метка добавлена")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id данных")
+	cmd.Flags().StringVar(&tag, "tag", "", "метка")
+	_ = cmd.MarkFlagRequired("id")
+	_ = cmd.MarkFlagRequired("tag")
+	return cmd
+}
+
+// UntagItemCmd возвращает команду cobra для снятия метки с данных.
+func (c *CLI) UntagItemCmd(ctx context.Context) *cobra.Command {
+	var id, tag string
+	cmd := &cobra.Command{
+		Use:   "untag",
+		Short: "Снять метку",
+		Long:  "Снять метку с данных",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.UntagItem(ctx, id, tag); err != nil {
+				return err
+			}
+			fmt.Println("Метка снята")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id данных")
+	cmd.Flags().StringVar(&tag, "tag", "", "метка")
+	_ = cmd.MarkFlagRequired("id")
+	_ = cmd.MarkFlagRequired("tag")
+	return cmd
+}
+
+// PinItemCmd возвращает команду cobra для добавления данных в избранное.
+func (c *CLI) PinItemCmd(ctx context.Context) *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:   "pin",
+		Short: "Добавить в избранное",
+		Long:  "Закрепить данные в избранном. Избранные данные можно вывести в начале списка (getall --favorites-first)",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.SetFavorite(ctx, id, true); err != nil {
+				return err
+			}
+			fmt.Println("Данные добавлены в избранное")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id данных")
+	_ = cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+// UnpinItemCmd возвращает команду cobra для удаления данных из избранного.
+func (c *CLI) UnpinItemCmd(ctx context.Context) *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:   "unpin",
+		Short: "Убрать из избранного",
+		Long:  "Убрать данные из избранного",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.SetFavorite(ctx, id, false); err != nil {
+				return err
+			}
+			fmt.Println("Данные убраны из избранного")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id данных")
+	_ = cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+// TagsCmd возвращает команду cobra для вывода списка меток.
+func (c *CLI) TagsCmd(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tags",
+		Short: "Список меток",
+		Long:  "Показать метки с количеством данных с каждой из них (без данных в корзине)",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			tags, err := c.service.ListTags(ctx)
+			if err != nil {
+				return err
+			}
+			if len(tags) == 0 {
+				fmt.Println("Меток нет")
+				return nil
+			}
+			for _, tag := range tags {
+				fmt.Printf("%s: %d\n", tag.Name, tag.Items)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,217 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/client/config"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// OrgCmd возвращает команду cobra для работы с организациями.
+func (c *CLI) OrgCmd(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "org",
+		Short: "Организации",
+		Long: "Показать организации пользователя; создать или удалить организацию, управлять участниками " +
+			"и выбрать организацию для команд хранилища",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			orgs, err := c.service.ListOrgs(ctx)
+			if err != nil {
+				return err
+			}
+			if len(orgs) == 0 {
+				fmt.Println("Организаций нет")
+				return nil
+			}
+			current := config.GetOrg()
+			for _, org := range orgs {
+				mark := ""
+				if org.ID == current {
+					mark = " (выбрана)"
+				}
+				fmt.Printf("id: %s; Название: %s; Роль: %s; Добавлен: %s%s\n",
+					org.ID, org.Name, org.Role, org.CreatedAt.Local().Format(time.DateTime), mark)
+			}
+			return nil
+		},
+	}
+
+	cmd.AddCommand(
+		c.CreateOrgCmd(ctx),
+		c.DeleteOrgCmd(ctx),
+		c.OrgMembersCmd(ctx),
+		c.AddOrgMemberCmd(ctx),
+		c.SetOrgMemberRoleCmd(ctx),
+		c.RemoveOrgMemberCmd(ctx),
+		c.UseOrgCmd(),
+	)
+
+	return cmd
+}
+
+// CreateOrgCmd возвращает команду cobra для создания организации.
+func (c *CLI) CreateOrgCmd(ctx context.Context) *cobra.Command {
+	var name string
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Создать организацию",
+		Long:  "Создать организацию. Пользователь становится ее владельцем",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			id, err := c.service.CreateOrg(ctx, name)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Организация создана, id: %s\n", id)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&name, "name", "n", "", "название организации")
+	_ = cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+// DeleteOrgCmd возвращает команду cobra для удаления организации.
+func (c *CLI) DeleteOrgCmd(ctx context.Context) *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Удалить организацию",
+		Long:  "Удалить организацию вместе со всеми ее данными. Доступно только владельцу",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.DeleteOrg(ctx, id); err != nil {
+				return err
+			}
+			if config.GetOrg() == id {
+				if err := config.SaveOrg(""); err != nil {
+					return err
+				}
+			}
+			fmt.Println("Организация удалена")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id организации")
+	_ = cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+// OrgMembersCmd возвращает команду cobra для вывода участников организации.
+func (c *CLI) OrgMembersCmd(ctx context.Context) *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:   "members",
+		Short: "Участники организации",
+		Long:  "Показать участников организации и их роли",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			members, err := c.service.ListOrgMembers(ctx, id)
+			if err != nil {
+				return err
+			}
+			for _, member := range members {
+				fmt.Printf("Логин: %s; Роль: %s; Добавлен: %s\n",
+					member.Login, member.Role, member.CreatedAt.Local().Format(time.DateTime))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id организации")
+	_ = cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+// AddOrgMemberCmd возвращает команду cobra для добавления участника в организацию.
+func (c *CLI) AddOrgMemberCmd(ctx context.Context) *cobra.Command {
+	var id, login, role string
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Добавить участника",
+		Long: "Добавить пользователя в организацию. Роли: OWNER - полный доступ, ADMIN - управление " +
+			"участниками MEMBER и READER, MEMBER - чтение и изменение данных, READER - только чтение",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.AddOrgMember(ctx, id, login, model.OrgRole(role)); err != nil {
+				return err
+			}
+			fmt.Println("Участник добавлен")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id организации")
+	cmd.Flags().StringVarP(&login, "login", "l", "", "логин пользователя")
+	cmd.Flags().StringVar(&role, "role", string(model.OrgRoleMember), "роль участника")
+	_ = cmd.MarkFlagRequired("id")
+	_ = cmd.MarkFlagRequired("login")
+	return cmd
+}
+
+// SetOrgMemberRoleCmd возвращает команду cobra для изменения роли участника организации.
+func (c *CLI) SetOrgMemberRoleCmd(ctx context.Context) *cobra.Command {
+	var id, login, role string
+	cmd := &cobra.Command{
+		Use:   "role",
+		Short: "Изменить роль участника",
+		Long:  "Изменить роль участника организации. В организации должен остаться хотя бы один владелец",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.SetOrgMemberRole(ctx, id, login, model.OrgRole(role)); err != nil {
+				return err
+			}
+			fmt.Println("Роль участника изменена")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id организации")
+	cmd.Flags().StringVarP(&login, "login", "l", "", "логин участника")
+	cmd.Flags().StringVar(&role, "role", "", "новая роль участника")
+	_ = cmd.MarkFlagRequired("id")
+	_ = cmd.MarkFlagRequired("login")
+	_ = cmd.MarkFlagRequired("role")
+	return cmd
+}
+
+// RemoveOrgMemberCmd возвращает команду cobra для удаления участника из организации.
+func (c *CLI) RemoveOrgMemberCmd(ctx context.Context) *cobra.Command {
+	var id, login string
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Удалить участника",
+		Long:  "Удалить участника из организации. Любой участник может выйти из организации, указав свой логин",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.RemoveOrgMember(ctx, id, login); err != nil {
+				return err
+			}
+			fmt.Println("Участник удален")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id организации")
+	cmd.Flags().StringVarP(&login, "login", "l", "", "логин участника")
+	_ = cmd.MarkFlagRequired("id")
+	_ = cmd.MarkFlagRequired("login")
+	return cmd
+}
+
+// UseOrgCmd возвращает команду cobra для выбора организации, с данными которой работают команды хранилища.
+func (c *CLI) UseOrgCmd() *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:   "use",
+		Short: "Выбрать организацию",
+		Long: "Выбрать организацию, с данными которой работают команды хранилища. " +
+			"Без --id команды хранилища снова работают с личными данными",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := config.SaveOrg(id); err != nil {
+				return err
+			}
+			if id == "" {
+				fmt.Println("Команды хранилища работают с личными данными")
+				return nil
+			}
+			fmt.Printf("Команды хранилища работают с данными организации %s\n", id)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id организации")
+	return cmd
+}
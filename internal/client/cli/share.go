@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// ShareCmd возвращает команду cobra для открытия доступа к данным другому пользователю.
+func (c *CLI) ShareCmd(ctx context.Context) *cobra.Command {
+	var id, login string
+	var write bool
+	cmd := &cobra.Command{
+		Use:   "share",
+		Short: "Открыть доступ к данным",
+		Long: "Открыть другому пользователю доступ к данным на чтение или, с флагом --write, на чтение и изменение. " +
+			"Повторный вызов меняет уровень доступа. Доступ к файлам не поддерживается",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.ShareItem(ctx, id, login, write); err != nil {
+				return err
+			}
+			fmt.Println("Доступ к данным открыт")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id данных")
+	cmd.Flags().StringVarP(&login, "login", "l", "", "логин пользователя")
+	cmd.Flags().BoolVar(&write, "write", false, "разрешить изменение данных")
+	_ = cmd.MarkFlagRequired("id")
+	_ = cmd.MarkFlagRequired("login")
+
+	cmd.AddCommand(c.RevokeShareCmd(ctx))
+
+	return cmd
+}
+
+// RevokeShareCmd возвращает команду cobra для закрытия доступа к данным.
+func (c *CLI) RevokeShareCmd(ctx context.Context) *cobra.Command {
+	var id, login string
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Закрыть доступ к данным",
+		Long: "Закрыть пользователю доступ к данным. Данные перешифровываются новым ключом, " +
+			"который передается только оставшимся получателям",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.RevokeShare(ctx, id, login); err != nil {
+				return err
+			}
+			fmt.Println("Доступ к данным закрыт")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id данных")
+	cmd.Flags().StringVarP(&login, "login", "l", "", "логин пользователя")
+	_ = cmd.MarkFlagRequired("id")
+	_ = cmd.MarkFlagRequired("login")
+	return cmd
+}
+
+// SharedCmd возвращает команду cobra для вывода данных с совместным доступом.
+func (c *CLI) SharedCmd(ctx context.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "shared",
+		Short: "Данные с совместным доступом",
+		Long: "Показать данные других пользователей, к которым открыт доступ, " +
+			"и свои данные, к которым открыт доступ другим пользователям",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			shared, err := c.service.ListShared(ctx)
+			if err != nil {
+				return err
+			}
+			fmt.Println("Доступно мне:")
+			if len(shared.WithMe) == 0 {
+				fmt.Println("  нет данных")
+			}
+			for _, item := range shared.WithMe {
+				fmt.Printf(
+					"  id: %s; Тип: %s; %s; Владелец: %s; Доступ: %s; Открыт: %s\n",
+					item.ID, item.Type, describeMeta(item.Meta), item.Login, describeAccess(item.Write),
+					item.CreatedAt.Local().Format(time.DateTime),
+				)
+			}
+			fmt.Println("Открыто мной:")
+			if len(shared.ByMe) == 0 {
+				fmt.Println("  нет данных")
+			}
+			for _, item := range shared.ByMe {
+				fmt.Printf(
+					"  id: %s; Тип: %s; Пользователь: %s; Доступ: %s; Открыт: %s\n",
+					item.ID, item.Type, item.Login, describeAccess(item.Write),
+					item.CreatedAt.Local().Format(time.DateTime),
+				)
+			}
+			return nil
+		},
+	}
+}
+
+// describeAccess возвращает описание уровня доступа для вывода.
+func describeAccess(write bool) string {
+	if write {
+		return "чтение и изменение"
+	}
+	return "чтение"
+}
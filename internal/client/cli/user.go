@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -52,3 +53,61 @@ func (c *CLI) LoginCmd(ctx context.Context) *cobra.Command {
 	_ = cmd.MarkFlagRequired("password")
 	return cmd
 }
+
+// AuditCmd возвращает команду cobra для вывода журнала аудита пользователя.
+func (c *CLI) AuditCmd(ctx context.Context) *cobra.Command {
+	var limit int
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Журнал аудита",
+		Long:  "Вывести последние события журнала аудита (обращения к данным и аккаунту) и проверить его целостность",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			events, err := c.service.GetAuditLog(ctx)
+			if events == nil && err != nil {
+				return err
+			}
+			shown := events
+			if limit > 0 && len(shown) > limit {
+				shown = shown[len(shown)-limit:]
+			}
+			for _, event := range shown {
+				item := ""
+				if event.ItemID != "" {
+					item = "; Данные: " + event.ItemID
+				}
+				fmt.Printf(
+					"%d. %s; %s%s; Адрес: %s; Результат: %s\n",
+					event.Seq, event.CreatedAt.Local().Format(time.DateTime), event.Action, item, event.Peer, event.Code,
+				)
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Журнал аудита не поврежден, всего событий: %d\n", len(events))
+			return nil
+		},
+	}
+	cmd.Flags().IntVarP(&limit, "limit", "n", 50, "количество последних событий для вывода (0 - все)")
+	return cmd
+}
+
+// DeleteAccountCmd возвращает команду cobra для удаления аккаунта пользователя.
+func (c *CLI) DeleteAccountCmd(ctx context.Context) *cobra.Command {
+	var password string
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Удаление аккаунта",
+		Long: "Безвозвратно удалить аккаунт вместе со всеми данными, их историей и журналом аудита. " +
+			"Удаление подтверждается паролем, сохраненный JWT удаляется",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := c.service.DeleteAccount(ctx, password); err != nil {
+				return err
+			}
+			fmt.Println("Аккаунт и все данные удалены")
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&password, "password", "p", "", "пароль для подтверждения удаления")
+	_ = cmd.MarkFlagRequired("password")
+	return cmd
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pinbrain/gophkeeper/internal/model"
 	"github.com/spf13/cobra"
@@ -21,154 +24,273 @@ func (c *CLI) GetDataCmd(ctx context.Context) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			switch dataType {
-			case model.Password:
-				item, ok := res.(*model.PasswordItem)
-				if !ok {
-					return errors.New("некорректные данные о пароле")
-				}
-				fmt.Printf(
-					"Ресурс: %s; Логин: %s; Пароль: %s\nКомментарий: %s\n",
-					item.Meta.Resource,
-					item.Meta.Login,
-					item.Data,
-					item.Meta.Comment,
-				)
-				return nil
+			return printItem(dataType, res)
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id данных для загрузки")
+	_ = cmd.MarkFlagRequired("id")
+	return cmd
+}
 
-			case model.BankCard:
-				item, ok := res.(*model.BankCardItem)
-				if !ok {
-					return errors.New("некорректные данные о банковской карте")
+// HistoryCmd возвращает команду cobra для просмотра истории изменения данных.
+func (c *CLI) HistoryCmd(ctx context.Context) *cobra.Command {
+	var id string
+	var revision int64
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "История изменений",
+		Long:  "Получить список сохраненных версий данных по id или загрузить конкретную версию",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if revision > 0 {
+				dataType, res, err := c.service.GetDataRevision(ctx, id, revision)
+				if err != nil {
+					return err
 				}
-				fmt.Printf(
-					"Банк: %s\nДержатель: %s; Номер: %s; Действует до: %d-%d; csv: %s\nКомментарий: %s\n",
-					item.Meta.Bank,
-					item.Data.Holder,
-					item.Data.Number,
-					item.Data.ValidMonth,
-					item.Data.ValidYear,
-					item.Data.CSV,
-					item.Meta.Comment,
-				)
+				return printItem(dataType, res)
+			}
+			history, err := c.service.GetDataHistory(ctx, id)
+			if err != nil {
+				return err
+			}
+			if len(history) == 0 {
+				fmt.Println("Предыдущих версий нет")
 				return nil
-
-			case model.Text:
-				item, ok := res.(*model.TextItem)
-				if !ok {
-					return errors.New("некорректные данные о тексте")
-				}
+			}
+			for _, rev := range history {
 				fmt.Printf(
-					"Название: %s\nТекст: %s\nКомментарий: %s\n",
-					item.Meta.Name, item.Data, item.Meta.Comment,
+					"Версия: %d; Сохранена: %s\n",
+					rev.Revision, rev.CreatedAt.Local().Format(time.DateTime),
 				)
-				return nil
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id данных")
+	_ = cmd.MarkFlagRequired("id")
+	cmd.Flags().Int64Var(&revision, "rev", 0, "номер версии для загрузки")
+	return cmd
+}
 
-			case model.File:
-				item, ok := res.(*model.FileItem)
-				if !ok {
-					return errors.New("некорректные данные о файле")
-				}
-				fmt.Printf(
-					"Файл '%s' успешно загружен.\n",
-					item.Meta.Name,
-				)
-				return nil
+// RestoreDataCmd возвращает команду cobra для восстановления предыдущей версии данных.
+func (c *CLI) RestoreDataCmd(ctx context.Context) *cobra.Command {
+	var id string
+	var revision int64
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Восстановить версию",
+		Long:  "Восстановить сохраненную версию данных по id и номеру версии",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			err := c.service.RestoreData(ctx, id, revision)
+			if err != nil {
+				return err
 			}
-			return fmt.Errorf("неизвестный тип данных: %s", dataType)
+			fmt.Println("Данные успешно восстановлены")
+			return nil
 		},
 	}
-	cmd.Flags().StringVar(&id, "id", "", "id данных для загрузки")
+	cmd.Flags().StringVar(&id, "id", "", "id данных для восстановления")
 	_ = cmd.MarkFlagRequired("id")
+	cmd.Flags().Int64Var(&revision, "rev", 0, "номер версии")
+	_ = cmd.MarkFlagRequired("rev")
 	return cmd
 }
 
+// listPageSize размер страницы, которой команда getall загружает список данных.
+const listPageSize = 100
+
 // GetAllByTypeCmd возвращает команду cobra для получения перечня хранимых данных.
+// Список загружается постранично до конца.
 func (c *CLI) GetAllByTypeCmd(ctx context.Context) *cobra.Command {
-	var dataType string
+	var dataType, sortBy, folderID, tag string
+	var desc, favoritesFirst bool
 	cmd := &cobra.Command{
 		Use:   "getall",
 		Short: "Получить список данных",
-		Long:  "Получить список хранящихся данных определенного типа",
+		Long: "Получить список хранящихся данных (всех, определенного типа, из папки или с меткой). " +
+			"Сортировка по времени создания (created), обновления (updated), последнего получения (accessed) " +
+			"или полю мета данных (resource, login, name, bank, comment, extension)",
 		RunE: func(_ *cobra.Command, _ []string) error {
-			res, err := c.service.GetAllByType(ctx, model.DataType(dataType))
-			if err != nil {
-				return err
+			query := model.ItemsQuery{
+				Type:           model.DataType(dataType),
+				Desc:           desc,
+				PageSize:       listPageSize,
+				FolderID:       folderID,
+				Tag:            tag,
+				FavoritesFirst: favoritesFirst,
 			}
-			if len(res) == 0 {
-				fmt.Println("Данных нет")
-				return nil
+			switch sortBy {
+			case string(model.SortByCreated), string(model.SortByUpdated), string(model.SortByAccessed):
+				query.SortBy = model.ItemsSort(sortBy)
+			default:
+				query.SortBy = model.SortByMeta
+				query.MetaField = sortBy
 			}
-			switch dataType {
-			case string(model.Password):
-				for _, item := range res {
-					meta, ok := item.Meta.(*model.PasswordMeta)
-					if !ok {
-						return errors.New("не удалось получить мета данные о пароле")
-					}
-					fmt.Printf(
-						"id: %s; Ресурс: %s; Логин: %s; Комментарий: %s\n",
-						item.ID, meta.Resource, meta.Login, meta.Comment,
-					)
-				}
 
-			case string(model.Text):
+			var total int
+			var pageToken string
+			for {
+				res, next, err := c.service.ListItems(ctx, query, pageToken)
+				if err != nil {
+					return err
+				}
 				for _, item := range res {
-					meta, ok := item.Meta.(*model.TextMeta)
-					if !ok {
-						return errors.New("не удалось получить мета данные о тексте")
+					if err = printItemInfo(item); err != nil {
+						return err
 					}
-					fmt.Printf(
-						"id: %s; Имя: %s; Комментарий: %s\n",
-						item.ID, meta.Name, meta.Comment,
-					)
 				}
+				total += len(res)
+				if next == "" {
+					break
+				}
+				pageToken = next
+			}
+			if total == 0 {
+				fmt.Println("Данных нет")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&dataType, "type", "t", "", "тип данных для вывода списка (по умолчанию все типы)")
+	cmd.Flags().StringVarP(&sortBy, "sort", "s", string(model.SortByCreated), "поле сортировки")
+	cmd.Flags().BoolVar(&desc, "desc", false, "сортировка по убыванию")
+	cmd.Flags().StringVar(&folderID, "folder", "", "id папки для вывода только ее данных (без вложенных папок)")
+	cmd.Flags().StringVar(&tag, "tag", "", "метка для вывода только данных с ней")
+	cmd.Flags().BoolVar(&favoritesFirst, "favorites-first", false, "вывести избранные данные в начале списка")
+	return cmd
+}
 
-			case string(model.BankCard):
-				for _, item := range res {
-					meta, ok := item.Meta.(*model.BankCardMeta)
-					if !ok {
-						return errors.New("не удалось получить мета данные о банковской карте")
-					}
-					fmt.Printf(
-						"id: %s; Банк: %s; Комментарий: %s\n",
-						item.ID, meta.Bank, meta.Comment,
-					)
+// RecentCmd возвращает команду cobra для вывода данных, которые получались последними.
+func (c *CLI) RecentCmd(ctx context.Context) *cobra.Command {
+	var limit int
+	cmd := &cobra.Command{
+		Use:   "recent",
+		Short: "Недавние данные",
+		Long:  "Показать данные, которые последними загружались из хранилища, начиная с самых недавних",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if limit <= 0 {
+				return errors.New("количество данных должно быть больше 0")
+			}
+			items, err := c.service.RecentItems(ctx, limit)
+			if err != nil {
+				return err
+			}
+			if len(items) == 0 {
+				fmt.Println("Данные еще не загружались")
+				return nil
+			}
+			for _, item := range items {
+				if err = printItemInfo(item); err != nil {
+					return err
 				}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().IntVarP(&limit, "number", "n", 10, "количество данных")
+	return cmd
+}
 
-			case string(model.File):
-				for _, item := range res {
-					meta, ok := item.Meta.(*model.FileMeta)
-					if !ok {
-						return errors.New("не удалось получить мета данные о файле")
-					}
-					fmt.Printf(
-						"id: %s; Имя: %s; Расширение: %s; Комментарий: %s\n",
-						item.ID, meta.Name, meta.Extension, meta.Comment,
-					)
+// SearchCmd возвращает команду cobra для поиска данных по мета данным.
+func (c *CLI) SearchCmd(ctx context.Context) *cobra.Command {
+	var prefix, exact bool
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Поиск данных",
+		Long: "Найти данные всех типов по вхождению строки (без учета регистра) " +
+			"в ресурс, логин, название, банк или комментарий. " +
+			"С флагом --exact ищутся данные с точно совпадающим ресурсом, логином, названием или банком",
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			res, err := c.service.SearchItems(ctx, args[0], prefix, exact)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				fmt.Println("Ничего не найдено")
+				return nil
+			}
+			for _, item := range res {
+				if err = printItemInfo(item); err != nil {
+					return err
 				}
 			}
 			return nil
 		},
 	}
-	cmd.Flags().StringVarP(&dataType, "type", "t", "", "тип данных для вывода списка")
-	_ = cmd.MarkFlagRequired("type")
+	cmd.Flags().BoolVarP(&prefix, "prefix", "p", false, "искать только по началу значения поля")
+	cmd.Flags().BoolVarP(&exact, "exact", "e", false, "искать по точному совпадению значения поля")
+	cmd.MarkFlagsMutuallyExclusive("prefix", "exact")
 	return cmd
 }
 
+// printItemInfo выводит строку списка данных в зависимости от их типа, с папкой, метками, сроком хранения
+// и статистикой получения.
+func printItemInfo(item model.ItemInfo) error {
+	updated := item.UpdatedAt.Local().Format(time.DateTime)
+	labels := itemLabels(item)
+	switch meta := item.Meta.(type) {
+	case *model.PasswordMeta:
+		fmt.Printf(
+			"id: %s; Ресурс: %s; Логин: %s; Комментарий: %s; Изменено: %s%s\n",
+			item.ID, meta.Resource, meta.Login, meta.Comment, updated, labels,
+		)
+	case *model.TextMeta:
+		fmt.Printf(
+			"id: %s; Имя: %s; Комментарий: %s; Изменено: %s%s\n",
+			item.ID, meta.Name, meta.Comment, updated, labels,
+		)
+	case *model.BankCardMeta:
+		fmt.Printf(
+			"id: %s; Банк: %s; Комментарий: %s; Изменено: %s%s\n",
+			item.ID, meta.Bank, meta.Comment, updated, labels,
+		)
+	case *model.FileMeta:
+		fmt.Printf(
+			"id: %s; Имя: %s; Расширение: %s; Комментарий: %s; Изменено: %s%s\n",
+			item.ID, meta.Name, meta.Extension, meta.Comment, updated, labels,
+		)
+	default:
+		return fmt.Errorf("не удалось получить мета данные: неизвестный тип данных %s", item.Type)
+	}
+	return nil
+}
+
+// itemLabels возвращает описание избранного, папки, меток, срока хранения и статистики получения данных
+// для строки списка или пустую строку, если их нет.
+func itemLabels(item model.ItemInfo) string {
+	var labels string
+	if item.Favorite {
+		labels += "; В избранном"
+	}
+	if item.FolderID != "" {
+		labels += "; Папка: " + item.FolderID
+	}
+	if len(item.Tags) > 0 {
+		labels += "; Метки: " + strings.Join(item.Tags, ", ")
+	}
+	if item.ExpiresAt != nil {
+		labels += "; Удаляется: " + item.ExpiresAt.Local().Format(time.DateTime)
+	}
+	if item.LastAccessedAt != nil {
+		labels += fmt.Sprintf("; Загружено: %s; Загрузок: %d",
+			item.LastAccessedAt.Local().Format(time.DateTime), item.AccessCount)
+	}
+	return labels
+}
+
 // DeleteDataCmd возвращает команду cobra для удаления данных.
 func (c *CLI) DeleteDataCmd(ctx context.Context) *cobra.Command {
 	var id string
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Удалить",
-		Long:  "Удалить данные по id",
+		Long:  "Переместить данные в корзину по id",
 		RunE: func(_ *cobra.Command, _ []string) error {
 			err := c.service.DeleteData(ctx, id)
 			if err != nil {
 				return err
 			}
-			fmt.Println("Данные успешно удалены")
+			fmt.Println("Данные перемещены в корзину")
 			return nil
 		},
 	}
@@ -177,6 +299,208 @@ func (c *CLI) DeleteDataCmd(ctx context.Context) *cobra.Command {
 	return cmd
 }
 
+// printItem выводит загруженные данные в зависимости от их типа.
+func printItem(dataType model.DataType, res any) error {
+	switch dataType {
+	case model.Password:
+		item, ok := res.(*model.PasswordItem)
+		if !ok {
+			return errors.New("некорректные данные о пароле")
+		}
+		fmt.Printf(
+			"Ресурс: %s; Логин: %s; Пароль: %s\nКомментарий: %s\nВерсия: %d\n",
+			item.Meta.Resource,
+			item.Meta.Login,
+			item.Data,
+			item.Meta.Comment,
+			item.Revision,
+		)
+		return nil
+
+	case model.BankCard:
+		item, ok := res.(*model.BankCardItem)
+		if !ok {
+			return errors.New("некорректные данные о банковской карте")
+		}
+		fmt.Printf(
+			"Банк: %s\nДержатель: %s; Номер: %s; Действует до: %d-%d; csv: %s\nКомментарий: %s\nВерсия: %d\n",
+			item.Meta.Bank,
+			item.Data.Holder,
+			item.Data.Number,
+			item.Data.ValidMonth,
+			item.Data.ValidYear,
+			item.Data.CSV,
+			item.Meta.Comment,
+			item.Revision,
+		)
+		return nil
+
+	case model.Text:
+		item, ok := res.(*model.TextItem)
+		if !ok {
+			return errors.New("некорректные данные о тексте")
+		}
+		fmt.Printf(
+			"Название: %s\nТекст: %s\nКомментарий: %s\nВерсия: %d\n",
+			item.Meta.Name, item.Data, item.Meta.Comment, item.Revision,
+		)
+		return nil
+
+	case model.File:
+		item, ok := res.(*model.FileItem)
+		if !ok {
+			return errors.New("некорректные данные о файле")
+		}
+		fmt.Printf(
+			"Файл '%s' успешно загружен.\nВерсия: %d\n",
+			item.Meta.Name, item.Revision,
+		)
+		return nil
+	}
+	return fmt.Errorf("неизвестный тип данных: %s", dataType)
+}
+
+// TrashCmd возвращает команду cobra для работы с корзиной.
+func (c *CLI) TrashCmd(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trash",
+		Short: "Корзина",
+		Long:  "Показать содержимое корзины; восстановить или безвозвратно удалить данные",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			res, err := c.service.GetTrash(ctx)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				fmt.Println("Корзина пуста")
+				return nil
+			}
+			for _, item := range res {
+				fmt.Printf(
+					"id: %s; Тип: %s; %s; Удалено: %s\n",
+					item.ID, item.Type, describeMeta(item.Meta), item.DeletedAt.Local().Format(time.DateTime),
+				)
+			}
+			return nil
+		},
+	}
+
+	cmd.AddCommand(
+		c.RestoreFromTrashCmd(ctx),
+		c.EmptyTrashCmd(ctx),
+	)
+
+	return cmd
+}
+
+// RestoreFromTrashCmd возвращает команду cobra для восстановления данных из корзины.
+func (c *CLI) RestoreFromTrashCmd(ctx context.Context) *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Восстановить из корзины",
+		Long:  "Восстановить данные из корзины по id",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			err := c.service.RestoreFromTrash(ctx, id)
+			if err != nil {
+				return err
+			}
+			fmt.Println("Данные успешно восстановлены из корзины")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&id, "id", "", "id данных для восстановления")
+	_ = cmd.MarkFlagRequired("id")
+	return cmd
+}
+
+// EmptyTrashCmd возвращает команду cobra для очистки корзины.
+func (c *CLI) EmptyTrashCmd(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "empty",
+		Short: "Очистить корзину",
+		Long:  "Безвозвратно удалить все данные из корзины",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			deleted, err := c.service.EmptyTrash(ctx)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Корзина очищена, удалено записей: %d\n", deleted)
+			return nil
+		},
+	}
+	return cmd
+}
+
+// UsageCmd возвращает команду cobra для вывода занятого объема хранилища.
+func (c *CLI) UsageCmd(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Занятый объем",
+		Long:  "Показать занятый объем хранилища по типам данных и ограничения (учитываются и данные в корзине)",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			usage, err := c.service.GetUsage(ctx)
+			if err != nil {
+				return err
+			}
+			var items, bytes int64
+			for _, typeUsage := range usage.Types {
+				fmt.Printf("Тип: %s; Записей: %d; Объем: %s\n",
+					typeUsage.Type, typeUsage.Items, formatBytes(typeUsage.Bytes))
+				items += typeUsage.Items
+				bytes += typeUsage.Bytes
+			}
+			quota := usage.Quota
+			fmt.Printf("Всего записей: %d из %s\n", items, formatLimit(quota.MaxItems, strconv.FormatInt(quota.MaxItems, 10)))
+			fmt.Printf("Всего объем: %s из %s\n", formatBytes(bytes), formatLimit(quota.MaxBytes, formatBytes(quota.MaxBytes)))
+			fmt.Printf("Максимальный размер данных: %s\n", formatLimit(quota.MaxItemSize, formatBytes(quota.MaxItemSize)))
+			fmt.Printf("Максимальный размер мета данных: %s\n",
+				formatLimit(quota.MaxMetaSize, formatBytes(quota.MaxMetaSize)))
+			return nil
+		},
+	}
+	return cmd
+}
+
+// formatLimit возвращает отформатированное значение ограничения или признак его отсутствия.
+func formatLimit(limit int64, formatted string) string {
+	if limit <= 0 {
+		return "без ограничений"
+	}
+	return formatted
+}
+
+// formatBytes форматирует размер в байтах в читаемый вид.
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d Б", size)
+	}
+	units := []string{"КиБ", "МиБ", "ГиБ", "ТиБ"}
+	value := float64(size) / unit
+	i := 0
+	for value >= unit && i < len(units)-1 {
+		value /= unit
+		i++
+	}
+	return fmt.Sprintf("%.1f %s", value, units[i])
+}
+
+// describeMeta возвращает краткое описание данных по их мета данным.
+func describeMeta(meta any) string {
+	switch m := meta.(type) {
+	case *model.PasswordMeta:
+		return fmt.Sprintf("Ресурс: %s; Логин: %s", m.Resource, m.Login)
+	case *model.TextMeta:
+		return "Имя: " + m.Name
+	case *model.BankCardMeta:
+		return "Банк: " + m.Bank
+	case *model.FileMeta:
+		return "Имя: " + m.Name
+	}
+	return ""
+}
+
 // AddDataCmd возвращает команду cobra для сохранения новых данных.
 func (c *CLI) AddDataCmd(ctx context.Context) *cobra.Command {
 	cmd := &cobra.Command{
@@ -198,6 +522,7 @@ func (c *CLI) AddDataCmd(ctx context.Context) *cobra.Command {
 // AddPasswordCmd возвращает команду cobra для сохранения пароля.
 func (c *CLI) AddPasswordCmd(ctx context.Context) *cobra.Command {
 	var password, login, resource, comment string
+	var expiresIn time.Duration
 	cmd := &cobra.Command{
 		Use:   "password",
 		Short: "Добавить пароль",
@@ -207,7 +532,7 @@ func (c *CLI) AddPasswordCmd(ctx context.Context) *cobra.Command {
 				Resource: resource,
 				Login:    login,
 				Comment:  comment,
-			})
+			}, expiresIn)
 			if err != nil {
 				return err
 			}
@@ -222,12 +547,14 @@ func (c *CLI) AddPasswordCmd(ctx context.Context) *cobra.Command {
 	cmd.Flags().StringVarP(&resource, "resource", "r", "", "название ресурса")
 	_ = cmd.MarkFlagRequired("resource")
 	cmd.Flags().StringVarP(&comment, "comment", "c", "", "комментарий")
+	expiresInFlag(cmd, &expiresIn)
 	return cmd
 }
 
 // AddTextCmd возвращает команду cobra для сохранения текстовой информации.
 func (c *CLI) AddTextCmd(ctx context.Context) *cobra.Command {
 	var data, name, comment string
+	var expiresIn time.Duration
 	cmd := &cobra.Command{
 		Use:   "text",
 		Short: "Добавить текст",
@@ -236,7 +563,7 @@ func (c *CLI) AddTextCmd(ctx context.Context) *cobra.Command {
 			err := c.service.AddText(ctx, data, model.TextMeta{
 				Name:    name,
 				Comment: comment,
-			})
+			}, expiresIn)
 			if err != nil {
 				return err
 			}
@@ -249,6 +576,7 @@ func (c *CLI) AddTextCmd(ctx context.Context) *cobra.Command {
 	cmd.Flags().StringVarP(&name, "name", "n", "", "название текста")
 	_ = cmd.MarkFlagRequired("name")
 	cmd.Flags().StringVarP(&comment, "comment", "c", "", "комментарий")
+	expiresInFlag(cmd, &expiresIn)
 	return cmd
 }
 
@@ -256,6 +584,7 @@ func (c *CLI) AddTextCmd(ctx context.Context) *cobra.Command {
 func (c *CLI) AddBankCardCmd(ctx context.Context) *cobra.Command {
 	cardData := model.BankCardData{}
 	var bankName, comment string
+	var expiresIn time.Duration
 	cmd := &cobra.Command{
 		Use:   "bcard",
 		Short: "Добавить карту",
@@ -264,7 +593,7 @@ func (c *CLI) AddBankCardCmd(ctx context.Context) *cobra.Command {
 			err := c.service.AddBankCard(ctx, cardData, model.BankCardMeta{
 				Bank:    bankName,
 				Comment: comment,
-			})
+			}, expiresIn)
 			if err != nil {
 				return err
 			}
@@ -285,18 +614,20 @@ func (c *CLI) AddBankCardCmd(ctx context.Context) *cobra.Command {
 	cmd.Flags().StringVarP(&bankName, "bank", "b", "", "банк")
 	_ = cmd.MarkFlagRequired("bank")
 	cmd.Flags().StringVarP(&comment, "comment", "c", "", "комментарий")
+	expiresInFlag(cmd, &expiresIn)
 	return cmd
 }
 
 // AddFileCmd возвращает команду cobra для сохранения файла.
 func (c *CLI) AddFileCmd(ctx context.Context) *cobra.Command {
 	var file, comment string
+	var expiresIn time.Duration
 	cmd := &cobra.Command{
 		Use:   "file",
 		Short: "Добавить файл",
 		Long:  "Добавить в хранилище файл",
 		RunE: func(_ *cobra.Command, _ []string) error {
-			err := c.service.AddFile(ctx, file, comment)
+			err := c.service.AddFile(ctx, file, comment, expiresIn)
 			if err != nil {
 				return err
 			}
@@ -307,5 +638,150 @@ func (c *CLI) AddFileCmd(ctx context.Context) *cobra.Command {
 	cmd.Flags().StringVarP(&file, "path", "p", "", "файл")
 	_ = cmd.MarkFlagRequired("path")
 	cmd.Flags().StringVarP(&comment, "comment", "c", "", "комментарий")
+	expiresInFlag(cmd, &expiresIn)
+	return cmd
+}
+
+// expiresInFlag добавляет команде сохранения данных флаг срока их хранения.
+func expiresInFlag(cmd *cobra.Command, expiresIn *time.Duration) {
+	cmd.Flags().DurationVar(expiresIn, "expires-in", 0,
+		"срок хранения, после которого данные удаляются с сервера, например 24h (по умолчанию бессрочно)")
+}
+
+// UpdateDataCmd возвращает команду cobra для изменения данных.
+func (c *CLI) UpdateDataCmd(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Изменить",
+		Long: "Заменить данные в хранилище по id. Если указана версия (--rev), изменения сохраняются, " +
+			"только если с момента ее загрузки данные не менялись",
+	}
+
+	cmd.AddCommand(
+		c.UpdatePasswordCmd(ctx),
+		c.UpdateTextCmd(ctx),
+		c.UpdateBankCardCmd(ctx),
+		c.UpdateFileCmd(ctx),
+	)
+
+	return cmd
+}
+
+// addUpdateFlags добавляет команде изменения флаги id данных и версии, на основе которой сделаны изменения.
+func addUpdateFlags(cmd *cobra.Command, id *string, revision *int64) {
+	cmd.Flags().StringVar(id, "id", "", "id данных для изменения")
+	_ = cmd.MarkFlagRequired("id")
+	cmd.Flags().Int64Var(revision, "rev", 0, "версия данных, на основе которой сделаны изменения (0 - без проверки)")
+}
+
+// printUpdated выводит результат изменения данных.
+func printUpdated(revision int64, err error) error {
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Данные успешно изменены, новая версия: %d\n", revision)
+	return nil
+}
+
+// UpdatePasswordCmd возвращает команду cobra для изменения пароля.
+func (c *CLI) UpdatePasswordCmd(ctx context.Context) *cobra.Command {
+	var id, password, login, resource, comment string
+	var revision int64
+	cmd := &cobra.Command{
+		Use:   "password",
+		Short: "Изменить пароль",
+		Long:  "Заменить сохраненный в хранилище пароль",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return printUpdated(c.service.UpdatePassword(ctx, id, revision, password, model.PasswordMeta{
+				Resource: resource,
+				Login:    login,
+				Comment:  comment,
+			}))
+		},
+	}
+	addUpdateFlags(cmd, &id, &revision)
+	cmd.Flags().StringVarP(&password, "password", "p", "", "пароль для хранения")
+	_ = cmd.MarkFlagRequired("password")
+	cmd.Flags().StringVarP(&login, "login", "l", "", "логин от ресурса")
+	_ = cmd.MarkFlagRequired("login")
+	cmd.Flags().StringVarP(&resource, "resource", "r", "", "название ресурса")
+	_ = cmd.MarkFlagRequired("resource")
+	cmd.Flags().StringVarP(&comment, "comment", "c", "", "комментарий")
+	return cmd
+}
+
+// UpdateTextCmd возвращает команду cobra для изменения текстовой информации.
+func (c *CLI) UpdateTextCmd(ctx context.Context) *cobra.Command {
+	var id, data, name, comment string
+	var revision int64
+	cmd := &cobra.Command{
+		Use:   "text",
+		Short: "Изменить текст",
+		Long:  "Заменить сохраненную в хранилище текстовую информацию",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return printUpdated(c.service.UpdateText(ctx, id, revision, data, model.TextMeta{
+				Name:    name,
+				Comment: comment,
+			}))
+		},
+	}
+	addUpdateFlags(cmd, &id, &revision)
+	cmd.Flags().StringVarP(&data, "text", "t", "", "текстовые данные")
+	_ = cmd.MarkFlagRequired("text")
+	cmd.Flags().StringVarP(&name, "name", "n", "", "название текста")
+	_ = cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&comment, "comment", "c", "", "комментарий")
+	return cmd
+}
+
+// UpdateBankCardCmd возвращает команду cobra для изменения данных банковской карты.
+func (c *CLI) UpdateBankCardCmd(ctx context.Context) *cobra.Command {
+	cardData := model.BankCardData{}
+	var id, bankName, comment string
+	var revision int64
+	cmd := &cobra.Command{
+		Use:   "bcard",
+		Short: "Изменить карту",
+		Long:  "Заменить сохраненные в хранилище данные банковской карты",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return printUpdated(c.service.UpdateBankCard(ctx, id, revision, cardData, model.BankCardMeta{
+				Bank:    bankName,
+				Comment: comment,
+			}))
+		},
+	}
+	addUpdateFlags(cmd, &id, &revision)
+	cmd.Flags().StringVarP(&cardData.Holder, "owner", "o", "", "держатель")
+	_ = cmd.MarkFlagRequired("owner")
+	cmd.Flags().StringVarP(&cardData.Number, "number", "n", "", "номер карты")
+	_ = cmd.MarkFlagRequired("number")
+	cmd.Flags().StringVarP(&cardData.CSV, "csv", "s", "", "csv код")
+	_ = cmd.MarkFlagRequired("csv")
+	cmd.Flags().IntVarP(&cardData.ValidMonth, "month", "m", 0, "месяц действия до")
+	_ = cmd.MarkFlagRequired("month")
+	cmd.Flags().IntVarP(&cardData.ValidYear, "year", "y", 0, "год действия до")
+	_ = cmd.MarkFlagRequired("year")
+	cmd.Flags().StringVarP(&bankName, "bank", "b", "", "банк")
+	_ = cmd.MarkFlagRequired("bank")
+	cmd.Flags().StringVarP(&comment, "comment", "c", "", "комментарий")
+	return cmd
+}
+
+// UpdateFileCmd возвращает команду cobra для замены файла.
+func (c *CLI) UpdateFileCmd(ctx context.Context) *cobra.Command {
+	var id, file, comment string
+	var revision int64
+	cmd := &cobra.Command{
+		Use:   "file",
+		Short: "Заменить файл",
+		Long:  "Заменить сохраненный в хранилище файл",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return printUpdated(c.service.UpdateFile(ctx, id, revision, file, comment))
+		},
+	}
+	addUpdateFlags(cmd, &id, &revision)
+	cmd.Flags().StringVarP(&file, "path", "p", "", "файл")
+	_ = cmd.MarkFlagRequired("path")
+	cmd.Flags().StringVarP(&comment, "comment", "c", "", "комментарий")
 	return cmd
 }
@@ -64,6 +64,20 @@ func SaveJWT(jwt string) error {
 	return nil
 }
 
+// GetOrg возвращает id организации, с данными которой работают команды хранилища.
+func GetOrg() string {
+	return viper.GetString("org")
+}
+
+// SaveOrg сохраняет id организации для команд хранилища. Пустой id - работа с личными данными.
+func SaveOrg(org string) error {
+	viper.Set("org", org)
+	if err := viper.WriteConfig(); err != nil {
+		return fmt.Errorf("не удалось сохранить организацию: %w", err)
+	}
+	return nil
+}
+
 // GetBuildInfo возвращает инфо о сборке - версию и дату
 func GetBuildInfo() (string, string) {
 	return viper.GetString("Version"), viper.GetString("BuildTime")
@@ -13,6 +13,7 @@ import (
 type Client struct {
 	UserClient  pb.UserServiceClient
 	VaultClient pb.VaultServiceClient
+	OrgClient   pb.OrgServiceClient
 }
 
 // NewGRPCConnection создает и возвращает новый grpc клиент.
@@ -25,6 +26,7 @@ func NewGRPCConnection(cfg *config.ClientConfig) (*Client, error) {
 	conn, err := grpc.NewClient(cfg.ServerAddress,
 		grpc.WithTransportCredentials(creds),
 		grpc.WithUnaryInterceptor(interceptors.TokenInterceptor()),
+		grpc.WithStreamInterceptor(interceptors.StreamTokenInterceptor()),
 	)
 	if err != nil {
 		return nil, err
@@ -32,5 +34,6 @@ func NewGRPCConnection(cfg *config.ClientConfig) (*Client, error) {
 	return &Client{
 		UserClient:  pb.NewUserServiceClient(conn),
 		VaultClient: pb.NewVaultServiceClient(conn),
+		OrgClient:   pb.NewOrgServiceClient(conn),
 	}, nil
 }
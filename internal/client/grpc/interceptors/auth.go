@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/pinbrain/gophkeeper/internal/client/config"
+	"github.com/pinbrain/gophkeeper/internal/model"
 	pb "github.com/pinbrain/gophkeeper/internal/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -25,23 +26,52 @@ func TokenInterceptor() grpc.UnaryClientInterceptor {
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
-		jwt := config.GetJWT()
-		if jwt != "" && strings.Split(method, "/")[1] != pb.UserService_ServiceDesc.ServiceName {
-			md := metadata.Pairs(config.GetJWTMetaKey(), jwt)
-			ctx = metadata.NewOutgoingContext(ctx, md)
-		}
+		err := invoker(withToken(ctx, method), method, req, reply, cc, opts...)
+		checkUnauthenticated(err)
+		return err
+	}
+}
 
-		err := invoker(ctx, method, req, reply, cc, opts...)
-		if err != nil {
-			if s, ok := status.FromError(err); ok && s.Code() == codes.Unauthenticated {
-				fmt.Println("Unauthenticated error received. Deleting token.")
+// StreamTokenInterceptor проставляет в метаданные для каждого исходящего потокового grpc запроса jwt.
+func StreamTokenInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		stream, err := streamer(withToken(ctx, method), desc, cc, method, opts...)
+		checkUnauthenticated(err)
+		return stream, err
+	}
+}
 
-				if jwtErr := config.SaveJWT(""); jwtErr != nil {
-					log.Fatalf("Error clearing jwt from config file: %v", jwtErr)
-				}
-			}
+// withToken добавляет jwt в метаданные запроса, кроме запросов регистрации и входа.
+// В запросы к хранилищу также добавляется выбранная организация.
+func withToken(ctx context.Context, method string) context.Context {
+	jwt := config.GetJWT()
+	if jwt != "" && method != pb.UserService_Register_FullMethodName && method != pb.UserService_Login_FullMethodName {
+		md := metadata.Pairs(config.GetJWTMetaKey(), jwt)
+		if org := config.GetOrg(); org != "" && strings.HasPrefix(method, "/"+pb.VaultService_ServiceDesc.ServiceName+"/") {
+			md.Set(model.OrgMDKey, org)
 		}
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+	return ctx
+}
 
-		return err
+// checkUnauthenticated удаляет сохраненный jwt, если сервер его не принял.
+func checkUnauthenticated(err error) {
+	if err == nil {
+		return
+	}
+	if s, ok := status.FromError(err); ok && s.Code() == codes.Unauthenticated {
+		fmt.Println("Unauthenticated error received. Deleting token.")
+
+		if jwtErr := config.SaveJWT(""); jwtErr != nil {
+			log.Fatalf("Error clearing jwt from config file: %v", jwtErr)
+		}
 	}
 }
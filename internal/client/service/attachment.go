@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	"google.golang.org/grpc/status"
+)
+
+// AttachFile прикрепляет файл к данным и возвращает id вложения. Файл передается на сервер потоком частей.
+func (s *Service) AttachFile(ctx context.Context, id string, file string, comment string) (string, error) {
+	res, err := s.uploadFile(ctx, &proto.UploadFileReq{ParentId: id}, file, comment)
+	if err != nil {
+		return "", err
+	}
+	return res.GetId(), nil
+}
+
+// ListAttachments получает список файлов, прикрепленных к данным.
+func (s *Service) ListAttachments(ctx context.Context, id string) ([]model.AttachmentInfo, error) {
+	res, err := s.grpcClient.VaultClient.GetData(ctx, &proto.GetDataReq{Id: id, SkipFileContent: true})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, fmt.Errorf("не удалось получить вложения: %s", s.Message())
+		}
+		return nil, err
+	}
+	attachments := make([]model.AttachmentInfo, 0, len(res.GetAttachments()))
+	for _, attachment := range res.GetAttachments() {
+		var meta model.FileMeta
+		if err = json.Unmarshal([]byte(attachment.GetMeta()), &meta); err != nil {
+			return nil, fmt.Errorf("не удалось прочитать мета данные вложения: %w", err)
+		}
+		attachments = append(attachments, model.AttachmentInfo{
+			ID:        attachment.GetId(),
+			Meta:      &meta,
+			Size:      attachment.GetSize(),
+			CreatedAt: attachment.GetCreatedAt().AsTime(),
+		})
+	}
+	return attachments, nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/client/grpc"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/proto/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAttachFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	require.NoError(t, os.WriteFile("test_file", []byte("recovery codes"), 0o600))
+	defer os.Remove("test_file")
+
+	stream := mocks.NewMockVaultService_UploadFileClient(ctrl)
+	var requests []*proto.UploadFileReq
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *proto.UploadFileReq) error {
+		requests = append(requests, req)
+		return nil
+	}).Times(1)
+	stream.EXPECT().CloseAndRecv().DoAndReturn(func() (*proto.UploadFileRes, error) {
+		require.Len(t, requests, 1)
+		assert.Equal(t, "1", requests[0].GetParentId())
+		assert.Empty(t, requests[0].GetId())
+		assert.Equal(t, []byte("recovery codes"), requests[0].GetChunk())
+		return &proto.UploadFileRes{Id: "2", Revision: 1}, nil
+	})
+	vaultSrvGRPCMock.EXPECT().UploadFile(gomock.Any()).Return(stream, nil)
+
+	id, err := service.AttachFile(context.Background(), "1", "test_file", "")
+	require.NoError(t, err)
+	assert.Equal(t, "2", id)
+}
+
+func TestListAttachments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	vaultSrvGRPCMock.EXPECT().GetData(gomock.Any(), &proto.GetDataReq{Id: "1", SkipFileContent: true}).
+		Times(1).Return(&proto.GetDataRes{
+		Id:   "1",
+		Item: &proto.Item{Type: string(model.Password), Meta: `{"resource":"site"}`},
+		Attachments: []*proto.GetDataRes_Attachment{
+			{
+				Id: "2", Meta: `{"name":"codes.txt","extension":".txt"}`, Size: 14,
+				CreatedAt: timestamppb.New(created),
+			},
+		},
+	}, nil)
+	attachments, err := service.ListAttachments(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, []model.AttachmentInfo{
+		{ID: "2", Meta: &model.FileMeta{Name: "codes.txt", Extension: ".txt"}, Size: 14, CreatedAt: created},
+	}, attachments)
+
+	vaultSrvGRPCMock.EXPECT().GetData(gomock.Any(), &proto.GetDataReq{Id: "3", SkipFileContent: true}).
+		Times(1).Return(nil, status.Error(codes.NotFound, "Данные не найдены"))
+	_, err = service.ListAttachments(context.Background(), "3")
+	require.ErrorContains(t, err, "Данные не найдены")
+}
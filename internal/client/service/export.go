@@ -0,0 +1,162 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ExportVault получает все данные пользователя одним потоком и записывает их в w
+// по одной записи JSON в строке. Возвращает количество выгруженных данных.
+func (s *Service) ExportVault(ctx context.Context, w io.Writer) (int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := s.grpcClient.VaultClient.ExportVault(ctx, &proto.ExportVaultReq{})
+	if err != nil {
+		return 0, exportError(err)
+	}
+	enc := json.NewEncoder(w)
+	var (
+		record *model.ExportRecord
+		size   int64
+		count  int
+	)
+	// flush записывает полностью полученные данные.
+	flush := func() error {
+		if record == nil {
+			return nil
+		}
+		if int64(len(record.Data)) != size {
+			return fmt.Errorf("данные %s получены не полностью: получено %d из %d байт", record.ID, len(record.Data), size)
+		}
+		if err := enc.Encode(record); err != nil {
+			return fmt.Errorf("не удалось записать данные: %w", err)
+		}
+		count++
+		return nil
+	}
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return count, exportError(err)
+		}
+		if r := res.GetRecord(); r != nil {
+			if err = flush(); err != nil {
+				return count, err
+			}
+			record = &model.ExportRecord{
+				ID:        r.GetId(),
+				Type:      model.DataType(r.GetType()),
+				Meta:      r.GetMeta(),
+				Data:      make([]byte, 0, r.GetSize()),
+				ParentID:  r.GetParentId(),
+				CreatedAt: r.GetCreatedAt().AsTime(),
+				UpdatedAt: r.GetUpdatedAt().AsTime(),
+				ExpiresAt: optionalTime(r.GetExpiresAt()),
+			}
+			size = r.GetSize()
+		} else if record == nil {
+			return count, errors.New("не удалось выгрузить данные: получены данные без описания")
+		}
+		record.Data = append(record.Data, res.GetChunk()...)
+	}
+	if err = flush(); err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// ImportVault передает на сервер одним потоком данные, выгруженные ExportVault.
+// Сервер сохраняет данные в одной транзакции: при ошибках в отдельных записях не сохраняется ничего,
+// а ошибки возвращаются в результате.
+func (s *Service) ImportVault(ctx context.Context, r io.Reader) (*model.ImportResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := s.grpcClient.VaultClient.ImportVault(ctx)
+	if err != nil {
+		return nil, importError(err)
+	}
+	dec := json.NewDecoder(bufio.NewReader(r))
+	for index := 0; ; index++ {
+		var record model.ExportRecord
+		if err = dec.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("не удалось прочитать запись %d: %w", index, err)
+		}
+		if err = sendImportRecord(stream, &record); err != nil {
+			// Причину ошибки отправки возвращает CloseAndRecv.
+			break
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, importError(err)
+	}
+	result := &model.ImportResult{Imported: res.GetImported()}
+	for _, failure := range res.GetFailures() {
+		result.Failures = append(result.Failures, model.ImportFailure{
+			Index: failure.GetIndex(),
+			ID:    failure.GetId(),
+			Error: failure.GetError(),
+		})
+	}
+	return result, nil
+}
+
+// sendImportRecord передает описание данных и сами данные частями размера fileChunkSize.
+func sendImportRecord(stream proto.VaultService_ImportVaultClient, record *model.ExportRecord) error {
+	req := &proto.ImportVaultReq{Record: &proto.VaultRecord{
+		Id:        record.ID,
+		Type:      string(record.Type),
+		Meta:      record.Meta,
+		Size:      int64(len(record.Data)),
+		ParentId:  record.ParentID,
+		CreatedAt: timestamppb.New(record.CreatedAt),
+		UpdatedAt: timestamppb.New(record.UpdatedAt),
+	}}
+	if record.ExpiresAt != nil {
+		req.Record.ExpiresAt = timestamppb.New(*record.ExpiresAt)
+	}
+	data := record.Data
+	for {
+		n := min(fileChunkSize, len(data))
+		req.Chunk = data[:n]
+		if err := stream.Send(req); err != nil {
+			return err
+		}
+		data = data[n:]
+		if len(data) == 0 {
+			return nil
+		}
+		req = &proto.ImportVaultReq{}
+	}
+}
+
+// exportError формирует ошибку выгрузки данных.
+func exportError(err error) error {
+	if s, ok := status.FromError(err); ok {
+		return fmt.Errorf("не удалось выгрузить данные: %s", s.Message())
+	}
+	return err
+}
+
+// importError формирует ошибку загрузки данных.
+func importError(err error) error {
+	if s, ok := status.FromError(err); ok {
+		return fmt.Errorf("не удалось загрузить данные: %s", s.Message())
+	}
+	return err
+}
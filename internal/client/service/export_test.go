@@ -0,0 +1,130 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/client/grpc"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/proto/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestExportVault(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	responses := []*proto.ExportVaultRes{
+		{Record: &proto.VaultRecord{
+			Id: "1", Type: string(model.Text), Meta: `{"name":"note"}`, Size: 4,
+			CreatedAt: timestamppb.New(created), UpdatedAt: timestamppb.New(created),
+		}},
+		{Chunk: []byte("te")},
+		{Chunk: []byte("xt")},
+		{Record: &proto.VaultRecord{
+			Id: "2", Type: string(model.File), Meta: `{"name":"codes.txt"}`, ParentId: "1",
+			CreatedAt: timestamppb.New(created), UpdatedAt: timestamppb.New(created),
+			ExpiresAt: timestamppb.New(created.Add(time.Hour)),
+		}},
+	}
+	stream := mocks.NewMockVaultService_ExportVaultClient(ctrl)
+	stream.EXPECT().Recv().DoAndReturn(func() (*proto.ExportVaultRes, error) {
+		if len(responses) == 0 {
+			return nil, io.EOF
+		}
+		res := responses[0]
+		responses = responses[1:]
+		return res, nil
+	}).AnyTimes()
+	vaultSrvGRPCMock.EXPECT().ExportVault(gomock.Any(), &proto.ExportVaultReq{}).Return(stream, nil)
+
+	var buf bytes.Buffer
+	count, err := service.ExportVault(context.Background(), &buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var record model.ExportRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &record))
+	assert.Equal(t, model.ExportRecord{
+		ID: "1", Type: model.Text, Meta: `{"name":"note"}`, Data: []byte("text"), CreatedAt: created, UpdatedAt: created,
+	}, record)
+	record = model.ExportRecord{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+	assert.Equal(t, "1", record.ParentID)
+	require.NotNil(t, record.ExpiresAt)
+	assert.Equal(t, created.Add(time.Hour), *record.ExpiresAt)
+
+	vaultSrvGRPCMock.EXPECT().ExportVault(gomock.Any(), &proto.ExportVaultReq{}).
+		Return(nil, status.Error(codes.Unavailable, "Сервер недоступен"))
+	_, err = service.ExportVault(context.Background(), &buf)
+	require.ErrorContains(t, err, "Сервер недоступен")
+}
+
+func TestImportVault(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	content := bytes.Repeat([]byte("x"), fileChunkSize+10)
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	require.NoError(t, enc.Encode(model.ExportRecord{
+		ID: "1", Type: model.Text, Meta: `{"name":"note"}`, Data: []byte("text"), CreatedAt: created, UpdatedAt: created,
+	}))
+	require.NoError(t, enc.Encode(model.ExportRecord{
+		ID: "2", Type: model.File, Meta: `{"name":"codes.txt"}`, Data: content, ParentID: "1",
+	}))
+
+	stream := mocks.NewMockVaultService_ImportVaultClient(ctrl)
+	var requests []*proto.ImportVaultReq
+	stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *proto.ImportVaultReq) error {
+		requests = append(requests, req)
+		return nil
+	}).Times(3)
+	stream.EXPECT().CloseAndRecv().DoAndReturn(func() (*proto.ImportVaultRes, error) {
+		require.Len(t, requests, 3)
+		assert.Equal(t, "1", requests[0].GetRecord().GetId())
+		assert.Equal(t, int64(4), requests[0].GetRecord().GetSize())
+		assert.Equal(t, created, requests[0].GetRecord().GetCreatedAt().AsTime())
+		assert.Equal(t, []byte("text"), requests[0].GetChunk())
+		assert.Equal(t, "1", requests[1].GetRecord().GetParentId())
+		assert.Equal(t, int64(len(content)), requests[1].GetRecord().GetSize())
+		assert.Len(t, requests[1].GetChunk(), fileChunkSize)
+		assert.Nil(t, requests[2].GetRecord())
+		assert.Len(t, requests[2].GetChunk(), 10)
+		return &proto.ImportVaultRes{Failures: []*proto.ImportVaultRes_Failure{
+			{Index: 1, Id: "2", Error: "Превышен размер данных"},
+		}}, nil
+	})
+	vaultSrvGRPCMock.EXPECT().ImportVault(gomock.Any()).Return(stream, nil)
+
+	result, err := service.ImportVault(context.Background(), &buf)
+	require.NoError(t, err)
+	assert.Equal(t, &model.ImportResult{Failures: []model.ImportFailure{
+		{Index: 1, ID: "2", Error: "Превышен размер данных"},
+	}}, result)
+
+	stream = mocks.NewMockVaultService_ImportVaultClient(ctrl)
+	vaultSrvGRPCMock.EXPECT().ImportVault(gomock.Any()).Return(stream, nil)
+	_, err = service.ImportVault(context.Background(), strings.NewReader("not json"))
+	require.ErrorContains(t, err, "не удалось прочитать запись 0")
+}
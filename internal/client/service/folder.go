@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	"google.golang.org/grpc/status"
+)
+
+// CreateFolder создает папку (вложенную в parentID, если он указан) и возвращает ее id.
+func (s *Service) CreateFolder(ctx context.Context, name string, parentID string) (string, error) {
+	res, err := s.grpcClient.VaultClient.CreateFolder(ctx, &proto.CreateFolderReq{
+		Name:     name,
+		ParentId: parentID,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return "", fmt.Errorf("не удалось создать папку: %s", s.Message())
+		}
+		return "", err
+	}
+	return res.GetFolderId(), nil
+}
+
+// ListFolders получает папки пользователя в порядке их создания.
+func (s *Service) ListFolders(ctx context.Context) ([]model.Folder, error) {
+	res, err := s.grpcClient.VaultClient.ListFolders(ctx, &proto.ListFoldersReq{})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, fmt.Errorf("не удалось получить список папок: %s", s.Message())
+		}
+		return nil, err
+	}
+	folders := []model.Folder{}
+	for _, folder := range res.GetFolders() {
+		folders = append(folders, model.Folder{
+			ID:        folder.GetId(),
+			ParentID:  folder.GetParentId(),
+			Name:      folder.GetName(),
+			CreatedAt: folder.GetCreatedAt().AsTime(),
+		})
+	}
+	return folders, nil
+}
+
+// DeleteFolder удаляет папку. Ее данные и вложенные папки переходят в родительскую папку.
+func (s *Service) DeleteFolder(ctx context.Context, id string) error {
+	_, err := s.grpcClient.VaultClient.DeleteFolder(ctx, &proto.DeleteFolderReq{FolderId: id})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось удалить папку: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// MoveItem перемещает данные в папку; при пустом folderID данные убираются из папки.
+func (s *Service) MoveItem(ctx context.Context, id string, folderID string) error {
+	_, err := s.grpcClient.VaultClient.MoveItem(ctx, &proto.MoveItemReq{Id: id, FolderId: folderID})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось переместить данные: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// TagItem добавляет данным метку.
+func (s *Service) TagItem(ctx context.Context, id string, tag string) error {
+	_, err := s.grpcClient.VaultClient.TagItem(ctx, &proto.TagItemReq{Id: id, Tag: tag})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось добавить метку: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// UntagItem снимает метку с данных.
+func (s *Service) UntagItem(ctx context.Context, id string, tag string) error {
+	_, err := s.grpcClient.VaultClient.UntagItem(ctx, &proto.UntagItemReq{Id: id, Tag: tag})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось снять метку: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// SetFavorite закрепляет данные в избранном или убирает их оттуда.
+func (s *Service) SetFavorite(ctx context.Context, id string, favorite bool) error {
+	_, err := s.grpcClient.VaultClient.SetFavorite(ctx, &proto.SetFavoriteReq{Id: id, Favorite: favorite})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось изменить избранное: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// ListTags получает метки пользователя с количеством данных с каждой из них.
+func (s *Service) ListTags(ctx context.Context) ([]model.Tag, error) {
+	res, err := s.grpcClient.VaultClient.ListTags(ctx, &proto.ListTagsReq{})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, fmt.Errorf("не удалось получить список меток: %s", s.Message())
+		}
+		return nil, err
+	}
+	tags := []model.Tag{}
+	for _, tag := range res.GetTags() {
+		tags = append(tags, model.Tag{Name: tag.GetName(), Items: tag.GetItems()})
+	}
+	return tags, nil
+}
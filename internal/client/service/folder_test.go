@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/client/grpc"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/proto/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().CreateFolder(gomock.Any(), &proto.CreateFolderReq{Name: "work", ParentId: "parent"}).
+		Times(1).Return(&proto.CreateFolderRes{FolderId: "folder"}, nil)
+	id, err := service.CreateFolder(context.Background(), "work", "parent")
+	require.NoError(t, err)
+	assert.Equal(t, "folder", id)
+
+	vaultSrvGRPCMock.EXPECT().CreateFolder(gomock.Any(), &proto.CreateFolderReq{Name: "work", ParentId: "unknown"}).
+		Times(1).Return(nil, status.Error(codes.NotFound, "Родительская папка не найдена"))
+	_, err = service.CreateFolder(context.Background(), "work", "unknown")
+	require.ErrorContains(t, err, "Родительская папка не найдена")
+}
+
+func TestListFolders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	vaultSrvGRPCMock.EXPECT().ListFolders(gomock.Any(), &proto.ListFoldersReq{}).
+		Times(1).Return(&proto.ListFoldersRes{
+		Folders: []*proto.Folder{
+			{Id: "work", Name: "work", CreatedAt: timestamppb.New(created)},
+			{Id: "projects", Name: "projects", ParentId: "work", CreatedAt: timestamppb.New(created)},
+		},
+	}, nil)
+	folders, err := service.ListFolders(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []model.Folder{
+		{ID: "work", Name: "work", CreatedAt: created},
+		{ID: "projects", Name: "projects", ParentID: "work", CreatedAt: created},
+	}, folders)
+}
+
+func TestDeleteFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().DeleteFolder(gomock.Any(), &proto.DeleteFolderReq{FolderId: "folder"}).
+		Times(1).Return(&proto.DeleteFolderRes{}, nil)
+	require.NoError(t, service.DeleteFolder(context.Background(), "folder"))
+}
+
+func TestMoveItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().MoveItem(gomock.Any(), &proto.MoveItemReq{Id: "1", FolderId: "folder"}).
+		Times(1).Return(&proto.MoveItemRes{}, nil)
+	require.NoError(t, service.MoveItem(context.Background(), "1", "folder"))
+
+	vaultSrvGRPCMock.EXPECT().MoveItem(gomock.Any(), &proto.MoveItemReq{Id: "1", FolderId: "unknown"}).
+		Times(1).Return(nil, status.Error(codes.NotFound, "Папка не найдена"))
+	require.ErrorContains(t, service.MoveItem(context.Background(), "1", "unknown"), "Папка не найдена")
+}
+
+func TestTagItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().TagItem(gomock.Any(), &proto.TagItemReq{Id: "1", Tag: "work"}).
+		Times(1).Return(&proto.TagItemRes{}, nil)
+	require.NoError(t, service.TagItem(context.Background(), "1", "work"))
+
+	vaultSrvGRPCMock.EXPECT().UntagItem(gomock.Any(), &proto.UntagItemReq{Id: "1", Tag: "work"}).
+		Times(1).Return(nil, status.Error(codes.NotFound, "У данных нет такой метки"))
+	require.ErrorContains(t, service.UntagItem(context.Background(), "1", "work"), "У данных нет такой метки")
+}
+
+func TestSetFavorite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().SetFavorite(gomock.Any(), &proto.SetFavoriteReq{Id: "1", Favorite: true}).
+		Times(1).Return(&proto.SetFavoriteRes{}, nil)
+	require.NoError(t, service.SetFavorite(context.Background(), "1", true))
+
+	vaultSrvGRPCMock.EXPECT().SetFavorite(gomock.Any(), &proto.SetFavoriteReq{Id: "2"}).
+		Times(1).Return(nil, status.Error(codes.NotFound, "Данные не найдены"))
+	require.ErrorContains(t, service.SetFavorite(context.Background(), "2", false), "Данные не найдены")
+}
+
+func TestListTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().ListTags(gomock.Any(), &proto.ListTagsReq{}).
+		Times(1).Return(&proto.ListTagsRes{
+		Tags: []*proto.ListTagsRes_Tag{{Name: "work", Items: 2}},
+	}, nil)
+	tags, err := service.ListTags(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []model.Tag{{Name: "work", Items: 2}}, tags)
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	"google.golang.org/grpc/status"
+)
+
+// CreateOrg создает организацию и возвращает ее id. Пользователь становится ее владельцем.
+func (s *Service) CreateOrg(ctx context.Context, name string) (string, error) {
+	res, err := s.grpcClient.OrgClient.CreateOrg(ctx, &proto.CreateOrgReq{Name: name})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return "", fmt.Errorf("не удалось создать организацию: %s", s.Message())
+		}
+		return "", err
+	}
+	return res.GetOrgId(), nil
+}
+
+// ListOrgs получает список организаций пользователя.
+func (s *Service) ListOrgs(ctx context.Context) ([]model.OrgInfo, error) {
+	res, err := s.grpcClient.OrgClient.ListOrgs(ctx, &proto.ListOrgsReq{})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, fmt.Errorf("не удалось получить список организаций: %s", s.Message())
+		}
+		return nil, err
+	}
+	orgs := make([]model.OrgInfo, 0, len(res.GetOrgs()))
+	for _, org := range res.GetOrgs() {
+		orgs = append(orgs, model.OrgInfo{
+			ID:        org.GetOrgId(),
+			Name:      org.GetName(),
+			Role:      model.OrgRole(org.GetRole()),
+			CreatedAt: org.GetCreatedAt().AsTime(),
+		})
+	}
+	return orgs, nil
+}
+
+// DeleteOrg удаляет организацию вместе со всеми ее данными.
+func (s *Service) DeleteOrg(ctx context.Context, id string) error {
+	_, err := s.grpcClient.OrgClient.DeleteOrg(ctx, &proto.DeleteOrgReq{OrgId: id})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось удалить организацию: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// AddOrgMember добавляет пользователя с логином login в организацию с ролью role.
+func (s *Service) AddOrgMember(ctx context.Context, id string, login string, role model.OrgRole) error {
+	_, err := s.grpcClient.OrgClient.AddOrgMember(ctx, &proto.AddOrgMemberReq{
+		OrgId: id, Login: login, Role: string(role),
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось добавить участника: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// SetOrgMemberRole меняет роль участника организации.
+func (s *Service) SetOrgMemberRole(ctx context.Context, id string, login string, role model.OrgRole) error {
+	_, err := s.grpcClient.OrgClient.SetOrgMemberRole(ctx, &proto.SetOrgMemberRoleReq{
+		OrgId: id, Login: login, Role: string(role),
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось изменить роль участника: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// RemoveOrgMember удаляет участника из организации.
+func (s *Service) RemoveOrgMember(ctx context.Context, id string, login string) error {
+	_, err := s.grpcClient.OrgClient.RemoveOrgMember(ctx, &proto.RemoveOrgMemberReq{OrgId: id, Login: login})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось удалить участника: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// ListOrgMembers получает список участников организации.
+func (s *Service) ListOrgMembers(ctx context.Context, id string) ([]model.OrgMember, error) {
+	res, err := s.grpcClient.OrgClient.ListOrgMembers(ctx, &proto.ListOrgMembersReq{OrgId: id})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, fmt.Errorf("не удалось получить список участников: %s", s.Message())
+		}
+		return nil, err
+	}
+	members := make([]model.OrgMember, 0, len(res.GetMembers()))
+	for _, member := range res.GetMembers() {
+		members = append(members, model.OrgMember{
+			OrgID:     id,
+			Login:     member.GetLogin(),
+			Role:      model.OrgRole(member.GetRole()),
+			CreatedAt: member.GetCreatedAt().AsTime(),
+		})
+	}
+	return members, nil
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/client/grpc"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/proto/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateOrg(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orgSrvGRPCMock := mocks.NewMockOrgServiceClient(ctrl)
+	service := NewService(&grpc.Client{OrgClient: orgSrvGRPCMock})
+
+	orgSrvGRPCMock.EXPECT().CreateOrg(gomock.Any(), &proto.CreateOrgReq{Name: "team"}).
+		Times(1).Return(&proto.CreateOrgRes{OrgId: "org"}, nil)
+	id, err := service.CreateOrg(context.Background(), "team")
+	require.NoError(t, err)
+	assert.Equal(t, "org", id)
+
+	orgSrvGRPCMock.EXPECT().CreateOrg(gomock.Any(), &proto.CreateOrgReq{Name: "team"}).
+		Times(1).Return(nil, status.Error(codes.FailedPrecondition, "Нет ключей"))
+	_, err = service.CreateOrg(context.Background(), "team")
+	require.ErrorContains(t, err, "Нет ключей")
+}
+
+func TestListOrgs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orgSrvGRPCMock := mocks.NewMockOrgServiceClient(ctrl)
+	service := NewService(&grpc.Client{OrgClient: orgSrvGRPCMock})
+
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	orgSrvGRPCMock.EXPECT().ListOrgs(gomock.Any(), &proto.ListOrgsReq{}).
+		Times(1).Return(&proto.ListOrgsRes{
+		Orgs: []*proto.ListOrgsRes_Org{
+			{OrgId: "org", Name: "team", Role: string(model.OrgRoleAdmin), CreatedAt: timestamppb.New(created)},
+		},
+	}, nil)
+	orgs, err := service.ListOrgs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []model.OrgInfo{
+		{ID: "org", Name: "team", Role: model.OrgRoleAdmin, CreatedAt: created},
+	}, orgs)
+}
+
+func TestDeleteOrg(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orgSrvGRPCMock := mocks.NewMockOrgServiceClient(ctrl)
+	service := NewService(&grpc.Client{OrgClient: orgSrvGRPCMock})
+
+	orgSrvGRPCMock.EXPECT().DeleteOrg(gomock.Any(), &proto.DeleteOrgReq{OrgId: "org"}).
+		Times(1).Return(nil, status.Error(codes.PermissionDenied, "Удалить организацию может только владелец"))
+	err := service.DeleteOrg(context.Background(), "org")
+	require.ErrorContains(t, err, "только владелец")
+}
+
+func TestOrgMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orgSrvGRPCMock := mocks.NewMockOrgServiceClient(ctrl)
+	service := NewService(&grpc.Client{OrgClient: orgSrvGRPCMock})
+	ctx := context.Background()
+
+	orgSrvGRPCMock.EXPECT().AddOrgMember(gomock.Any(), &proto.AddOrgMemberReq{
+		OrgId: "org", Login: "friend", Role: string(model.OrgRoleReader),
+	}).Times(1).Return(&proto.AddOrgMemberRes{}, nil)
+	require.NoError(t, service.AddOrgMember(ctx, "org", "friend", model.OrgRoleReader))
+
+	orgSrvGRPCMock.EXPECT().SetOrgMemberRole(gomock.Any(), &proto.SetOrgMemberRoleReq{
+		OrgId: "org", Login: "friend", Role: string(model.OrgRoleMember),
+	}).Times(1).Return(&proto.SetOrgMemberRoleRes{}, nil)
+	require.NoError(t, service.SetOrgMemberRole(ctx, "org", "friend", model.OrgRoleMember))
+
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	orgSrvGRPCMock.EXPECT().ListOrgMembers(gomock.Any(), &proto.ListOrgMembersReq{OrgId: "org"}).
+		Times(1).Return(&proto.ListOrgMembersRes{
+		Members: []*proto.ListOrgMembersRes_Member{
+			{Login: "user", Role: string(model.OrgRoleOwner), CreatedAt: timestamppb.New(created)},
+			{Login: "friend", Role: string(model.OrgRoleMember), CreatedAt: timestamppb.New(created)},
+		},
+	}, nil)
+	members, err := service.ListOrgMembers(ctx, "org")
+	require.NoError(t, err)
+	assert.Equal(t, []model.OrgMember{
+		{OrgID: "org", Login: "user", Role: model.OrgRoleOwner, CreatedAt: created},
+		{OrgID: "org", Login: "friend", Role: model.OrgRoleMember, CreatedAt: created},
+	}, members)
+
+	orgSrvGRPCMock.EXPECT().RemoveOrgMember(gomock.Any(), &proto.RemoveOrgMemberReq{OrgId: "org", Login: "friend"}).
+		Times(1).Return(&proto.RemoveOrgMemberRes{}, nil)
+	require.NoError(t, service.RemoveOrgMember(ctx, "org", "friend"))
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	"google.golang.org/grpc/status"
+)
+
+// ShareItem открывает пользователю с логином login доступ к данным на чтение или на чтение и изменение.
+func (s *Service) ShareItem(ctx context.Context, id string, login string, write bool) error {
+	_, err := s.grpcClient.VaultClient.ShareItem(ctx, &proto.ShareItemReq{Id: id, Login: login, Write: write})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось открыть доступ к данным: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// RevokeShare закрывает пользователю с логином login доступ к данным.
+func (s *Service) RevokeShare(ctx context.Context, id string, login string) error {
+	_, err := s.grpcClient.VaultClient.RevokeShare(ctx, &proto.RevokeShareReq{Id: id, Login: login})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось закрыть доступ к данным: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// ListShared получает данные других пользователей, к которым открыт доступ,
+// и свои данные, к которым открыт доступ другим пользователям.
+func (s *Service) ListShared(ctx context.Context) (*model.SharedItems, error) {
+	res, err := s.grpcClient.VaultClient.ListShared(ctx, &proto.ListSharedReq{})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, fmt.Errorf("не удалось получить список данных с совместным доступом: %s", s.Message())
+		}
+		return nil, err
+	}
+	result := &model.SharedItems{WithMe: []model.SharedItemInfo{}, ByMe: []model.SharedItemInfo{}}
+	for _, item := range res.GetSharedWithMe() {
+		dataType := model.DataType(item.GetType())
+		meta, err := parseMeta(dataType, item.GetMeta())
+		if err != nil {
+			return nil, err
+		}
+		result.WithMe = append(result.WithMe, model.SharedItemInfo{
+			ID:        item.GetId(),
+			Login:     item.GetOwner(),
+			Type:      dataType,
+			Meta:      meta,
+			Write:     item.GetWrite(),
+			CreatedAt: item.GetCreatedAt().AsTime(),
+		})
+	}
+	for _, item := range res.GetSharedByMe() {
+		result.ByMe = append(result.ByMe, model.SharedItemInfo{
+			ID:        item.GetId(),
+			Login:     item.GetLogin(),
+			Type:      model.DataType(item.GetType()),
+			Write:     item.GetWrite(),
+			CreatedAt: item.GetCreatedAt().AsTime(),
+		})
+	}
+	return result, nil
+}
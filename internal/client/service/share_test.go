@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/client/grpc"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/proto/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestShareItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().ShareItem(gomock.Any(), &proto.ShareItemReq{Id: "1", Login: "friend", Write: true}).
+		Times(1).Return(&proto.ShareItemRes{}, nil)
+	require.NoError(t, service.ShareItem(context.Background(), "1", "friend", true))
+
+	vaultSrvGRPCMock.EXPECT().ShareItem(gomock.Any(), &proto.ShareItemReq{Id: "1", Login: "unknown"}).
+		Times(1).Return(nil, status.Error(codes.NotFound, "Пользователь не найден"))
+	err := service.ShareItem(context.Background(), "1", "unknown", false)
+	require.ErrorContains(t, err, "Пользователь не найден")
+}
+
+func TestRevokeShare(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().RevokeShare(gomock.Any(), &proto.RevokeShareReq{Id: "1", Login: "friend"}).
+		Times(1).Return(&proto.RevokeShareRes{}, nil)
+	require.NoError(t, service.RevokeShare(context.Background(), "1", "friend"))
+}
+
+func TestListShared(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	vaultSrvGRPCMock.EXPECT().ListShared(gomock.Any(), &proto.ListSharedReq{}).
+		Times(1).Return(&proto.ListSharedRes{
+		SharedWithMe: []*proto.ListSharedRes_Incoming{
+			{
+				Id: "1", Owner: "family", Type: string(model.Password), Meta: `{"resource":"wifi"}`,
+				CreatedAt: timestamppb.New(created),
+			},
+		},
+		SharedByMe: []*proto.ListSharedRes_Outgoing{
+			{Id: "2", Login: "friend", Type: string(model.Text), Write: true, CreatedAt: timestamppb.New(created)},
+		},
+	}, nil)
+	shared, err := service.ListShared(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &model.SharedItems{
+		WithMe: []model.SharedItemInfo{
+			{
+				ID: "1", Login: "family", Type: model.Password, Meta: &model.PasswordMeta{Resource: "wifi"},
+				CreatedAt: created,
+			},
+		},
+		ByMe: []model.SharedItemInfo{
+			{ID: "2", Login: "friend", Type: model.Text, Write: true, CreatedAt: created},
+		},
+	}, shared)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/pinbrain/gophkeeper/internal/client/config"
+	"github.com/pinbrain/gophkeeper/internal/model"
 	"github.com/pinbrain/gophkeeper/internal/proto"
 	"google.golang.org/grpc/status"
 )
@@ -44,3 +45,66 @@ func (s *Service) Login(ctx context.Context, login, password string) (string, er
 	}
 	return res.GetToken(), nil
 }
+
+// DeleteAccount удаляет аккаунт пользователя со всеми его данными и удаляет сохраненный jwt.
+// Удаление подтверждается паролем пользователя.
+func (s *Service) DeleteAccount(ctx context.Context, password string) error {
+	_, err := s.grpcClient.UserClient.DeleteAccount(ctx, &proto.DeleteAccountReq{Password: password})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось удалить аккаунт: %s", s.Message())
+		}
+		return err
+	}
+	if err = config.SaveJWT(""); err != nil {
+		return fmt.Errorf("аккаунт удален: %w", err)
+	}
+	return nil
+}
+
+// auditPageSize количество событий журнала аудита, запрашиваемых за один запрос.
+const auditPageSize = 500
+
+// GetAuditLog загружает весь журнал аудита пользователя и проверяет связь его событий между собой.
+// Хэши событий вычислены на ключе сервера, поэтому их проверяет сервер при выдаче каждой страницы.
+// Если цепочка нарушена, возвращает загруженные события вместе с ошибкой.
+func (s *Service) GetAuditLog(ctx context.Context) ([]model.AuditEvent, error) {
+	var events []model.AuditEvent
+	var afterSeq int64
+	for {
+		res, err := s.grpcClient.UserClient.ListAuditEvents(ctx, &proto.ListAuditEventsReq{
+			AfterSeq: afterSeq,
+			PageSize: auditPageSize,
+		})
+		if err != nil {
+			if s, ok := status.FromError(err); ok {
+				return nil, fmt.Errorf("не удалось получить журнал аудита: %s", s.Message())
+			}
+			return nil, err
+		}
+		for _, event := range res.GetEvents() {
+			events = append(events, model.AuditEvent{
+				UserID:    event.GetUserId(),
+				Seq:       event.GetSeq(),
+				Action:    event.GetAction(),
+				ItemID:    event.GetItemId(),
+				Peer:      event.GetPeer(),
+				Code:      event.GetCode(),
+				CreatedAt: event.GetCreatedAt().AsTime(),
+				PrevHash:  event.GetPrevHash(),
+				Hash:      event.GetHash(),
+			})
+		}
+		if len(res.GetEvents()) < auditPageSize {
+			break
+		}
+		afterSeq = events[len(events)-1].Seq
+	}
+	if err := model.VerifyAuditLinks(events); err != nil {
+		return events, fmt.Errorf("журнал аудита поврежден: %w", err)
+	}
+	if len(events) > 0 && events[0].Seq != 1 {
+		return events, fmt.Errorf("журнал аудита поврежден: %w: missing first events", model.ErrAuditChainBroken)
+	}
+	return events, nil
+}
@@ -4,16 +4,19 @@ import (
 	"context"
 	"errors"
 	"os"
+	"slices"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pinbrain/gophkeeper/internal/client/config"
 	"github.com/pinbrain/gophkeeper/internal/client/grpc"
+	"github.com/pinbrain/gophkeeper/internal/model"
 	pb "github.com/pinbrain/gophkeeper/internal/proto"
 	"github.com/pinbrain/gophkeeper/internal/proto/mocks"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestRegister(t *testing.T) {
@@ -145,3 +148,163 @@ func TestLogin(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userSrvGRPCMock := mocks.NewMockUserServiceClient(ctrl)
+	service := NewService(&grpc.Client{UserClient: userSrvGRPCMock})
+
+	tests := []struct {
+		name    string
+		resErr  error
+		wantErr bool
+	}{
+		{
+			name: "Успешный запрос",
+		},
+		{
+			name:    "Ошибка запроса",
+			resErr:  errors.New("grpc res error"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpFile, err := os.CreateTemp(".", "jsonDB_*.json")
+			require.NoError(t, err)
+			defer os.Remove(tmpFile.Name())
+			viper.SetConfigFile(tmpFile.Name())
+			require.NoError(t, config.SaveJWT("some_jwt"))
+
+			userSrvGRPCMock.EXPECT().DeleteAccount(gomock.Any(), &pb.DeleteAccountReq{Password: "password"}).
+				Times(1).Return(&pb.DeleteAccountRes{}, tt.resErr)
+
+			err = service.DeleteAccount(context.Background(), "password")
+			if !tt.wantErr {
+				require.NoError(t, err)
+				assert.Empty(t, config.GetJWT())
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, "some_jwt", config.GetJWT())
+			}
+		})
+	}
+}
+
+// auditEventsRes возвращает ответ сервера с событиями журнала аудита.
+func auditEventsRes(events []model.AuditEvent) *pb.ListAuditEventsRes {
+	res := &pb.ListAuditEventsRes{}
+	for _, event := range events {
+		res.Events = append(res.Events, &pb.ListAuditEventsRes_Event{
+			UserId:    event.UserID,
+			Seq:       event.Seq,
+			Action:    event.Action,
+			ItemId:    event.ItemID,
+			Peer:      event.Peer,
+			Code:      event.Code,
+			CreatedAt: timestamppb.New(event.CreatedAt),
+			PrevHash:  event.PrevHash,
+			Hash:      event.Hash,
+		})
+	}
+	return res
+}
+
+func TestGetAuditLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userSrvGRPCMock := mocks.NewMockUserServiceClient(ctrl)
+	service := NewService(&grpc.Client{UserClient: userSrvGRPCMock})
+
+	// Цепочка из двух полных страниц и одной неполной.
+	events := make([]model.AuditEvent, 2*auditPageSize+1)
+	for i := range events {
+		events[i] = model.AuditEvent{UserID: "1", Action: "GetData", ItemID: "item", Peer: "127.0.0.1:5000", Code: "OK"}
+		if i == 0 {
+			events[i].Chain(nil, []byte("key"))
+		} else {
+			events[i].Chain(&events[i-1], []byte("key"))
+		}
+	}
+	// Хэши событий проверяет сервер, клиент обнаруживает нарушение связи событий.
+	tampered := slices.Clone(events[:3])
+	tampered[1].Hash = []byte("hash")
+
+	tests := []struct {
+		name      string
+		pages     [][]model.AuditEvent
+		resErr    error
+		wantCount int
+		wantErr   bool
+		wantChain bool
+	}{
+		{
+			name: "Успешный запрос",
+			pages: [][]model.AuditEvent{
+				events[:auditPageSize], events[auditPageSize : 2*auditPageSize], events[2*auditPageSize:],
+			},
+			wantCount: len(events),
+		},
+		{
+			name:  "Пустой журнал",
+			pages: [][]model.AuditEvent{nil},
+		},
+		{
+			name:      "Измененное событие",
+			pages:     [][]model.AuditEvent{tampered},
+			wantCount: len(tampered),
+			wantErr:   true,
+			wantChain: true,
+		},
+		{
+			name:      "Удаленное событие",
+			pages:     [][]model.AuditEvent{{events[0], events[2]}},
+			wantCount: 2,
+			wantErr:   true,
+			wantChain: true,
+		},
+		{
+			name:      "Удалено начало журнала",
+			pages:     [][]model.AuditEvent{events[1:3]},
+			wantCount: 2,
+			wantErr:   true,
+			wantChain: true,
+		},
+		{
+			name:    "Ошибка запроса",
+			resErr:  errors.New("grpc res error"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.resErr != nil {
+				userSrvGRPCMock.EXPECT().ListAuditEvents(gomock.Any(), gomock.Any()).Return(nil, tt.resErr)
+			}
+			var afterSeq int64
+			for _, page := range tt.pages {
+				userSrvGRPCMock.EXPECT().ListAuditEvents(gomock.Any(), &pb.ListAuditEventsReq{
+					AfterSeq: afterSeq,
+					PageSize: auditPageSize,
+				}).Return(auditEventsRes(page), nil)
+				if len(page) > 0 {
+					afterSeq = page[len(page)-1].Seq
+				}
+			}
+
+			res, err := service.GetAuditLog(context.Background())
+			assert.Len(t, res, tt.wantCount)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.wantChain, errors.Is(err, model.ErrAuditChainBroken))
+		})
+	}
+}
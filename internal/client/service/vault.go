@@ -5,22 +5,39 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/pinbrain/gophkeeper/internal/model"
 	"github.com/pinbrain/gophkeeper/internal/proto"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// ErrConflict возвращается при попытке изменить данные, которые уже были изменены на сервере.
+var ErrConflict = errors.New("конфликт версий")
+
+// fileChunkSize размер частей, которыми файл передается на сервер.
+const fileChunkSize = 1 << 20
+
 // addData реализует логику передачи объекта для сохранения на сервере.
-func (s *Service) addData(ctx context.Context, data []byte, meta string, dataType model.DataType) error {
+// expiresIn - срок хранения данных, по истечении которого сервер их удаляет (0 - бессрочно).
+func (s *Service) addData(
+	ctx context.Context, data []byte, meta string, dataType model.DataType, expiresIn time.Duration,
+) error {
+	if expiresIn < 0 {
+		return fmt.Errorf("некорректный срок хранения данных: %s", expiresIn)
+	}
 	_, err := s.grpcClient.VaultClient.AddData(ctx, &proto.AddDataReq{
 		Item: &proto.Item{
 			Data: data,
 			Type: string(dataType),
 			Meta: meta,
 		},
+		ExpiresAt: expiresAt(expiresIn),
 	})
 	if err != nil {
 		if s, ok := status.FromError(err); ok {
@@ -32,25 +49,29 @@ func (s *Service) addData(ctx context.Context, data []byte, meta string, dataTyp
 }
 
 // AddPassword сохраняет пароль в хранилище.
-func (s *Service) AddPassword(ctx context.Context, data string, meta model.PasswordMeta) error {
+func (s *Service) AddPassword(
+	ctx context.Context, data string, meta model.PasswordMeta, expiresIn time.Duration,
+) error {
 	metaB, err := json.Marshal(meta)
 	if err != nil {
 		return fmt.Errorf("не удалось сгенерировать строку с мета данными: %w", err)
 	}
-	return s.addData(ctx, []byte(data), string(metaB), model.Password)
+	return s.addData(ctx, []byte(data), string(metaB), model.Password, expiresIn)
 }
 
 // AddText сохраняет произвольные текстовые данные в хранилище.
-func (s *Service) AddText(ctx context.Context, data string, meta model.TextMeta) error {
+func (s *Service) AddText(ctx context.Context, data string, meta model.TextMeta, expiresIn time.Duration) error {
 	metaB, err := json.Marshal(meta)
 	if err != nil {
 		return fmt.Errorf("не удалось сгенерировать строку с мета данными: %w", err)
 	}
-	return s.addData(ctx, []byte(data), string(metaB), model.Text)
+	return s.addData(ctx, []byte(data), string(metaB), model.Text, expiresIn)
 }
 
 // AddBankCard сохраняет данные банковской карты в хранилище.
-func (s *Service) AddBankCard(ctx context.Context, data model.BankCardData, meta model.BankCardMeta) error {
+func (s *Service) AddBankCard(
+	ctx context.Context, data model.BankCardData, meta model.BankCardMeta, expiresIn time.Duration,
+) error {
 	metaB, err := json.Marshal(meta)
 	if err != nil {
 		return fmt.Errorf("не удалось сгенерировать строку с мета данными: %w", err)
@@ -59,38 +80,191 @@ func (s *Service) AddBankCard(ctx context.Context, data model.BankCardData, meta
 	if err != nil {
 		return fmt.Errorf("не удалось сгенерировать строку с данными банковской карты: %w", err)
 	}
-	return s.addData(ctx, dataB, string(metaB), model.BankCard)
+	return s.addData(ctx, dataB, string(metaB), model.BankCard, expiresIn)
+}
+
+// AddFile сохраняет файл в хранилище. Файл передается на сервер потоком частей.
+func (s *Service) AddFile(ctx context.Context, file string, comment string, expiresIn time.Duration) error {
+	if expiresIn < 0 {
+		return fmt.Errorf("некорректный срок хранения данных: %s", expiresIn)
+	}
+	_, err := s.uploadFile(ctx, &proto.UploadFileReq{ExpiresAt: expiresAt(expiresIn)}, file, comment)
+	return err
+}
+
+// expiresAt возвращает время окончания срока хранения данных через expiresIn от текущего момента
+// или nil, если срок хранения не задан.
+func expiresAt(expiresIn time.Duration) *timestamppb.Timestamp {
+	if expiresIn <= 0 {
+		return nil
+	}
+	return timestamppb.New(time.Now().Add(expiresIn))
+}
+
+// uploadFile передает файл на сервер потоком частей размера fileChunkSize.
+// header - первое сообщение потока без мета данных и содержимого файла: для нового файла id пустой,
+// revision - версия заменяемого файла (0 - без проверки версии), parent_id - данные, к которым прикрепляется
+// новый файл, expires_at - срок хранения. Возвращает id и номер новой версии данных.
+func (s *Service) uploadFile(
+	ctx context.Context, header *proto.UploadFileReq, file string, comment string,
+) (*proto.UploadFileRes, error) {
+	meta, err := fileMeta(file, comment)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать файл: %w", err)
+	}
+	defer f.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := s.grpcClient.VaultClient.UploadFile(ctx)
+	if err != nil {
+		return nil, uploadError(err)
+	}
+	req := &proto.UploadFileReq{
+		Id: header.GetId(), Revision: header.GetRevision(), ParentId: header.GetParentId(),
+		ExpiresAt: header.GetExpiresAt(), Meta: meta,
+	}
+	buf := make([]byte, fileChunkSize)
+	for {
+		n, readErr := io.ReadFull(f, buf)
+		if readErr != nil && !errors.Is(readErr, io.EOF) && !errors.Is(readErr, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("не удалось прочитать файл: %w", readErr)
+		}
+		// Первое сообщение с мета данными отправляется даже для пустого файла.
+		if n > 0 || req.GetMeta() != "" {
+			req.Chunk = buf[:n]
+			if err = stream.Send(req); err != nil {
+				// Причину ошибки отправки возвращает CloseAndRecv.
+				break
+			}
+			req = &proto.UploadFileReq{}
+		}
+		if readErr != nil {
+			break
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, uploadError(err)
+	}
+	return res, nil
 }
 
-// AddFile сохраняет файл в хранилище.
-func (s *Service) AddFile(ctx context.Context, file string, comment string) error {
+// uploadError формирует ошибку передачи файла на сервер.
+func uploadError(err error) error {
+	if s, ok := status.FromError(err); ok {
+		if s.Code() == codes.Aborted {
+			return fmt.Errorf("%w: %s", ErrConflict, s.Message())
+		}
+		return fmt.Errorf("не удалось сохранить файл: %s", s.Message())
+	}
+	return err
+}
+
+// fileMeta формирует строку мета данных для файла.
+func fileMeta(file string, comment string) (string, error) {
 	fileInfo, err := os.Stat(file)
 	if err != nil {
-		return fmt.Errorf("не удалось прочитать файл: %w", err)
+		return "", fmt.Errorf("не удалось прочитать файл: %w", err)
+	}
+	if !filepath.IsAbs(file) && !filepath.IsLocal(file) {
+		return "", errors.New("невалидное полное имя файла")
+	}
+	if fileInfo.IsDir() {
+		return "", errors.New("невозможно сохранить директорию")
 	}
 	meta := model.FileMeta{
 		Name:      fileInfo.Name(),
 		Extension: filepath.Ext(file),
 		Comment:   comment,
 	}
-	if !filepath.IsAbs(file) && !filepath.IsLocal(file) {
-		return errors.New("невалидное полное имя файла")
+	metaB, err := json.Marshal(meta)
+	if err != nil {
+		return "", fmt.Errorf("не удалось сгенерировать строку с мета данными: %w", err)
 	}
-	data, err := os.ReadFile(file)
+	return string(metaB), nil
+}
+
+// updateData реализует логику передачи измененного объекта на сервер.
+// revision - версия данных, на основе которой сделаны изменения (0 - без проверки версии).
+// Возвращает номер новой версии данных.
+func (s *Service) updateData(ctx context.Context, id string, revision int64, data []byte, meta string) (int64, error) {
+	res, err := s.grpcClient.VaultClient.UpdateData(ctx, &proto.UpdateDataReq{
+		Id:       id,
+		Data:     data,
+		Meta:     meta,
+		Revision: revision,
+	})
 	if err != nil {
-		return fmt.Errorf("не удалось прочитать файл: %w", err)
+		if s, ok := status.FromError(err); ok {
+			if s.Code() == codes.Aborted {
+				return 0, fmt.Errorf("%w: %s", ErrConflict, s.Message())
+			}
+			return 0, fmt.Errorf("не удалось обновить данные: %s", s.Message())
+		}
+		return 0, err
 	}
+	return res.GetRevision(), nil
+}
+
+// UpdatePassword обновляет пароль в хранилище.
+func (s *Service) UpdatePassword(
+	ctx context.Context, id string, revision int64, data string, meta model.PasswordMeta,
+) (int64, error) {
 	metaB, err := json.Marshal(meta)
 	if err != nil {
-		return fmt.Errorf("не удалось сгенерировать строку с мета данными: %w", err)
+		return 0, fmt.Errorf("не удалось сгенерировать строку с мета данными: %w", err)
+	}
+	return s.updateData(ctx, id, revision, []byte(data), string(metaB))
+}
+
+// UpdateText обновляет текстовые данные в хранилище.
+func (s *Service) UpdateText(
+	ctx context.Context, id string, revision int64, data string, meta model.TextMeta,
+) (int64, error) {
+	metaB, err := json.Marshal(meta)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось сгенерировать строку с мета данными: %w", err)
+	}
+	return s.updateData(ctx, id, revision, []byte(data), string(metaB))
+}
+
+// UpdateBankCard обновляет данные банковской карты в хранилище.
+func (s *Service) UpdateBankCard(
+	ctx context.Context, id string, revision int64, data model.BankCardData, meta model.BankCardMeta,
+) (int64, error) {
+	metaB, err := json.Marshal(meta)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось сгенерировать строку с мета данными: %w", err)
+	}
+	dataB, err := json.Marshal(data)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось сгенерировать строку с данными банковской карты: %w", err)
 	}
-	return s.addData(ctx, data, string(metaB), model.File)
+	return s.updateData(ctx, id, revision, dataB, string(metaB))
+}
+
+// UpdateFile заменяет файл в хранилище. Файл передается на сервер потоком частей.
+func (s *Service) UpdateFile(
+	ctx context.Context, id string, revision int64, file string, comment string,
+) (int64, error) {
+	res, err := s.uploadFile(ctx, &proto.UploadFileReq{Id: id, Revision: revision}, file, comment)
+	if err != nil {
+		return 0, err
+	}
+	return res.GetRevision(), nil
 }
 
 // GetData загружает данные из хранилища.
+// Содержимое файла загружается отдельным потоком и сохраняется в текущую директорию.
 func (s *Service) GetData(ctx context.Context, id string) (model.DataType, any, error) {
 	res, err := s.grpcClient.VaultClient.GetData(ctx, &proto.GetDataReq{
-		Id: id,
+		Id:              id,
+		SkipFileContent: true,
 	})
 	if err != nil {
 		if s, ok := status.FromError(err); ok {
@@ -98,7 +272,78 @@ func (s *Service) GetData(ctx context.Context, id string) (model.DataType, any,
 		}
 		return "", nil, err
 	}
-	resItem := res.GetItem()
+	if res.GetItem().GetType() == string(model.File) {
+		item, err := s.downloadFile(ctx, id)
+		if err != nil {
+			return "", nil, err
+		}
+		return model.File, item, nil
+	}
+	return parseItem(res.GetItem(), res.GetRevision())
+}
+
+// downloadFile загружает файл потоком частей и сохраняет его в текущую директорию.
+func (s *Service) downloadFile(ctx context.Context, id string) (*model.FileItem, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := s.grpcClient.VaultClient.DownloadFile(ctx, &proto.DownloadFileReq{Id: id})
+	if err != nil {
+		return nil, downloadError(err)
+	}
+	header, err := stream.Recv()
+	if err != nil {
+		return nil, downloadError(err)
+	}
+	meta := &model.FileMeta{}
+	if err = json.Unmarshal([]byte(header.GetMeta()), meta); err != nil {
+		return nil, fmt.Errorf("не удалось прочитать мета данные: %w", err)
+	}
+	if !filepath.IsLocal(meta.Name) || filepath.Base(meta.Name) != meta.Name {
+		return nil, fmt.Errorf("невалидное имя файла: %s", meta.Name)
+	}
+	file, err := os.Create(meta.Name)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сохранить файл: %w", err)
+	}
+	defer file.Close()
+
+	var size int64
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, downloadError(err)
+		}
+		n, err := file.Write(res.GetChunk())
+		if err != nil {
+			return nil, fmt.Errorf("не удалось записать данные в файл: %w", err)
+		}
+		size += int64(n)
+	}
+	if size != header.GetSize() {
+		return nil, fmt.Errorf("файл загружен не полностью: получено %d из %d байт", size, header.GetSize())
+	}
+	return &model.FileItem{
+		Type:     model.File,
+		Meta:     *meta,
+		Revision: header.GetRevision(),
+	}, nil
+}
+
+// downloadError формирует ошибку загрузки файла с сервера.
+func downloadError(err error) error {
+	if s, ok := status.FromError(err); ok {
+		return fmt.Errorf("не удалось получить файл: %s", s.Message())
+	}
+	return err
+}
+
+// parseItem разбирает полученный с сервера объект указанной версии в зависимости от его типа.
+// Данные файла сохраняются в текущую директорию.
+func parseItem(resItem *proto.Item, revision int64) (model.DataType, any, error) {
+	var err error
 	itemType := resItem.GetType()
 	switch itemType {
 	case string(model.Password):
@@ -108,9 +353,10 @@ func (s *Service) GetData(ctx context.Context, id string) (model.DataType, any,
 			return "", nil, fmt.Errorf("не удалось прочитать мета данные: %w", err)
 		}
 		return model.Password, &model.PasswordItem{
-			Type: model.Password,
-			Meta: *meta,
-			Data: string(resItem.GetData()),
+			Type:     model.Password,
+			Meta:     *meta,
+			Revision: revision,
+			Data:     string(resItem.GetData()),
 		}, nil
 
 	case string(model.BankCard):
@@ -125,8 +371,9 @@ func (s *Service) GetData(ctx context.Context, id string) (model.DataType, any,
 			return "", nil, fmt.Errorf("не удалось прочитать данные: %w", err)
 		}
 		return model.BankCard, &model.BankCardItem{
-			Type: model.BankCard,
-			Meta: *meta,
+			Type:     model.BankCard,
+			Meta:     *meta,
+			Revision: revision,
 			Data: model.BankCardData{
 				Number:     data.Number,
 				Holder:     data.Holder,
@@ -143,9 +390,10 @@ func (s *Service) GetData(ctx context.Context, id string) (model.DataType, any,
 			return "", nil, fmt.Errorf("не удалось прочитать мета данные: %w", err)
 		}
 		return model.Text, &model.TextItem{
-			Type: model.Text,
-			Meta: *meta,
-			Data: string(resItem.GetData()),
+			Type:     model.Text,
+			Meta:     *meta,
+			Revision: revision,
+			Data:     string(resItem.GetData()),
 		}, nil
 
 	case string(model.File):
@@ -165,8 +413,9 @@ func (s *Service) GetData(ctx context.Context, id string) (model.DataType, any,
 			return "", nil, fmt.Errorf("не удалось записать данные в файл: %w", err)
 		}
 		return model.File, &model.FileItem{
-			Type: model.File,
-			Meta: *meta,
+			Type:     model.File,
+			Meta:     *meta,
+			Revision: revision,
 		}, nil
 	}
 	return "", nil, fmt.Errorf("неизвестный тип данных: %s", itemType)
@@ -187,22 +436,9 @@ func (s *Service) GetAllByType(ctx context.Context, dataType model.DataType) ([]
 	result := []model.ItemInfo{}
 
 	for _, item := range items {
-		var meta any
-		switch dataType {
-		case model.Password:
-			meta = &model.PasswordMeta{}
-		case model.BankCard:
-			meta = &model.BankCardMeta{}
-		case model.Text:
-			meta = &model.TextMeta{}
-		case model.File:
-			meta = &model.FileMeta{}
-		default:
-			return nil, fmt.Errorf("неизвестный тип данных: %s", dataType)
-		}
-		err = json.Unmarshal([]byte(item.GetMeta()), meta)
+		meta, err := parseMeta(dataType, item.GetMeta())
 		if err != nil {
-			return nil, fmt.Errorf("не удалось прочитать мета данные: %w", err)
+			return nil, err
 		}
 		result = append(result, model.ItemInfo{
 			ID:   item.GetId(),
@@ -213,7 +449,138 @@ func (s *Service) GetAllByType(ctx context.Context, dataType model.DataType) ([]
 	return result, nil
 }
 
-// DeleteData удаляет данные из хранилища.
+// ListItems получает одну страницу списка данных из хранилища.
+// Возвращает токен следующей страницы или пустую строку, если страница последняя.
+func (s *Service) ListItems(
+	ctx context.Context, query model.ItemsQuery, pageToken string,
+) ([]model.ItemInfo, string, error) {
+	req := &proto.ListItemsReq{
+		Type:           string(query.Type),
+		MetaField:      query.MetaField,
+		Desc:           query.Desc,
+		PageSize:       int32(query.PageSize),
+		PageToken:      pageToken,
+		FolderId:       query.FolderID,
+		Tag:            query.Tag,
+		FavoritesFirst: query.FavoritesFirst,
+	}
+	switch query.SortBy {
+	case model.SortByCreated, "":
+		req.SortBy = proto.ListItemsReq_CREATED_AT
+	case model.SortByUpdated:
+		req.SortBy = proto.ListItemsReq_UPDATED_AT
+	case model.SortByMeta:
+		req.SortBy = proto.ListItemsReq_META
+	case model.SortByAccessed:
+		req.SortBy = proto.ListItemsReq_ACCESSED
+	default:
+		return nil, "", fmt.Errorf("неизвестное поле сортировки: %s", query.SortBy)
+	}
+	res, err := s.grpcClient.VaultClient.ListItems(ctx, req)
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, "", fmt.Errorf("не удалось получить данные: %s", s.Message())
+		}
+		return nil, "", err
+	}
+	result, err := parseListItems(res.GetItems())
+	if err != nil {
+		return nil, "", err
+	}
+	return result, res.GetNextPageToken(), nil
+}
+
+// RecentItems получает до limit данных, которые получались последними, начиная с самых недавних.
+func (s *Service) RecentItems(ctx context.Context, limit int) ([]model.ItemInfo, error) {
+	items, _, err := s.ListItems(ctx, model.ItemsQuery{SortBy: model.SortByAccessed, Desc: true, PageSize: limit}, "")
+	if err != nil {
+		return nil, err
+	}
+	// Данные, которые ни разу не получались, идут в конце списка.
+	recent := make([]model.ItemInfo, 0, len(items))
+	for _, item := range items {
+		if item.LastAccessedAt != nil {
+			recent = append(recent, item)
+		}
+	}
+	return recent, nil
+}
+
+// SearchItems ищет данные по вхождению строки в мета данные (или по началу значения поля, если prefix).
+// Если exact, ищутся данные, у которых значение ресурса, логина, названия или банка совпадает со строкой.
+func (s *Service) SearchItems(ctx context.Context, query string, prefix bool, exact bool) ([]model.ItemInfo, error) {
+	res, err := s.grpcClient.VaultClient.SearchItems(ctx, &proto.SearchItemsReq{
+		Query:  query,
+		Prefix: prefix,
+		Exact:  exact,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, fmt.Errorf("не удалось выполнить поиск: %s", s.Message())
+		}
+		return nil, err
+	}
+	return parseListItems(res.GetItems())
+}
+
+// parseListItems разбирает полученный с сервера список данных.
+func parseListItems(items []*proto.ListItemsRes_ListItem) ([]model.ItemInfo, error) {
+	result := []model.ItemInfo{}
+	for _, item := range items {
+		dataType := model.DataType(item.GetType())
+		meta, err := parseMeta(dataType, item.GetMeta())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, model.ItemInfo{
+			ID:             item.GetId(),
+			Type:           dataType,
+			Meta:           meta,
+			Revision:       item.GetRevision(),
+			CreatedAt:      item.GetCreatedAt().AsTime(),
+			UpdatedAt:      item.GetUpdatedAt().AsTime(),
+			FolderID:       item.GetFolderId(),
+			Tags:           item.GetTags(),
+			ExpiresAt:      optionalTime(item.GetExpiresAt()),
+			Favorite:       item.GetFavorite(),
+			LastAccessedAt: optionalTime(item.GetLastAccessedAt()),
+			AccessCount:    item.GetAccessCount(),
+		})
+	}
+	return result, nil
+}
+
+// optionalTime возвращает время из ответа сервера или nil, если оно не задано.
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// parseMeta разбирает мета данные в зависимости от типа данных.
+func parseMeta(dataType model.DataType, rawMeta string) (any, error) {
+	var meta any
+	switch dataType {
+	case model.Password:
+		meta = &model.PasswordMeta{}
+	case model.BankCard:
+		meta = &model.BankCardMeta{}
+	case model.Text:
+		meta = &model.TextMeta{}
+	case model.File:
+		meta = &model.FileMeta{}
+	default:
+		return nil, fmt.Errorf("неизвестный тип данных: %s", dataType)
+	}
+	if err := json.Unmarshal([]byte(rawMeta), meta); err != nil {
+		return nil, fmt.Errorf("не удалось прочитать мета данные: %w", err)
+	}
+	return meta, nil
+}
+
+// DeleteData перемещает данные в корзину.
 func (s *Service) DeleteData(ctx context.Context, id string) error {
 	_, err := s.grpcClient.VaultClient.DeleteData(ctx, &proto.DeleteDataReq{
 		Id: id,
@@ -226,3 +593,133 @@ func (s *Service) DeleteData(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+// GetDataHistory получает список сохраненных версий данных.
+func (s *Service) GetDataHistory(ctx context.Context, id string) ([]model.RevisionInfo, error) {
+	res, err := s.grpcClient.VaultClient.GetDataHistory(ctx, &proto.GetDataHistoryReq{
+		Id: id,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, fmt.Errorf("не удалось получить историю данных: %s", s.Message())
+		}
+		return nil, err
+	}
+	result := []model.RevisionInfo{}
+	for _, rev := range res.GetRevisions() {
+		result = append(result, model.RevisionInfo{
+			Revision:  rev.GetRevision(),
+			CreatedAt: rev.GetCreatedAt().AsTime(),
+		})
+	}
+	return result, nil
+}
+
+// GetDataRevision загружает указанную версию данных из хранилища.
+func (s *Service) GetDataRevision(ctx context.Context, id string, revision int64) (model.DataType, any, error) {
+	res, err := s.grpcClient.VaultClient.GetDataRevision(ctx, &proto.GetDataRevisionReq{
+		Id:       id,
+		Revision: revision,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return "", nil, fmt.Errorf("не удалось получить версию данных: %s", s.Message())
+		}
+		return "", nil, err
+	}
+	return parseItem(res.GetItem(), res.GetRevision())
+}
+
+// RestoreData восстанавливает указанную версию данных.
+func (s *Service) RestoreData(ctx context.Context, id string, revision int64) error {
+	_, err := s.grpcClient.VaultClient.RestoreData(ctx, &proto.RestoreDataReq{
+		Id:       id,
+		Revision: revision,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось восстановить данные: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// GetTrash получает список данных в корзине.
+func (s *Service) GetTrash(ctx context.Context) ([]model.TrashItemInfo, error) {
+	res, err := s.grpcClient.VaultClient.GetTrash(ctx, &proto.GetTrashReq{})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, fmt.Errorf("не удалось получить содержимое корзины: %s", s.Message())
+		}
+		return nil, err
+	}
+	result := []model.TrashItemInfo{}
+	for _, item := range res.GetItems() {
+		dataType := model.DataType(item.GetType())
+		meta, err := parseMeta(dataType, item.GetMeta())
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, model.TrashItemInfo{
+			ID:        item.GetId(),
+			Type:      dataType,
+			Meta:      meta,
+			DeletedAt: item.GetDeletedAt().AsTime(),
+		})
+	}
+	return result, nil
+}
+
+// RestoreFromTrash восстанавливает данные из корзины.
+func (s *Service) RestoreFromTrash(ctx context.Context, id string) error {
+	_, err := s.grpcClient.VaultClient.RestoreFromTrash(ctx, &proto.RestoreFromTrashReq{
+		Id: id,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return fmt.Errorf("не удалось восстановить данные из корзины: %s", s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+// EmptyTrash безвозвратно удаляет все данные из корзины и возвращает количество удаленных записей.
+func (s *Service) EmptyTrash(ctx context.Context) (int64, error) {
+	res, err := s.grpcClient.VaultClient.EmptyTrash(ctx, &proto.EmptyTrashReq{})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return 0, fmt.Errorf("не удалось очистить корзину: %s", s.Message())
+		}
+		return 0, err
+	}
+	return res.GetDeleted(), nil
+}
+
+// GetUsage получает занятый объем хранилища по типам данных и действующие ограничения.
+func (s *Service) GetUsage(ctx context.Context) (*model.UsageInfo, error) {
+	res, err := s.grpcClient.VaultClient.GetUsage(ctx, &proto.GetUsageReq{})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			return nil, fmt.Errorf("не удалось получить занятый объем: %s", s.Message())
+		}
+		return nil, err
+	}
+	usage := &model.UsageInfo{
+		Quota: model.Quota{
+			MaxBytes:    res.GetQuota().GetMaxBytes(),
+			MaxItems:    res.GetQuota().GetMaxItems(),
+			MaxItemSize: res.GetQuota().GetMaxItemSize(),
+			MaxMetaSize: res.GetQuota().GetMaxMetaSize(),
+		},
+	}
+	for _, typeUsage := range res.GetUsage() {
+		usage.Types = append(usage.Types, model.TypeUsage{
+			Type:  model.DataType(typeUsage.GetType()),
+			Items: typeUsage.GetItems(),
+			Bytes: typeUsage.GetBytes(),
+		})
+	}
+	return usage, nil
+}
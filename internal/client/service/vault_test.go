@@ -1,12 +1,15 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pinbrain/gophkeeper/internal/client/grpc"
@@ -15,6 +18,9 @@ import (
 	"github.com/pinbrain/gophkeeper/internal/proto/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestAddPassword(t *testing.T) {
@@ -59,7 +65,7 @@ func TestAddPassword(t *testing.T) {
 				},
 			}).Times(1).Return(&proto.AddDataRes{}, tt.resErr)
 
-			err = service.AddPassword(context.Background(), tt.request.data, tt.request.meta)
+			err = service.AddPassword(context.Background(), tt.request.data, tt.request.meta, 0)
 			if tt.resErr == nil {
 				require.NoError(t, err)
 			} else {
@@ -110,7 +116,7 @@ func TestText(t *testing.T) {
 				},
 			}).Times(1).Return(&proto.AddDataRes{}, tt.resErr)
 
-			err = service.AddText(context.Background(), tt.request.data, tt.request.meta)
+			err = service.AddText(context.Background(), tt.request.data, tt.request.meta, 0)
 			if tt.resErr == nil {
 				require.NoError(t, err)
 			} else {
@@ -169,7 +175,7 @@ func TestAddBankCard(t *testing.T) {
 				},
 			}).Times(1).Return(&proto.AddDataRes{}, tt.resErr)
 
-			err = service.AddBankCard(context.Background(), tt.request.data, tt.request.meta)
+			err = service.AddBankCard(context.Background(), tt.request.data, tt.request.meta, 0)
 			if tt.resErr == nil {
 				require.NoError(t, err)
 			} else {
@@ -179,6 +185,28 @@ func TestAddBankCard(t *testing.T) {
 	}
 }
 
+func TestAddDataExpiresIn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().AddData(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *proto.AddDataReq, _ ...any) (*proto.AddDataRes, error) {
+			require.NotNil(t, req.GetExpiresAt())
+			assert.WithinDuration(t, time.Now().Add(time.Hour), req.GetExpiresAt().AsTime(), time.Minute)
+			return &proto.AddDataRes{}, nil
+		}).Times(1)
+	err := service.AddText(context.Background(), "some text", model.TextMeta{Name: "note"}, time.Hour)
+	require.NoError(t, err)
+
+	err = service.AddText(context.Background(), "some text", model.TextMeta{Name: "note"}, -time.Hour)
+	require.ErrorContains(t, err, "некорректный срок хранения данных")
+	err = service.AddFile(context.Background(), "test_file", "", -time.Hour)
+	require.ErrorContains(t, err, "некорректный срок хранения данных")
+}
+
 func TestAddFile(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -188,6 +216,7 @@ func TestAddFile(t *testing.T) {
 
 	tests := []struct {
 		name        string
+		content     []byte
 		createFile  bool
 		file        string
 		comment     string
@@ -195,6 +224,15 @@ func TestAddFile(t *testing.T) {
 	}{
 		{
 			name:        "Успешный запрос",
+			content:     bytes.Repeat([]byte("content"), fileChunkSize/3),
+			createFile:  true,
+			file:        "test_file",
+			comment:     "some comment",
+			isFileError: false,
+		},
+		{
+			name:        "Пустой файл",
+			content:     []byte{},
 			createFile:  true,
 			file:        "test_file",
 			comment:     "some comment",
@@ -219,9 +257,8 @@ func TestAddFile(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.createFile {
-				tmpFile, err := os.Create(tt.file)
-				require.NoError(t, err)
-				defer os.Remove(tmpFile.Name())
+				require.NoError(t, os.WriteFile(tt.file, tt.content, 0o600))
+				defer os.Remove(tt.file)
 			}
 			if !tt.isFileError {
 				metaB, err := json.Marshal(model.FileMeta{
@@ -229,15 +266,29 @@ func TestAddFile(t *testing.T) {
 					Comment: tt.comment,
 				})
 				require.NoError(t, err)
-				vaultSrvGRPCMock.EXPECT().AddData(gomock.Any(), &proto.AddDataReq{
-					Item: &proto.Item{
-						Data: []byte{},
-						Type: string(model.File),
-						Meta: string(metaB),
-					},
+				stream := mocks.NewMockVaultService_UploadFileClient(ctrl)
+				var requests []*proto.UploadFileReq
+				stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *proto.UploadFileReq) error {
+					// Буфер чтения переиспользуется, поэтому часть копируется.
+					req.Chunk = bytes.Clone(req.Chunk)
+					requests = append(requests, req)
+					return nil
+				}).MinTimes(1)
+				stream.EXPECT().CloseAndRecv().DoAndReturn(func() (*proto.UploadFileRes, error) {
+					require.NotEmpty(t, requests)
+					assert.Equal(t, string(metaB), requests[0].GetMeta())
+					assert.Empty(t, requests[0].GetId())
+					content := []byte{}
+					for _, req := range requests {
+						assert.LessOrEqual(t, len(req.GetChunk()), fileChunkSize)
+						content = append(content, req.GetChunk()...)
+					}
+					assert.Equal(t, tt.content, content)
+					return &proto.UploadFileRes{Id: "1", Revision: 1}, nil
 				})
+				vaultSrvGRPCMock.EXPECT().UploadFile(gomock.Any()).Return(stream, nil)
 			}
-			err := service.AddFile(context.Background(), tt.file, tt.comment)
+			err := service.AddFile(context.Background(), tt.file, tt.comment, 0)
 			if !tt.isFileError {
 				require.NoError(t, err)
 			} else {
@@ -247,6 +298,146 @@ func TestAddFile(t *testing.T) {
 	}
 }
 
+func TestUpdateFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	file := "test_update_file"
+	require.NoError(t, os.WriteFile(file, []byte("new content"), 0o600))
+	defer os.Remove(file)
+
+	tests := []struct {
+		name     string
+		grpcRes  *proto.UploadFileRes
+		grpcErr  error
+		want     int64
+		conflict bool
+		wantErr  bool
+	}{
+		{
+			name:    "Успешный запрос",
+			grpcRes: &proto.UploadFileRes{Id: "1", Revision: 3},
+			want:    3,
+		},
+		{
+			name:     "Конфликт версий",
+			grpcErr:  status.Error(codes.Aborted, "Данные были изменены"),
+			conflict: true,
+			wantErr:  true,
+		},
+		{
+			name:    "Ошибка сервера",
+			grpcErr: status.Error(codes.NotFound, "Данные не найдены"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := mocks.NewMockVaultService_UploadFileClient(ctrl)
+			stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *proto.UploadFileReq) error {
+				assert.Equal(t, "1", req.GetId())
+				assert.Equal(t, int64(2), req.GetRevision())
+				assert.Equal(t, []byte("new content"), req.GetChunk())
+				return nil
+			})
+			stream.EXPECT().CloseAndRecv().Return(tt.grpcRes, tt.grpcErr)
+			vaultSrvGRPCMock.EXPECT().UploadFile(gomock.Any()).Return(stream, nil)
+
+			revision, err := service.UpdateFile(context.Background(), "1", 2, file, "")
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.conflict, errors.Is(err, ErrConflict))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, revision)
+		})
+	}
+}
+
+func TestDownloadFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	file := "test_download_file"
+	meta := model.FileMeta{Name: file, Comment: "some comment"}
+	metaB, err := json.Marshal(meta)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		responses []*proto.DownloadFileRes
+		wantErr   bool
+	}{
+		{
+			name: "Успешный запрос",
+			responses: []*proto.DownloadFileRes{
+				{Meta: string(metaB), Revision: 2, Size: 11},
+				{Chunk: []byte("some ")},
+				{Chunk: []byte("data")},
+				{Chunk: []byte("!!")},
+			},
+		},
+		{
+			name: "Файл загружен не полностью",
+			responses: []*proto.DownloadFileRes{
+				{Meta: string(metaB), Revision: 2, Size: 11},
+				{Chunk: []byte("some ")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Невалидное имя файла",
+			responses: []*proto.DownloadFileRes{
+				{Meta: `{"name": "../file"}`, Revision: 2},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer os.Remove(file)
+			vaultSrvGRPCMock.EXPECT().GetData(gomock.Any(), &proto.GetDataReq{Id: "1", SkipFileContent: true}).
+				Return(&proto.GetDataRes{
+					Id:       "1",
+					Revision: 2,
+					Item:     &proto.Item{Type: string(model.File), Meta: string(metaB)},
+				}, nil)
+			stream := mocks.NewMockVaultService_DownloadFileClient(ctrl)
+			responses := tt.responses
+			stream.EXPECT().Recv().DoAndReturn(func() (*proto.DownloadFileRes, error) {
+				if len(responses) == 0 {
+					return nil, io.EOF
+				}
+				res := responses[0]
+				responses = responses[1:]
+				return res, nil
+			}).AnyTimes()
+			vaultSrvGRPCMock.EXPECT().DownloadFile(gomock.Any(), &proto.DownloadFileReq{Id: "1"}).Return(stream, nil)
+
+			dataType, item, err := service.GetData(context.Background(), "1")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, model.File, dataType)
+			assert.Equal(t, &model.FileItem{Type: model.File, Meta: meta, Revision: 2}, item)
+			content, err := os.ReadFile(file)
+			require.NoError(t, err)
+			assert.Equal(t, "some data!!", string(content))
+		})
+	}
+}
+
 func TestGetData(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -270,7 +461,8 @@ func TestGetData(t *testing.T) {
 			name: "Получение пароля",
 			id:   "1",
 			grpcRes: &proto.GetDataRes{
-				Id: "1",
+				Id:       "1",
+				Revision: 4,
 				Item: &proto.Item{
 					Data: []byte("password"),
 					Type: string(model.Password),
@@ -293,7 +485,8 @@ func TestGetData(t *testing.T) {
 						Login:    "user",
 						Comment:  "some comment",
 					},
-					Data: "password",
+					Data:     "password",
+					Revision: 4,
 				},
 				err: nil,
 			},
@@ -385,7 +578,7 @@ func TestGetData(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			vaultSrvGRPCMock.EXPECT().GetData(gomock.Any(), &proto.GetDataReq{Id: tt.id}).
+			vaultSrvGRPCMock.EXPECT().GetData(gomock.Any(), &proto.GetDataReq{Id: tt.id, SkipFileContent: true}).
 				Times(1).Return(tt.grpcRes, tt.grpcErr)
 
 			dataType, item, err := service.GetData(context.Background(), tt.id)
@@ -411,3 +604,361 @@ func TestDeleteData(t *testing.T) {
 	err := service.DeleteData(context.Background(), "1")
 	require.NoError(t, err)
 }
+
+func TestUpdatePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	meta := model.PasswordMeta{Resource: "some_resource", Login: "user"}
+	metaB, err := json.Marshal(meta)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		grpcRes  *proto.UpdateDataRes
+		grpcErr  error
+		want     int64
+		conflict bool
+		wantErr  bool
+	}{
+		{
+			name:    "Успешный запрос",
+			grpcRes: &proto.UpdateDataRes{Revision: 3},
+			want:    3,
+		},
+		{
+			name:     "Конфликт версий",
+			grpcErr:  status.Error(codes.Aborted, "Данные были изменены"),
+			conflict: true,
+			wantErr:  true,
+		},
+		{
+			name:    "Ошибка сервера",
+			grpcErr: status.Error(codes.NotFound, "Данные не найдены"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vaultSrvGRPCMock.EXPECT().UpdateData(gomock.Any(), &proto.UpdateDataReq{
+				Id:       "1",
+				Data:     []byte("new_password"),
+				Meta:     string(metaB),
+				Revision: 2,
+			}).Times(1).Return(tt.grpcRes, tt.grpcErr)
+
+			revision, err := service.UpdatePassword(context.Background(), "1", 2, "new_password", meta)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.conflict, errors.Is(err, ErrConflict))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, revision)
+		})
+	}
+}
+
+func TestGetDataHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	vaultSrvGRPCMock.EXPECT().GetDataHistory(gomock.Any(), &proto.GetDataHistoryReq{Id: "1"}).
+		Times(1).Return(&proto.GetDataHistoryRes{
+		Revisions: []*proto.GetDataHistoryRes_Revision{
+			{Revision: 1, CreatedAt: timestamppb.New(created)},
+		},
+	}, nil)
+	history, err := service.GetDataHistory(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, []model.RevisionInfo{{Revision: 1, CreatedAt: created}}, history)
+}
+
+func TestGetDataRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().GetDataRevision(gomock.Any(), &proto.GetDataRevisionReq{Id: "1", Revision: 2}).
+		Times(1).Return(&proto.GetDataRevisionRes{
+		Id:       "1",
+		Revision: 2,
+		Item: &proto.Item{
+			Data: []byte("old password"),
+			Type: string(model.Password),
+			Meta: `{"resource": "some_resource", "login": "user"}`,
+		},
+	}, nil)
+	dataType, item, err := service.GetDataRevision(context.Background(), "1", 2)
+	require.NoError(t, err)
+	assert.Equal(t, model.Password, dataType)
+	assert.Equal(t, &model.PasswordItem{
+		Type: model.Password,
+		Meta: model.PasswordMeta{
+			Resource: "some_resource",
+			Login:    "user",
+		},
+		Data:     "old password",
+		Revision: 2,
+	}, item)
+
+	vaultSrvGRPCMock.EXPECT().GetDataRevision(gomock.Any(), &proto.GetDataRevisionReq{Id: "1", Revision: 3}).
+		Times(1).Return(nil, errors.New("grpc error"))
+	_, _, err = service.GetDataRevision(context.Background(), "1", 3)
+	require.Error(t, err)
+}
+
+func TestRestoreData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().RestoreData(gomock.Any(), &proto.RestoreDataReq{Id: "1", Revision: 1}).
+		Times(1).Return(&proto.RestoreDataRes{}, nil)
+	err := service.RestoreData(context.Background(), "1", 1)
+	require.NoError(t, err)
+}
+
+func TestGetTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	deletedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	vaultSrvGRPCMock.EXPECT().GetTrash(gomock.Any(), &proto.GetTrashReq{}).
+		Times(1).Return(&proto.GetTrashRes{
+		Items: []*proto.GetTrashRes_TrashItem{
+			{
+				Id:        "1",
+				Type:      string(model.Text),
+				Meta:      `{"name": "text name", "comment": "some comment"}`,
+				DeletedAt: timestamppb.New(deletedAt),
+			},
+		},
+	}, nil)
+	trash, err := service.GetTrash(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []model.TrashItemInfo{
+		{
+			ID:   "1",
+			Type: model.Text,
+			Meta: &model.TextMeta{
+				Name:    "text name",
+				Comment: "some comment",
+			},
+			DeletedAt: deletedAt,
+		},
+	}, trash)
+
+	vaultSrvGRPCMock.EXPECT().GetTrash(gomock.Any(), &proto.GetTrashReq{}).
+		Times(1).Return(nil, errors.New("grpc error"))
+	_, err = service.GetTrash(context.Background())
+	require.Error(t, err)
+}
+
+func TestRestoreFromTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().RestoreFromTrash(gomock.Any(), &proto.RestoreFromTrashReq{Id: "1"}).
+		Times(1).Return(&proto.RestoreFromTrashRes{}, nil)
+	err := service.RestoreFromTrash(context.Background(), "1")
+	require.NoError(t, err)
+}
+
+func TestEmptyTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().EmptyTrash(gomock.Any(), &proto.EmptyTrashReq{}).
+		Times(1).Return(&proto.EmptyTrashRes{Deleted: 2}, nil)
+	deleted, err := service.EmptyTrash(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+}
+
+func TestListItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+	expires := updated.Add(24 * time.Hour)
+	vaultSrvGRPCMock.EXPECT().ListItems(gomock.Any(), &proto.ListItemsReq{
+		Type:      string(model.Password),
+		SortBy:    proto.ListItemsReq_META,
+		MetaField: "resource",
+		Desc:      true,
+		PageSize:  10,
+		PageToken: "token",
+		FolderId:  "folder",
+		Tag:       "work",
+	}).Times(1).Return(&proto.ListItemsRes{
+		Items: []*proto.ListItemsRes_ListItem{
+			{
+				Id:        "1",
+				Type:      string(model.Password),
+				Meta:      `{"resource": "some_resource", "login": "user"}`,
+				CreatedAt: timestamppb.New(created),
+				UpdatedAt: timestamppb.New(updated),
+				FolderId:  "folder",
+				Tags:      []string{"work"},
+				ExpiresAt: timestamppb.New(expires),
+			},
+		},
+		NextPageToken: "next",
+	}, nil)
+	items, next, err := service.ListItems(context.Background(), model.ItemsQuery{
+		Type:      model.Password,
+		SortBy:    model.SortByMeta,
+		MetaField: "resource",
+		Desc:      true,
+		PageSize:  10,
+		FolderID:  "folder",
+		Tag:       "work",
+	}, "token")
+	require.NoError(t, err)
+	assert.Equal(t, "next", next)
+	assert.Equal(t, []model.ItemInfo{
+		{
+			ID:   "1",
+			Type: model.Password,
+			Meta: &model.PasswordMeta{
+				Resource: "some_resource",
+				Login:    "user",
+			},
+			CreatedAt: created,
+			UpdatedAt: updated,
+			FolderID:  "folder",
+			Tags:      []string{"work"},
+			ExpiresAt: &expires,
+		},
+	}, items)
+
+	_, _, err = service.ListItems(context.Background(), model.ItemsQuery{SortBy: "unknown"}, "")
+	require.Error(t, err)
+}
+
+func TestRecentItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	accessed := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	vaultSrvGRPCMock.EXPECT().ListItems(gomock.Any(), &proto.ListItemsReq{
+		SortBy:   proto.ListItemsReq_ACCESSED,
+		Desc:     true,
+		PageSize: 5,
+	}).Times(1).Return(&proto.ListItemsRes{
+		Items: []*proto.ListItemsRes_ListItem{
+			{
+				Id:             "1",
+				Type:           string(model.Text),
+				Meta:           `{"name": "note"}`,
+				Favorite:       true,
+				LastAccessedAt: timestamppb.New(accessed),
+				AccessCount:    3,
+			},
+			{Id: "2", Type: string(model.Text), Meta: `{"name": "unused"}`},
+		},
+	}, nil)
+	items, err := service.RecentItems(context.Background(), 5)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "1", items[0].ID)
+	assert.True(t, items[0].Favorite)
+	assert.Equal(t, &accessed, items[0].LastAccessedAt)
+	assert.Equal(t, int64(3), items[0].AccessCount)
+}
+
+func TestSearchItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().SearchItems(gomock.Any(), &proto.SearchItemsReq{Query: "bank", Prefix: true}).
+		Times(1).Return(&proto.SearchItemsRes{
+		Items: []*proto.ListItemsRes_ListItem{
+			{
+				Id:        "1",
+				Type:      string(model.BankCard),
+				Meta:      `{"bank": "some bank"}`,
+				CreatedAt: timestamppb.New(time.Time{}),
+				UpdatedAt: timestamppb.New(time.Time{}),
+			},
+		},
+	}, nil)
+	items, err := service.SearchItems(context.Background(), "bank", true, false)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, &model.BankCardMeta{Bank: "some bank"}, items[0].Meta)
+
+	vaultSrvGRPCMock.EXPECT().SearchItems(gomock.Any(), &proto.SearchItemsReq{Query: "some bank", Exact: true}).
+		Times(1).Return(&proto.SearchItemsRes{}, nil)
+	items, err = service.SearchItems(context.Background(), "some bank", false, true)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	vaultSrvGRPCMock.EXPECT().SearchItems(gomock.Any(), &proto.SearchItemsReq{Query: "bank"}).
+		Times(1).Return(nil, errors.New("grpc error"))
+	_, err = service.SearchItems(context.Background(), "bank", false, false)
+	require.Error(t, err)
+}
+
+func TestGetUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	vaultSrvGRPCMock := mocks.NewMockVaultServiceClient(ctrl)
+	service := NewService(&grpc.Client{VaultClient: vaultSrvGRPCMock})
+
+	vaultSrvGRPCMock.EXPECT().GetUsage(gomock.Any(), &proto.GetUsageReq{}).
+		Times(1).Return(&proto.GetUsageRes{
+		Usage: []*proto.GetUsageRes_TypeUsage{
+			{Type: string(model.File), Items: 2, Bytes: 2048},
+			{Type: string(model.Password), Items: 1, Bytes: 30},
+		},
+		Quota: &proto.GetUsageRes_Quota{MaxBytes: 4096, MaxItems: 10, MaxMetaSize: 100},
+	}, nil)
+	usage, err := service.GetUsage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &model.UsageInfo{
+		Types: []model.TypeUsage{
+			{Type: model.File, Items: 2, Bytes: 2048},
+			{Type: model.Password, Items: 1, Bytes: 30},
+		},
+		Quota: model.Quota{MaxBytes: 4096, MaxItems: 10, MaxMetaSize: 100},
+	}, usage)
+
+	vaultSrvGRPCMock.EXPECT().GetUsage(gomock.Any(), &proto.GetUsageReq{}).
+		Times(1).Return(nil, status.Error(codes.Internal, "Internal server error"))
+	_, err = service.GetUsage(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrAuditChainBroken возвращается, если цепочка событий журнала аудита нарушена
+// (событие изменено, удалено или вставлено).
+var ErrAuditChainBroken = errors.New("audit chain is broken")
+
+// auditHeadInfo отделяет отметки последнего события журнала от хэшей событий.
+const auditHeadInfo = "gophkeeper audit head"
+
+// AuditEvent описывает запись журнала аудита.
+// События каждого пользователя образуют цепочку: хэш события - HMAC-SHA256 от его полей и хэша предыдущего
+// события на ключе журнала, полученном из мастер ключа сервера. Без ключа нельзя пересчитать хэши,
+// поэтому изменение или удаление любого события обнаруживается при проверке цепочки.
+type AuditEvent struct {
+	UserID    string // Пользователь, выполнивший запрос.
+	Seq       int64  // Порядковый номер события в журнале пользователя, начиная с 1.
+	Action    string // Вызванный метод сервера.
+	ItemID    string // Данные, к которым относится запрос; пустое значение - запрос не относится к данным.
+	Peer      string // Адрес клиента.
+	Code      string // Код результата выполнения запроса.
+	CreatedAt time.Time
+	PrevHash  []byte // Хэш предыдущего события пользователя, пустой у первого события.
+	Hash      []byte
+}
+
+// Chain связывает событие с последним событием журнала пользователя (nil - событий еще нет):
+// заполняет порядковый номер, хэш предыдущего события и хэш самого события на ключе журнала.
+// Время события приводится к UTC с точностью до микросекунд, чтобы хэш совпадал после чтения из БД.
+func (e *AuditEvent) Chain(last *AuditEvent, key []byte) {
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+	e.CreatedAt = e.CreatedAt.UTC().Truncate(time.Microsecond)
+	e.Seq = 1
+	e.PrevHash = []byte{}
+	if last != nil {
+		e.Seq = last.Seq + 1
+		e.PrevHash = last.Hash
+	}
+	e.Hash = e.ComputeHash(key)
+}
+
+// ComputeHash вычисляет хэш события по его полям и хэшу предыдущего события на ключе журнала.
+func (e AuditEvent) ComputeHash(key []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	for _, field := range []string{e.UserID, e.Action, e.ItemID, e.Peer, e.Code, string(e.PrevHash)} {
+		// Длина перед каждым полем исключает совпадение хэшей при переносе символов между полями.
+		_ = binary.Write(h, binary.BigEndian, int64(len(field)))
+		h.Write([]byte(field))
+	}
+	_ = binary.Write(h, binary.BigEndian, e.Seq)
+	_ = binary.Write(h, binary.BigEndian, e.CreatedAt.UnixMicro())
+	return h.Sum(nil)
+}
+
+// HeadTag вычисляет отметку последнего события журнала (см. AuditHead).
+// Отметка отличается от хэша события, поэтому хэш более раннего события нельзя выдать за отметку.
+func (e AuditEvent) HeadTag(key []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(auditHeadInfo))
+	h.Write(e.Hash)
+	return h.Sum(nil)
+}
+
+// AuditHead описывает последнее событие журнала пользователя. Хранилище обновляет его вместе
+// с добавлением события, поэтому удаление событий с конца журнала обнаруживается при проверке:
+// события с номером Seq нет или его отметка не совпадает с Tag.
+type AuditHead struct {
+	Seq int64  // Номер последнего события; 0 - событий нет.
+	Tag []byte // Отметка последнего события (см. AuditEvent.HeadTag).
+}
+
+// Verify проверяет, что событие является последним событием журнала.
+func (h AuditHead) Verify(event AuditEvent, key []byte) error {
+	if event.Seq != h.Seq || !hmac.Equal(h.Tag, event.HeadTag(key)) {
+		return fmt.Errorf("%w: event %d is not the last event", ErrAuditChainBroken, event.Seq)
+	}
+	return nil
+}
+
+// VerifyAuditChain проверяет непрерывную последовательность событий журнала пользователя,
+// упорядоченную по номеру: хэш каждого события на ключе журнала и связь событий между собой
+// (см. VerifyAuditLinks).
+func VerifyAuditChain(events []AuditEvent, key []byte) error {
+	for _, event := range events {
+		if !hmac.Equal(event.Hash, event.ComputeHash(key)) {
+			return fmt.Errorf("%w: event %d hash mismatch", ErrAuditChainBroken, event.Seq)
+		}
+	}
+	return VerifyAuditLinks(events)
+}
+
+// VerifyAuditLinks проверяет связь событий непрерывной последовательности журнала без ключа:
+// номера событий идут подряд, и каждое событие ссылается на хэш предыдущего.
+// Если последовательность начинается с первого события, проверяется и начало цепочки.
+func VerifyAuditLinks(events []AuditEvent) error {
+	for i, event := range events {
+		switch {
+		case i > 0:
+			prev := events[i-1]
+			if event.Seq != prev.Seq+1 || !bytes.Equal(event.PrevHash, prev.Hash) {
+				return fmt.Errorf("%w: event %d does not follow event %d", ErrAuditChainBroken, event.Seq, prev.Seq)
+			}
+		case event.Seq == 1 && len(event.PrevHash) != 0:
+			return fmt.Errorf("%w: first event has previous hash", ErrAuditChainBroken)
+		}
+	}
+	return nil
+}
+
+// ListAuditParams описывает параметры выборки событий журнала аудита из хранилища.
+type ListAuditParams struct {
+	AfterSeq int64 // Номер события, после которого нужно вернуть события; 0 - с начала журнала.
+	Limit    int   // Максимальное количество событий.
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testChain возвращает цепочку событий с указанными методами, связанную на ключе key.
+func testChain(key []byte, actions ...string) []AuditEvent {
+	events := make([]AuditEvent, len(actions))
+	for i, action := range actions {
+		events[i] = AuditEvent{UserID: "1", Action: action, ItemID: "item", Peer: "127.0.0.1:5000", Code: "OK"}
+		if i == 0 {
+			events[i].Chain(nil, key)
+		} else {
+			events[i].Chain(&events[i-1], key)
+		}
+	}
+	return events
+}
+
+func TestVerifyAuditChain(t *testing.T) {
+	key := []byte("audit key")
+	events := testChain(key, "Login", "GetData", "DeleteData")
+
+	// Хэши пересчитаны после изменения события так, как их мог бы пересчитать владелец БД без ключа.
+	recomputed := testChain(key, "Login", "GetData", "DeleteData")
+	recomputed[1].Action = "ListItems"
+	for i := 1; i < len(recomputed); i++ {
+		recomputed[i].PrevHash = recomputed[i-1].Hash
+		hash := sha256.Sum256([]byte(recomputed[i].Action + string(recomputed[i].PrevHash)))
+		recomputed[i].Hash = hash[:]
+	}
+	// Хэши пересчитаны на другом ключе.
+	rekeyed := testChain([]byte("other key"), "Login", "ListItems", "DeleteData")
+
+	tests := []struct {
+		name    string
+		events  []AuditEvent
+		wantErr bool
+	}{
+		{
+			name:   "Цепочка не изменена",
+			events: events,
+		},
+		{
+			name:   "Страница с середины журнала",
+			events: events[1:],
+		},
+		{
+			name:   "Пустая цепочка",
+			events: nil,
+		},
+		{
+			name:    "Событие изменено и хэши пересчитаны без ключа",
+			events:  recomputed,
+			wantErr: true,
+		},
+		{
+			name:    "Хэши пересчитаны на другом ключе",
+			events:  rekeyed,
+			wantErr: true,
+		},
+		{
+			name:    "Событие удалено",
+			events:  []AuditEvent{events[0], events[2]},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyAuditChain(tt.events, key)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrAuditChainBroken)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	// Связь событий без ключа не обнаруживает пересчитанные хэши, их проверяет сервер.
+	require.NoError(t, VerifyAuditLinks(recomputed))
+	assert.ErrorIs(t, VerifyAuditLinks([]AuditEvent{events[0], events[2]}), ErrAuditChainBroken)
+}
+
+func TestAuditHead(t *testing.T) {
+	key := []byte("audit key")
+	events := testChain(key, "Login", "GetData", "DeleteData")
+	head := AuditHead{Seq: 3, Tag: events[2].HeadTag(key)}
+	require.NoError(t, head.Verify(events[2], key))
+
+	// Последнее событие удалено: предыдущее событие не совпадает с отметкой, даже если изменить номер.
+	assert.ErrorIs(t, head.Verify(events[1], key), ErrAuditChainBroken)
+	truncated := AuditHead{Seq: 2, Tag: head.Tag}
+	assert.ErrorIs(t, truncated.Verify(events[1], key), ErrAuditChainBroken)
+	// Хэш события нельзя выдать за отметку.
+	forged := AuditHead{Seq: 2, Tag: events[1].Hash}
+	assert.ErrorIs(t, forged.Verify(events[1], key), ErrAuditChainBroken)
+	assert.ErrorIs(t, head.Verify(events[2], []byte("other key")), ErrAuditChainBroken)
+}
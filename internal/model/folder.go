@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// Folder описывает папку пользователя для группировки данных. Папки могут быть вложенными.
+type Folder struct {
+	ID          string
+	UserID      string
+	ParentID    string // Родительская папка, пустая строка - папка верхнего уровня.
+	EncryptName []byte // Зашифрованное название папки.
+	Name        string // Название папки в открытом виде, сервер записывает его после расшифровки.
+	CreatedAt   time.Time
+}
+
+// Tag описывает метку данных пользователя.
+type Tag struct {
+	ID          string
+	UserID      string
+	EncryptName []byte // Зашифрованное название метки.
+	NameIndex   string // Слепой индекс названия, по которому хранилище находит метку.
+	Name        string // Название метки в открытом виде, сервер записывает его после расшифровки.
+	Items       int64  // Количество данных с меткой (без данных в корзине).
+}
@@ -0,0 +1,58 @@
+package model
+
+import "time"
+
+// OrgRole enum ролей участников организации.
+type OrgRole string
+
+// Роли участников организации.
+const (
+	OrgRoleOwner  OrgRole = "OWNER"  // Полный доступ, включая удаление организации и назначение владельцев.
+	OrgRoleAdmin  OrgRole = "ADMIN"  // Изменение данных и управление участниками с ролями MEMBER и READER.
+	OrgRoleMember OrgRole = "MEMBER" // Чтение и изменение данных.
+	OrgRoleReader OrgRole = "READER" // Только чтение данных.
+)
+
+// Valid проверяет, что роль известна.
+func (r OrgRole) Valid() bool {
+	switch r {
+	case OrgRoleOwner, OrgRoleAdmin, OrgRoleMember, OrgRoleReader:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrgLoginPrefix префикс логинов аккаунтов организаций; такие логины недоступны при регистрации.
+const OrgLoginPrefix = "org:"
+
+// OrgMDKey ключ в метаданных grpc запроса, в котором передается id организации,
+// с данными которой работает запрос к хранилищу.
+const OrgMDKey = "org"
+
+// Organization описывает структуру организации.
+type Organization struct {
+	ID              string
+	EncryptName     []byte // Название, зашифрованное ключом организации.
+	EncryptedSecret string // Ключ организации, зашифрованный мастер ключом (в hex формате).
+	CreatedAt       time.Time
+}
+
+// OrgMember описывает участника организации.
+type OrgMember struct {
+	OrgID          string
+	UserID         string
+	Login          string // Заполняется только в списке участников.
+	Role           OrgRole
+	EncryptKey     []byte // Ключ организации, зашифрованный открытым ключом участника.
+	EncryptOrgName []byte // Заполняется только в списке организаций пользователя.
+	CreatedAt      time.Time
+}
+
+// OrgInfo описывает структуру организации для вывода списка.
+type OrgInfo struct {
+	ID        string
+	Name      string
+	Role      OrgRole
+	CreatedAt time.Time
+}
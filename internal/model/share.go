@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+// ShareAccess enum уровней доступа к чужим данным.
+type ShareAccess string
+
+// Уровни доступа к чужим данным.
+const (
+	ShareRead  ShareAccess = "READ"
+	ShareWrite ShareAccess = "WRITE"
+)
+
+// Share описывает доступ, предоставленный владельцем данных другому пользователю.
+type Share struct {
+	ItemID         string
+	OwnerID        string
+	OwnerLogin     string // Заполняется только в списке доступов.
+	RecipientID    string
+	RecipientLogin string // Заполняется только в списке доступов.
+	Access         ShareAccess
+	EncryptKey     []byte   // Ключ данных, зашифрованный открытым ключом получателя.
+	ItemType       DataType // Заполняется только в списке доступов.
+	SharedMeta     []byte   // Мета данные, зашифрованные ключом данных (заполняется только в списке доступов).
+	CreatedAt      time.Time
+}
+
+// ItemKey описывает перевод данных на собственный (в том числе новый) ключ: содержимое текущей и всех
+// предыдущих версий, перешифрованное ключом данных, и мета данные для пользователей с доступом к данным.
+type ItemKey struct {
+	EncryptKey  []byte // Ключ данных, зашифрованный ключом владельца.
+	PrevKey     []byte // Текущий зашифрованный ключ данных (nil - данные зашифрованы ключом владельца).
+	Revision    int64  // Версия данных, содержимое которой перешифровано.
+	EncryptData []byte
+	SharedMeta  []byte
+	History     []ItemKeyRevision
+}
+
+// ItemKeyRevision описывает перешифрованное ключом данных содержимое предыдущей версии.
+type ItemKeyRevision struct {
+	Revision    int64
+	EncryptData []byte
+	SharedMeta  []byte
+}
+
+// SharedItemInfo описывает структуру данных с совместным доступом для вывода списка.
+type SharedItemInfo struct {
+	ID        string
+	Login     string // Логин владельца для чужих данных, логин получателя для своих.
+	Type      DataType
+	Meta      any // Заполняется только для чужих данных.
+	Write     bool
+	CreatedAt time.Time
+}
+
+// SharedItems описывает списки данных с совместным доступом.
+type SharedItems struct {
+	WithMe []SharedItemInfo // Данные других пользователей, к которым открыт доступ.
+	ByMe   []SharedItemInfo // Свои данные, к которым открыт доступ другим пользователям.
+}
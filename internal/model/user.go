@@ -6,4 +6,8 @@ type User struct {
 	Login           string
 	PasswordHash    string
 	EncryptedSecret string
+	// Открытый ключ X25519 пользователя, которым шифруются ключи данных, к которым ему открыт доступ.
+	PublicKey []byte
+	// Закрытый ключ X25519, зашифрованный ключом пользователя. Пустой, пока ключи не созданы.
+	EncryptPrivateKey []byte
 }
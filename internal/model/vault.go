@@ -1,6 +1,11 @@
 package model
 
-import "time"
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+)
 
 // DataType enum типов данных.
 type DataType string
@@ -18,10 +23,238 @@ type VaultItem struct {
 	ID          string
 	UserID      string
 	EncryptData []byte
-	Meta        string
-	Type        DataType
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	EncryptMeta []byte   // Зашифрованные мета данные.
+	MetaIndex   []string // Слепые индексы полей мета данных для поиска по точному совпадению.
+	// Мета данные в открытом виде. Хранилище возвращает в нем только мета данные, сохраненные
+	// до включения их шифрования; сервер записывает сюда мета данные после расшифровки EncryptMeta.
+	Meta      string
+	Type      DataType
+	Revision  int64 // Номер текущей версии, увеличивается при каждом изменении данных.
+	Chunked   bool  // EncryptData содержит манифест файла, сохраненного блоками в хранилище блоков.
+	Size      int64 // Размер незашифрованных данных (для файла, сохраненного блоками, - размер файла).
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time // Время перемещения в корзину, nil - данные не удалены.
+	FolderID  string     // Папка данных, пустая строка - данные не в папке.
+	ParentID  string     // Данные, к которым прикреплено вложение; пустая строка - данные не являются вложением.
+	ExpiresAt *time.Time // Время, после которого данные удаляются; nil - данные хранятся бессрочно.
+	TagIDs    []string   // Id меток данных (заполняется только в списках данных).
+	Favorite  bool       // Данные закреплены в избранном.
+	// Время последнего получения данных, nil - данные не получались.
+	LastAccessedAt *time.Time
+	AccessCount    int64 // Количество получений данных.
+	// Собственный ключ данных, зашифрованный ключом владельца. Появляется при первом предоставлении
+	// доступа к данным; nil - данные зашифрованы ключом владельца.
+	EncryptKey []byte
+	SharedMeta []byte // Мета данные, зашифрованные ключом данных, для пользователей с доступом к ним.
+}
+
+// MetaValue возвращает строковое значение поля мета данных или пустую строку,
+// если такого поля нет.
+func (i VaultItem) MetaValue(field string) string {
+	var meta map[string]any
+	if err := json.Unmarshal([]byte(i.Meta), &meta); err != nil {
+		return ""
+	}
+	value, _ := meta[field].(string)
+	return value
+}
+
+// Expired проверяет, истек ли к моменту now срок хранения данных.
+func (i VaultItem) Expired(now time.Time) bool {
+	return i.ExpiresAt != nil && !i.ExpiresAt.After(now)
+}
+
+// CreateTimes возвращает время создания и обновления новых данных. Заданное время сохраняется
+// (например, при импорте), незаданное заменяется now; время обновления не раньше времени создания.
+func (i VaultItem) CreateTimes(now time.Time) (time.Time, time.Time) {
+	created, updated := i.CreatedAt, i.UpdatedAt
+	if created.IsZero() {
+		created = now
+	}
+	if updated.IsZero() || updated.Before(created) {
+		updated = created
+	}
+	return created, updated
+}
+
+// ItemsSort enum полей сортировки списка данных.
+type ItemsSort string
+
+// Поля сортировки списка данных.
+const (
+	SortByCreated  ItemsSort = "created"
+	SortByUpdated  ItemsSort = "updated"
+	SortByMeta     ItemsSort = "meta"
+	SortByAccessed ItemsSort = "accessed" // По времени последнего получения, не получавшиеся данные - в начале.
+)
+
+// ItemsQuery описывает параметры запроса списка данных.
+type ItemsQuery struct {
+	Type      DataType  // Тип данных, пустое значение - все типы.
+	SortBy    ItemsSort // Поле сортировки.
+	MetaField string    // Поле мета данных при сортировке по мета данным.
+	Desc      bool      // Сортировка по убыванию.
+	PageSize  int       // Размер страницы.
+	FolderID  string    // Папка данных, пустое значение - все данные.
+	Tag       string    // Метка данных, пустое значение - все данные.
+	// Избранные данные в начале списка независимо от направления сортировки.
+	FavoritesFirst bool
+}
+
+// ItemsCursor описывает позицию в отсортированном списке данных,
+// после которой начинается следующая страница.
+type ItemsCursor struct {
+	Favorite bool      // Данные в избранном (только при выборке с избранными данными в начале).
+	Time     time.Time // Значение времени при сортировке по created/updated/accessed.
+	Meta     string    // Значение поля мета данных при сортировке по мета данным.
+	ID       string
+}
+
+// NewItemsCursor возвращает позицию в списке, соответствующую переданным данным.
+func NewItemsCursor(item VaultItem, params ListItemsParams) *ItemsCursor {
+	cursor := &ItemsCursor{ID: item.ID, Favorite: params.FavoritesFirst && item.Favorite}
+	switch params.SortBy {
+	case SortByCreated:
+		cursor.Time = item.CreatedAt
+	case SortByUpdated:
+		cursor.Time = item.UpdatedAt
+	case SortByAccessed:
+		if item.LastAccessedAt != nil {
+			cursor.Time = *item.LastAccessedAt
+		}
+	case SortByMeta:
+		cursor.Meta = item.MetaValue(params.MetaField)
+	}
+	return cursor
+}
+
+// CompareItemsCursors сравнивает позиции в списке: сначала по значению сортировки, затем по id.
+// Признак избранного не учитывается (см. CompareListPositions).
+func CompareItemsCursors(a, b *ItemsCursor) int {
+	if c := a.Time.Compare(b.Time); c != 0 {
+		return c
+	}
+	if c := strings.Compare(a.Meta, b.Meta); c != 0 {
+		return c
+	}
+	return strings.Compare(a.ID, b.ID)
+}
+
+// CompareListPositions сравнивает позиции в списке с учетом направления сортировки:
+// избранные данные идут первыми, остальные упорядочиваются по CompareItemsCursors (при desc - в обратном порядке).
+func CompareListPositions(a, b *ItemsCursor, desc bool) int {
+	if a.Favorite != b.Favorite {
+		if a.Favorite {
+			return -1
+		}
+		return 1
+	}
+	c := CompareItemsCursors(a, b)
+	if desc {
+		return -c
+	}
+	return c
+}
+
+// ListItemsParams описывает параметры выборки списка данных.
+// Хранилище сортирует данные только по created/updated: мета данные в нем зашифрованы,
+// поэтому сортировку по ним выполняет сервер после расшифровки (см. PageItems).
+type ListItemsParams struct {
+	Type      DataType     // Тип данных, пустое значение - все типы.
+	SortBy    ItemsSort    // Поле сортировки, при равенстве значений данные упорядочиваются по id.
+	MetaField string       // Поле мета данных при сортировке по мета данным.
+	Desc      bool         // Сортировка по убыванию.
+	Limit     int          // Максимальное количество записей.
+	After     *ItemsCursor // Позиция, после которой нужно вернуть данные; nil - с начала списка.
+	FolderID  string       // Только данные из папки (без вложенных папок), пустое значение - все данные.
+	TagIndex  string       // Только данные с меткой, слепой индекс которой передан; пустое значение - все данные.
+	// Избранные данные в начале списка, затем остальные; порядок внутри групп задается SortBy и Desc.
+	FavoritesFirst bool
+}
+
+// PageItems упорядочивает данные по параметрам выборки и возвращает страницу,
+// начинающуюся после позиции params.After. Тип данных не фильтруется.
+func PageItems(items []VaultItem, params ListItemsParams) []VaultItem {
+	slices.SortFunc(items, func(a, b VaultItem) int {
+		return CompareListPositions(NewItemsCursor(a, params), NewItemsCursor(b, params), params.Desc)
+	})
+
+	if params.After != nil {
+		start := len(items)
+		for i, item := range items {
+			if CompareListPositions(NewItemsCursor(item, params), params.After, params.Desc) > 0 {
+				start = i
+				break
+			}
+		}
+		items = items[start:]
+	}
+	if params.Limit > 0 && len(items) > params.Limit {
+		items = items[:params.Limit]
+	}
+	return items
+}
+
+// SearchItemsParams описывает параметры поиска данных в хранилище по слепым индексам мета данных.
+type SearchItemsParams struct {
+	Index []string // Слепые индексы, хотя бы один из которых должен быть у данных.
+	Limit int      // Максимальное количество записей.
+}
+
+// SearchMetaFields возвращает поля мета данных, по которым выполняется поиск подстроки.
+func SearchMetaFields() []string {
+	return []string{"resource", "login", "name", "bank", "comment"}
+}
+
+// IndexedMetaFields возвращает поля мета данных, для которых вычисляются слепые индексы
+// (поиск по точному совпадению значения).
+func IndexedMetaFields() []string {
+	return []string{"resource", "login", "name", "bank"}
+}
+
+// MatchMeta проверяет, содержит ли одно из полей поиска мета данных строку query (в нижнем регистре).
+// При prefix значение поля должно начинаться со строки query.
+func MatchMeta(item VaultItem, query string, prefix bool) bool {
+	for _, field := range SearchMetaFields() {
+		value := strings.ToLower(item.MetaValue(field))
+		if prefix && strings.HasPrefix(value, query) || !prefix && strings.Contains(value, query) {
+			return true
+		}
+	}
+	return false
+}
+
+// LegacyMeta описывает мета данные, сохраненные в открытом виде до включения их шифрования.
+type LegacyMeta struct {
+	UserID      string
+	ItemID      string
+	Revision    int64    // Номер версии из истории изменений; 0 - текущая версия данных.
+	Meta        string   // Мета данные в открытом виде.
+	EncryptMeta []byte   // Зашифрованные мета данные, которыми нужно заменить открытые.
+	MetaIndex   []string // Слепые индексы полей мета данных.
+}
+
+// VaultItemRevision описывает структуру сохраненной предыдущей версии данных.
+type VaultItemRevision struct {
+	ItemID      string
+	Revision    int64
+	EncryptData []byte
+	EncryptMeta []byte
+	MetaIndex   []string
+	Meta        string    // Мета данные версии, сохраненные до включения их шифрования.
+	Chunked     bool      // EncryptData содержит манифест файла, сохраненного блоками в хранилище блоков.
+	Size        int64     // Размер незашифрованных данных версии.
+	SharedMeta  []byte    // Мета данные версии, зашифрованные ключом данных (см. VaultItem.SharedMeta).
+	CreatedAt   time.Time // Время, когда данные этой версии были сохранены.
+}
+
+// TypeUsage описывает объем хранилища, занятый данными пользователя одного типа.
+// Учитываются текущие версии данных, включая данные в корзине.
+type TypeUsage struct {
+	Type  DataType
+	Items int64 // Количество данных.
+	Bytes int64 // Суммарный размер данных и мета данных (зашифрованных или сохраненных до шифрования).
 }
 
 // PasswordMeta описывает структуру мета данных пароля.
@@ -61,33 +294,109 @@ type BankCardData struct {
 
 // PasswordItem описывает структуру пароля, которую возвращает сервис.
 type PasswordItem struct {
-	Type DataType
-	Meta PasswordMeta
-	Data string
+	Type     DataType
+	Meta     PasswordMeta
+	Data     string
+	Revision int64
 }
 
 // TextItem описывает структуру текстовой информации, которую возвращает сервис.
 type TextItem struct {
-	Type DataType
-	Meta TextMeta
-	Data string
+	Type     DataType
+	Meta     TextMeta
+	Data     string
+	Revision int64
 }
 
 // BankCardItem описывает структуру банковской карты, которую возвращает сервис.
 type BankCardItem struct {
-	Type DataType
-	Meta BankCardMeta
-	Data BankCardData
+	Type     DataType
+	Meta     BankCardMeta
+	Data     BankCardData
+	Revision int64
 }
 
 // FileItem описывает структуру файла, которую возвращает сервис.
 type FileItem struct {
-	Type DataType
-	Meta FileMeta
+	Type     DataType
+	Meta     FileMeta
+	Revision int64
+}
+
+// RevisionInfo описывает структуру данных о версии для вывода истории изменений.
+type RevisionInfo struct {
+	Revision  int64
+	CreatedAt time.Time
 }
 
 // ItemInfo описывает структуру данных для вывода списка.
 type ItemInfo struct {
-	ID   string
-	Meta any
+	ID        string
+	Type      DataType
+	Meta      any
+	Revision  int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	FolderID  string
+	Tags      []string
+	ExpiresAt *time.Time // Время удаления данных, nil - данные хранятся бессрочно.
+	Favorite  bool
+	// Время последнего получения данных, nil - данные не получались.
+	LastAccessedAt *time.Time
+	AccessCount    int64
+}
+
+// TrashItemInfo описывает структуру данных в корзине для вывода списка.
+type TrashItemInfo struct {
+	ID        string
+	Type      DataType
+	Meta      any
+	DeletedAt time.Time
+}
+
+// AttachmentInfo описывает структуру файла, прикрепленного к данным, для вывода.
+type AttachmentInfo struct {
+	ID        string
+	Meta      *FileMeta
+	Size      int64
+	CreatedAt time.Time
+}
+
+// UsageInfo описывает структуру данных об использовании хранилища для вывода.
+type UsageInfo struct {
+	Types []TypeUsage
+	Quota Quota
+}
+
+// Quota описывает ограничения объема данных пользователя (0 - ограничение отключено).
+type Quota struct {
+	MaxBytes    int64
+	MaxItems    int64
+	MaxItemSize int64
+	MaxMetaSize int64
+}
+
+// ExportRecord описывает структуру данных в файле экспорта хранилища (одна запись JSON в строке).
+type ExportRecord struct {
+	ID        string     `json:"id"`
+	Type      DataType   `json:"type"`
+	Meta      string     `json:"meta"`
+	Data      []byte     `json:"data"`
+	ParentID  string     `json:"parentId,omitempty"` // Данные из того же файла, к которым прикреплено вложение.
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// ImportFailure описывает ошибку импорта одной записи файла экспорта.
+type ImportFailure struct {
+	Index int64 // Номер записи в файле, начиная с 0.
+	ID    string
+	Error string
+}
+
+// ImportResult описывает результат импорта данных. Если есть ошибки, данные не импортируются.
+type ImportResult struct {
+	Imported int64
+	Failures []ImportFailure
 }
@@ -0,0 +1,352 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/proto/org_grpc.pb.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	proto "github.com/pinbrain/gophkeeper/internal/proto"
+	grpc "google.golang.org/grpc"
+)
+
+// MockOrgServiceClient is a mock of OrgServiceClient interface.
+type MockOrgServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrgServiceClientMockRecorder
+}
+
+// MockOrgServiceClientMockRecorder is the mock recorder for MockOrgServiceClient.
+type MockOrgServiceClientMockRecorder struct {
+	mock *MockOrgServiceClient
+}
+
+// NewMockOrgServiceClient creates a new mock instance.
+func NewMockOrgServiceClient(ctrl *gomock.Controller) *MockOrgServiceClient {
+	mock := &MockOrgServiceClient{ctrl: ctrl}
+	mock.recorder = &MockOrgServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrgServiceClient) EXPECT() *MockOrgServiceClientMockRecorder {
+	return m.recorder
+}
+
+// AddOrgMember mocks base method.
+func (m *MockOrgServiceClient) AddOrgMember(ctx context.Context, in *proto.AddOrgMemberReq, opts ...grpc.CallOption) (*proto.AddOrgMemberRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddOrgMember", varargs...)
+	ret0, _ := ret[0].(*proto.AddOrgMemberRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrgMember indicates an expected call of AddOrgMember.
+func (mr *MockOrgServiceClientMockRecorder) AddOrgMember(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrgMember", reflect.TypeOf((*MockOrgServiceClient)(nil).AddOrgMember), varargs...)
+}
+
+// CreateOrg mocks base method.
+func (m *MockOrgServiceClient) CreateOrg(ctx context.Context, in *proto.CreateOrgReq, opts ...grpc.CallOption) (*proto.CreateOrgRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateOrg", varargs...)
+	ret0, _ := ret[0].(*proto.CreateOrgRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrg indicates an expected call of CreateOrg.
+func (mr *MockOrgServiceClientMockRecorder) CreateOrg(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrg", reflect.TypeOf((*MockOrgServiceClient)(nil).CreateOrg), varargs...)
+}
+
+// DeleteOrg mocks base method.
+func (m *MockOrgServiceClient) DeleteOrg(ctx context.Context, in *proto.DeleteOrgReq, opts ...grpc.CallOption) (*proto.DeleteOrgRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteOrg", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteOrgRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrg indicates an expected call of DeleteOrg.
+func (mr *MockOrgServiceClientMockRecorder) DeleteOrg(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrg", reflect.TypeOf((*MockOrgServiceClient)(nil).DeleteOrg), varargs...)
+}
+
+// ListOrgMembers mocks base method.
+func (m *MockOrgServiceClient) ListOrgMembers(ctx context.Context, in *proto.ListOrgMembersReq, opts ...grpc.CallOption) (*proto.ListOrgMembersRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOrgMembers", varargs...)
+	ret0, _ := ret[0].(*proto.ListOrgMembersRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgMembers indicates an expected call of ListOrgMembers.
+func (mr *MockOrgServiceClientMockRecorder) ListOrgMembers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgMembers", reflect.TypeOf((*MockOrgServiceClient)(nil).ListOrgMembers), varargs...)
+}
+
+// ListOrgs mocks base method.
+func (m *MockOrgServiceClient) ListOrgs(ctx context.Context, in *proto.ListOrgsReq, opts ...grpc.CallOption) (*proto.ListOrgsRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOrgs", varargs...)
+	ret0, _ := ret[0].(*proto.ListOrgsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgs indicates an expected call of ListOrgs.
+func (mr *MockOrgServiceClientMockRecorder) ListOrgs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgs", reflect.TypeOf((*MockOrgServiceClient)(nil).ListOrgs), varargs...)
+}
+
+// RemoveOrgMember mocks base method.
+func (m *MockOrgServiceClient) RemoveOrgMember(ctx context.Context, in *proto.RemoveOrgMemberReq, opts ...grpc.CallOption) (*proto.RemoveOrgMemberRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveOrgMember", varargs...)
+	ret0, _ := ret[0].(*proto.RemoveOrgMemberRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveOrgMember indicates an expected call of RemoveOrgMember.
+func (mr *MockOrgServiceClientMockRecorder) RemoveOrgMember(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrgMember", reflect.TypeOf((*MockOrgServiceClient)(nil).RemoveOrgMember), varargs...)
+}
+
+// SetOrgMemberRole mocks base method.
+func (m *MockOrgServiceClient) SetOrgMemberRole(ctx context.Context, in *proto.SetOrgMemberRoleReq, opts ...grpc.CallOption) (*proto.SetOrgMemberRoleRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetOrgMemberRole", varargs...)
+	ret0, _ := ret[0].(*proto.SetOrgMemberRoleRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetOrgMemberRole indicates an expected call of SetOrgMemberRole.
+func (mr *MockOrgServiceClientMockRecorder) SetOrgMemberRole(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrgMemberRole", reflect.TypeOf((*MockOrgServiceClient)(nil).SetOrgMemberRole), varargs...)
+}
+
+// MockOrgServiceServer is a mock of OrgServiceServer interface.
+type MockOrgServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrgServiceServerMockRecorder
+}
+
+// MockOrgServiceServerMockRecorder is the mock recorder for MockOrgServiceServer.
+type MockOrgServiceServerMockRecorder struct {
+	mock *MockOrgServiceServer
+}
+
+// NewMockOrgServiceServer creates a new mock instance.
+func NewMockOrgServiceServer(ctrl *gomock.Controller) *MockOrgServiceServer {
+	mock := &MockOrgServiceServer{ctrl: ctrl}
+	mock.recorder = &MockOrgServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrgServiceServer) EXPECT() *MockOrgServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddOrgMember mocks base method.
+func (m *MockOrgServiceServer) AddOrgMember(arg0 context.Context, arg1 *proto.AddOrgMemberReq) (*proto.AddOrgMemberRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrgMember", arg0, arg1)
+	ret0, _ := ret[0].(*proto.AddOrgMemberRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrgMember indicates an expected call of AddOrgMember.
+func (mr *MockOrgServiceServerMockRecorder) AddOrgMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrgMember", reflect.TypeOf((*MockOrgServiceServer)(nil).AddOrgMember), arg0, arg1)
+}
+
+// CreateOrg mocks base method.
+func (m *MockOrgServiceServer) CreateOrg(arg0 context.Context, arg1 *proto.CreateOrgReq) (*proto.CreateOrgRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrg", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CreateOrgRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrg indicates an expected call of CreateOrg.
+func (mr *MockOrgServiceServerMockRecorder) CreateOrg(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrg", reflect.TypeOf((*MockOrgServiceServer)(nil).CreateOrg), arg0, arg1)
+}
+
+// DeleteOrg mocks base method.
+func (m *MockOrgServiceServer) DeleteOrg(arg0 context.Context, arg1 *proto.DeleteOrgReq) (*proto.DeleteOrgRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrg", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteOrgRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrg indicates an expected call of DeleteOrg.
+func (mr *MockOrgServiceServerMockRecorder) DeleteOrg(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrg", reflect.TypeOf((*MockOrgServiceServer)(nil).DeleteOrg), arg0, arg1)
+}
+
+// ListOrgMembers mocks base method.
+func (m *MockOrgServiceServer) ListOrgMembers(arg0 context.Context, arg1 *proto.ListOrgMembersReq) (*proto.ListOrgMembersRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgMembers", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListOrgMembersRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgMembers indicates an expected call of ListOrgMembers.
+func (mr *MockOrgServiceServerMockRecorder) ListOrgMembers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgMembers", reflect.TypeOf((*MockOrgServiceServer)(nil).ListOrgMembers), arg0, arg1)
+}
+
+// ListOrgs mocks base method.
+func (m *MockOrgServiceServer) ListOrgs(arg0 context.Context, arg1 *proto.ListOrgsReq) (*proto.ListOrgsRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgs", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListOrgsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgs indicates an expected call of ListOrgs.
+func (mr *MockOrgServiceServerMockRecorder) ListOrgs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgs", reflect.TypeOf((*MockOrgServiceServer)(nil).ListOrgs), arg0, arg1)
+}
+
+// RemoveOrgMember mocks base method.
+func (m *MockOrgServiceServer) RemoveOrgMember(arg0 context.Context, arg1 *proto.RemoveOrgMemberReq) (*proto.RemoveOrgMemberRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrgMember", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RemoveOrgMemberRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveOrgMember indicates an expected call of RemoveOrgMember.
+func (mr *MockOrgServiceServerMockRecorder) RemoveOrgMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrgMember", reflect.TypeOf((*MockOrgServiceServer)(nil).RemoveOrgMember), arg0, arg1)
+}
+
+// SetOrgMemberRole mocks base method.
+func (m *MockOrgServiceServer) SetOrgMemberRole(arg0 context.Context, arg1 *proto.SetOrgMemberRoleReq) (*proto.SetOrgMemberRoleRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOrgMemberRole", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SetOrgMemberRoleRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetOrgMemberRole indicates an expected call of SetOrgMemberRole.
+func (mr *MockOrgServiceServerMockRecorder) SetOrgMemberRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrgMemberRole", reflect.TypeOf((*MockOrgServiceServer)(nil).SetOrgMemberRole), arg0, arg1)
+}
+
+// mustEmbedUnimplementedOrgServiceServer mocks base method.
+func (m *MockOrgServiceServer) mustEmbedUnimplementedOrgServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedOrgServiceServer")
+}
+
+// mustEmbedUnimplementedOrgServiceServer indicates an expected call of mustEmbedUnimplementedOrgServiceServer.
+func (mr *MockOrgServiceServerMockRecorder) mustEmbedUnimplementedOrgServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedOrgServiceServer", reflect.TypeOf((*MockOrgServiceServer)(nil).mustEmbedUnimplementedOrgServiceServer))
+}
+
+// MockUnsafeOrgServiceServer is a mock of UnsafeOrgServiceServer interface.
+type MockUnsafeOrgServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeOrgServiceServerMockRecorder
+}
+
+// MockUnsafeOrgServiceServerMockRecorder is the mock recorder for MockUnsafeOrgServiceServer.
+type MockUnsafeOrgServiceServerMockRecorder struct {
+	mock *MockUnsafeOrgServiceServer
+}
+
+// NewMockUnsafeOrgServiceServer creates a new mock instance.
+func NewMockUnsafeOrgServiceServer(ctrl *gomock.Controller) *MockUnsafeOrgServiceServer {
+	mock := &MockUnsafeOrgServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeOrgServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeOrgServiceServer) EXPECT() *MockUnsafeOrgServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedOrgServiceServer mocks base method.
+func (m *MockUnsafeOrgServiceServer) mustEmbedUnimplementedOrgServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedOrgServiceServer")
+}
+
+// mustEmbedUnimplementedOrgServiceServer indicates an expected call of mustEmbedUnimplementedOrgServiceServer.
+func (mr *MockUnsafeOrgServiceServerMockRecorder) mustEmbedUnimplementedOrgServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedOrgServiceServer", reflect.TypeOf((*MockUnsafeOrgServiceServer)(nil).mustEmbedUnimplementedOrgServiceServer))
+}
@@ -36,6 +36,46 @@ func (m *MockUserServiceClient) EXPECT() *MockUserServiceClientMockRecorder {
 	return m.recorder
 }
 
+// DeleteAccount mocks base method.
+func (m *MockUserServiceClient) DeleteAccount(ctx context.Context, in *proto.DeleteAccountReq, opts ...grpc.CallOption) (*proto.DeleteAccountRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAccount", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteAccountRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockUserServiceClientMockRecorder) DeleteAccount(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockUserServiceClient)(nil).DeleteAccount), varargs...)
+}
+
+// ListAuditEvents mocks base method.
+func (m *MockUserServiceClient) ListAuditEvents(ctx context.Context, in *proto.ListAuditEventsReq, opts ...grpc.CallOption) (*proto.ListAuditEventsRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuditEvents", varargs...)
+	ret0, _ := ret[0].(*proto.ListAuditEventsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockUserServiceClientMockRecorder) ListAuditEvents(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockUserServiceClient)(nil).ListAuditEvents), varargs...)
+}
+
 // Login mocks base method.
 func (m *MockUserServiceClient) Login(ctx context.Context, in *proto.LoginReq, opts ...grpc.CallOption) (*proto.LoginRes, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +139,36 @@ func (m *MockUserServiceServer) EXPECT() *MockUserServiceServerMockRecorder {
 	return m.recorder
 }
 
+// DeleteAccount mocks base method.
+func (m *MockUserServiceServer) DeleteAccount(arg0 context.Context, arg1 *proto.DeleteAccountReq) (*proto.DeleteAccountRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteAccountRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockUserServiceServerMockRecorder) DeleteAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockUserServiceServer)(nil).DeleteAccount), arg0, arg1)
+}
+
+// ListAuditEvents mocks base method.
+func (m *MockUserServiceServer) ListAuditEvents(arg0 context.Context, arg1 *proto.ListAuditEventsReq) (*proto.ListAuditEventsRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListAuditEventsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockUserServiceServerMockRecorder) ListAuditEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockUserServiceServer)(nil).ListAuditEvents), arg0, arg1)
+}
+
 // Login mocks base method.
 func (m *MockUserServiceServer) Login(arg0 context.Context, arg1 *proto.LoginReq) (*proto.LoginRes, error) {
 	m.ctrl.T.Helper()
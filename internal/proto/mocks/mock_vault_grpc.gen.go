@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	proto "github.com/pinbrain/gophkeeper/internal/proto"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockVaultServiceClient is a mock of VaultServiceClient interface.
@@ -56,6 +57,26 @@ func (mr *MockVaultServiceClientMockRecorder) AddData(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddData", reflect.TypeOf((*MockVaultServiceClient)(nil).AddData), varargs...)
 }
 
+// CreateFolder mocks base method.
+func (m *MockVaultServiceClient) CreateFolder(ctx context.Context, in *proto.CreateFolderReq, opts ...grpc.CallOption) (*proto.CreateFolderRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateFolder", varargs...)
+	ret0, _ := ret[0].(*proto.CreateFolderRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockVaultServiceClientMockRecorder) CreateFolder(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockVaultServiceClient)(nil).CreateFolder), varargs...)
+}
+
 // DeleteData mocks base method.
 func (m *MockVaultServiceClient) DeleteData(ctx context.Context, in *proto.DeleteDataReq, opts ...grpc.CallOption) (*proto.DeleteDataRes, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +97,86 @@ func (mr *MockVaultServiceClientMockRecorder) DeleteData(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteData", reflect.TypeOf((*MockVaultServiceClient)(nil).DeleteData), varargs...)
 }
 
+// DeleteFolder mocks base method.
+func (m *MockVaultServiceClient) DeleteFolder(ctx context.Context, in *proto.DeleteFolderReq, opts ...grpc.CallOption) (*proto.DeleteFolderRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteFolder", varargs...)
+	ret0, _ := ret[0].(*proto.DeleteFolderRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockVaultServiceClientMockRecorder) DeleteFolder(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockVaultServiceClient)(nil).DeleteFolder), varargs...)
+}
+
+// DownloadFile mocks base method.
+func (m *MockVaultServiceClient) DownloadFile(ctx context.Context, in *proto.DownloadFileReq, opts ...grpc.CallOption) (proto.VaultService_DownloadFileClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DownloadFile", varargs...)
+	ret0, _ := ret[0].(proto.VaultService_DownloadFileClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadFile indicates an expected call of DownloadFile.
+func (mr *MockVaultServiceClientMockRecorder) DownloadFile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadFile", reflect.TypeOf((*MockVaultServiceClient)(nil).DownloadFile), varargs...)
+}
+
+// EmptyTrash mocks base method.
+func (m *MockVaultServiceClient) EmptyTrash(ctx context.Context, in *proto.EmptyTrashReq, opts ...grpc.CallOption) (*proto.EmptyTrashRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EmptyTrash", varargs...)
+	ret0, _ := ret[0].(*proto.EmptyTrashRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmptyTrash indicates an expected call of EmptyTrash.
+func (mr *MockVaultServiceClientMockRecorder) EmptyTrash(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyTrash", reflect.TypeOf((*MockVaultServiceClient)(nil).EmptyTrash), varargs...)
+}
+
+// ExportVault mocks base method.
+func (m *MockVaultServiceClient) ExportVault(ctx context.Context, in *proto.ExportVaultReq, opts ...grpc.CallOption) (proto.VaultService_ExportVaultClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportVault", varargs...)
+	ret0, _ := ret[0].(proto.VaultService_ExportVaultClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportVault indicates an expected call of ExportVault.
+func (mr *MockVaultServiceClientMockRecorder) ExportVault(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportVault", reflect.TypeOf((*MockVaultServiceClient)(nil).ExportVault), varargs...)
+}
+
 // GetAllByType mocks base method.
 func (m *MockVaultServiceClient) GetAllByType(ctx context.Context, in *proto.GetAllByTypeReq, opts ...grpc.CallOption) (*proto.GetAllByTypeRes, error) {
 	m.ctrl.T.Helper()
@@ -116,167 +217,1929 @@ func (mr *MockVaultServiceClientMockRecorder) GetData(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetData", reflect.TypeOf((*MockVaultServiceClient)(nil).GetData), varargs...)
 }
 
-// UpdateData mocks base method.
-func (m *MockVaultServiceClient) UpdateData(ctx context.Context, in *proto.UpdateDataReq, opts ...grpc.CallOption) (*proto.UpdateDataRes, error) {
+// GetDataHistory mocks base method.
+func (m *MockVaultServiceClient) GetDataHistory(ctx context.Context, in *proto.GetDataHistoryReq, opts ...grpc.CallOption) (*proto.GetDataHistoryRes, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateData", varargs...)
-	ret0, _ := ret[0].(*proto.UpdateDataRes)
+	ret := m.ctrl.Call(m, "GetDataHistory", varargs...)
+	ret0, _ := ret[0].(*proto.GetDataHistoryRes)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateData indicates an expected call of UpdateData.
-func (mr *MockVaultServiceClientMockRecorder) UpdateData(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// GetDataHistory indicates an expected call of GetDataHistory.
+func (mr *MockVaultServiceClientMockRecorder) GetDataHistory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateData", reflect.TypeOf((*MockVaultServiceClient)(nil).UpdateData), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataHistory", reflect.TypeOf((*MockVaultServiceClient)(nil).GetDataHistory), varargs...)
 }
 
-// MockVaultServiceServer is a mock of VaultServiceServer interface.
-type MockVaultServiceServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockVaultServiceServerMockRecorder
+// GetDataRevision mocks base method.
+func (m *MockVaultServiceClient) GetDataRevision(ctx context.Context, in *proto.GetDataRevisionReq, opts ...grpc.CallOption) (*proto.GetDataRevisionRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDataRevision", varargs...)
+	ret0, _ := ret[0].(*proto.GetDataRevisionRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockVaultServiceServerMockRecorder is the mock recorder for MockVaultServiceServer.
-type MockVaultServiceServerMockRecorder struct {
-	mock *MockVaultServiceServer
+// GetDataRevision indicates an expected call of GetDataRevision.
+func (mr *MockVaultServiceClientMockRecorder) GetDataRevision(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataRevision", reflect.TypeOf((*MockVaultServiceClient)(nil).GetDataRevision), varargs...)
 }
 
-// NewMockVaultServiceServer creates a new mock instance.
-func NewMockVaultServiceServer(ctrl *gomock.Controller) *MockVaultServiceServer {
-	mock := &MockVaultServiceServer{ctrl: ctrl}
-	mock.recorder = &MockVaultServiceServerMockRecorder{mock}
-	return mock
+// GetTrash mocks base method.
+func (m *MockVaultServiceClient) GetTrash(ctx context.Context, in *proto.GetTrashReq, opts ...grpc.CallOption) (*proto.GetTrashRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTrash", varargs...)
+	ret0, _ := ret[0].(*proto.GetTrashRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockVaultServiceServer) EXPECT() *MockVaultServiceServerMockRecorder {
-	return m.recorder
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockVaultServiceClientMockRecorder) GetTrash(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockVaultServiceClient)(nil).GetTrash), varargs...)
 }
 
-// AddData mocks base method.
-func (m *MockVaultServiceServer) AddData(arg0 context.Context, arg1 *proto.AddDataReq) (*proto.AddDataRes, error) {
+// GetUsage mocks base method.
+func (m *MockVaultServiceClient) GetUsage(ctx context.Context, in *proto.GetUsageReq, opts ...grpc.CallOption) (*proto.GetUsageRes, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddData", arg0, arg1)
-	ret0, _ := ret[0].(*proto.AddDataRes)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUsage", varargs...)
+	ret0, _ := ret[0].(*proto.GetUsageRes)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddData indicates an expected call of AddData.
-func (mr *MockVaultServiceServerMockRecorder) AddData(arg0, arg1 interface{}) *gomock.Call {
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockVaultServiceClientMockRecorder) GetUsage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddData", reflect.TypeOf((*MockVaultServiceServer)(nil).AddData), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockVaultServiceClient)(nil).GetUsage), varargs...)
 }
 
-// DeleteData mocks base method.
-func (m *MockVaultServiceServer) DeleteData(arg0 context.Context, arg1 *proto.DeleteDataReq) (*proto.DeleteDataRes, error) {
+// ImportVault mocks base method.
+func (m *MockVaultServiceClient) ImportVault(ctx context.Context, opts ...grpc.CallOption) (proto.VaultService_ImportVaultClient, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteData", arg0, arg1)
-	ret0, _ := ret[0].(*proto.DeleteDataRes)
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportVault", varargs...)
+	ret0, _ := ret[0].(proto.VaultService_ImportVaultClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteData indicates an expected call of DeleteData.
-func (mr *MockVaultServiceServerMockRecorder) DeleteData(arg0, arg1 interface{}) *gomock.Call {
+// ImportVault indicates an expected call of ImportVault.
+func (mr *MockVaultServiceClientMockRecorder) ImportVault(ctx interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteData", reflect.TypeOf((*MockVaultServiceServer)(nil).DeleteData), arg0, arg1)
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportVault", reflect.TypeOf((*MockVaultServiceClient)(nil).ImportVault), varargs...)
 }
 
-// GetAllByType mocks base method.
-func (m *MockVaultServiceServer) GetAllByType(arg0 context.Context, arg1 *proto.GetAllByTypeReq) (*proto.GetAllByTypeRes, error) {
+// ListFolders mocks base method.
+func (m *MockVaultServiceClient) ListFolders(ctx context.Context, in *proto.ListFoldersReq, opts ...grpc.CallOption) (*proto.ListFoldersRes, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllByType", arg0, arg1)
-	ret0, _ := ret[0].(*proto.GetAllByTypeRes)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFolders", varargs...)
+	ret0, _ := ret[0].(*proto.ListFoldersRes)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllByType indicates an expected call of GetAllByType.
-func (mr *MockVaultServiceServerMockRecorder) GetAllByType(arg0, arg1 interface{}) *gomock.Call {
+// ListFolders indicates an expected call of ListFolders.
+func (mr *MockVaultServiceClientMockRecorder) ListFolders(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByType", reflect.TypeOf((*MockVaultServiceServer)(nil).GetAllByType), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFolders", reflect.TypeOf((*MockVaultServiceClient)(nil).ListFolders), varargs...)
 }
 
-// GetData mocks base method.
-func (m *MockVaultServiceServer) GetData(arg0 context.Context, arg1 *proto.GetDataReq) (*proto.GetDataRes, error) {
+// ListItems mocks base method.
+func (m *MockVaultServiceClient) ListItems(ctx context.Context, in *proto.ListItemsReq, opts ...grpc.CallOption) (*proto.ListItemsRes, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetData", arg0, arg1)
-	ret0, _ := ret[0].(*proto.GetDataRes)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListItems", varargs...)
+	ret0, _ := ret[0].(*proto.ListItemsRes)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetData indicates an expected call of GetData.
-func (mr *MockVaultServiceServerMockRecorder) GetData(arg0, arg1 interface{}) *gomock.Call {
+// ListItems indicates an expected call of ListItems.
+func (mr *MockVaultServiceClientMockRecorder) ListItems(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetData", reflect.TypeOf((*MockVaultServiceServer)(nil).GetData), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockVaultServiceClient)(nil).ListItems), varargs...)
 }
 
-// UpdateData mocks base method.
-func (m *MockVaultServiceServer) UpdateData(arg0 context.Context, arg1 *proto.UpdateDataReq) (*proto.UpdateDataRes, error) {
+// ListShared mocks base method.
+func (m *MockVaultServiceClient) ListShared(ctx context.Context, in *proto.ListSharedReq, opts ...grpc.CallOption) (*proto.ListSharedRes, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateData", arg0, arg1)
-	ret0, _ := ret[0].(*proto.UpdateDataRes)
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListShared", varargs...)
+	ret0, _ := ret[0].(*proto.ListSharedRes)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateData indicates an expected call of UpdateData.
-func (mr *MockVaultServiceServerMockRecorder) UpdateData(arg0, arg1 interface{}) *gomock.Call {
+// ListShared indicates an expected call of ListShared.
+func (mr *MockVaultServiceClientMockRecorder) ListShared(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateData", reflect.TypeOf((*MockVaultServiceServer)(nil).UpdateData), arg0, arg1)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShared", reflect.TypeOf((*MockVaultServiceClient)(nil).ListShared), varargs...)
 }
 
-// mustEmbedUnimplementedVaultServiceServer mocks base method.
-func (m *MockVaultServiceServer) mustEmbedUnimplementedVaultServiceServer() {
+// ListTags mocks base method.
+func (m *MockVaultServiceClient) ListTags(ctx context.Context, in *proto.ListTagsReq, opts ...grpc.CallOption) (*proto.ListTagsRes, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "mustEmbedUnimplementedVaultServiceServer")
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTags", varargs...)
+	ret0, _ := ret[0].(*proto.ListTagsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// mustEmbedUnimplementedVaultServiceServer indicates an expected call of mustEmbedUnimplementedVaultServiceServer.
-func (mr *MockVaultServiceServerMockRecorder) mustEmbedUnimplementedVaultServiceServer() *gomock.Call {
+// ListTags indicates an expected call of ListTags.
+func (mr *MockVaultServiceClientMockRecorder) ListTags(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedVaultServiceServer", reflect.TypeOf((*MockVaultServiceServer)(nil).mustEmbedUnimplementedVaultServiceServer))
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockVaultServiceClient)(nil).ListTags), varargs...)
 }
 
-// MockUnsafeVaultServiceServer is a mock of UnsafeVaultServiceServer interface.
-type MockUnsafeVaultServiceServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockUnsafeVaultServiceServerMockRecorder
+// MoveItem mocks base method.
+func (m *MockVaultServiceClient) MoveItem(ctx context.Context, in *proto.MoveItemReq, opts ...grpc.CallOption) (*proto.MoveItemRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveItem", varargs...)
+	ret0, _ := ret[0].(*proto.MoveItemRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockUnsafeVaultServiceServerMockRecorder is the mock recorder for MockUnsafeVaultServiceServer.
-type MockUnsafeVaultServiceServerMockRecorder struct {
-	mock *MockUnsafeVaultServiceServer
+// MoveItem indicates an expected call of MoveItem.
+func (mr *MockVaultServiceClientMockRecorder) MoveItem(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveItem", reflect.TypeOf((*MockVaultServiceClient)(nil).MoveItem), varargs...)
 }
 
-// NewMockUnsafeVaultServiceServer creates a new mock instance.
-func NewMockUnsafeVaultServiceServer(ctrl *gomock.Controller) *MockUnsafeVaultServiceServer {
-	mock := &MockUnsafeVaultServiceServer{ctrl: ctrl}
-	mock.recorder = &MockUnsafeVaultServiceServerMockRecorder{mock}
-	return mock
+// RestoreData mocks base method.
+func (m *MockVaultServiceClient) RestoreData(ctx context.Context, in *proto.RestoreDataReq, opts ...grpc.CallOption) (*proto.RestoreDataRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreData", varargs...)
+	ret0, _ := ret[0].(*proto.RestoreDataRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUnsafeVaultServiceServer) EXPECT() *MockUnsafeVaultServiceServerMockRecorder {
-	return m.recorder
+// RestoreData indicates an expected call of RestoreData.
+func (mr *MockVaultServiceClientMockRecorder) RestoreData(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreData", reflect.TypeOf((*MockVaultServiceClient)(nil).RestoreData), varargs...)
 }
 
-// mustEmbedUnimplementedVaultServiceServer mocks base method.
-func (m *MockUnsafeVaultServiceServer) mustEmbedUnimplementedVaultServiceServer() {
+// RestoreFromTrash mocks base method.
+func (m *MockVaultServiceClient) RestoreFromTrash(ctx context.Context, in *proto.RestoreFromTrashReq, opts ...grpc.CallOption) (*proto.RestoreFromTrashRes, error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "mustEmbedUnimplementedVaultServiceServer")
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreFromTrash", varargs...)
+	ret0, _ := ret[0].(*proto.RestoreFromTrashRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// mustEmbedUnimplementedVaultServiceServer indicates an expected call of mustEmbedUnimplementedVaultServiceServer.
-func (mr *MockUnsafeVaultServiceServerMockRecorder) mustEmbedUnimplementedVaultServiceServer() *gomock.Call {
+// RestoreFromTrash indicates an expected call of RestoreFromTrash.
+func (mr *MockVaultServiceClientMockRecorder) RestoreFromTrash(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedVaultServiceServer", reflect.TypeOf((*MockUnsafeVaultServiceServer)(nil).mustEmbedUnimplementedVaultServiceServer))
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFromTrash", reflect.TypeOf((*MockVaultServiceClient)(nil).RestoreFromTrash), varargs...)
+}
+
+// RevokeShare mocks base method.
+func (m *MockVaultServiceClient) RevokeShare(ctx context.Context, in *proto.RevokeShareReq, opts ...grpc.CallOption) (*proto.RevokeShareRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeShare", varargs...)
+	ret0, _ := ret[0].(*proto.RevokeShareRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeShare indicates an expected call of RevokeShare.
+func (mr *MockVaultServiceClientMockRecorder) RevokeShare(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShare", reflect.TypeOf((*MockVaultServiceClient)(nil).RevokeShare), varargs...)
+}
+
+// SearchItems mocks base method.
+func (m *MockVaultServiceClient) SearchItems(ctx context.Context, in *proto.SearchItemsReq, opts ...grpc.CallOption) (*proto.SearchItemsRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchItems", varargs...)
+	ret0, _ := ret[0].(*proto.SearchItemsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchItems indicates an expected call of SearchItems.
+func (mr *MockVaultServiceClientMockRecorder) SearchItems(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchItems", reflect.TypeOf((*MockVaultServiceClient)(nil).SearchItems), varargs...)
+}
+
+// SetFavorite mocks base method.
+func (m *MockVaultServiceClient) SetFavorite(ctx context.Context, in *proto.SetFavoriteReq, opts ...grpc.CallOption) (*proto.SetFavoriteRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetFavorite", varargs...)
+	ret0, _ := ret[0].(*proto.SetFavoriteRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFavorite indicates an expected call of SetFavorite.
+func (mr *MockVaultServiceClientMockRecorder) SetFavorite(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFavorite", reflect.TypeOf((*MockVaultServiceClient)(nil).SetFavorite), varargs...)
+}
+
+// ShareItem mocks base method.
+func (m *MockVaultServiceClient) ShareItem(ctx context.Context, in *proto.ShareItemReq, opts ...grpc.CallOption) (*proto.ShareItemRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ShareItem", varargs...)
+	ret0, _ := ret[0].(*proto.ShareItemRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShareItem indicates an expected call of ShareItem.
+func (mr *MockVaultServiceClientMockRecorder) ShareItem(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareItem", reflect.TypeOf((*MockVaultServiceClient)(nil).ShareItem), varargs...)
+}
+
+// TagItem mocks base method.
+func (m *MockVaultServiceClient) TagItem(ctx context.Context, in *proto.TagItemReq, opts ...grpc.CallOption) (*proto.TagItemRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TagItem", varargs...)
+	ret0, _ := ret[0].(*proto.TagItemRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagItem indicates an expected call of TagItem.
+func (mr *MockVaultServiceClientMockRecorder) TagItem(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagItem", reflect.TypeOf((*MockVaultServiceClient)(nil).TagItem), varargs...)
+}
+
+// UntagItem mocks base method.
+func (m *MockVaultServiceClient) UntagItem(ctx context.Context, in *proto.UntagItemReq, opts ...grpc.CallOption) (*proto.UntagItemRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UntagItem", varargs...)
+	ret0, _ := ret[0].(*proto.UntagItemRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagItem indicates an expected call of UntagItem.
+func (mr *MockVaultServiceClientMockRecorder) UntagItem(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagItem", reflect.TypeOf((*MockVaultServiceClient)(nil).UntagItem), varargs...)
+}
+
+// UpdateData mocks base method.
+func (m *MockVaultServiceClient) UpdateData(ctx context.Context, in *proto.UpdateDataReq, opts ...grpc.CallOption) (*proto.UpdateDataRes, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateData", varargs...)
+	ret0, _ := ret[0].(*proto.UpdateDataRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateData indicates an expected call of UpdateData.
+func (mr *MockVaultServiceClientMockRecorder) UpdateData(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateData", reflect.TypeOf((*MockVaultServiceClient)(nil).UpdateData), varargs...)
+}
+
+// UploadFile mocks base method.
+func (m *MockVaultServiceClient) UploadFile(ctx context.Context, opts ...grpc.CallOption) (proto.VaultService_UploadFileClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadFile", varargs...)
+	ret0, _ := ret[0].(proto.VaultService_UploadFileClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadFile indicates an expected call of UploadFile.
+func (mr *MockVaultServiceClientMockRecorder) UploadFile(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockVaultServiceClient)(nil).UploadFile), varargs...)
+}
+
+// MockVaultService_UploadFileClient is a mock of VaultService_UploadFileClient interface.
+type MockVaultService_UploadFileClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultService_UploadFileClientMockRecorder
+}
+
+// MockVaultService_UploadFileClientMockRecorder is the mock recorder for MockVaultService_UploadFileClient.
+type MockVaultService_UploadFileClientMockRecorder struct {
+	mock *MockVaultService_UploadFileClient
+}
+
+// NewMockVaultService_UploadFileClient creates a new mock instance.
+func NewMockVaultService_UploadFileClient(ctrl *gomock.Controller) *MockVaultService_UploadFileClient {
+	mock := &MockVaultService_UploadFileClient{ctrl: ctrl}
+	mock.recorder = &MockVaultService_UploadFileClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaultService_UploadFileClient) EXPECT() *MockVaultService_UploadFileClientMockRecorder {
+	return m.recorder
+}
+
+// CloseAndRecv mocks base method.
+func (m *MockVaultService_UploadFileClient) CloseAndRecv() (*proto.UploadFileRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAndRecv")
+	ret0, _ := ret[0].(*proto.UploadFileRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAndRecv indicates an expected call of CloseAndRecv.
+func (mr *MockVaultService_UploadFileClientMockRecorder) CloseAndRecv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockVaultService_UploadFileClient)(nil).CloseAndRecv))
+}
+
+// CloseSend mocks base method.
+func (m *MockVaultService_UploadFileClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockVaultService_UploadFileClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockVaultService_UploadFileClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockVaultService_UploadFileClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockVaultService_UploadFileClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockVaultService_UploadFileClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockVaultService_UploadFileClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockVaultService_UploadFileClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockVaultService_UploadFileClient)(nil).Header))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockVaultService_UploadFileClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockVaultService_UploadFileClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockVaultService_UploadFileClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockVaultService_UploadFileClient) Send(arg0 *proto.UploadFileReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockVaultService_UploadFileClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockVaultService_UploadFileClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockVaultService_UploadFileClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockVaultService_UploadFileClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockVaultService_UploadFileClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockVaultService_UploadFileClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockVaultService_UploadFileClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockVaultService_UploadFileClient)(nil).Trailer))
+}
+
+// MockVaultService_DownloadFileClient is a mock of VaultService_DownloadFileClient interface.
+type MockVaultService_DownloadFileClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultService_DownloadFileClientMockRecorder
+}
+
+// MockVaultService_DownloadFileClientMockRecorder is the mock recorder for MockVaultService_DownloadFileClient.
+type MockVaultService_DownloadFileClientMockRecorder struct {
+	mock *MockVaultService_DownloadFileClient
+}
+
+// NewMockVaultService_DownloadFileClient creates a new mock instance.
+func NewMockVaultService_DownloadFileClient(ctrl *gomock.Controller) *MockVaultService_DownloadFileClient {
+	mock := &MockVaultService_DownloadFileClient{ctrl: ctrl}
+	mock.recorder = &MockVaultService_DownloadFileClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaultService_DownloadFileClient) EXPECT() *MockVaultService_DownloadFileClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockVaultService_DownloadFileClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockVaultService_DownloadFileClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockVaultService_DownloadFileClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockVaultService_DownloadFileClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockVaultService_DownloadFileClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockVaultService_DownloadFileClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockVaultService_DownloadFileClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockVaultService_DownloadFileClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockVaultService_DownloadFileClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockVaultService_DownloadFileClient) Recv() (*proto.DownloadFileRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*proto.DownloadFileRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockVaultService_DownloadFileClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockVaultService_DownloadFileClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockVaultService_DownloadFileClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockVaultService_DownloadFileClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockVaultService_DownloadFileClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockVaultService_DownloadFileClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockVaultService_DownloadFileClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockVaultService_DownloadFileClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockVaultService_DownloadFileClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockVaultService_DownloadFileClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockVaultService_DownloadFileClient)(nil).Trailer))
+}
+
+// MockVaultService_ExportVaultClient is a mock of VaultService_ExportVaultClient interface.
+type MockVaultService_ExportVaultClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultService_ExportVaultClientMockRecorder
+}
+
+// MockVaultService_ExportVaultClientMockRecorder is the mock recorder for MockVaultService_ExportVaultClient.
+type MockVaultService_ExportVaultClientMockRecorder struct {
+	mock *MockVaultService_ExportVaultClient
+}
+
+// NewMockVaultService_ExportVaultClient creates a new mock instance.
+func NewMockVaultService_ExportVaultClient(ctrl *gomock.Controller) *MockVaultService_ExportVaultClient {
+	mock := &MockVaultService_ExportVaultClient{ctrl: ctrl}
+	mock.recorder = &MockVaultService_ExportVaultClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaultService_ExportVaultClient) EXPECT() *MockVaultService_ExportVaultClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockVaultService_ExportVaultClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockVaultService_ExportVaultClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockVaultService_ExportVaultClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockVaultService_ExportVaultClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockVaultService_ExportVaultClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockVaultService_ExportVaultClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockVaultService_ExportVaultClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockVaultService_ExportVaultClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockVaultService_ExportVaultClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockVaultService_ExportVaultClient) Recv() (*proto.ExportVaultRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*proto.ExportVaultRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockVaultService_ExportVaultClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockVaultService_ExportVaultClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockVaultService_ExportVaultClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockVaultService_ExportVaultClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockVaultService_ExportVaultClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockVaultService_ExportVaultClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockVaultService_ExportVaultClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockVaultService_ExportVaultClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockVaultService_ExportVaultClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockVaultService_ExportVaultClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockVaultService_ExportVaultClient)(nil).Trailer))
+}
+
+// MockVaultService_ImportVaultClient is a mock of VaultService_ImportVaultClient interface.
+type MockVaultService_ImportVaultClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultService_ImportVaultClientMockRecorder
+}
+
+// MockVaultService_ImportVaultClientMockRecorder is the mock recorder for MockVaultService_ImportVaultClient.
+type MockVaultService_ImportVaultClientMockRecorder struct {
+	mock *MockVaultService_ImportVaultClient
+}
+
+// NewMockVaultService_ImportVaultClient creates a new mock instance.
+func NewMockVaultService_ImportVaultClient(ctrl *gomock.Controller) *MockVaultService_ImportVaultClient {
+	mock := &MockVaultService_ImportVaultClient{ctrl: ctrl}
+	mock.recorder = &MockVaultService_ImportVaultClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaultService_ImportVaultClient) EXPECT() *MockVaultService_ImportVaultClientMockRecorder {
+	return m.recorder
+}
+
+// CloseAndRecv mocks base method.
+func (m *MockVaultService_ImportVaultClient) CloseAndRecv() (*proto.ImportVaultRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAndRecv")
+	ret0, _ := ret[0].(*proto.ImportVaultRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAndRecv indicates an expected call of CloseAndRecv.
+func (mr *MockVaultService_ImportVaultClientMockRecorder) CloseAndRecv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockVaultService_ImportVaultClient)(nil).CloseAndRecv))
+}
+
+// CloseSend mocks base method.
+func (m *MockVaultService_ImportVaultClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockVaultService_ImportVaultClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockVaultService_ImportVaultClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockVaultService_ImportVaultClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockVaultService_ImportVaultClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockVaultService_ImportVaultClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockVaultService_ImportVaultClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockVaultService_ImportVaultClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockVaultService_ImportVaultClient)(nil).Header))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockVaultService_ImportVaultClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockVaultService_ImportVaultClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockVaultService_ImportVaultClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockVaultService_ImportVaultClient) Send(arg0 *proto.ImportVaultReq) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockVaultService_ImportVaultClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockVaultService_ImportVaultClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockVaultService_ImportVaultClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockVaultService_ImportVaultClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockVaultService_ImportVaultClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockVaultService_ImportVaultClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockVaultService_ImportVaultClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockVaultService_ImportVaultClient)(nil).Trailer))
+}
+
+// MockVaultServiceServer is a mock of VaultServiceServer interface.
+type MockVaultServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultServiceServerMockRecorder
+}
+
+// MockVaultServiceServerMockRecorder is the mock recorder for MockVaultServiceServer.
+type MockVaultServiceServerMockRecorder struct {
+	mock *MockVaultServiceServer
+}
+
+// NewMockVaultServiceServer creates a new mock instance.
+func NewMockVaultServiceServer(ctrl *gomock.Controller) *MockVaultServiceServer {
+	mock := &MockVaultServiceServer{ctrl: ctrl}
+	mock.recorder = &MockVaultServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaultServiceServer) EXPECT() *MockVaultServiceServerMockRecorder {
+	return m.recorder
+}
+
+// AddData mocks base method.
+func (m *MockVaultServiceServer) AddData(arg0 context.Context, arg1 *proto.AddDataReq) (*proto.AddDataRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddData", arg0, arg1)
+	ret0, _ := ret[0].(*proto.AddDataRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddData indicates an expected call of AddData.
+func (mr *MockVaultServiceServerMockRecorder) AddData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddData", reflect.TypeOf((*MockVaultServiceServer)(nil).AddData), arg0, arg1)
+}
+
+// CreateFolder mocks base method.
+func (m *MockVaultServiceServer) CreateFolder(arg0 context.Context, arg1 *proto.CreateFolderReq) (*proto.CreateFolderRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CreateFolderRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockVaultServiceServerMockRecorder) CreateFolder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockVaultServiceServer)(nil).CreateFolder), arg0, arg1)
+}
+
+// DeleteData mocks base method.
+func (m *MockVaultServiceServer) DeleteData(arg0 context.Context, arg1 *proto.DeleteDataReq) (*proto.DeleteDataRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteData", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteDataRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteData indicates an expected call of DeleteData.
+func (mr *MockVaultServiceServerMockRecorder) DeleteData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteData", reflect.TypeOf((*MockVaultServiceServer)(nil).DeleteData), arg0, arg1)
+}
+
+// DeleteFolder mocks base method.
+func (m *MockVaultServiceServer) DeleteFolder(arg0 context.Context, arg1 *proto.DeleteFolderReq) (*proto.DeleteFolderRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolder", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DeleteFolderRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockVaultServiceServerMockRecorder) DeleteFolder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockVaultServiceServer)(nil).DeleteFolder), arg0, arg1)
+}
+
+// DownloadFile mocks base method.
+func (m *MockVaultServiceServer) DownloadFile(arg0 *proto.DownloadFileReq, arg1 proto.VaultService_DownloadFileServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadFile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadFile indicates an expected call of DownloadFile.
+func (mr *MockVaultServiceServerMockRecorder) DownloadFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadFile", reflect.TypeOf((*MockVaultServiceServer)(nil).DownloadFile), arg0, arg1)
+}
+
+// EmptyTrash mocks base method.
+func (m *MockVaultServiceServer) EmptyTrash(arg0 context.Context, arg1 *proto.EmptyTrashReq) (*proto.EmptyTrashRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmptyTrash", arg0, arg1)
+	ret0, _ := ret[0].(*proto.EmptyTrashRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmptyTrash indicates an expected call of EmptyTrash.
+func (mr *MockVaultServiceServerMockRecorder) EmptyTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyTrash", reflect.TypeOf((*MockVaultServiceServer)(nil).EmptyTrash), arg0, arg1)
+}
+
+// ExportVault mocks base method.
+func (m *MockVaultServiceServer) ExportVault(arg0 *proto.ExportVaultReq, arg1 proto.VaultService_ExportVaultServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportVault", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportVault indicates an expected call of ExportVault.
+func (mr *MockVaultServiceServerMockRecorder) ExportVault(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportVault", reflect.TypeOf((*MockVaultServiceServer)(nil).ExportVault), arg0, arg1)
+}
+
+// GetAllByType mocks base method.
+func (m *MockVaultServiceServer) GetAllByType(arg0 context.Context, arg1 *proto.GetAllByTypeReq) (*proto.GetAllByTypeRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByType", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetAllByTypeRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByType indicates an expected call of GetAllByType.
+func (mr *MockVaultServiceServerMockRecorder) GetAllByType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByType", reflect.TypeOf((*MockVaultServiceServer)(nil).GetAllByType), arg0, arg1)
+}
+
+// GetData mocks base method.
+func (m *MockVaultServiceServer) GetData(arg0 context.Context, arg1 *proto.GetDataReq) (*proto.GetDataRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetData", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetDataRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetData indicates an expected call of GetData.
+func (mr *MockVaultServiceServerMockRecorder) GetData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetData", reflect.TypeOf((*MockVaultServiceServer)(nil).GetData), arg0, arg1)
+}
+
+// GetDataHistory mocks base method.
+func (m *MockVaultServiceServer) GetDataHistory(arg0 context.Context, arg1 *proto.GetDataHistoryReq) (*proto.GetDataHistoryRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataHistory", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetDataHistoryRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataHistory indicates an expected call of GetDataHistory.
+func (mr *MockVaultServiceServerMockRecorder) GetDataHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataHistory", reflect.TypeOf((*MockVaultServiceServer)(nil).GetDataHistory), arg0, arg1)
+}
+
+// GetDataRevision mocks base method.
+func (m *MockVaultServiceServer) GetDataRevision(arg0 context.Context, arg1 *proto.GetDataRevisionReq) (*proto.GetDataRevisionRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataRevision", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetDataRevisionRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataRevision indicates an expected call of GetDataRevision.
+func (mr *MockVaultServiceServerMockRecorder) GetDataRevision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataRevision", reflect.TypeOf((*MockVaultServiceServer)(nil).GetDataRevision), arg0, arg1)
+}
+
+// GetTrash mocks base method.
+func (m *MockVaultServiceServer) GetTrash(arg0 context.Context, arg1 *proto.GetTrashReq) (*proto.GetTrashRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetTrashRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockVaultServiceServerMockRecorder) GetTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockVaultServiceServer)(nil).GetTrash), arg0, arg1)
+}
+
+// GetUsage mocks base method.
+func (m *MockVaultServiceServer) GetUsage(arg0 context.Context, arg1 *proto.GetUsageReq) (*proto.GetUsageRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetUsageRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockVaultServiceServerMockRecorder) GetUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockVaultServiceServer)(nil).GetUsage), arg0, arg1)
+}
+
+// ImportVault mocks base method.
+func (m *MockVaultServiceServer) ImportVault(arg0 proto.VaultService_ImportVaultServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportVault", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportVault indicates an expected call of ImportVault.
+func (mr *MockVaultServiceServerMockRecorder) ImportVault(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportVault", reflect.TypeOf((*MockVaultServiceServer)(nil).ImportVault), arg0)
+}
+
+// ListFolders mocks base method.
+func (m *MockVaultServiceServer) ListFolders(arg0 context.Context, arg1 *proto.ListFoldersReq) (*proto.ListFoldersRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFolders", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListFoldersRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFolders indicates an expected call of ListFolders.
+func (mr *MockVaultServiceServerMockRecorder) ListFolders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFolders", reflect.TypeOf((*MockVaultServiceServer)(nil).ListFolders), arg0, arg1)
+}
+
+// ListItems mocks base method.
+func (m *MockVaultServiceServer) ListItems(arg0 context.Context, arg1 *proto.ListItemsReq) (*proto.ListItemsRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItems", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListItemsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItems indicates an expected call of ListItems.
+func (mr *MockVaultServiceServerMockRecorder) ListItems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockVaultServiceServer)(nil).ListItems), arg0, arg1)
+}
+
+// ListShared mocks base method.
+func (m *MockVaultServiceServer) ListShared(arg0 context.Context, arg1 *proto.ListSharedReq) (*proto.ListSharedRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShared", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListSharedRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShared indicates an expected call of ListShared.
+func (mr *MockVaultServiceServerMockRecorder) ListShared(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShared", reflect.TypeOf((*MockVaultServiceServer)(nil).ListShared), arg0, arg1)
+}
+
+// ListTags mocks base method.
+func (m *MockVaultServiceServer) ListTags(arg0 context.Context, arg1 *proto.ListTagsReq) (*proto.ListTagsRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListTagsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockVaultServiceServerMockRecorder) ListTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockVaultServiceServer)(nil).ListTags), arg0, arg1)
+}
+
+// MoveItem mocks base method.
+func (m *MockVaultServiceServer) MoveItem(arg0 context.Context, arg1 *proto.MoveItemReq) (*proto.MoveItemRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveItem", arg0, arg1)
+	ret0, _ := ret[0].(*proto.MoveItemRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveItem indicates an expected call of MoveItem.
+func (mr *MockVaultServiceServerMockRecorder) MoveItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveItem", reflect.TypeOf((*MockVaultServiceServer)(nil).MoveItem), arg0, arg1)
+}
+
+// RestoreData mocks base method.
+func (m *MockVaultServiceServer) RestoreData(arg0 context.Context, arg1 *proto.RestoreDataReq) (*proto.RestoreDataRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreData", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RestoreDataRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreData indicates an expected call of RestoreData.
+func (mr *MockVaultServiceServerMockRecorder) RestoreData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreData", reflect.TypeOf((*MockVaultServiceServer)(nil).RestoreData), arg0, arg1)
+}
+
+// RestoreFromTrash mocks base method.
+func (m *MockVaultServiceServer) RestoreFromTrash(arg0 context.Context, arg1 *proto.RestoreFromTrashReq) (*proto.RestoreFromTrashRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFromTrash", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RestoreFromTrashRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreFromTrash indicates an expected call of RestoreFromTrash.
+func (mr *MockVaultServiceServerMockRecorder) RestoreFromTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFromTrash", reflect.TypeOf((*MockVaultServiceServer)(nil).RestoreFromTrash), arg0, arg1)
+}
+
+// RevokeShare mocks base method.
+func (m *MockVaultServiceServer) RevokeShare(arg0 context.Context, arg1 *proto.RevokeShareReq) (*proto.RevokeShareRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShare", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RevokeShareRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeShare indicates an expected call of RevokeShare.
+func (mr *MockVaultServiceServerMockRecorder) RevokeShare(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShare", reflect.TypeOf((*MockVaultServiceServer)(nil).RevokeShare), arg0, arg1)
+}
+
+// SearchItems mocks base method.
+func (m *MockVaultServiceServer) SearchItems(arg0 context.Context, arg1 *proto.SearchItemsReq) (*proto.SearchItemsRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchItems", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SearchItemsRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchItems indicates an expected call of SearchItems.
+func (mr *MockVaultServiceServerMockRecorder) SearchItems(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchItems", reflect.TypeOf((*MockVaultServiceServer)(nil).SearchItems), arg0, arg1)
+}
+
+// SetFavorite mocks base method.
+func (m *MockVaultServiceServer) SetFavorite(arg0 context.Context, arg1 *proto.SetFavoriteReq) (*proto.SetFavoriteRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFavorite", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SetFavoriteRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFavorite indicates an expected call of SetFavorite.
+func (mr *MockVaultServiceServerMockRecorder) SetFavorite(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFavorite", reflect.TypeOf((*MockVaultServiceServer)(nil).SetFavorite), arg0, arg1)
+}
+
+// ShareItem mocks base method.
+func (m *MockVaultServiceServer) ShareItem(arg0 context.Context, arg1 *proto.ShareItemReq) (*proto.ShareItemRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareItem", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ShareItemRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShareItem indicates an expected call of ShareItem.
+func (mr *MockVaultServiceServerMockRecorder) ShareItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareItem", reflect.TypeOf((*MockVaultServiceServer)(nil).ShareItem), arg0, arg1)
+}
+
+// TagItem mocks base method.
+func (m *MockVaultServiceServer) TagItem(arg0 context.Context, arg1 *proto.TagItemReq) (*proto.TagItemRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagItem", arg0, arg1)
+	ret0, _ := ret[0].(*proto.TagItemRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagItem indicates an expected call of TagItem.
+func (mr *MockVaultServiceServerMockRecorder) TagItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagItem", reflect.TypeOf((*MockVaultServiceServer)(nil).TagItem), arg0, arg1)
+}
+
+// UntagItem mocks base method.
+func (m *MockVaultServiceServer) UntagItem(arg0 context.Context, arg1 *proto.UntagItemReq) (*proto.UntagItemRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagItem", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UntagItemRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagItem indicates an expected call of UntagItem.
+func (mr *MockVaultServiceServerMockRecorder) UntagItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagItem", reflect.TypeOf((*MockVaultServiceServer)(nil).UntagItem), arg0, arg1)
+}
+
+// UpdateData mocks base method.
+func (m *MockVaultServiceServer) UpdateData(arg0 context.Context, arg1 *proto.UpdateDataReq) (*proto.UpdateDataRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateData", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UpdateDataRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateData indicates an expected call of UpdateData.
+func (mr *MockVaultServiceServerMockRecorder) UpdateData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateData", reflect.TypeOf((*MockVaultServiceServer)(nil).UpdateData), arg0, arg1)
+}
+
+// UploadFile mocks base method.
+func (m *MockVaultServiceServer) UploadFile(arg0 proto.VaultService_UploadFileServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadFile", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadFile indicates an expected call of UploadFile.
+func (mr *MockVaultServiceServerMockRecorder) UploadFile(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockVaultServiceServer)(nil).UploadFile), arg0)
+}
+
+// mustEmbedUnimplementedVaultServiceServer mocks base method.
+func (m *MockVaultServiceServer) mustEmbedUnimplementedVaultServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedVaultServiceServer")
+}
+
+// mustEmbedUnimplementedVaultServiceServer indicates an expected call of mustEmbedUnimplementedVaultServiceServer.
+func (mr *MockVaultServiceServerMockRecorder) mustEmbedUnimplementedVaultServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedVaultServiceServer", reflect.TypeOf((*MockVaultServiceServer)(nil).mustEmbedUnimplementedVaultServiceServer))
+}
+
+// MockUnsafeVaultServiceServer is a mock of UnsafeVaultServiceServer interface.
+type MockUnsafeVaultServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeVaultServiceServerMockRecorder
+}
+
+// MockUnsafeVaultServiceServerMockRecorder is the mock recorder for MockUnsafeVaultServiceServer.
+type MockUnsafeVaultServiceServerMockRecorder struct {
+	mock *MockUnsafeVaultServiceServer
+}
+
+// NewMockUnsafeVaultServiceServer creates a new mock instance.
+func NewMockUnsafeVaultServiceServer(ctrl *gomock.Controller) *MockUnsafeVaultServiceServer {
+	mock := &MockUnsafeVaultServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeVaultServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeVaultServiceServer) EXPECT() *MockUnsafeVaultServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedVaultServiceServer mocks base method.
+func (m *MockUnsafeVaultServiceServer) mustEmbedUnimplementedVaultServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedVaultServiceServer")
+}
+
+// mustEmbedUnimplementedVaultServiceServer indicates an expected call of mustEmbedUnimplementedVaultServiceServer.
+func (mr *MockUnsafeVaultServiceServerMockRecorder) mustEmbedUnimplementedVaultServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedVaultServiceServer", reflect.TypeOf((*MockUnsafeVaultServiceServer)(nil).mustEmbedUnimplementedVaultServiceServer))
+}
+
+// MockVaultService_UploadFileServer is a mock of VaultService_UploadFileServer interface.
+type MockVaultService_UploadFileServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultService_UploadFileServerMockRecorder
+}
+
+// MockVaultService_UploadFileServerMockRecorder is the mock recorder for MockVaultService_UploadFileServer.
+type MockVaultService_UploadFileServerMockRecorder struct {
+	mock *MockVaultService_UploadFileServer
+}
+
+// NewMockVaultService_UploadFileServer creates a new mock instance.
+func NewMockVaultService_UploadFileServer(ctrl *gomock.Controller) *MockVaultService_UploadFileServer {
+	mock := &MockVaultService_UploadFileServer{ctrl: ctrl}
+	mock.recorder = &MockVaultService_UploadFileServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaultService_UploadFileServer) EXPECT() *MockVaultService_UploadFileServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockVaultService_UploadFileServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockVaultService_UploadFileServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockVaultService_UploadFileServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockVaultService_UploadFileServer) Recv() (*proto.UploadFileReq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*proto.UploadFileReq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockVaultService_UploadFileServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockVaultService_UploadFileServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockVaultService_UploadFileServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockVaultService_UploadFileServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockVaultService_UploadFileServer)(nil).RecvMsg), m)
+}
+
+// SendAndClose mocks base method.
+func (m *MockVaultService_UploadFileServer) SendAndClose(arg0 *proto.UploadFileRes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAndClose", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAndClose indicates an expected call of SendAndClose.
+func (mr *MockVaultService_UploadFileServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockVaultService_UploadFileServer)(nil).SendAndClose), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockVaultService_UploadFileServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockVaultService_UploadFileServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockVaultService_UploadFileServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockVaultService_UploadFileServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockVaultService_UploadFileServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockVaultService_UploadFileServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockVaultService_UploadFileServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockVaultService_UploadFileServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockVaultService_UploadFileServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockVaultService_UploadFileServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockVaultService_UploadFileServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockVaultService_UploadFileServer)(nil).SetTrailer), arg0)
+}
+
+// MockVaultService_DownloadFileServer is a mock of VaultService_DownloadFileServer interface.
+type MockVaultService_DownloadFileServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultService_DownloadFileServerMockRecorder
+}
+
+// MockVaultService_DownloadFileServerMockRecorder is the mock recorder for MockVaultService_DownloadFileServer.
+type MockVaultService_DownloadFileServerMockRecorder struct {
+	mock *MockVaultService_DownloadFileServer
+}
+
+// NewMockVaultService_DownloadFileServer creates a new mock instance.
+func NewMockVaultService_DownloadFileServer(ctrl *gomock.Controller) *MockVaultService_DownloadFileServer {
+	mock := &MockVaultService_DownloadFileServer{ctrl: ctrl}
+	mock.recorder = &MockVaultService_DownloadFileServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaultService_DownloadFileServer) EXPECT() *MockVaultService_DownloadFileServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockVaultService_DownloadFileServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockVaultService_DownloadFileServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockVaultService_DownloadFileServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockVaultService_DownloadFileServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockVaultService_DownloadFileServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockVaultService_DownloadFileServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockVaultService_DownloadFileServer) Send(arg0 *proto.DownloadFileRes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockVaultService_DownloadFileServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockVaultService_DownloadFileServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockVaultService_DownloadFileServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockVaultService_DownloadFileServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockVaultService_DownloadFileServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockVaultService_DownloadFileServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockVaultService_DownloadFileServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockVaultService_DownloadFileServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockVaultService_DownloadFileServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockVaultService_DownloadFileServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockVaultService_DownloadFileServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockVaultService_DownloadFileServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockVaultService_DownloadFileServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockVaultService_DownloadFileServer)(nil).SetTrailer), arg0)
+}
+
+// MockVaultService_ExportVaultServer is a mock of VaultService_ExportVaultServer interface.
+type MockVaultService_ExportVaultServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultService_ExportVaultServerMockRecorder
+}
+
+// MockVaultService_ExportVaultServerMockRecorder is the mock recorder for MockVaultService_ExportVaultServer.
+type MockVaultService_ExportVaultServerMockRecorder struct {
+	mock *MockVaultService_ExportVaultServer
+}
+
+// NewMockVaultService_ExportVaultServer creates a new mock instance.
+func NewMockVaultService_ExportVaultServer(ctrl *gomock.Controller) *MockVaultService_ExportVaultServer {
+	mock := &MockVaultService_ExportVaultServer{ctrl: ctrl}
+	mock.recorder = &MockVaultService_ExportVaultServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaultService_ExportVaultServer) EXPECT() *MockVaultService_ExportVaultServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockVaultService_ExportVaultServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockVaultService_ExportVaultServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockVaultService_ExportVaultServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockVaultService_ExportVaultServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockVaultService_ExportVaultServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockVaultService_ExportVaultServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockVaultService_ExportVaultServer) Send(arg0 *proto.ExportVaultRes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockVaultService_ExportVaultServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockVaultService_ExportVaultServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockVaultService_ExportVaultServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockVaultService_ExportVaultServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockVaultService_ExportVaultServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockVaultService_ExportVaultServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockVaultService_ExportVaultServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockVaultService_ExportVaultServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockVaultService_ExportVaultServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockVaultService_ExportVaultServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockVaultService_ExportVaultServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockVaultService_ExportVaultServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockVaultService_ExportVaultServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockVaultService_ExportVaultServer)(nil).SetTrailer), arg0)
+}
+
+// MockVaultService_ImportVaultServer is a mock of VaultService_ImportVaultServer interface.
+type MockVaultService_ImportVaultServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultService_ImportVaultServerMockRecorder
+}
+
+// MockVaultService_ImportVaultServerMockRecorder is the mock recorder for MockVaultService_ImportVaultServer.
+type MockVaultService_ImportVaultServerMockRecorder struct {
+	mock *MockVaultService_ImportVaultServer
+}
+
+// NewMockVaultService_ImportVaultServer creates a new mock instance.
+func NewMockVaultService_ImportVaultServer(ctrl *gomock.Controller) *MockVaultService_ImportVaultServer {
+	mock := &MockVaultService_ImportVaultServer{ctrl: ctrl}
+	mock.recorder = &MockVaultService_ImportVaultServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaultService_ImportVaultServer) EXPECT() *MockVaultService_ImportVaultServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockVaultService_ImportVaultServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockVaultService_ImportVaultServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockVaultService_ImportVaultServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockVaultService_ImportVaultServer) Recv() (*proto.ImportVaultReq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*proto.ImportVaultReq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockVaultService_ImportVaultServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockVaultService_ImportVaultServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockVaultService_ImportVaultServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockVaultService_ImportVaultServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockVaultService_ImportVaultServer)(nil).RecvMsg), m)
+}
+
+// SendAndClose mocks base method.
+func (m *MockVaultService_ImportVaultServer) SendAndClose(arg0 *proto.ImportVaultRes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAndClose", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAndClose indicates an expected call of SendAndClose.
+func (mr *MockVaultService_ImportVaultServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockVaultService_ImportVaultServer)(nil).SendAndClose), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockVaultService_ImportVaultServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockVaultService_ImportVaultServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockVaultService_ImportVaultServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockVaultService_ImportVaultServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockVaultService_ImportVaultServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockVaultService_ImportVaultServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockVaultService_ImportVaultServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockVaultService_ImportVaultServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockVaultService_ImportVaultServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockVaultService_ImportVaultServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockVaultService_ImportVaultServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockVaultService_ImportVaultServer)(nil).SetTrailer), arg0)
 }
@@ -0,0 +1,1176 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.12.4
+// source: internal/proto/org.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateOrgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateOrgReq) Reset() {
+	*x = CreateOrgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrgReq) ProtoMessage() {}
+
+func (x *CreateOrgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrgReq.ProtoReflect.Descriptor instead.
+func (*CreateOrgReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateOrgReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateOrgRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+}
+
+func (x *CreateOrgRes) Reset() {
+	*x = CreateOrgRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrgRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrgRes) ProtoMessage() {}
+
+func (x *CreateOrgRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrgRes.ProtoReflect.Descriptor instead.
+func (*CreateOrgRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateOrgRes) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type ListOrgsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListOrgsReq) Reset() {
+	*x = ListOrgsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrgsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgsReq) ProtoMessage() {}
+
+func (x *ListOrgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgsReq.ProtoReflect.Descriptor instead.
+func (*ListOrgsReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{2}
+}
+
+type ListOrgsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orgs []*ListOrgsRes_Org `protobuf:"bytes,1,rep,name=orgs,proto3" json:"orgs,omitempty"`
+}
+
+func (x *ListOrgsRes) Reset() {
+	*x = ListOrgsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrgsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgsRes) ProtoMessage() {}
+
+func (x *ListOrgsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgsRes.ProtoReflect.Descriptor instead.
+func (*ListOrgsRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListOrgsRes) GetOrgs() []*ListOrgsRes_Org {
+	if x != nil {
+		return x.Orgs
+	}
+	return nil
+}
+
+type DeleteOrgReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+}
+
+func (x *DeleteOrgReq) Reset() {
+	*x = DeleteOrgReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOrgReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrgReq) ProtoMessage() {}
+
+func (x *DeleteOrgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrgReq.ProtoReflect.Descriptor instead.
+func (*DeleteOrgReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteOrgReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type DeleteOrgRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteOrgRes) Reset() {
+	*x = DeleteOrgRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOrgRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrgRes) ProtoMessage() {}
+
+func (x *DeleteOrgRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrgRes.ProtoReflect.Descriptor instead.
+func (*DeleteOrgRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{5}
+}
+
+type AddOrgMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Login string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Role  string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *AddOrgMemberReq) Reset() {
+	*x = AddOrgMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddOrgMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrgMemberReq) ProtoMessage() {}
+
+func (x *AddOrgMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrgMemberReq.ProtoReflect.Descriptor instead.
+func (*AddOrgMemberReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddOrgMemberReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *AddOrgMemberReq) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *AddOrgMemberReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AddOrgMemberRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddOrgMemberRes) Reset() {
+	*x = AddOrgMemberRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddOrgMemberRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrgMemberRes) ProtoMessage() {}
+
+func (x *AddOrgMemberRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrgMemberRes.ProtoReflect.Descriptor instead.
+func (*AddOrgMemberRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{7}
+}
+
+type SetOrgMemberRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Login string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Role  string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *SetOrgMemberRoleReq) Reset() {
+	*x = SetOrgMemberRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOrgMemberRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgMemberRoleReq) ProtoMessage() {}
+
+func (x *SetOrgMemberRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgMemberRoleReq.ProtoReflect.Descriptor instead.
+func (*SetOrgMemberRoleReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetOrgMemberRoleReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *SetOrgMemberRoleReq) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SetOrgMemberRoleReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type SetOrgMemberRoleRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetOrgMemberRoleRes) Reset() {
+	*x = SetOrgMemberRoleRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOrgMemberRoleRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrgMemberRoleRes) ProtoMessage() {}
+
+func (x *SetOrgMemberRoleRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrgMemberRoleRes.ProtoReflect.Descriptor instead.
+func (*SetOrgMemberRoleRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{9}
+}
+
+type RemoveOrgMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Login string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *RemoveOrgMemberReq) Reset() {
+	*x = RemoveOrgMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveOrgMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrgMemberReq) ProtoMessage() {}
+
+func (x *RemoveOrgMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrgMemberReq.ProtoReflect.Descriptor instead.
+func (*RemoveOrgMemberReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RemoveOrgMemberReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RemoveOrgMemberReq) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type RemoveOrgMemberRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveOrgMemberRes) Reset() {
+	*x = RemoveOrgMemberRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveOrgMemberRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveOrgMemberRes) ProtoMessage() {}
+
+func (x *RemoveOrgMemberRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveOrgMemberRes.ProtoReflect.Descriptor instead.
+func (*RemoveOrgMemberRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{11}
+}
+
+type ListOrgMembersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+}
+
+func (x *ListOrgMembersReq) Reset() {
+	*x = ListOrgMembersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrgMembersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgMembersReq) ProtoMessage() {}
+
+func (x *ListOrgMembersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgMembersReq.ProtoReflect.Descriptor instead.
+func (*ListOrgMembersReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListOrgMembersReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type ListOrgMembersRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*ListOrgMembersRes_Member `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *ListOrgMembersRes) Reset() {
+	*x = ListOrgMembersRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrgMembersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgMembersRes) ProtoMessage() {}
+
+func (x *ListOrgMembersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgMembersRes.ProtoReflect.Descriptor instead.
+func (*ListOrgMembersRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListOrgMembersRes) GetMembers() []*ListOrgMembersRes_Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ListOrgsRes_Org struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId     string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role      string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ListOrgsRes_Org) Reset() {
+	*x = ListOrgsRes_Org{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrgsRes_Org) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgsRes_Org) ProtoMessage() {}
+
+func (x *ListOrgsRes_Org) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgsRes_Org.ProtoReflect.Descriptor instead.
+func (*ListOrgsRes_Org) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *ListOrgsRes_Org) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListOrgsRes_Org) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListOrgsRes_Org) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListOrgsRes_Org) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListOrgMembersRes_Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login     string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Role      string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ListOrgMembersRes_Member) Reset() {
+	*x = ListOrgMembersRes_Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_org_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrgMembersRes_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrgMembersRes_Member) ProtoMessage() {}
+
+func (x *ListOrgMembersRes_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_org_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrgMembersRes_Member.ProtoReflect.Descriptor instead.
+func (*ListOrgMembersRes_Member) Descriptor() ([]byte, []int) {
+	return file_internal_proto_org_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *ListOrgMembersRes_Member) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *ListOrgMembersRes_Member) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListOrgMembersRes_Member) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_internal_proto_org_proto protoreflect.FileDescriptor
+
+var file_internal_proto_org_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x6f, 0x72, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x22, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x25, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x12,
+	0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x22, 0xb4, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x6f, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x2e, 0x4f, 0x72, 0x67, 0x52, 0x04, 0x6f, 0x72, 0x67, 0x73, 0x1a, 0x7f, 0x0a, 0x03, 0x4f,
+	0x72, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x25, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06,
+	0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72,
+	0x67, 0x49, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x67,
+	0x52, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4f, 0x72,
+	0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x13, 0x53, 0x65,
+	0x74, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x12, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x14, 0x0a, 0x12,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x22, 0x2a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0xb7,
+	0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x6d, 0x0a, 0x06, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0xf5, 0x02, 0x0a, 0x0a, 0x4f, 0x72, 0x67,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x67, 0x12, 0x0d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67,
+	0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x52,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x12, 0x0c,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x73, 0x52, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x12, 0x0d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4f, 0x72, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f,
+	0x72, 0x67, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x67,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x10, 0x53, 0x65, 0x74,
+	0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x2e,
+	0x53, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x69, 0x6e, 0x62, 0x72, 0x61, 0x69, 0x6e, 0x2f, 0x67, 0x6f, 0x70, 0x68, 0x6b, 0x65, 0x65, 0x70,
+	0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_proto_org_proto_rawDescOnce sync.Once
+	file_internal_proto_org_proto_rawDescData = file_internal_proto_org_proto_rawDesc
+)
+
+func file_internal_proto_org_proto_rawDescGZIP() []byte {
+	file_internal_proto_org_proto_rawDescOnce.Do(func() {
+		file_internal_proto_org_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_proto_org_proto_rawDescData)
+	})
+	return file_internal_proto_org_proto_rawDescData
+}
+
+var file_internal_proto_org_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_internal_proto_org_proto_goTypes = []any{
+	(*CreateOrgReq)(nil),             // 0: CreateOrgReq
+	(*CreateOrgRes)(nil),             // 1: CreateOrgRes
+	(*ListOrgsReq)(nil),              // 2: ListOrgsReq
+	(*ListOrgsRes)(nil),              // 3: ListOrgsRes
+	(*DeleteOrgReq)(nil),             // 4: DeleteOrgReq
+	(*DeleteOrgRes)(nil),             // 5: DeleteOrgRes
+	(*AddOrgMemberReq)(nil),          // 6: AddOrgMemberReq
+	(*AddOrgMemberRes)(nil),          // 7: AddOrgMemberRes
+	(*SetOrgMemberRoleReq)(nil),      // 8: SetOrgMemberRoleReq
+	(*SetOrgMemberRoleRes)(nil),      // 9: SetOrgMemberRoleRes
+	(*RemoveOrgMemberReq)(nil),       // 10: RemoveOrgMemberReq
+	(*RemoveOrgMemberRes)(nil),       // 11: RemoveOrgMemberRes
+	(*ListOrgMembersReq)(nil),        // 12: ListOrgMembersReq
+	(*ListOrgMembersRes)(nil),        // 13: ListOrgMembersRes
+	(*ListOrgsRes_Org)(nil),          // 14: ListOrgsRes.Org
+	(*ListOrgMembersRes_Member)(nil), // 15: ListOrgMembersRes.Member
+	(*timestamppb.Timestamp)(nil),    // 16: google.protobuf.Timestamp
+}
+var file_internal_proto_org_proto_depIdxs = []int32{
+	14, // 0: ListOrgsRes.orgs:type_name -> ListOrgsRes.Org
+	15, // 1: ListOrgMembersRes.members:type_name -> ListOrgMembersRes.Member
+	16, // 2: ListOrgsRes.Org.created_at:type_name -> google.protobuf.Timestamp
+	16, // 3: ListOrgMembersRes.Member.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: OrgService.CreateOrg:input_type -> CreateOrgReq
+	2,  // 5: OrgService.ListOrgs:input_type -> ListOrgsReq
+	4,  // 6: OrgService.DeleteOrg:input_type -> DeleteOrgReq
+	6,  // 7: OrgService.AddOrgMember:input_type -> AddOrgMemberReq
+	8,  // 8: OrgService.SetOrgMemberRole:input_type -> SetOrgMemberRoleReq
+	10, // 9: OrgService.RemoveOrgMember:input_type -> RemoveOrgMemberReq
+	12, // 10: OrgService.ListOrgMembers:input_type -> ListOrgMembersReq
+	1,  // 11: OrgService.CreateOrg:output_type -> CreateOrgRes
+	3,  // 12: OrgService.ListOrgs:output_type -> ListOrgsRes
+	5,  // 13: OrgService.DeleteOrg:output_type -> DeleteOrgRes
+	7,  // 14: OrgService.AddOrgMember:output_type -> AddOrgMemberRes
+	9,  // 15: OrgService.SetOrgMemberRole:output_type -> SetOrgMemberRoleRes
+	11, // 16: OrgService.RemoveOrgMember:output_type -> RemoveOrgMemberRes
+	13, // 17: OrgService.ListOrgMembers:output_type -> ListOrgMembersRes
+	11, // [11:18] is the sub-list for method output_type
+	4,  // [4:11] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_internal_proto_org_proto_init() }
+func file_internal_proto_org_proto_init() {
+	if File_internal_proto_org_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_proto_org_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateOrgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateOrgRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOrgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOrgsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteOrgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteOrgRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*AddOrgMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*AddOrgMemberRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*SetOrgMemberRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*SetOrgMemberRoleRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveOrgMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveOrgMemberRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOrgMembersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOrgMembersRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOrgsRes_Org); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_org_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOrgMembersRes_Member); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_internal_proto_org_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_internal_proto_org_proto_goTypes,
+		DependencyIndexes: file_internal_proto_org_proto_depIdxs,
+		MessageInfos:      file_internal_proto_org_proto_msgTypes,
+	}.Build()
+	File_internal_proto_org_proto = out.File
+	file_internal_proto_org_proto_rawDesc = nil
+	file_internal_proto_org_proto_goTypes = nil
+	file_internal_proto_org_proto_depIdxs = nil
+}
@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.12.4
+// source: internal/proto/org.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	OrgService_CreateOrg_FullMethodName        = "/OrgService/CreateOrg"
+	OrgService_ListOrgs_FullMethodName         = "/OrgService/ListOrgs"
+	OrgService_DeleteOrg_FullMethodName        = "/OrgService/DeleteOrg"
+	OrgService_AddOrgMember_FullMethodName     = "/OrgService/AddOrgMember"
+	OrgService_SetOrgMemberRole_FullMethodName = "/OrgService/SetOrgMemberRole"
+	OrgService_RemoveOrgMember_FullMethodName  = "/OrgService/RemoveOrgMember"
+	OrgService_ListOrgMembers_FullMethodName   = "/OrgService/ListOrgMembers"
+)
+
+// OrgServiceClient is the client API for OrgService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrgServiceClient interface {
+	CreateOrg(ctx context.Context, in *CreateOrgReq, opts ...grpc.CallOption) (*CreateOrgRes, error)
+	ListOrgs(ctx context.Context, in *ListOrgsReq, opts ...grpc.CallOption) (*ListOrgsRes, error)
+	DeleteOrg(ctx context.Context, in *DeleteOrgReq, opts ...grpc.CallOption) (*DeleteOrgRes, error)
+	AddOrgMember(ctx context.Context, in *AddOrgMemberReq, opts ...grpc.CallOption) (*AddOrgMemberRes, error)
+	SetOrgMemberRole(ctx context.Context, in *SetOrgMemberRoleReq, opts ...grpc.CallOption) (*SetOrgMemberRoleRes, error)
+	RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberReq, opts ...grpc.CallOption) (*RemoveOrgMemberRes, error)
+	ListOrgMembers(ctx context.Context, in *ListOrgMembersReq, opts ...grpc.CallOption) (*ListOrgMembersRes, error)
+}
+
+type orgServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrgServiceClient(cc grpc.ClientConnInterface) OrgServiceClient {
+	return &orgServiceClient{cc}
+}
+
+func (c *orgServiceClient) CreateOrg(ctx context.Context, in *CreateOrgReq, opts ...grpc.CallOption) (*CreateOrgRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrgRes)
+	err := c.cc.Invoke(ctx, OrgService_CreateOrg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) ListOrgs(ctx context.Context, in *ListOrgsReq, opts ...grpc.CallOption) (*ListOrgsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrgsRes)
+	err := c.cc.Invoke(ctx, OrgService_ListOrgs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) DeleteOrg(ctx context.Context, in *DeleteOrgReq, opts ...grpc.CallOption) (*DeleteOrgRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOrgRes)
+	err := c.cc.Invoke(ctx, OrgService_DeleteOrg_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) AddOrgMember(ctx context.Context, in *AddOrgMemberReq, opts ...grpc.CallOption) (*AddOrgMemberRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddOrgMemberRes)
+	err := c.cc.Invoke(ctx, OrgService_AddOrgMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) SetOrgMemberRole(ctx context.Context, in *SetOrgMemberRoleReq, opts ...grpc.CallOption) (*SetOrgMemberRoleRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOrgMemberRoleRes)
+	err := c.cc.Invoke(ctx, OrgService_SetOrgMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberReq, opts ...grpc.CallOption) (*RemoveOrgMemberRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveOrgMemberRes)
+	err := c.cc.Invoke(ctx, OrgService_RemoveOrgMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgServiceClient) ListOrgMembers(ctx context.Context, in *ListOrgMembersReq, opts ...grpc.CallOption) (*ListOrgMembersRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrgMembersRes)
+	err := c.cc.Invoke(ctx, OrgService_ListOrgMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrgServiceServer is the server API for OrgService service.
+// All implementations must embed UnimplementedOrgServiceServer
+// for forward compatibility.
+type OrgServiceServer interface {
+	CreateOrg(context.Context, *CreateOrgReq) (*CreateOrgRes, error)
+	ListOrgs(context.Context, *ListOrgsReq) (*ListOrgsRes, error)
+	DeleteOrg(context.Context, *DeleteOrgReq) (*DeleteOrgRes, error)
+	AddOrgMember(context.Context, *AddOrgMemberReq) (*AddOrgMemberRes, error)
+	SetOrgMemberRole(context.Context, *SetOrgMemberRoleReq) (*SetOrgMemberRoleRes, error)
+	RemoveOrgMember(context.Context, *RemoveOrgMemberReq) (*RemoveOrgMemberRes, error)
+	ListOrgMembers(context.Context, *ListOrgMembersReq) (*ListOrgMembersRes, error)
+	mustEmbedUnimplementedOrgServiceServer()
+}
+
+// UnimplementedOrgServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrgServiceServer struct{}
+
+func (UnimplementedOrgServiceServer) CreateOrg(context.Context, *CreateOrgReq) (*CreateOrgRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrg not implemented")
+}
+func (UnimplementedOrgServiceServer) ListOrgs(context.Context, *ListOrgsReq) (*ListOrgsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrgs not implemented")
+}
+func (UnimplementedOrgServiceServer) DeleteOrg(context.Context, *DeleteOrgReq) (*DeleteOrgRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrg not implemented")
+}
+func (UnimplementedOrgServiceServer) AddOrgMember(context.Context, *AddOrgMemberReq) (*AddOrgMemberRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrgMember not implemented")
+}
+func (UnimplementedOrgServiceServer) SetOrgMemberRole(context.Context, *SetOrgMemberRoleReq) (*SetOrgMemberRoleRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrgMemberRole not implemented")
+}
+func (UnimplementedOrgServiceServer) RemoveOrgMember(context.Context, *RemoveOrgMemberReq) (*RemoveOrgMemberRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrgMember not implemented")
+}
+func (UnimplementedOrgServiceServer) ListOrgMembers(context.Context, *ListOrgMembersReq) (*ListOrgMembersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrgMembers not implemented")
+}
+func (UnimplementedOrgServiceServer) mustEmbedUnimplementedOrgServiceServer() {}
+func (UnimplementedOrgServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeOrgServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrgServiceServer will
+// result in compilation errors.
+type UnsafeOrgServiceServer interface {
+	mustEmbedUnimplementedOrgServiceServer()
+}
+
+func RegisterOrgServiceServer(s grpc.ServiceRegistrar, srv OrgServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOrgServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrgService_ServiceDesc, srv)
+}
+
+func _OrgService_CreateOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).CreateOrg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_CreateOrg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).CreateOrg(ctx, req.(*CreateOrgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_ListOrgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).ListOrgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_ListOrgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).ListOrgs(ctx, req.(*ListOrgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_DeleteOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).DeleteOrg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_DeleteOrg_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).DeleteOrg(ctx, req.(*DeleteOrgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_AddOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrgMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).AddOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_AddOrgMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).AddOrgMember(ctx, req.(*AddOrgMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_SetOrgMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrgMemberRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).SetOrgMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_SetOrgMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).SetOrgMemberRole(ctx, req.(*SetOrgMemberRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_RemoveOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrgMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).RemoveOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_RemoveOrgMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).RemoveOrgMember(ctx, req.(*RemoveOrgMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrgService_ListOrgMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrgMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgServiceServer).ListOrgMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrgService_ListOrgMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgServiceServer).ListOrgMembers(ctx, req.(*ListOrgMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrgService_ServiceDesc is the grpc.ServiceDesc for OrgService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrgService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "OrgService",
+	HandlerType: (*OrgServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOrg",
+			Handler:    _OrgService_CreateOrg_Handler,
+		},
+		{
+			MethodName: "ListOrgs",
+			Handler:    _OrgService_ListOrgs_Handler,
+		},
+		{
+			MethodName: "DeleteOrg",
+			Handler:    _OrgService_DeleteOrg_Handler,
+		},
+		{
+			MethodName: "AddOrgMember",
+			Handler:    _OrgService_AddOrgMember_Handler,
+		},
+		{
+			MethodName: "SetOrgMemberRole",
+			Handler:    _OrgService_SetOrgMemberRole_Handler,
+		},
+		{
+			MethodName: "RemoveOrgMember",
+			Handler:    _OrgService_RemoveOrgMember_Handler,
+		},
+		{
+			MethodName: "ListOrgMembers",
+			Handler:    _OrgService_ListOrgMembers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/proto/org.proto",
+}
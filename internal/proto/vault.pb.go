@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +21,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ListItemsReq_SortBy int32
+
+const (
+	ListItemsReq_CREATED_AT ListItemsReq_SortBy = 0
+	ListItemsReq_UPDATED_AT ListItemsReq_SortBy = 1
+	ListItemsReq_META       ListItemsReq_SortBy = 2
+	ListItemsReq_ACCESSED   ListItemsReq_SortBy = 3
+)
+
+// Enum value maps for ListItemsReq_SortBy.
+var (
+	ListItemsReq_SortBy_name = map[int32]string{
+		0: "CREATED_AT",
+		1: "UPDATED_AT",
+		2: "META",
+		3: "ACCESSED",
+	}
+	ListItemsReq_SortBy_value = map[string]int32{
+		"CREATED_AT": 0,
+		"UPDATED_AT": 1,
+		"META":       2,
+		"ACCESSED":   3,
+	}
+)
+
+func (x ListItemsReq_SortBy) Enum() *ListItemsReq_SortBy {
+	p := new(ListItemsReq_SortBy)
+	*p = x
+	return p
+}
+
+func (x ListItemsReq_SortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListItemsReq_SortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_vault_proto_enumTypes[0].Descriptor()
+}
+
+func (ListItemsReq_SortBy) Type() protoreflect.EnumType {
+	return &file_internal_proto_vault_proto_enumTypes[0]
+}
+
+func (x ListItemsReq_SortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListItemsReq_SortBy.Descriptor instead.
+func (ListItemsReq_SortBy) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{11, 0}
+}
+
 type Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -88,7 +141,8 @@ type AddDataReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Item *Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Item      *Item                  `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (x *AddDataReq) Reset() {
@@ -130,10 +184,19 @@ func (x *AddDataReq) GetItem() *Item {
 	return nil
 }
 
+func (x *AddDataReq) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type AddDataRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *AddDataRes) Reset() {
@@ -168,12 +231,20 @@ func (*AddDataRes) Descriptor() ([]byte, []int) {
 	return file_internal_proto_vault_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *AddDataRes) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type GetDataReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SkipFileContent bool   `protobuf:"varint,2,opt,name=skip_file_content,json=skipFileContent,proto3" json:"skip_file_content,omitempty"`
 }
 
 func (x *GetDataReq) Reset() {
@@ -215,13 +286,23 @@ func (x *GetDataReq) GetId() string {
 	return ""
 }
 
+func (x *GetDataReq) GetSkipFileContent() bool {
+	if x != nil {
+		return x.SkipFileContent
+	}
+	return false
+}
+
 type GetDataRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Item *Item  `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Id          string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Item        *Item                    `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Revision    int64                    `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	Attachments []*GetDataRes_Attachment `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	ExpiresAt   *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (x *GetDataRes) Reset() {
@@ -270,6 +351,27 @@ func (x *GetDataRes) GetItem() *Item {
 	return nil
 }
 
+func (x *GetDataRes) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GetDataRes) GetAttachments() []*GetDataRes_Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *GetDataRes) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type DeleteDataReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -360,9 +462,11 @@ type UpdateDataReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	Meta string `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data      []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Meta      string                 `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	Revision  int64                  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (x *UpdateDataReq) Reset() {
@@ -418,10 +522,26 @@ func (x *UpdateDataReq) GetMeta() string {
 	return ""
 }
 
+func (x *UpdateDataReq) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *UpdateDataReq) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type UpdateDataRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
 func (x *UpdateDataRes) Reset() {
@@ -456,6 +576,13 @@ func (*UpdateDataRes) Descriptor() ([]byte, []int) {
 	return file_internal_proto_vault_proto_rawDescGZIP(), []int{8}
 }
 
+func (x *UpdateDataRes) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
 type GetAllByTypeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -550,17 +677,24 @@ func (x *GetAllByTypeRes) GetItems() []*GetAllByTypeRes_TypeItem {
 	return nil
 }
 
-type GetAllByTypeRes_TypeItem struct {
+type ListItemsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Meta string `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Type           string              `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	SortBy         ListItemsReq_SortBy `protobuf:"varint,2,opt,name=sort_by,json=sortBy,proto3,enum=ListItemsReq_SortBy" json:"sort_by,omitempty"`
+	MetaField      string              `protobuf:"bytes,3,opt,name=meta_field,json=metaField,proto3" json:"meta_field,omitempty"`
+	Desc           bool                `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	PageSize       int32               `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string              `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	FolderId       string              `protobuf:"bytes,7,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Tag            string              `protobuf:"bytes,8,opt,name=tag,proto3" json:"tag,omitempty"`
+	FavoritesFirst bool                `protobuf:"varint,9,opt,name=favorites_first,json=favoritesFirst,proto3" json:"favorites_first,omitempty"`
 }
 
-func (x *GetAllByTypeRes_TypeItem) Reset() {
-	*x = GetAllByTypeRes_TypeItem{}
+func (x *ListItemsReq) Reset() {
+	*x = ListItemsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_vault_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -568,13 +702,13 @@ func (x *GetAllByTypeRes_TypeItem) Reset() {
 	}
 }
 
-func (x *GetAllByTypeRes_TypeItem) String() string {
+func (x *ListItemsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllByTypeRes_TypeItem) ProtoMessage() {}
+func (*ListItemsReq) ProtoMessage() {}
 
-func (x *GetAllByTypeRes_TypeItem) ProtoReflect() protoreflect.Message {
+func (x *ListItemsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_vault_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -586,207 +720,4082 @@ func (x *GetAllByTypeRes_TypeItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllByTypeRes_TypeItem.ProtoReflect.Descriptor instead.
-func (*GetAllByTypeRes_TypeItem) Descriptor() ([]byte, []int) {
-	return file_internal_proto_vault_proto_rawDescGZIP(), []int{10, 0}
+// Deprecated: Use ListItemsReq.ProtoReflect.Descriptor instead.
+func (*ListItemsReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetAllByTypeRes_TypeItem) GetId() string {
+func (x *ListItemsReq) GetType() string {
 	if x != nil {
-		return x.Id
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetAllByTypeRes_TypeItem) GetMeta() string {
+func (x *ListItemsReq) GetSortBy() ListItemsReq_SortBy {
 	if x != nil {
-		return x.Meta
+		return x.SortBy
+	}
+	return ListItemsReq_CREATED_AT
+}
+
+func (x *ListItemsReq) GetMetaField() string {
+	if x != nil {
+		return x.MetaField
 	}
 	return ""
 }
 
-var File_internal_proto_vault_proto protoreflect.FileDescriptor
+func (x *ListItemsReq) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
 
-var file_internal_proto_vault_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x04,
-	0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
-	0x22, 0x27, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x19,
-	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x0c, 0x0a, 0x0a, 0x41, 0x64, 0x64,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x05, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x1f,
-	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x0f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
-	0x22, 0x47, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x0f, 0x0a, 0x0d, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x22, 0x72, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x65, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x2e, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6d, 0x65, 0x74, 0x61, 0x32, 0xe8, 0x01, 0x0a, 0x0c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x0b, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x0b,
-	0x2e, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0b, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
-	0x12, 0x2c, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x0e,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x12, 0x2c,
-	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0c,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73,
-	0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
-	0x69, 0x6e, 0x62, 0x72, 0x61, 0x69, 0x6e, 0x2f, 0x67, 0x6f, 0x70, 0x68, 0x6b, 0x65, 0x65, 0x70,
-	0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ListItemsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-var (
-	file_internal_proto_vault_proto_rawDescOnce sync.Once
-	file_internal_proto_vault_proto_rawDescData = file_internal_proto_vault_proto_rawDesc
-)
+func (x *ListItemsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
 
-func file_internal_proto_vault_proto_rawDescGZIP() []byte {
-	file_internal_proto_vault_proto_rawDescOnce.Do(func() {
-		file_internal_proto_vault_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_proto_vault_proto_rawDescData)
-	})
-	return file_internal_proto_vault_proto_rawDescData
+func (x *ListItemsReq) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
 }
 
-var file_internal_proto_vault_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
-var file_internal_proto_vault_proto_goTypes = []any{
-	(*Item)(nil),                     // 0: Item
-	(*AddDataReq)(nil),               // 1: AddDataReq
-	(*AddDataRes)(nil),               // 2: AddDataRes
-	(*GetDataReq)(nil),               // 3: GetDataReq
-	(*GetDataRes)(nil),               // 4: GetDataRes
-	(*DeleteDataReq)(nil),            // 5: DeleteDataReq
-	(*DeleteDataRes)(nil),            // 6: DeleteDataRes
-	(*UpdateDataReq)(nil),            // 7: UpdateDataReq
-	(*UpdateDataRes)(nil),            // 8: UpdateDataRes
-	(*GetAllByTypeReq)(nil),          // 9: GetAllByTypeReq
-	(*GetAllByTypeRes)(nil),          // 10: GetAllByTypeRes
-	(*GetAllByTypeRes_TypeItem)(nil), // 11: GetAllByTypeRes.TypeItem
+func (x *ListItemsReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
 }
-var file_internal_proto_vault_proto_depIdxs = []int32{
-	0,  // 0: AddDataReq.item:type_name -> Item
-	0,  // 1: GetDataRes.item:type_name -> Item
-	11, // 2: GetAllByTypeRes.items:type_name -> GetAllByTypeRes.TypeItem
-	1,  // 3: VaultService.AddData:input_type -> AddDataReq
-	3,  // 4: VaultService.GetData:input_type -> GetDataReq
-	5,  // 5: VaultService.DeleteData:input_type -> DeleteDataReq
-	7,  // 6: VaultService.UpdateData:input_type -> UpdateDataReq
-	9,  // 7: VaultService.GetAllByType:input_type -> GetAllByTypeReq
-	2,  // 8: VaultService.AddData:output_type -> AddDataRes
-	4,  // 9: VaultService.GetData:output_type -> GetDataRes
-	6,  // 10: VaultService.DeleteData:output_type -> DeleteDataRes
-	8,  // 11: VaultService.UpdateData:output_type -> UpdateDataRes
-	10, // 12: VaultService.GetAllByType:output_type -> GetAllByTypeRes
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+
+func (x *ListItemsReq) GetFavoritesFirst() bool {
+	if x != nil {
+		return x.FavoritesFirst
+	}
+	return false
 }
 
-func init() { file_internal_proto_vault_proto_init() }
-func file_internal_proto_vault_proto_init() {
-	if File_internal_proto_vault_proto != nil {
-		return
+type ListItemsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items         []*ListItemsRes_ListItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListItemsRes) Reset() {
+	*x = ListItemsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_internal_proto_vault_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*Item); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_proto_vault_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*AddDataReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_proto_vault_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*AddDataRes); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_proto_vault_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDataReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_proto_vault_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDataRes); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *ListItemsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsRes) ProtoMessage() {}
+
+func (x *ListItemsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_internal_proto_vault_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteDataReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsRes.ProtoReflect.Descriptor instead.
+func (*ListItemsRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListItemsRes) GetItems() []*ListItemsRes_ListItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListItemsRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query  string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Prefix bool   `protobuf:"varint,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Limit  int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Exact  bool   `protobuf:"varint,4,opt,name=exact,proto3" json:"exact,omitempty"`
+}
+
+func (x *SearchItemsReq) Reset() {
+	*x = SearchItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchItemsReq) ProtoMessage() {}
+
+func (x *SearchItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchItemsReq.ProtoReflect.Descriptor instead.
+func (*SearchItemsReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchItemsReq) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchItemsReq) GetPrefix() bool {
+	if x != nil {
+		return x.Prefix
+	}
+	return false
+}
+
+func (x *SearchItemsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchItemsReq) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+type SearchItemsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*ListItemsRes_ListItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SearchItemsRes) Reset() {
+	*x = SearchItemsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchItemsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchItemsRes) ProtoMessage() {}
+
+func (x *SearchItemsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchItemsRes.ProtoReflect.Descriptor instead.
+func (*SearchItemsRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchItemsRes) GetItems() []*ListItemsRes_ListItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetDataHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetDataHistoryReq) Reset() {
+	*x = GetDataHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataHistoryReq) ProtoMessage() {}
+
+func (x *GetDataHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetDataHistoryReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetDataHistoryReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDataHistoryRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*GetDataHistoryRes_Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *GetDataHistoryRes) Reset() {
+	*x = GetDataHistoryRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataHistoryRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataHistoryRes) ProtoMessage() {}
+
+func (x *GetDataHistoryRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataHistoryRes.ProtoReflect.Descriptor instead.
+func (*GetDataHistoryRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetDataHistoryRes) GetRevisions() []*GetDataHistoryRes_Revision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type GetDataRevisionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision int64  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *GetDataRevisionReq) Reset() {
+	*x = GetDataRevisionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataRevisionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataRevisionReq) ProtoMessage() {}
+
+func (x *GetDataRevisionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataRevisionReq.ProtoReflect.Descriptor instead.
+func (*GetDataRevisionReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetDataRevisionReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDataRevisionReq) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetDataRevisionRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision int64  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Item     *Item  `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *GetDataRevisionRes) Reset() {
+	*x = GetDataRevisionRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataRevisionRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataRevisionRes) ProtoMessage() {}
+
+func (x *GetDataRevisionRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataRevisionRes.ProtoReflect.Descriptor instead.
+func (*GetDataRevisionRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetDataRevisionRes) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDataRevisionRes) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GetDataRevisionRes) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type RestoreDataReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision int64  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *RestoreDataReq) Reset() {
+	*x = RestoreDataReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreDataReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDataReq) ProtoMessage() {}
+
+func (x *RestoreDataReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDataReq.ProtoReflect.Descriptor instead.
+func (*RestoreDataReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RestoreDataReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreDataReq) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RestoreDataRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreDataRes) Reset() {
+	*x = RestoreDataRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreDataRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDataRes) ProtoMessage() {}
+
+func (x *RestoreDataRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDataRes.ProtoReflect.Descriptor instead.
+func (*RestoreDataRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{20}
+}
+
+type GetTrashReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetTrashReq) Reset() {
+	*x = GetTrashReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrashReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrashReq) ProtoMessage() {}
+
+func (x *GetTrashReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrashReq.ProtoReflect.Descriptor instead.
+func (*GetTrashReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{21}
+}
+
+type GetTrashRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*GetTrashRes_TrashItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetTrashRes) Reset() {
+	*x = GetTrashRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrashRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrashRes) ProtoMessage() {}
+
+func (x *GetTrashRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrashRes.ProtoReflect.Descriptor instead.
+func (*GetTrashRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetTrashRes) GetItems() []*GetTrashRes_TrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RestoreFromTrashReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreFromTrashReq) Reset() {
+	*x = RestoreFromTrashReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreFromTrashReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFromTrashReq) ProtoMessage() {}
+
+func (x *RestoreFromTrashReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFromTrashReq.ProtoReflect.Descriptor instead.
+func (*RestoreFromTrashReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RestoreFromTrashReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreFromTrashRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreFromTrashRes) Reset() {
+	*x = RestoreFromTrashRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreFromTrashRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreFromTrashRes) ProtoMessage() {}
+
+func (x *RestoreFromTrashRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreFromTrashRes.ProtoReflect.Descriptor instead.
+func (*RestoreFromTrashRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{24}
+}
+
+type EmptyTrashReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyTrashReq) Reset() {
+	*x = EmptyTrashReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyTrashReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyTrashReq) ProtoMessage() {}
+
+func (x *EmptyTrashReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyTrashReq.ProtoReflect.Descriptor instead.
+func (*EmptyTrashReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{25}
+}
+
+type EmptyTrashRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted int64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *EmptyTrashRes) Reset() {
+	*x = EmptyTrashRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyTrashRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyTrashRes) ProtoMessage() {}
+
+func (x *EmptyTrashRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyTrashRes.ProtoReflect.Descriptor instead.
+func (*EmptyTrashRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EmptyTrashRes) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+type UploadFileReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision  int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Meta      string                 `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	Chunk     []byte                 `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	ParentId  string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *UploadFileReq) Reset() {
+	*x = UploadFileReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileReq) ProtoMessage() {}
+
+func (x *UploadFileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileReq.ProtoReflect.Descriptor instead.
+func (*UploadFileReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UploadFileReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadFileReq) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *UploadFileReq) GetMeta() string {
+	if x != nil {
+		return x.Meta
+	}
+	return ""
+}
+
+func (x *UploadFileReq) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *UploadFileReq) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *UploadFileReq) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type UploadFileRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision int64  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *UploadFileRes) Reset() {
+	*x = UploadFileRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFileRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileRes) ProtoMessage() {}
+
+func (x *UploadFileRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileRes.ProtoReflect.Descriptor instead.
+func (*UploadFileRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UploadFileRes) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadFileRes) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type DownloadFileReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DownloadFileReq) Reset() {
+	*x = DownloadFileReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadFileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileReq) ProtoMessage() {}
+
+func (x *DownloadFileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileReq.ProtoReflect.Descriptor instead.
+func (*DownloadFileReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DownloadFileReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DownloadFileRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Meta     string `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Revision int64  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Size     int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Chunk    []byte `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *DownloadFileRes) Reset() {
+	*x = DownloadFileRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadFileRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileRes) ProtoMessage() {}
+
+func (x *DownloadFileRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileRes.ProtoReflect.Descriptor instead.
+func (*DownloadFileRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DownloadFileRes) GetMeta() string {
+	if x != nil {
+		return x.Meta
+	}
+	return ""
+}
+
+func (x *DownloadFileRes) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *DownloadFileRes) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DownloadFileRes) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type GetUsageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUsageReq) Reset() {
+	*x = GetUsageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReq) ProtoMessage() {}
+
+func (x *GetUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReq.ProtoReflect.Descriptor instead.
+func (*GetUsageReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{31}
+}
+
+type GetUsageRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usage []*GetUsageRes_TypeUsage `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	Quota *GetUsageRes_Quota       `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *GetUsageRes) Reset() {
+	*x = GetUsageRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRes) ProtoMessage() {}
+
+func (x *GetUsageRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRes.ProtoReflect.Descriptor instead.
+func (*GetUsageRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetUsageRes) GetUsage() []*GetUsageRes_TypeUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *GetUsageRes) GetQuota() *GetUsageRes_Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type Folder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId  string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Folder) Reset() {
+	*x = Folder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Folder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Folder) ProtoMessage() {}
+
+func (x *Folder) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Folder.ProtoReflect.Descriptor instead.
+func (*Folder) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Folder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Folder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Folder) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Folder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateFolderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+}
+
+func (x *CreateFolderReq) Reset() {
+	*x = CreateFolderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFolderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderReq) ProtoMessage() {}
+
+func (x *CreateFolderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFolderReq.ProtoReflect.Descriptor instead.
+func (*CreateFolderReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateFolderReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFolderReq) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type CreateFolderRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FolderId string `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+}
+
+func (x *CreateFolderRes) Reset() {
+	*x = CreateFolderRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFolderRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderRes) ProtoMessage() {}
+
+func (x *CreateFolderRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFolderRes.ProtoReflect.Descriptor instead.
+func (*CreateFolderRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateFolderRes) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type ListFoldersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFoldersReq) Reset() {
+	*x = ListFoldersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFoldersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFoldersReq) ProtoMessage() {}
+
+func (x *ListFoldersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFoldersReq.ProtoReflect.Descriptor instead.
+func (*ListFoldersReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{36}
+}
+
+type ListFoldersRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Folders []*Folder `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+}
+
+func (x *ListFoldersRes) Reset() {
+	*x = ListFoldersRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFoldersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFoldersRes) ProtoMessage() {}
+
+func (x *ListFoldersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFoldersRes.ProtoReflect.Descriptor instead.
+func (*ListFoldersRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListFoldersRes) GetFolders() []*Folder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+type DeleteFolderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FolderId string `protobuf:"bytes,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+}
+
+func (x *DeleteFolderReq) Reset() {
+	*x = DeleteFolderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFolderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFolderReq) ProtoMessage() {}
+
+func (x *DeleteFolderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFolderReq.ProtoReflect.Descriptor instead.
+func (*DeleteFolderReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteFolderReq) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type DeleteFolderRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteFolderRes) Reset() {
+	*x = DeleteFolderRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFolderRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFolderRes) ProtoMessage() {}
+
+func (x *DeleteFolderRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFolderRes.ProtoReflect.Descriptor instead.
+func (*DeleteFolderRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{39}
+}
+
+type MoveItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FolderId string `protobuf:"bytes,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+}
+
+func (x *MoveItemReq) Reset() {
+	*x = MoveItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveItemReq) ProtoMessage() {}
+
+func (x *MoveItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveItemReq.ProtoReflect.Descriptor instead.
+func (*MoveItemReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MoveItemReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveItemReq) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+type MoveItemRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MoveItemRes) Reset() {
+	*x = MoveItemRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveItemRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveItemRes) ProtoMessage() {}
+
+func (x *MoveItemRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveItemRes.ProtoReflect.Descriptor instead.
+func (*MoveItemRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{41}
+}
+
+type TagItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *TagItemReq) Reset() {
+	*x = TagItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagItemReq) ProtoMessage() {}
+
+func (x *TagItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagItemReq.ProtoReflect.Descriptor instead.
+func (*TagItemReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TagItemReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TagItemReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type TagItemRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TagItemRes) Reset() {
+	*x = TagItemRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagItemRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagItemRes) ProtoMessage() {}
+
+func (x *TagItemRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagItemRes.ProtoReflect.Descriptor instead.
+func (*TagItemRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{43}
+}
+
+type UntagItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *UntagItemReq) Reset() {
+	*x = UntagItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UntagItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UntagItemReq) ProtoMessage() {}
+
+func (x *UntagItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UntagItemReq.ProtoReflect.Descriptor instead.
+func (*UntagItemReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UntagItemReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UntagItemReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type UntagItemRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UntagItemRes) Reset() {
+	*x = UntagItemRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UntagItemRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UntagItemRes) ProtoMessage() {}
+
+func (x *UntagItemRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UntagItemRes.ProtoReflect.Descriptor instead.
+func (*UntagItemRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{45}
+}
+
+type ListTagsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTagsReq) Reset() {
+	*x = ListTagsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsReq) ProtoMessage() {}
+
+func (x *ListTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsReq.ProtoReflect.Descriptor instead.
+func (*ListTagsReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{46}
+}
+
+type ListTagsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*ListTagsRes_Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ListTagsRes) Reset() {
+	*x = ListTagsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRes) ProtoMessage() {}
+
+func (x *ListTagsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRes.ProtoReflect.Descriptor instead.
+func (*ListTagsRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListTagsRes) GetTags() []*ListTagsRes_Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetFavoriteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Favorite bool   `protobuf:"varint,2,opt,name=favorite,proto3" json:"favorite,omitempty"`
+}
+
+func (x *SetFavoriteReq) Reset() {
+	*x = SetFavoriteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFavoriteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFavoriteReq) ProtoMessage() {}
+
+func (x *SetFavoriteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFavoriteReq.ProtoReflect.Descriptor instead.
+func (*SetFavoriteReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetFavoriteReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetFavoriteReq) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+type SetFavoriteRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetFavoriteRes) Reset() {
+	*x = SetFavoriteRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFavoriteRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFavoriteRes) ProtoMessage() {}
+
+func (x *SetFavoriteRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFavoriteRes.ProtoReflect.Descriptor instead.
+func (*SetFavoriteRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{49}
+}
+
+type ShareItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Write bool   `protobuf:"varint,3,opt,name=write,proto3" json:"write,omitempty"`
+}
+
+func (x *ShareItemReq) Reset() {
+	*x = ShareItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareItemReq) ProtoMessage() {}
+
+func (x *ShareItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareItemReq.ProtoReflect.Descriptor instead.
+func (*ShareItemReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ShareItemReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShareItemReq) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *ShareItemReq) GetWrite() bool {
+	if x != nil {
+		return x.Write
+	}
+	return false
+}
+
+type ShareItemRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ShareItemRes) Reset() {
+	*x = ShareItemRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareItemRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareItemRes) ProtoMessage() {}
+
+func (x *ShareItemRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareItemRes.ProtoReflect.Descriptor instead.
+func (*ShareItemRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{51}
+}
+
+type RevokeShareReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *RevokeShareReq) Reset() {
+	*x = RevokeShareReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeShareReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareReq) ProtoMessage() {}
+
+func (x *RevokeShareReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareReq.ProtoReflect.Descriptor instead.
+func (*RevokeShareReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RevokeShareReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RevokeShareReq) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type RevokeShareRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeShareRes) Reset() {
+	*x = RevokeShareRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeShareRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareRes) ProtoMessage() {}
+
+func (x *RevokeShareRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareRes.ProtoReflect.Descriptor instead.
+func (*RevokeShareRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{53}
+}
+
+type ListSharedReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSharedReq) Reset() {
+	*x = ListSharedReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSharedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedReq) ProtoMessage() {}
+
+func (x *ListSharedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedReq.ProtoReflect.Descriptor instead.
+func (*ListSharedReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{54}
+}
+
+type ListSharedRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SharedWithMe []*ListSharedRes_Incoming `protobuf:"bytes,1,rep,name=shared_with_me,json=sharedWithMe,proto3" json:"shared_with_me,omitempty"`
+	SharedByMe   []*ListSharedRes_Outgoing `protobuf:"bytes,2,rep,name=shared_by_me,json=sharedByMe,proto3" json:"shared_by_me,omitempty"`
+}
+
+func (x *ListSharedRes) Reset() {
+	*x = ListSharedRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSharedRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedRes) ProtoMessage() {}
+
+func (x *ListSharedRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedRes.ProtoReflect.Descriptor instead.
+func (*ListSharedRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListSharedRes) GetSharedWithMe() []*ListSharedRes_Incoming {
+	if x != nil {
+		return x.SharedWithMe
+	}
+	return nil
+}
+
+func (x *ListSharedRes) GetSharedByMe() []*ListSharedRes_Outgoing {
+	if x != nil {
+		return x.SharedByMe
+	}
+	return nil
+}
+
+type VaultRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Meta      string                 `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	Size      int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	ParentId  string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *VaultRecord) Reset() {
+	*x = VaultRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultRecord) ProtoMessage() {}
+
+func (x *VaultRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultRecord.ProtoReflect.Descriptor instead.
+func (*VaultRecord) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *VaultRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VaultRecord) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VaultRecord) GetMeta() string {
+	if x != nil {
+		return x.Meta
+	}
+	return ""
+}
+
+func (x *VaultRecord) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *VaultRecord) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *VaultRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *VaultRecord) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *VaultRecord) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ExportVaultReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportVaultReq) Reset() {
+	*x = ExportVaultReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportVaultReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportVaultReq) ProtoMessage() {}
+
+func (x *ExportVaultReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportVaultReq.ProtoReflect.Descriptor instead.
+func (*ExportVaultReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{57}
+}
+
+type ExportVaultRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record *VaultRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	Chunk  []byte       `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ExportVaultRes) Reset() {
+	*x = ExportVaultRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportVaultRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportVaultRes) ProtoMessage() {}
+
+func (x *ExportVaultRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportVaultRes.ProtoReflect.Descriptor instead.
+func (*ExportVaultRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ExportVaultRes) GetRecord() *VaultRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ExportVaultRes) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ImportVaultReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record *VaultRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	Chunk  []byte       `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ImportVaultReq) Reset() {
+	*x = ImportVaultReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportVaultReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVaultReq) ProtoMessage() {}
+
+func (x *ImportVaultReq) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVaultReq.ProtoReflect.Descriptor instead.
+func (*ImportVaultReq) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ImportVaultReq) GetRecord() *VaultRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ImportVaultReq) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ImportVaultRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Imported int64                     `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Failures []*ImportVaultRes_Failure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (x *ImportVaultRes) Reset() {
+	*x = ImportVaultRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportVaultRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVaultRes) ProtoMessage() {}
+
+func (x *ImportVaultRes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVaultRes.ProtoReflect.Descriptor instead.
+func (*ImportVaultRes) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ImportVaultRes) GetImported() int64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportVaultRes) GetFailures() []*ImportVaultRes_Failure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type GetDataRes_Attachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta      string                 `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Size      int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *GetDataRes_Attachment) Reset() {
+	*x = GetDataRes_Attachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataRes_Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataRes_Attachment) ProtoMessage() {}
+
+func (x *GetDataRes_Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataRes_Attachment.ProtoReflect.Descriptor instead.
+func (*GetDataRes_Attachment) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *GetDataRes_Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDataRes_Attachment) GetMeta() string {
+	if x != nil {
+		return x.Meta
+	}
+	return ""
+}
+
+func (x *GetDataRes_Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetDataRes_Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetAllByTypeRes_TypeItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta string `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+}
+
+func (x *GetAllByTypeRes_TypeItem) Reset() {
+	*x = GetAllByTypeRes_TypeItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllByTypeRes_TypeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllByTypeRes_TypeItem) ProtoMessage() {}
+
+func (x *GetAllByTypeRes_TypeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllByTypeRes_TypeItem.ProtoReflect.Descriptor instead.
+func (*GetAllByTypeRes_TypeItem) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *GetAllByTypeRes_TypeItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAllByTypeRes_TypeItem) GetMeta() string {
+	if x != nil {
+		return x.Meta
+	}
+	return ""
+}
+
+type ListItemsRes_ListItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Meta           string                 `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Revision       int64                  `protobuf:"varint,6,opt,name=revision,proto3" json:"revision,omitempty"`
+	FolderId       string                 `protobuf:"bytes,7,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`
+	Tags           []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Favorite       bool                   `protobuf:"varint,10,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_accessed_at,json=lastAccessedAt,proto3" json:"last_accessed_at,omitempty"`
+	AccessCount    int64                  `protobuf:"varint,12,opt,name=access_count,json=accessCount,proto3" json:"access_count,omitempty"`
+}
+
+func (x *ListItemsRes_ListItem) Reset() {
+	*x = ListItemsRes_ListItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListItemsRes_ListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsRes_ListItem) ProtoMessage() {}
+
+func (x *ListItemsRes_ListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsRes_ListItem.ProtoReflect.Descriptor instead.
+func (*ListItemsRes_ListItem) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *ListItemsRes_ListItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListItemsRes_ListItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListItemsRes_ListItem) GetMeta() string {
+	if x != nil {
+		return x.Meta
+	}
+	return ""
+}
+
+func (x *ListItemsRes_ListItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ListItemsRes_ListItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ListItemsRes_ListItem) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ListItemsRes_ListItem) GetFolderId() string {
+	if x != nil {
+		return x.FolderId
+	}
+	return ""
+}
+
+func (x *ListItemsRes_ListItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListItemsRes_ListItem) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ListItemsRes_ListItem) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *ListItemsRes_ListItem) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *ListItemsRes_ListItem) GetAccessCount() int64 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+type GetDataHistoryRes_Revision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision  int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *GetDataHistoryRes_Revision) Reset() {
+	*x = GetDataHistoryRes_Revision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDataHistoryRes_Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataHistoryRes_Revision) ProtoMessage() {}
+
+func (x *GetDataHistoryRes_Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataHistoryRes_Revision.ProtoReflect.Descriptor instead.
+func (*GetDataHistoryRes_Revision) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *GetDataHistoryRes_Revision) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GetDataHistoryRes_Revision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetTrashRes_TrashItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Meta      string                 `protobuf:"bytes,3,opt,name=meta,proto3" json:"meta,omitempty"`
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+}
+
+func (x *GetTrashRes_TrashItem) Reset() {
+	*x = GetTrashRes_TrashItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrashRes_TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrashRes_TrashItem) ProtoMessage() {}
+
+func (x *GetTrashRes_TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrashRes_TrashItem.ProtoReflect.Descriptor instead.
+func (*GetTrashRes_TrashItem) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *GetTrashRes_TrashItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTrashRes_TrashItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetTrashRes_TrashItem) GetMeta() string {
+	if x != nil {
+		return x.Meta
+	}
+	return ""
+}
+
+func (x *GetTrashRes_TrashItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type GetUsageRes_TypeUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Items int64  `protobuf:"varint,2,opt,name=items,proto3" json:"items,omitempty"`
+	Bytes int64  `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *GetUsageRes_TypeUsage) Reset() {
+	*x = GetUsageRes_TypeUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageRes_TypeUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRes_TypeUsage) ProtoMessage() {}
+
+func (x *GetUsageRes_TypeUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRes_TypeUsage.ProtoReflect.Descriptor instead.
+func (*GetUsageRes_TypeUsage) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *GetUsageRes_TypeUsage) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetUsageRes_TypeUsage) GetItems() int64 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *GetUsageRes_TypeUsage) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type GetUsageRes_Quota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxBytes    int64 `protobuf:"varint,1,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	MaxItems    int64 `protobuf:"varint,2,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	MaxItemSize int64 `protobuf:"varint,3,opt,name=max_item_size,json=maxItemSize,proto3" json:"max_item_size,omitempty"`
+	MaxMetaSize int64 `protobuf:"varint,4,opt,name=max_meta_size,json=maxMetaSize,proto3" json:"max_meta_size,omitempty"`
+}
+
+func (x *GetUsageRes_Quota) Reset() {
+	*x = GetUsageRes_Quota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageRes_Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRes_Quota) ProtoMessage() {}
+
+func (x *GetUsageRes_Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRes_Quota.ProtoReflect.Descriptor instead.
+func (*GetUsageRes_Quota) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{32, 1}
+}
+
+func (x *GetUsageRes_Quota) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *GetUsageRes_Quota) GetMaxItems() int64 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+func (x *GetUsageRes_Quota) GetMaxItemSize() int64 {
+	if x != nil {
+		return x.MaxItemSize
+	}
+	return 0
+}
+
+func (x *GetUsageRes_Quota) GetMaxMetaSize() int64 {
+	if x != nil {
+		return x.MaxMetaSize
+	}
+	return 0
+}
+
+type ListTagsRes_Tag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Items int64  `protobuf:"varint,2,opt,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ListTagsRes_Tag) Reset() {
+	*x = ListTagsRes_Tag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsRes_Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRes_Tag) ProtoMessage() {}
+
+func (x *ListTagsRes_Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRes_Tag.ProtoReflect.Descriptor instead.
+func (*ListTagsRes_Tag) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *ListTagsRes_Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListTagsRes_Tag) GetItems() int64 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+type ListSharedRes_Incoming struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner     string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Type      string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Meta      string                 `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	Write     bool                   `protobuf:"varint,5,opt,name=write,proto3" json:"write,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ListSharedRes_Incoming) Reset() {
+	*x = ListSharedRes_Incoming{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSharedRes_Incoming) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedRes_Incoming) ProtoMessage() {}
+
+func (x *ListSharedRes_Incoming) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedRes_Incoming.ProtoReflect.Descriptor instead.
+func (*ListSharedRes_Incoming) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{55, 0}
+}
+
+func (x *ListSharedRes_Incoming) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListSharedRes_Incoming) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListSharedRes_Incoming) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListSharedRes_Incoming) GetMeta() string {
+	if x != nil {
+		return x.Meta
+	}
+	return ""
+}
+
+func (x *ListSharedRes_Incoming) GetWrite() bool {
+	if x != nil {
+		return x.Write
+	}
+	return false
+}
+
+func (x *ListSharedRes_Incoming) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSharedRes_Outgoing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login     string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Type      string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Write     bool                   `protobuf:"varint,4,opt,name=write,proto3" json:"write,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ListSharedRes_Outgoing) Reset() {
+	*x = ListSharedRes_Outgoing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSharedRes_Outgoing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedRes_Outgoing) ProtoMessage() {}
+
+func (x *ListSharedRes_Outgoing) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedRes_Outgoing.ProtoReflect.Descriptor instead.
+func (*ListSharedRes_Outgoing) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{55, 1}
+}
+
+func (x *ListSharedRes_Outgoing) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListSharedRes_Outgoing) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *ListSharedRes_Outgoing) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListSharedRes_Outgoing) GetWrite() bool {
+	if x != nil {
+		return x.Write
+	}
+	return false
+}
+
+func (x *ListSharedRes_Outgoing) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ImportVaultRes_Failure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index int64  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ImportVaultRes_Failure) Reset() {
+	*x = ImportVaultRes_Failure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_vault_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportVaultRes_Failure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVaultRes_Failure) ProtoMessage() {}
+
+func (x *ImportVaultRes_Failure) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_vault_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVaultRes_Failure.ProtoReflect.Descriptor instead.
+func (*ImportVaultRes_Failure) Descriptor() ([]byte, []int) {
+	return file_internal_proto_vault_proto_rawDescGZIP(), []int{60, 0}
+}
+
+func (x *ImportVaultRes_Failure) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ImportVaultRes_Failure) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportVaultRes_Failure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_internal_proto_vault_proto protoreflect.FileDescriptor
+
+var file_internal_proto_vault_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a,
+	0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x22, 0x62, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12,
+	0x19, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x1c, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x48, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x6b,
+	0x69, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xc9, 0x02,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x7f, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x1f, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0d,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x2b, 0x0a, 0x0d,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x72, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x1a, 0x2e, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6d, 0x65, 0x74, 0x61, 0x22, 0xda, 0x02, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x6f, 0x72,
+	0x74, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79,
+	0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x61,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65,
+	0x74, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x46, 0x69, 0x72, 0x73, 0x74, 0x22,
+	0x40, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x45, 0x54,
+	0x41, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10,
+	0x03, 0x22, 0xac, 0x04, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0xc5, 0x03, 0x0a, 0x08, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c,
+	0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x6a, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x22, 0x3e, 0x0a, 0x0e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2c,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x23, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xb1, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x61, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x40, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x22, 0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x22, 0x0d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x22, 0xbb, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x1a, 0x7e, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x25, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x22,
+	0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x22, 0x29, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xbd, 0x01, 0x0a, 0x0d,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x3b, 0x0a, 0x0d, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x21, 0x0a, 0x0f, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6b, 0x0a, 0x0f, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65,
+	0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x0d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x22, 0xbe, 0x02, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x1a,
+	0x4b, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x1a, 0x89, 0x01, 0x0a,
+	0x05, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x4d, 0x65, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x06, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x42, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x22, 0x33, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x07, 0x66, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x52, 0x07, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x22, 0x2e, 0x0a, 0x0f, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x3a, 0x0a,
+	0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x4d, 0x6f, 0x76,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x0a, 0x54, 0x61, 0x67, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x0c, 0x0a, 0x0a, 0x54, 0x61, 0x67, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x22, 0x30, 0x0a, 0x0c, 0x55, 0x6e, 0x74, 0x61, 0x67, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x0e, 0x0a, 0x0c, 0x55, 0x6e, 0x74, 0x61,
+	0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x22, 0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x22, 0x64, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x2f, 0x0a, 0x03,
+	0x54, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3c, 0x0a,
+	0x0e, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x53,
+	0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x22, 0x4a, 0x0a,
+	0x0c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x22, 0x36, 0x0a, 0x0e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x22, 0xcd, 0x03, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57,
+	0x69, 0x74, 0x68, 0x4d, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x5f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65,
+	0x1a, 0xa9, 0x01, 0x0a, 0x08, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x95, 0x01, 0x0a,
+	0x08, 0x4f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xa7, 0x02, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x10,
+	0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
+	0x22, 0x4c, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x4c,
+	0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x24, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0xa8, 0x01, 0x0a,
+	0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x2e, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x1a, 0x45, 0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xea, 0x0a, 0x0a, 0x0c, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x0b, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x1a, 0x0b, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x12, 0x23, 0x0a,
+	0x07, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0b, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x12, 0x2c, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x0e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x1a, 0x0e, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x12, 0x2c, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x0e,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x12, 0x32,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x65, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x0d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0d,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a,
+	0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x0f, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x38,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x0f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x73, 0x68, 0x12, 0x0c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x1a, 0x0c, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x12, 0x3e,
+	0x0a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x61,
+	0x73, 0x68, 0x12, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x12, 0x2c,
+	0x0a, 0x0a, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x0e, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x0a,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x28, 0x01, 0x12, 0x34, 0x0a, 0x0c,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x30, 0x01, 0x12, 0x26, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0c,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x12, 0x2f,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x0f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0f,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12,
+	0x32, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12,
+	0x10, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x0c, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e,
+	0x4d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x07, 0x54,
+	0x61, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0b, 0x2e, 0x54, 0x61, 0x67, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x54, 0x61, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x09, 0x55, 0x6e, 0x74, 0x61, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0d, 0x2e,
+	0x55, 0x6e, 0x74, 0x61, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x55,
+	0x6e, 0x74, 0x61, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x08, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x0c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x12, 0x0f, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x0d, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
+	0x1a, 0x0d, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x12,
+	0x2f, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x0f,
+	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x0f, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x12, 0x2c, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x0e,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0e,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x12, 0x31,
+	0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x0f, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x30,
+	0x01, 0x12, 0x31, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x12, 0x0f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x0f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52,
+	0x65, 0x73, 0x28, 0x01, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x70, 0x69, 0x6e, 0x62, 0x72, 0x61, 0x69, 0x6e, 0x2f, 0x67, 0x6f, 0x70, 0x68,
+	0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_proto_vault_proto_rawDescOnce sync.Once
+	file_internal_proto_vault_proto_rawDescData = file_internal_proto_vault_proto_rawDesc
+)
+
+func file_internal_proto_vault_proto_rawDescGZIP() []byte {
+	file_internal_proto_vault_proto_rawDescOnce.Do(func() {
+		file_internal_proto_vault_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_proto_vault_proto_rawDescData)
+	})
+	return file_internal_proto_vault_proto_rawDescData
+}
+
+var file_internal_proto_vault_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_internal_proto_vault_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
+var file_internal_proto_vault_proto_goTypes = []any{
+	(ListItemsReq_SortBy)(0),           // 0: ListItemsReq.SortBy
+	(*Item)(nil),                       // 1: Item
+	(*AddDataReq)(nil),                 // 2: AddDataReq
+	(*AddDataRes)(nil),                 // 3: AddDataRes
+	(*GetDataReq)(nil),                 // 4: GetDataReq
+	(*GetDataRes)(nil),                 // 5: GetDataRes
+	(*DeleteDataReq)(nil),              // 6: DeleteDataReq
+	(*DeleteDataRes)(nil),              // 7: DeleteDataRes
+	(*UpdateDataReq)(nil),              // 8: UpdateDataReq
+	(*UpdateDataRes)(nil),              // 9: UpdateDataRes
+	(*GetAllByTypeReq)(nil),            // 10: GetAllByTypeReq
+	(*GetAllByTypeRes)(nil),            // 11: GetAllByTypeRes
+	(*ListItemsReq)(nil),               // 12: ListItemsReq
+	(*ListItemsRes)(nil),               // 13: ListItemsRes
+	(*SearchItemsReq)(nil),             // 14: SearchItemsReq
+	(*SearchItemsRes)(nil),             // 15: SearchItemsRes
+	(*GetDataHistoryReq)(nil),          // 16: GetDataHistoryReq
+	(*GetDataHistoryRes)(nil),          // 17: GetDataHistoryRes
+	(*GetDataRevisionReq)(nil),         // 18: GetDataRevisionReq
+	(*GetDataRevisionRes)(nil),         // 19: GetDataRevisionRes
+	(*RestoreDataReq)(nil),             // 20: RestoreDataReq
+	(*RestoreDataRes)(nil),             // 21: RestoreDataRes
+	(*GetTrashReq)(nil),                // 22: GetTrashReq
+	(*GetTrashRes)(nil),                // 23: GetTrashRes
+	(*RestoreFromTrashReq)(nil),        // 24: RestoreFromTrashReq
+	(*RestoreFromTrashRes)(nil),        // 25: RestoreFromTrashRes
+	(*EmptyTrashReq)(nil),              // 26: EmptyTrashReq
+	(*EmptyTrashRes)(nil),              // 27: EmptyTrashRes
+	(*UploadFileReq)(nil),              // 28: UploadFileReq
+	(*UploadFileRes)(nil),              // 29: UploadFileRes
+	(*DownloadFileReq)(nil),            // 30: DownloadFileReq
+	(*DownloadFileRes)(nil),            // 31: DownloadFileRes
+	(*GetUsageReq)(nil),                // 32: GetUsageReq
+	(*GetUsageRes)(nil),                // 33: GetUsageRes
+	(*Folder)(nil),                     // 34: Folder
+	(*CreateFolderReq)(nil),            // 35: CreateFolderReq
+	(*CreateFolderRes)(nil),            // 36: CreateFolderRes
+	(*ListFoldersReq)(nil),             // 37: ListFoldersReq
+	(*ListFoldersRes)(nil),             // 38: ListFoldersRes
+	(*DeleteFolderReq)(nil),            // 39: DeleteFolderReq
+	(*DeleteFolderRes)(nil),            // 40: DeleteFolderRes
+	(*MoveItemReq)(nil),                // 41: MoveItemReq
+	(*MoveItemRes)(nil),                // 42: MoveItemRes
+	(*TagItemReq)(nil),                 // 43: TagItemReq
+	(*TagItemRes)(nil),                 // 44: TagItemRes
+	(*UntagItemReq)(nil),               // 45: UntagItemReq
+	(*UntagItemRes)(nil),               // 46: UntagItemRes
+	(*ListTagsReq)(nil),                // 47: ListTagsReq
+	(*ListTagsRes)(nil),                // 48: ListTagsRes
+	(*SetFavoriteReq)(nil),             // 49: SetFavoriteReq
+	(*SetFavoriteRes)(nil),             // 50: SetFavoriteRes
+	(*ShareItemReq)(nil),               // 51: ShareItemReq
+	(*ShareItemRes)(nil),               // 52: ShareItemRes
+	(*RevokeShareReq)(nil),             // 53: RevokeShareReq
+	(*RevokeShareRes)(nil),             // 54: RevokeShareRes
+	(*ListSharedReq)(nil),              // 55: ListSharedReq
+	(*ListSharedRes)(nil),              // 56: ListSharedRes
+	(*VaultRecord)(nil),                // 57: VaultRecord
+	(*ExportVaultReq)(nil),             // 58: ExportVaultReq
+	(*ExportVaultRes)(nil),             // 59: ExportVaultRes
+	(*ImportVaultReq)(nil),             // 60: ImportVaultReq
+	(*ImportVaultRes)(nil),             // 61: ImportVaultRes
+	(*GetDataRes_Attachment)(nil),      // 62: GetDataRes.Attachment
+	(*GetAllByTypeRes_TypeItem)(nil),   // 63: GetAllByTypeRes.TypeItem
+	(*ListItemsRes_ListItem)(nil),      // 64: ListItemsRes.ListItem
+	(*GetDataHistoryRes_Revision)(nil), // 65: GetDataHistoryRes.Revision
+	(*GetTrashRes_TrashItem)(nil),      // 66: GetTrashRes.TrashItem
+	(*GetUsageRes_TypeUsage)(nil),      // 67: GetUsageRes.TypeUsage
+	(*GetUsageRes_Quota)(nil),          // 68: GetUsageRes.Quota
+	(*ListTagsRes_Tag)(nil),            // 69: ListTagsRes.Tag
+	(*ListSharedRes_Incoming)(nil),     // 70: ListSharedRes.Incoming
+	(*ListSharedRes_Outgoing)(nil),     // 71: ListSharedRes.Outgoing
+	(*ImportVaultRes_Failure)(nil),     // 72: ImportVaultRes.Failure
+	(*timestamppb.Timestamp)(nil),      // 73: google.protobuf.Timestamp
+}
+var file_internal_proto_vault_proto_depIdxs = []int32{
+	1,  // 0: AddDataReq.item:type_name -> Item
+	73, // 1: AddDataReq.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: GetDataRes.item:type_name -> Item
+	62, // 3: GetDataRes.attachments:type_name -> GetDataRes.Attachment
+	73, // 4: GetDataRes.expires_at:type_name -> google.protobuf.Timestamp
+	73, // 5: UpdateDataReq.expires_at:type_name -> google.protobuf.Timestamp
+	63, // 6: GetAllByTypeRes.items:type_name -> GetAllByTypeRes.TypeItem
+	0,  // 7: ListItemsReq.sort_by:type_name -> ListItemsReq.SortBy
+	64, // 8: ListItemsRes.items:type_name -> ListItemsRes.ListItem
+	64, // 9: SearchItemsRes.items:type_name -> ListItemsRes.ListItem
+	65, // 10: GetDataHistoryRes.revisions:type_name -> GetDataHistoryRes.Revision
+	1,  // 11: GetDataRevisionRes.item:type_name -> Item
+	66, // 12: GetTrashRes.items:type_name -> GetTrashRes.TrashItem
+	73, // 13: UploadFileReq.expires_at:type_name -> google.protobuf.Timestamp
+	67, // 14: GetUsageRes.usage:type_name -> GetUsageRes.TypeUsage
+	68, // 15: GetUsageRes.quota:type_name -> GetUsageRes.Quota
+	73, // 16: Folder.created_at:type_name -> google.protobuf.Timestamp
+	34, // 17: ListFoldersRes.folders:type_name -> Folder
+	69, // 18: ListTagsRes.tags:type_name -> ListTagsRes.Tag
+	70, // 19: ListSharedRes.shared_with_me:type_name -> ListSharedRes.Incoming
+	71, // 20: ListSharedRes.shared_by_me:type_name -> ListSharedRes.Outgoing
+	73, // 21: VaultRecord.created_at:type_name -> google.protobuf.Timestamp
+	73, // 22: VaultRecord.updated_at:type_name -> google.protobuf.Timestamp
+	73, // 23: VaultRecord.expires_at:type_name -> google.protobuf.Timestamp
+	57, // 24: ExportVaultRes.record:type_name -> VaultRecord
+	57, // 25: ImportVaultReq.record:type_name -> VaultRecord
+	72, // 26: ImportVaultRes.failures:type_name -> ImportVaultRes.Failure
+	73, // 27: GetDataRes.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	73, // 28: ListItemsRes.ListItem.created_at:type_name -> google.protobuf.Timestamp
+	73, // 29: ListItemsRes.ListItem.updated_at:type_name -> google.protobuf.Timestamp
+	73, // 30: ListItemsRes.ListItem.expires_at:type_name -> google.protobuf.Timestamp
+	73, // 31: ListItemsRes.ListItem.last_accessed_at:type_name -> google.protobuf.Timestamp
+	73, // 32: GetDataHistoryRes.Revision.created_at:type_name -> google.protobuf.Timestamp
+	73, // 33: GetTrashRes.TrashItem.deleted_at:type_name -> google.protobuf.Timestamp
+	73, // 34: ListSharedRes.Incoming.created_at:type_name -> google.protobuf.Timestamp
+	73, // 35: ListSharedRes.Outgoing.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 36: VaultService.AddData:input_type -> AddDataReq
+	4,  // 37: VaultService.GetData:input_type -> GetDataReq
+	6,  // 38: VaultService.DeleteData:input_type -> DeleteDataReq
+	8,  // 39: VaultService.UpdateData:input_type -> UpdateDataReq
+	10, // 40: VaultService.GetAllByType:input_type -> GetAllByTypeReq
+	12, // 41: VaultService.ListItems:input_type -> ListItemsReq
+	14, // 42: VaultService.SearchItems:input_type -> SearchItemsReq
+	16, // 43: VaultService.GetDataHistory:input_type -> GetDataHistoryReq
+	18, // 44: VaultService.GetDataRevision:input_type -> GetDataRevisionReq
+	20, // 45: VaultService.RestoreData:input_type -> RestoreDataReq
+	22, // 46: VaultService.GetTrash:input_type -> GetTrashReq
+	24, // 47: VaultService.RestoreFromTrash:input_type -> RestoreFromTrashReq
+	26, // 48: VaultService.EmptyTrash:input_type -> EmptyTrashReq
+	28, // 49: VaultService.UploadFile:input_type -> UploadFileReq
+	30, // 50: VaultService.DownloadFile:input_type -> DownloadFileReq
+	32, // 51: VaultService.GetUsage:input_type -> GetUsageReq
+	35, // 52: VaultService.CreateFolder:input_type -> CreateFolderReq
+	37, // 53: VaultService.ListFolders:input_type -> ListFoldersReq
+	39, // 54: VaultService.DeleteFolder:input_type -> DeleteFolderReq
+	41, // 55: VaultService.MoveItem:input_type -> MoveItemReq
+	43, // 56: VaultService.TagItem:input_type -> TagItemReq
+	45, // 57: VaultService.UntagItem:input_type -> UntagItemReq
+	47, // 58: VaultService.ListTags:input_type -> ListTagsReq
+	49, // 59: VaultService.SetFavorite:input_type -> SetFavoriteReq
+	51, // 60: VaultService.ShareItem:input_type -> ShareItemReq
+	53, // 61: VaultService.RevokeShare:input_type -> RevokeShareReq
+	55, // 62: VaultService.ListShared:input_type -> ListSharedReq
+	58, // 63: VaultService.ExportVault:input_type -> ExportVaultReq
+	60, // 64: VaultService.ImportVault:input_type -> ImportVaultReq
+	3,  // 65: VaultService.AddData:output_type -> AddDataRes
+	5,  // 66: VaultService.GetData:output_type -> GetDataRes
+	7,  // 67: VaultService.DeleteData:output_type -> DeleteDataRes
+	9,  // 68: VaultService.UpdateData:output_type -> UpdateDataRes
+	11, // 69: VaultService.GetAllByType:output_type -> GetAllByTypeRes
+	13, // 70: VaultService.ListItems:output_type -> ListItemsRes
+	15, // 71: VaultService.SearchItems:output_type -> SearchItemsRes
+	17, // 72: VaultService.GetDataHistory:output_type -> GetDataHistoryRes
+	19, // 73: VaultService.GetDataRevision:output_type -> GetDataRevisionRes
+	21, // 74: VaultService.RestoreData:output_type -> RestoreDataRes
+	23, // 75: VaultService.GetTrash:output_type -> GetTrashRes
+	25, // 76: VaultService.RestoreFromTrash:output_type -> RestoreFromTrashRes
+	27, // 77: VaultService.EmptyTrash:output_type -> EmptyTrashRes
+	29, // 78: VaultService.UploadFile:output_type -> UploadFileRes
+	31, // 79: VaultService.DownloadFile:output_type -> DownloadFileRes
+	33, // 80: VaultService.GetUsage:output_type -> GetUsageRes
+	36, // 81: VaultService.CreateFolder:output_type -> CreateFolderRes
+	38, // 82: VaultService.ListFolders:output_type -> ListFoldersRes
+	40, // 83: VaultService.DeleteFolder:output_type -> DeleteFolderRes
+	42, // 84: VaultService.MoveItem:output_type -> MoveItemRes
+	44, // 85: VaultService.TagItem:output_type -> TagItemRes
+	46, // 86: VaultService.UntagItem:output_type -> UntagItemRes
+	48, // 87: VaultService.ListTags:output_type -> ListTagsRes
+	50, // 88: VaultService.SetFavorite:output_type -> SetFavoriteRes
+	52, // 89: VaultService.ShareItem:output_type -> ShareItemRes
+	54, // 90: VaultService.RevokeShare:output_type -> RevokeShareRes
+	56, // 91: VaultService.ListShared:output_type -> ListSharedRes
+	59, // 92: VaultService.ExportVault:output_type -> ExportVaultRes
+	61, // 93: VaultService.ImportVault:output_type -> ImportVaultRes
+	65, // [65:94] is the sub-list for method output_type
+	36, // [36:65] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
+}
+
+func init() { file_internal_proto_vault_proto_init() }
+func file_internal_proto_vault_proto_init() {
+	if File_internal_proto_vault_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_proto_vault_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*AddDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*AddDataRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDataRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
 		file_internal_proto_vault_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*DeleteDataRes); i {
@@ -800,8 +4809,440 @@ func file_internal_proto_vault_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_vault_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateDataReq); i {
+		file_internal_proto_vault_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateDataRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllByTypeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllByTypeRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ListItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ListItemsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchItemsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDataHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDataHistoryRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDataRevisionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDataRevisionRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreDataReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreDataRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTrashReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTrashRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreFromTrashReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreFromTrashRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*EmptyTrashReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*EmptyTrashRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadFileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadFileRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadFileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadFileRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsageRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*Folder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFolderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFolderRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFoldersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ListFoldersRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFolderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFolderRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*MoveItemReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*MoveItemRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*TagItemReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*TagItemRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -812,8 +5253,8 @@ func file_internal_proto_vault_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_vault_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateDataRes); i {
+		file_internal_proto_vault_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*UntagItemReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -824,8 +5265,8 @@ func file_internal_proto_vault_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_vault_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllByTypeReq); i {
+		file_internal_proto_vault_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*UntagItemRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -836,8 +5277,8 @@ func file_internal_proto_vault_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_vault_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllByTypeRes); i {
+		file_internal_proto_vault_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTagsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -848,7 +5289,187 @@ func file_internal_proto_vault_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_vault_proto_msgTypes[11].Exporter = func(v any, i int) any {
+		file_internal_proto_vault_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTagsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*SetFavoriteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*SetFavoriteRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*ShareItemReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*ShareItemRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeShareReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeShareRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSharedReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSharedRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*VaultRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportVaultReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportVaultRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportVaultReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportVaultRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDataRes_Attachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[62].Exporter = func(v any, i int) any {
 			switch v := v.(*GetAllByTypeRes_TypeItem); i {
 			case 0:
 				return &v.state
@@ -860,19 +5481,128 @@ func file_internal_proto_vault_proto_init() {
 				return nil
 			}
 		}
+		file_internal_proto_vault_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*ListItemsRes_ListItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDataHistoryRes_Revision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTrashRes_TrashItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsageRes_TypeUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsageRes_Quota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTagsRes_Tag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSharedRes_Incoming); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSharedRes_Outgoing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_vault_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportVaultRes_Failure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_proto_vault_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      1,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_internal_proto_vault_proto_goTypes,
 		DependencyIndexes: file_internal_proto_vault_proto_depIdxs,
+		EnumInfos:         file_internal_proto_vault_proto_enumTypes,
 		MessageInfos:      file_internal_proto_vault_proto_msgTypes,
 	}.Build()
 	File_internal_proto_vault_proto = out.File
@@ -15,15 +15,39 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
 
 const (
-	VaultService_AddData_FullMethodName      = "/VaultService/AddData"
-	VaultService_GetData_FullMethodName      = "/VaultService/GetData"
-	VaultService_DeleteData_FullMethodName   = "/VaultService/DeleteData"
-	VaultService_UpdateData_FullMethodName   = "/VaultService/UpdateData"
-	VaultService_GetAllByType_FullMethodName = "/VaultService/GetAllByType"
+	VaultService_AddData_FullMethodName          = "/VaultService/AddData"
+	VaultService_GetData_FullMethodName          = "/VaultService/GetData"
+	VaultService_DeleteData_FullMethodName       = "/VaultService/DeleteData"
+	VaultService_UpdateData_FullMethodName       = "/VaultService/UpdateData"
+	VaultService_GetAllByType_FullMethodName     = "/VaultService/GetAllByType"
+	VaultService_ListItems_FullMethodName        = "/VaultService/ListItems"
+	VaultService_SearchItems_FullMethodName      = "/VaultService/SearchItems"
+	VaultService_GetDataHistory_FullMethodName   = "/VaultService/GetDataHistory"
+	VaultService_GetDataRevision_FullMethodName  = "/VaultService/GetDataRevision"
+	VaultService_RestoreData_FullMethodName      = "/VaultService/RestoreData"
+	VaultService_GetTrash_FullMethodName         = "/VaultService/GetTrash"
+	VaultService_RestoreFromTrash_FullMethodName = "/VaultService/RestoreFromTrash"
+	VaultService_EmptyTrash_FullMethodName       = "/VaultService/EmptyTrash"
+	VaultService_UploadFile_FullMethodName       = "/VaultService/UploadFile"
+	VaultService_DownloadFile_FullMethodName     = "/VaultService/DownloadFile"
+	VaultService_GetUsage_FullMethodName         = "/VaultService/GetUsage"
+	VaultService_CreateFolder_FullMethodName     = "/VaultService/CreateFolder"
+	VaultService_ListFolders_FullMethodName      = "/VaultService/ListFolders"
+	VaultService_DeleteFolder_FullMethodName     = "/VaultService/DeleteFolder"
+	VaultService_MoveItem_FullMethodName         = "/VaultService/MoveItem"
+	VaultService_TagItem_FullMethodName          = "/VaultService/TagItem"
+	VaultService_UntagItem_FullMethodName        = "/VaultService/UntagItem"
+	VaultService_ListTags_FullMethodName         = "/VaultService/ListTags"
+	VaultService_SetFavorite_FullMethodName      = "/VaultService/SetFavorite"
+	VaultService_ShareItem_FullMethodName        = "/VaultService/ShareItem"
+	VaultService_RevokeShare_FullMethodName      = "/VaultService/RevokeShare"
+	VaultService_ListShared_FullMethodName       = "/VaultService/ListShared"
+	VaultService_ExportVault_FullMethodName      = "/VaultService/ExportVault"
+	VaultService_ImportVault_FullMethodName      = "/VaultService/ImportVault"
 )
 
 // VaultServiceClient is the client API for VaultService service.
@@ -35,6 +59,30 @@ type VaultServiceClient interface {
 	DeleteData(ctx context.Context, in *DeleteDataReq, opts ...grpc.CallOption) (*DeleteDataRes, error)
 	UpdateData(ctx context.Context, in *UpdateDataReq, opts ...grpc.CallOption) (*UpdateDataRes, error)
 	GetAllByType(ctx context.Context, in *GetAllByTypeReq, opts ...grpc.CallOption) (*GetAllByTypeRes, error)
+	ListItems(ctx context.Context, in *ListItemsReq, opts ...grpc.CallOption) (*ListItemsRes, error)
+	SearchItems(ctx context.Context, in *SearchItemsReq, opts ...grpc.CallOption) (*SearchItemsRes, error)
+	GetDataHistory(ctx context.Context, in *GetDataHistoryReq, opts ...grpc.CallOption) (*GetDataHistoryRes, error)
+	GetDataRevision(ctx context.Context, in *GetDataRevisionReq, opts ...grpc.CallOption) (*GetDataRevisionRes, error)
+	RestoreData(ctx context.Context, in *RestoreDataReq, opts ...grpc.CallOption) (*RestoreDataRes, error)
+	GetTrash(ctx context.Context, in *GetTrashReq, opts ...grpc.CallOption) (*GetTrashRes, error)
+	RestoreFromTrash(ctx context.Context, in *RestoreFromTrashReq, opts ...grpc.CallOption) (*RestoreFromTrashRes, error)
+	EmptyTrash(ctx context.Context, in *EmptyTrashReq, opts ...grpc.CallOption) (*EmptyTrashRes, error)
+	UploadFile(ctx context.Context, opts ...grpc.CallOption) (VaultService_UploadFileClient, error)
+	DownloadFile(ctx context.Context, in *DownloadFileReq, opts ...grpc.CallOption) (VaultService_DownloadFileClient, error)
+	GetUsage(ctx context.Context, in *GetUsageReq, opts ...grpc.CallOption) (*GetUsageRes, error)
+	CreateFolder(ctx context.Context, in *CreateFolderReq, opts ...grpc.CallOption) (*CreateFolderRes, error)
+	ListFolders(ctx context.Context, in *ListFoldersReq, opts ...grpc.CallOption) (*ListFoldersRes, error)
+	DeleteFolder(ctx context.Context, in *DeleteFolderReq, opts ...grpc.CallOption) (*DeleteFolderRes, error)
+	MoveItem(ctx context.Context, in *MoveItemReq, opts ...grpc.CallOption) (*MoveItemRes, error)
+	TagItem(ctx context.Context, in *TagItemReq, opts ...grpc.CallOption) (*TagItemRes, error)
+	UntagItem(ctx context.Context, in *UntagItemReq, opts ...grpc.CallOption) (*UntagItemRes, error)
+	ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*ListTagsRes, error)
+	SetFavorite(ctx context.Context, in *SetFavoriteReq, opts ...grpc.CallOption) (*SetFavoriteRes, error)
+	ShareItem(ctx context.Context, in *ShareItemReq, opts ...grpc.CallOption) (*ShareItemRes, error)
+	RevokeShare(ctx context.Context, in *RevokeShareReq, opts ...grpc.CallOption) (*RevokeShareRes, error)
+	ListShared(ctx context.Context, in *ListSharedReq, opts ...grpc.CallOption) (*ListSharedRes, error)
+	ExportVault(ctx context.Context, in *ExportVaultReq, opts ...grpc.CallOption) (VaultService_ExportVaultClient, error)
+	ImportVault(ctx context.Context, opts ...grpc.CallOption) (VaultService_ImportVaultClient, error)
 }
 
 type vaultServiceClient struct {
@@ -95,6 +143,342 @@ func (c *vaultServiceClient) GetAllByType(ctx context.Context, in *GetAllByTypeR
 	return out, nil
 }
 
+func (c *vaultServiceClient) ListItems(ctx context.Context, in *ListItemsReq, opts ...grpc.CallOption) (*ListItemsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListItemsRes)
+	err := c.cc.Invoke(ctx, VaultService_ListItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) SearchItems(ctx context.Context, in *SearchItemsReq, opts ...grpc.CallOption) (*SearchItemsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchItemsRes)
+	err := c.cc.Invoke(ctx, VaultService_SearchItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetDataHistory(ctx context.Context, in *GetDataHistoryReq, opts ...grpc.CallOption) (*GetDataHistoryRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataHistoryRes)
+	err := c.cc.Invoke(ctx, VaultService_GetDataHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetDataRevision(ctx context.Context, in *GetDataRevisionReq, opts ...grpc.CallOption) (*GetDataRevisionRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataRevisionRes)
+	err := c.cc.Invoke(ctx, VaultService_GetDataRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) RestoreData(ctx context.Context, in *RestoreDataReq, opts ...grpc.CallOption) (*RestoreDataRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreDataRes)
+	err := c.cc.Invoke(ctx, VaultService_RestoreData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetTrash(ctx context.Context, in *GetTrashReq, opts ...grpc.CallOption) (*GetTrashRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrashRes)
+	err := c.cc.Invoke(ctx, VaultService_GetTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) RestoreFromTrash(ctx context.Context, in *RestoreFromTrashReq, opts ...grpc.CallOption) (*RestoreFromTrashRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreFromTrashRes)
+	err := c.cc.Invoke(ctx, VaultService_RestoreFromTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) EmptyTrash(ctx context.Context, in *EmptyTrashReq, opts ...grpc.CallOption) (*EmptyTrashRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyTrashRes)
+	err := c.cc.Invoke(ctx, VaultService_EmptyTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) UploadFile(ctx context.Context, opts ...grpc.CallOption) (VaultService_UploadFileClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VaultService_ServiceDesc.Streams[0], VaultService_UploadFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vaultServiceUploadFileClient{ClientStream: stream}
+	return x, nil
+}
+
+type VaultService_UploadFileClient interface {
+	Send(*UploadFileReq) error
+	CloseAndRecv() (*UploadFileRes, error)
+	grpc.ClientStream
+}
+
+type vaultServiceUploadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *vaultServiceUploadFileClient) Send(m *UploadFileReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *vaultServiceUploadFileClient) CloseAndRecv() (*UploadFileRes, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadFileRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vaultServiceClient) DownloadFile(ctx context.Context, in *DownloadFileReq, opts ...grpc.CallOption) (VaultService_DownloadFileClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VaultService_ServiceDesc.Streams[1], VaultService_DownloadFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vaultServiceDownloadFileClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VaultService_DownloadFileClient interface {
+	Recv() (*DownloadFileRes, error)
+	grpc.ClientStream
+}
+
+type vaultServiceDownloadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *vaultServiceDownloadFileClient) Recv() (*DownloadFileRes, error) {
+	m := new(DownloadFileRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vaultServiceClient) GetUsage(ctx context.Context, in *GetUsageReq, opts ...grpc.CallOption) (*GetUsageRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageRes)
+	err := c.cc.Invoke(ctx, VaultService_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) CreateFolder(ctx context.Context, in *CreateFolderReq, opts ...grpc.CallOption) (*CreateFolderRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFolderRes)
+	err := c.cc.Invoke(ctx, VaultService_CreateFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ListFolders(ctx context.Context, in *ListFoldersReq, opts ...grpc.CallOption) (*ListFoldersRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFoldersRes)
+	err := c.cc.Invoke(ctx, VaultService_ListFolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) DeleteFolder(ctx context.Context, in *DeleteFolderReq, opts ...grpc.CallOption) (*DeleteFolderRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFolderRes)
+	err := c.cc.Invoke(ctx, VaultService_DeleteFolder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) MoveItem(ctx context.Context, in *MoveItemReq, opts ...grpc.CallOption) (*MoveItemRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveItemRes)
+	err := c.cc.Invoke(ctx, VaultService_MoveItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) TagItem(ctx context.Context, in *TagItemReq, opts ...grpc.CallOption) (*TagItemRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagItemRes)
+	err := c.cc.Invoke(ctx, VaultService_TagItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) UntagItem(ctx context.Context, in *UntagItemReq, opts ...grpc.CallOption) (*UntagItemRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UntagItemRes)
+	err := c.cc.Invoke(ctx, VaultService_UntagItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*ListTagsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsRes)
+	err := c.cc.Invoke(ctx, VaultService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) SetFavorite(ctx context.Context, in *SetFavoriteReq, opts ...grpc.CallOption) (*SetFavoriteRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFavoriteRes)
+	err := c.cc.Invoke(ctx, VaultService_SetFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ShareItem(ctx context.Context, in *ShareItemReq, opts ...grpc.CallOption) (*ShareItemRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareItemRes)
+	err := c.cc.Invoke(ctx, VaultService_ShareItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) RevokeShare(ctx context.Context, in *RevokeShareReq, opts ...grpc.CallOption) (*RevokeShareRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeShareRes)
+	err := c.cc.Invoke(ctx, VaultService_RevokeShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ListShared(ctx context.Context, in *ListSharedReq, opts ...grpc.CallOption) (*ListSharedRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSharedRes)
+	err := c.cc.Invoke(ctx, VaultService_ListShared_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ExportVault(ctx context.Context, in *ExportVaultReq, opts ...grpc.CallOption) (VaultService_ExportVaultClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VaultService_ServiceDesc.Streams[2], VaultService_ExportVault_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vaultServiceExportVaultClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VaultService_ExportVaultClient interface {
+	Recv() (*ExportVaultRes, error)
+	grpc.ClientStream
+}
+
+type vaultServiceExportVaultClient struct {
+	grpc.ClientStream
+}
+
+func (x *vaultServiceExportVaultClient) Recv() (*ExportVaultRes, error) {
+	m := new(ExportVaultRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vaultServiceClient) ImportVault(ctx context.Context, opts ...grpc.CallOption) (VaultService_ImportVaultClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VaultService_ServiceDesc.Streams[3], VaultService_ImportVault_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vaultServiceImportVaultClient{ClientStream: stream}
+	return x, nil
+}
+
+type VaultService_ImportVaultClient interface {
+	Send(*ImportVaultReq) error
+	CloseAndRecv() (*ImportVaultRes, error)
+	grpc.ClientStream
+}
+
+type vaultServiceImportVaultClient struct {
+	grpc.ClientStream
+}
+
+func (x *vaultServiceImportVaultClient) Send(m *ImportVaultReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *vaultServiceImportVaultClient) CloseAndRecv() (*ImportVaultRes, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportVaultRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // VaultServiceServer is the server API for VaultService service.
 // All implementations must embed UnimplementedVaultServiceServer
 // for forward compatibility.
@@ -104,6 +488,30 @@ type VaultServiceServer interface {
 	DeleteData(context.Context, *DeleteDataReq) (*DeleteDataRes, error)
 	UpdateData(context.Context, *UpdateDataReq) (*UpdateDataRes, error)
 	GetAllByType(context.Context, *GetAllByTypeReq) (*GetAllByTypeRes, error)
+	ListItems(context.Context, *ListItemsReq) (*ListItemsRes, error)
+	SearchItems(context.Context, *SearchItemsReq) (*SearchItemsRes, error)
+	GetDataHistory(context.Context, *GetDataHistoryReq) (*GetDataHistoryRes, error)
+	GetDataRevision(context.Context, *GetDataRevisionReq) (*GetDataRevisionRes, error)
+	RestoreData(context.Context, *RestoreDataReq) (*RestoreDataRes, error)
+	GetTrash(context.Context, *GetTrashReq) (*GetTrashRes, error)
+	RestoreFromTrash(context.Context, *RestoreFromTrashReq) (*RestoreFromTrashRes, error)
+	EmptyTrash(context.Context, *EmptyTrashReq) (*EmptyTrashRes, error)
+	UploadFile(VaultService_UploadFileServer) error
+	DownloadFile(*DownloadFileReq, VaultService_DownloadFileServer) error
+	GetUsage(context.Context, *GetUsageReq) (*GetUsageRes, error)
+	CreateFolder(context.Context, *CreateFolderReq) (*CreateFolderRes, error)
+	ListFolders(context.Context, *ListFoldersReq) (*ListFoldersRes, error)
+	DeleteFolder(context.Context, *DeleteFolderReq) (*DeleteFolderRes, error)
+	MoveItem(context.Context, *MoveItemReq) (*MoveItemRes, error)
+	TagItem(context.Context, *TagItemReq) (*TagItemRes, error)
+	UntagItem(context.Context, *UntagItemReq) (*UntagItemRes, error)
+	ListTags(context.Context, *ListTagsReq) (*ListTagsRes, error)
+	SetFavorite(context.Context, *SetFavoriteReq) (*SetFavoriteRes, error)
+	ShareItem(context.Context, *ShareItemReq) (*ShareItemRes, error)
+	RevokeShare(context.Context, *RevokeShareReq) (*RevokeShareRes, error)
+	ListShared(context.Context, *ListSharedReq) (*ListSharedRes, error)
+	ExportVault(*ExportVaultReq, VaultService_ExportVaultServer) error
+	ImportVault(VaultService_ImportVaultServer) error
 	mustEmbedUnimplementedVaultServiceServer()
 }
 
@@ -129,6 +537,78 @@ func (UnimplementedVaultServiceServer) UpdateData(context.Context, *UpdateDataRe
 func (UnimplementedVaultServiceServer) GetAllByType(context.Context, *GetAllByTypeReq) (*GetAllByTypeRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllByType not implemented")
 }
+func (UnimplementedVaultServiceServer) ListItems(context.Context, *ListItemsReq) (*ListItemsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
+}
+func (UnimplementedVaultServiceServer) SearchItems(context.Context, *SearchItemsReq) (*SearchItemsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchItems not implemented")
+}
+func (UnimplementedVaultServiceServer) GetDataHistory(context.Context, *GetDataHistoryReq) (*GetDataHistoryRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistory not implemented")
+}
+func (UnimplementedVaultServiceServer) GetDataRevision(context.Context, *GetDataRevisionReq) (*GetDataRevisionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataRevision not implemented")
+}
+func (UnimplementedVaultServiceServer) RestoreData(context.Context, *RestoreDataReq) (*RestoreDataRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreData not implemented")
+}
+func (UnimplementedVaultServiceServer) GetTrash(context.Context, *GetTrashReq) (*GetTrashRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrash not implemented")
+}
+func (UnimplementedVaultServiceServer) RestoreFromTrash(context.Context, *RestoreFromTrashReq) (*RestoreFromTrashRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreFromTrash not implemented")
+}
+func (UnimplementedVaultServiceServer) EmptyTrash(context.Context, *EmptyTrashReq) (*EmptyTrashRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmptyTrash not implemented")
+}
+func (UnimplementedVaultServiceServer) UploadFile(VaultService_UploadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (UnimplementedVaultServiceServer) DownloadFile(*DownloadFileReq, VaultService_DownloadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+}
+func (UnimplementedVaultServiceServer) GetUsage(context.Context, *GetUsageReq) (*GetUsageRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedVaultServiceServer) CreateFolder(context.Context, *CreateFolderReq) (*CreateFolderRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedVaultServiceServer) ListFolders(context.Context, *ListFoldersReq) (*ListFoldersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFolders not implemented")
+}
+func (UnimplementedVaultServiceServer) DeleteFolder(context.Context, *DeleteFolderReq) (*DeleteFolderRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFolder not implemented")
+}
+func (UnimplementedVaultServiceServer) MoveItem(context.Context, *MoveItemReq) (*MoveItemRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveItem not implemented")
+}
+func (UnimplementedVaultServiceServer) TagItem(context.Context, *TagItemReq) (*TagItemRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagItem not implemented")
+}
+func (UnimplementedVaultServiceServer) UntagItem(context.Context, *UntagItemReq) (*UntagItemRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UntagItem not implemented")
+}
+func (UnimplementedVaultServiceServer) ListTags(context.Context, *ListTagsReq) (*ListTagsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedVaultServiceServer) SetFavorite(context.Context, *SetFavoriteReq) (*SetFavoriteRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFavorite not implemented")
+}
+func (UnimplementedVaultServiceServer) ShareItem(context.Context, *ShareItemReq) (*ShareItemRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareItem not implemented")
+}
+func (UnimplementedVaultServiceServer) RevokeShare(context.Context, *RevokeShareReq) (*RevokeShareRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShare not implemented")
+}
+func (UnimplementedVaultServiceServer) ListShared(context.Context, *ListSharedReq) (*ListSharedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShared not implemented")
+}
+func (UnimplementedVaultServiceServer) ExportVault(*ExportVaultReq, VaultService_ExportVaultServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportVault not implemented")
+}
+func (UnimplementedVaultServiceServer) ImportVault(VaultService_ImportVaultServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportVault not implemented")
+}
 func (UnimplementedVaultServiceServer) mustEmbedUnimplementedVaultServiceServer() {}
 func (UnimplementedVaultServiceServer) testEmbeddedByValue()                      {}
 
@@ -240,26 +720,480 @@ func _VaultService_GetAllByType_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-// VaultService_ServiceDesc is the grpc.ServiceDesc for VaultService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var VaultService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "VaultService",
-	HandlerType: (*VaultServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddData",
-			Handler:    _VaultService_AddData_Handler,
-		},
-		{
-			MethodName: "GetData",
-			Handler:    _VaultService_GetData_Handler,
-		},
-		{
-			MethodName: "DeleteData",
-			Handler:    _VaultService_DeleteData_Handler,
-		},
-		{
+func _VaultService_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListItems(ctx, req.(*ListItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_SearchItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SearchItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SearchItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SearchItems(ctx, req.(*SearchItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetDataHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetDataHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetDataHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetDataHistory(ctx, req.(*GetDataHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetDataRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetDataRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetDataRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetDataRevision(ctx, req.(*GetDataRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_RestoreData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).RestoreData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_RestoreData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).RestoreData(ctx, req.(*RestoreDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrashReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetTrash(ctx, req.(*GetTrashReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_RestoreFromTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreFromTrashReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).RestoreFromTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_RestoreFromTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).RestoreFromTrash(ctx, req.(*RestoreFromTrashReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_EmptyTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyTrashReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).EmptyTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_EmptyTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).EmptyTrash(ctx, req.(*EmptyTrashReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_UploadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VaultServiceServer).UploadFile(&vaultServiceUploadFileServer{ServerStream: stream})
+}
+
+type VaultService_UploadFileServer interface {
+	SendAndClose(*UploadFileRes) error
+	Recv() (*UploadFileReq, error)
+	grpc.ServerStream
+}
+
+type vaultServiceUploadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *vaultServiceUploadFileServer) SendAndClose(m *UploadFileRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *vaultServiceUploadFileServer) Recv() (*UploadFileReq, error) {
+	m := new(UploadFileReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _VaultService_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadFileReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VaultServiceServer).DownloadFile(m, &vaultServiceDownloadFileServer{ServerStream: stream})
+}
+
+type VaultService_DownloadFileServer interface {
+	Send(*DownloadFileRes) error
+	grpc.ServerStream
+}
+
+type vaultServiceDownloadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *vaultServiceDownloadFileServer) Send(m *DownloadFileRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VaultService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetUsage(ctx, req.(*GetUsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_CreateFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).CreateFolder(ctx, req.(*CreateFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ListFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFoldersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListFolders(ctx, req.(*ListFoldersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).DeleteFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_DeleteFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).DeleteFolder(ctx, req.(*DeleteFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_MoveItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).MoveItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_MoveItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).MoveItem(ctx, req.(*MoveItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_TagItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).TagItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_TagItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).TagItem(ctx, req.(*TagItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_UntagItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UntagItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).UntagItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_UntagItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).UntagItem(ctx, req.(*UntagItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListTags(ctx, req.(*ListTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_SetFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFavoriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SetFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SetFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SetFavorite(ctx, req.(*SetFavoriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ShareItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ShareItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ShareItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ShareItem(ctx, req.(*ShareItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_RevokeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).RevokeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_RevokeShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).RevokeShare(ctx, req.(*RevokeShareReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ListShared_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListShared(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListShared_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListShared(ctx, req.(*ListSharedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ExportVault_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportVaultReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VaultServiceServer).ExportVault(m, &vaultServiceExportVaultServer{ServerStream: stream})
+}
+
+type VaultService_ExportVaultServer interface {
+	Send(*ExportVaultRes) error
+	grpc.ServerStream
+}
+
+type vaultServiceExportVaultServer struct {
+	grpc.ServerStream
+}
+
+func (x *vaultServiceExportVaultServer) Send(m *ExportVaultRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VaultService_ImportVault_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VaultServiceServer).ImportVault(&vaultServiceImportVaultServer{ServerStream: stream})
+}
+
+type VaultService_ImportVaultServer interface {
+	SendAndClose(*ImportVaultRes) error
+	Recv() (*ImportVaultReq, error)
+	grpc.ServerStream
+}
+
+type vaultServiceImportVaultServer struct {
+	grpc.ServerStream
+}
+
+func (x *vaultServiceImportVaultServer) SendAndClose(m *ImportVaultRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *vaultServiceImportVaultServer) Recv() (*ImportVaultReq, error) {
+	m := new(ImportVaultReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// VaultService_ServiceDesc is the grpc.ServiceDesc for VaultService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VaultService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "VaultService",
+	HandlerType: (*VaultServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddData",
+			Handler:    _VaultService_AddData_Handler,
+		},
+		{
+			MethodName: "GetData",
+			Handler:    _VaultService_GetData_Handler,
+		},
+		{
+			MethodName: "DeleteData",
+			Handler:    _VaultService_DeleteData_Handler,
+		},
+		{
 			MethodName: "UpdateData",
 			Handler:    _VaultService_UpdateData_Handler,
 		},
@@ -267,7 +1201,108 @@ var VaultService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllByType",
 			Handler:    _VaultService_GetAllByType_Handler,
 		},
+		{
+			MethodName: "ListItems",
+			Handler:    _VaultService_ListItems_Handler,
+		},
+		{
+			MethodName: "SearchItems",
+			Handler:    _VaultService_SearchItems_Handler,
+		},
+		{
+			MethodName: "GetDataHistory",
+			Handler:    _VaultService_GetDataHistory_Handler,
+		},
+		{
+			MethodName: "GetDataRevision",
+			Handler:    _VaultService_GetDataRevision_Handler,
+		},
+		{
+			MethodName: "RestoreData",
+			Handler:    _VaultService_RestoreData_Handler,
+		},
+		{
+			MethodName: "GetTrash",
+			Handler:    _VaultService_GetTrash_Handler,
+		},
+		{
+			MethodName: "RestoreFromTrash",
+			Handler:    _VaultService_RestoreFromTrash_Handler,
+		},
+		{
+			MethodName: "EmptyTrash",
+			Handler:    _VaultService_EmptyTrash_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _VaultService_GetUsage_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _VaultService_CreateFolder_Handler,
+		},
+		{
+			MethodName: "ListFolders",
+			Handler:    _VaultService_ListFolders_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _VaultService_DeleteFolder_Handler,
+		},
+		{
+			MethodName: "MoveItem",
+			Handler:    _VaultService_MoveItem_Handler,
+		},
+		{
+			MethodName: "TagItem",
+			Handler:    _VaultService_TagItem_Handler,
+		},
+		{
+			MethodName: "UntagItem",
+			Handler:    _VaultService_UntagItem_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _VaultService_ListTags_Handler,
+		},
+		{
+			MethodName: "SetFavorite",
+			Handler:    _VaultService_SetFavorite_Handler,
+		},
+		{
+			MethodName: "ShareItem",
+			Handler:    _VaultService_ShareItem_Handler,
+		},
+		{
+			MethodName: "RevokeShare",
+			Handler:    _VaultService_RevokeShare_Handler,
+		},
+		{
+			MethodName: "ListShared",
+			Handler:    _VaultService_ListShared_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadFile",
+			Handler:       _VaultService_UploadFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadFile",
+			Handler:       _VaultService_DownloadFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportVault",
+			Handler:       _VaultService_ExportVault_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportVault",
+			Handler:       _VaultService_ImportVault_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/proto/vault.proto",
 }
@@ -0,0 +1,190 @@
+package backup
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+)
+
+// Архив начинается с сигнатуры, за которой следуют кадры: признак последнего кадра (1 байт),
+// длина зашифрованного блока (4 байта) и сам блок - nonce и шифротекст AES-256-GCM. В дополнительные
+// данные блока входят его номер и признак последнего кадра, поэтому перестановка, удаление
+// или обрезка кадров обнаруживаются при чтении.
+const (
+	signature = "GKBACKUP\x01"
+	frameSize = 1 << 20 // Максимальный размер незашифрованных данных кадра.
+	frameHead = 5
+)
+
+// Ошибки чтения архива.
+var (
+	ErrInvalidArchive = errors.New("file is not a backup archive")
+	ErrCorrupted      = errors.New("backup archive is corrupted or the key is wrong")
+	ErrTruncated      = errors.New("backup archive is truncated")
+)
+
+// newCipher создает шифр AES-GCM по ключу в hex формате.
+func newCipher(key string) (cipher.AEAD, error) {
+	keyB, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup key: %w", err)
+	}
+	if len(keyB) != 2*aes.BlockSize {
+		return nil, errors.New("invalid backup key: key must be 32 bytes long")
+	}
+	block, err := aes.NewCipher(keyB)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup key: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// frameAD возвращает дополнительные данные кадра.
+func frameAD(index uint64, last bool) []byte {
+	ad := binary.BigEndian.AppendUint64([]byte(signature), index)
+	if last {
+		return append(ad, 1)
+	}
+	return append(ad, 0)
+}
+
+// encryptWriter шифрует записываемые данные кадрами.
+type encryptWriter struct {
+	w     io.Writer
+	aead  cipher.AEAD
+	buf   []byte
+	index uint64
+}
+
+// newEncryptWriter записывает сигнатуру архива и возвращает writer, шифрующий данные ключом key.
+// Последний кадр записывается при вызове Close.
+func newEncryptWriter(w io.Writer, key string) (*encryptWriter, error) {
+	aead, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.WriteString(w, signature); err != nil {
+		return nil, err
+	}
+	return &encryptWriter{w: w, aead: aead}, nil
+}
+
+// Write шифрует и записывает заполненные кадры, остаток данных сохраняется до следующей записи.
+func (e *encryptWriter) Write(p []byte) (int, error) {
+	e.buf = append(e.buf, p...)
+	for len(e.buf) > frameSize {
+		if err := e.writeFrame(e.buf[:frameSize], false); err != nil {
+			return 0, err
+		}
+		e.buf = e.buf[frameSize:]
+	}
+	return len(p), nil
+}
+
+// Close записывает последний кадр.
+func (e *encryptWriter) Close() error {
+	return e.writeFrame(e.buf, true)
+}
+
+// writeFrame шифрует и записывает кадр.
+func (e *encryptWriter) writeFrame(data []byte, last bool) error {
+	nonce, err := utils.GenerateRandomBytes(e.aead.NonceSize())
+	if err != nil {
+		return err
+	}
+	sealed := e.aead.Seal(nonce, nonce, data, frameAD(e.index, last))
+	head := make([]byte, frameHead)
+	if last {
+		head[0] = 1
+	}
+	binary.BigEndian.PutUint32(head[1:], uint32(len(sealed)))
+	if _, err = e.w.Write(append(head, sealed...)); err != nil {
+		return err
+	}
+	e.index++
+	return nil
+}
+
+// decryptReader читает и расшифровывает кадры архива.
+type decryptReader struct {
+	r     *bufio.Reader
+	aead  cipher.AEAD
+	buf   []byte
+	index uint64
+	done  bool
+}
+
+// newDecryptReader проверяет сигнатуру архива и возвращает reader, расшифровывающий данные ключом key.
+func newDecryptReader(r io.Reader, key string) (*decryptReader, error) {
+	aead, err := newCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(r)
+	sig := make([]byte, len(signature))
+	if _, err = io.ReadFull(br, sig); err != nil || string(sig) != signature {
+		return nil, ErrInvalidArchive
+	}
+	return &decryptReader{r: br, aead: aead}, nil
+}
+
+// Read возвращает расшифрованные данные. io.EOF возвращается только после последнего кадра,
+// за которым нет других данных.
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.readFrame(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+// readFrame читает и расшифровывает следующий кадр.
+func (d *decryptReader) readFrame() error {
+	head := make([]byte, frameHead)
+	if _, err := io.ReadFull(d.r, head); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return ErrTruncated
+		}
+		return err
+	}
+	last := head[0] == 1
+	size := binary.BigEndian.Uint32(head[1:])
+	if head[0] > 1 || size < uint32(d.aead.NonceSize()+d.aead.Overhead()) ||
+		size > uint32(frameSize+d.aead.NonceSize()+d.aead.Overhead()) {
+		return ErrCorrupted
+	}
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(d.r, sealed); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return ErrTruncated
+		}
+		return err
+	}
+	nonce, ciphertext := sealed[:d.aead.NonceSize()], sealed[d.aead.NonceSize():]
+	data, err := d.aead.Open(nil, nonce, ciphertext, frameAD(d.index, last))
+	if err != nil {
+		return ErrCorrupted
+	}
+	if last {
+		if _, err = d.r.ReadByte(); !errors.Is(err, io.EOF) {
+			return ErrCorrupted
+		}
+		d.done = true
+	}
+	d.buf = data
+	d.index++
+	return nil
+}
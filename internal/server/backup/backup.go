@@ -0,0 +1,942 @@
+// Package backup содержит создание и восстановление резервной копии сервера - сжатого архива,
+// зашифрованного отдельным ключом резервного копирования (не мастер ключом).
+// В копию входят пользователи, организации с участниками, папки и метки, все данные (включая данные
+// в корзине) с предыдущими версиями, признаком избранного и статистикой получений, доступы к данным,
+// журналы аудита и блоки файлов. Копия создается через storage.Storage и загружается методами
+// storage.BackupStorage с исходными id, номерами версий и временем, поэтому переносится между любыми
+// хранилищами, а журналы аудита после восстановления проверяются тем же ключом журнала.
+//
+// Данные и ключи пользователей остаются зашифрованными так же, как в хранилище, поэтому сервер,
+// на который восстанавливается копия, должен использовать тот же мастер ключ.
+package backup
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// version версия формата записей резервной копии.
+const version = 2
+
+// auditPage количество событий журнала аудита, читаемых из хранилища за один запрос.
+const auditPage = 1000
+
+// ErrNotEmpty хранилище, в которое восстанавливается копия, содержит пользователей.
+var ErrNotEmpty = errors.New("storage is not empty")
+
+// Summary описывает количество объектов в резервной копии.
+type Summary struct {
+	Users       int64 `json:"users"`
+	Orgs        int64 `json:"orgs"`
+	Folders     int64 `json:"folders"`
+	Tags        int64 `json:"tags"`
+	Items       int64 `json:"items"`
+	Revisions   int64 `json:"revisions"`
+	Shares      int64 `json:"shares"`
+	AuditEvents int64 `json:"auditEvents"`
+	Chunks      int64 `json:"chunks"`
+}
+
+// record описывает одну запись архива; заполнено ровно одно поле.
+// Первая запись - заголовок, последняя - итоговое количество объектов.
+type record struct {
+	Header    *header          `json:"header,omitempty"`
+	User      *userRecord      `json:"user,omitempty"`
+	Org       *orgRecord       `json:"org,omitempty"`
+	Chunk     *chunkRecord     `json:"chunk,omitempty"`
+	Folder    *folderRecord    `json:"folder,omitempty"`
+	Tag       *tagRecord       `json:"tag,omitempty"`
+	Item      *itemRecord      `json:"item,omitempty"`
+	Share     *shareRecord     `json:"share,omitempty"`
+	Audit     *auditRecord     `json:"audit,omitempty"`
+	AuditHead *auditHeadRecord `json:"auditHead,omitempty"`
+	End       *Summary         `json:"end,omitempty"`
+}
+
+// header описывает заголовок архива.
+type header struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// userRecord описывает пользователя.
+type userRecord struct {
+	ID                string `json:"id"`
+	Login             string `json:"login"`
+	PasswordHash      string `json:"passwordHash"`
+	EncryptedSecret   string `json:"encryptedSecret"`
+	PublicKey         []byte `json:"publicKey,omitempty"`
+	EncryptPrivateKey []byte `json:"encryptPrivateKey,omitempty"`
+}
+
+// orgRecord описывает организацию с ключами ее аккаунта и участниками.
+type orgRecord struct {
+	ID                string         `json:"id"`
+	EncryptName       []byte         `json:"encryptName"`
+	EncryptedSecret   string         `json:"encryptedSecret"`
+	PublicKey         []byte         `json:"publicKey,omitempty"`
+	EncryptPrivateKey []byte         `json:"encryptPrivateKey,omitempty"`
+	CreatedAt         time.Time      `json:"createdAt"`
+	Members           []memberRecord `json:"members"`
+}
+
+// memberRecord описывает участника организации.
+type memberRecord struct {
+	UserID     string        `json:"userId"`
+	Role       model.OrgRole `json:"role"`
+	EncryptKey []byte        `json:"encryptKey"`
+	CreatedAt  time.Time     `json:"createdAt"`
+}
+
+// chunkRecord описывает блок файла пользователя или организации.
+type chunkRecord struct {
+	UserID string `json:"userId"`
+	Key    string `json:"key"`
+	Data   []byte `json:"data"`
+}
+
+// folderRecord описывает папку пользователя или организации.
+type folderRecord struct {
+	ID          string    `json:"id"`
+	UserID      string    `json:"userId"`
+	ParentID    string    `json:"parentId,omitempty"`
+	EncryptName []byte    `json:"encryptName"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// tagRecord описывает метку данных пользователя или организации.
+type tagRecord struct {
+	ID          string `json:"id"`
+	UserID      string `json:"userId"`
+	EncryptName []byte `json:"encryptName"`
+	NameIndex   string `json:"nameIndex"`
+}
+
+// shareRecord описывает доступ к данным, предоставленный другому пользователю.
+type shareRecord struct {
+	ItemID      string            `json:"itemId"`
+	OwnerID     string            `json:"ownerId"`
+	RecipientID string            `json:"recipientId"`
+	Access      model.ShareAccess `json:"access"`
+	EncryptKey  []byte            `json:"encryptKey"`
+	CreatedAt   time.Time         `json:"createdAt"`
+}
+
+// itemRecord описывает данные пользователя или организации с предыдущими версиями.
+type itemRecord struct {
+	ID          string         `json:"id"`
+	UserID      string         `json:"userId"`
+	Type        model.DataType `json:"type"`
+	EncryptData []byte         `json:"encryptData"`
+	EncryptMeta []byte         `json:"encryptMeta,omitempty"`
+	MetaIndex   []string       `json:"metaIndex,omitempty"`
+	Meta        string         `json:"meta,omitempty"`
+	Revision    int64          `json:"revision"`
+	Chunked     bool           `json:"chunked,omitempty"`
+	Size        int64          `json:"size"`
+	ParentID    string         `json:"parentId,omitempty"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	UpdatedAt   time.Time      `json:"updatedAt"`
+	DeletedAt   *time.Time     `json:"deletedAt,omitempty"`
+	ExpiresAt   *time.Time     `json:"expiresAt,omitempty"`
+	EncryptKey  []byte         `json:"encryptKey,omitempty"`
+	SharedMeta  []byte         `json:"sharedMeta,omitempty"`
+	FolderID    string         `json:"folderId,omitempty"`
+	TagIDs      []string       `json:"tagIds,omitempty"`
+	Favorite    bool           `json:"favorite,omitempty"`
+	// Время последнего получения данных, nil - данные не получались.
+	LastAccessedAt *time.Time       `json:"lastAccessedAt,omitempty"`
+	AccessCount    int64            `json:"accessCount,omitempty"`
+	History        []revisionRecord `json:"history,omitempty"`
+}
+
+// revisionRecord описывает предыдущую версию данных.
+type revisionRecord struct {
+	Revision    int64     `json:"revision"`
+	EncryptData []byte    `json:"encryptData"`
+	EncryptMeta []byte    `json:"encryptMeta,omitempty"`
+	MetaIndex   []string  `json:"metaIndex,omitempty"`
+	Meta        string    `json:"meta,omitempty"`
+	Chunked     bool      `json:"chunked,omitempty"`
+	Size        int64     `json:"size"`
+	SharedMeta  []byte    `json:"sharedMeta,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// auditRecord описывает событие журнала аудита пользователя или организации.
+type auditRecord struct {
+	UserID    string    `json:"userId"`
+	Seq       int64     `json:"seq"`
+	Action    string    `json:"action"`
+	ItemID    string    `json:"itemId,omitempty"`
+	Peer      string    `json:"peer"`
+	Code      string    `json:"code"`
+	CreatedAt time.Time `json:"createdAt"`
+	PrevHash  []byte    `json:"prevHash"`
+	Hash      []byte    `json:"hash"`
+}
+
+// auditHeadRecord описывает последнее событие журнала аудита; записывается после всех событий журнала.
+type auditHeadRecord struct {
+	UserID string `json:"userId"`
+	Seq    int64  `json:"seq"`
+	Tag    []byte `json:"tag"`
+}
+
+// Create записывает в w резервную копию хранилища s и хранилища блоков blobs, зашифрованную ключом key
+// (в hex формате). Мастер ключ сервера нужен для чтения манифестов файлов, по которым выбираются блоки.
+// Копия не является снимком на один момент времени, поэтому ее следует создавать при остановленном сервере.
+func Create(
+	ctx context.Context, s storage.Storage, blobs blob.Store, masterKey string, w io.Writer, key string,
+) (*Summary, error) {
+	enc, err := newEncryptWriter(w, key)
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(enc)
+	b := &builder{storage: s, blobs: blobs, masterKey: masterKey, enc: json.NewEncoder(gz), start: time.Now()}
+	if err = b.build(ctx); err != nil {
+		return nil, err
+	}
+	if err = gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress backup: %w", err)
+	}
+	if err = enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write backup: %w", err)
+	}
+	return &b.summary, nil
+}
+
+// builder записывает объекты хранилища в архив.
+type builder struct {
+	storage   storage.Storage
+	blobs     blob.Store
+	masterKey string
+	enc       *json.Encoder
+	start     time.Time
+	summary   Summary
+}
+
+// build записывает заголовок, пользователей, организации, блоки файлов, папки, метки, данные, доступы
+// к данным и журнал аудита каждого аккаунта и итоговое количество объектов.
+func (b *builder) build(ctx context.Context) error {
+	if err := b.write(&record{Header: &header{Version: version, CreatedAt: b.start}}); err != nil {
+		return err
+	}
+	users, err := b.storage.ListUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+	// Аккаунты организаций записываются после пользователей, которые в них участвуют.
+	var orgs []model.User
+	for _, user := range users {
+		if strings.HasPrefix(user.Login, model.OrgLoginPrefix) {
+			orgs = append(orgs, user)
+			continue
+		}
+		if err = b.write(&record{User: &userRecord{
+			ID:                user.ID,
+			Login:             user.Login,
+			PasswordHash:      user.PasswordHash,
+			EncryptedSecret:   user.EncryptedSecret,
+			PublicKey:         user.PublicKey,
+			EncryptPrivateKey: user.EncryptPrivateKey,
+		}}); err != nil {
+			return err
+		}
+		b.summary.Users++
+	}
+	if err = b.writeOrgs(ctx, orgs); err != nil {
+		return err
+	}
+	for _, account := range users {
+		if err = b.writeChunks(ctx, account); err != nil {
+			return err
+		}
+		if err = b.writeFolders(ctx, account.ID); err != nil {
+			return err
+		}
+		if err = b.writeTags(ctx, account.ID); err != nil {
+			return err
+		}
+		if err = b.writeItems(ctx, account.ID); err != nil {
+			return err
+		}
+		// Доступы записываются после данных владельца; получатели записаны раньше вместе со всеми пользователями.
+		if err = b.writeShares(ctx, account.ID); err != nil {
+			return err
+		}
+		if err = b.writeAudit(ctx, account.ID); err != nil {
+			return err
+		}
+	}
+	return b.write(&record{End: &b.summary})
+}
+
+// writeOrgs записывает организации по их аккаунтам.
+func (b *builder) writeOrgs(ctx context.Context, accounts []model.User) error {
+	orgs, err := b.storage.ListBackupOrgs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list organizations: %w", err)
+	}
+	orgsByID := make(map[string]model.Organization, len(orgs))
+	for _, org := range orgs {
+		orgsByID[org.ID] = org
+	}
+	for _, account := range accounts {
+		org, ok := orgsByID[account.ID]
+		if !ok {
+			return fmt.Errorf("organization %s not found", account.ID)
+		}
+		members, err := b.storage.ListOrgMembers(ctx, account.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list organization members: %w", err)
+		}
+		rec := &orgRecord{
+			ID:                account.ID,
+			EncryptName:       org.EncryptName,
+			EncryptedSecret:   account.EncryptedSecret,
+			PublicKey:         account.PublicKey,
+			EncryptPrivateKey: account.EncryptPrivateKey,
+			CreatedAt:         org.CreatedAt,
+		}
+		for _, member := range members {
+			rec.Members = append(rec.Members, memberRecord{
+				UserID:     member.UserID,
+				Role:       member.Role,
+				EncryptKey: member.EncryptKey,
+				CreatedAt:  member.CreatedAt,
+			})
+		}
+		if err = b.write(&record{Org: rec}); err != nil {
+			return err
+		}
+		b.summary.Orgs++
+	}
+	return nil
+}
+
+// writeChunks записывает блоки файлов, на которые ссылаются данные аккаунта, включая данные в корзине
+// и предыдущие версии. Блоки выбираются по манифестам файлов, а не по времени записи: время записи
+// существующего блока обновляется, когда тот же блок записывается снова в составе другого файла.
+func (b *builder) writeChunks(ctx context.Context, account model.User) error {
+	manifests, err := b.storage.ListChunkedData(ctx, account.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list chunked data: %w", err)
+	}
+	if len(manifests) == 0 {
+		return nil
+	}
+	secret, err := utils.DecryptUserSecret(account.EncryptedSecret, b.masterKey)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt secret of account %s: %w", account.ID, err)
+	}
+	written := make(map[string]bool)
+	for _, encManifest := range manifests {
+		manifest, err := blob.DecryptManifest(encManifest, secret)
+		if err != nil {
+			return fmt.Errorf("failed to read manifest of account %s: %w", account.ID, err)
+		}
+		for _, chunk := range manifest.Chunks {
+			if written[chunk.Key] {
+				continue
+			}
+			data, err := b.blobs.Get(ctx, account.ID, chunk.Key)
+			if err != nil {
+				return fmt.Errorf("failed to get chunk %s of account %s: %w", chunk.Key, account.ID, err)
+			}
+			if err = b.write(&record{Chunk: &chunkRecord{UserID: account.ID, Key: chunk.Key, Data: data}}); err != nil {
+				return err
+			}
+			written[chunk.Key] = true
+			b.summary.Chunks++
+		}
+	}
+	return nil
+}
+
+// writeFolders записывает папки аккаунта; родительская папка записывается раньше вложенных в нее.
+func (b *builder) writeFolders(ctx context.Context, userID string) error {
+	folders, err := b.storage.ListFolders(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to list folders: %w", err)
+	}
+	written := make(map[string]bool, len(folders))
+	for len(folders) > 0 {
+		var rest []model.Folder
+		for _, folder := range folders {
+			if folder.ParentID != "" && !written[folder.ParentID] {
+				rest = append(rest, folder)
+				continue
+			}
+			if err = b.write(&record{Folder: &folderRecord{
+				ID:          folder.ID,
+				UserID:      userID,
+				ParentID:    folder.ParentID,
+				EncryptName: folder.EncryptName,
+				CreatedAt:   folder.CreatedAt,
+			}}); err != nil {
+				return err
+			}
+			written[folder.ID] = true
+			b.summary.Folders++
+		}
+		if len(rest) == len(folders) {
+			return fmt.Errorf("folders of account %s have unknown parents", userID)
+		}
+		folders = rest
+	}
+	return nil
+}
+
+// writeTags записывает метки аккаунта, включая метки, которые есть только у данных в корзине.
+func (b *builder) writeTags(ctx context.Context, userID string) error {
+	tags, err := b.storage.ListTags(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to list tags: %w", err)
+	}
+	for _, tag := range tags {
+		if err = b.write(&record{Tag: &tagRecord{
+			ID:          tag.ID,
+			UserID:      userID,
+			EncryptName: tag.EncryptName,
+			NameIndex:   tag.NameIndex,
+		}}); err != nil {
+			return err
+		}
+		b.summary.Tags++
+	}
+	return nil
+}
+
+// writeItems записывает все данные аккаунта с предыдущими версиями, включая данные в корзине
+// и с истекшим сроком хранения; вложения записываются после всех остальных данных.
+func (b *builder) writeItems(ctx context.Context, userID string) error {
+	items, err := b.storage.ListBackupItems(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to list items: %w", err)
+	}
+	var attachments []model.VaultItem
+	for _, item := range items {
+		if item.ParentID != "" {
+			attachments = append(attachments, item)
+			continue
+		}
+		if err = b.writeItem(ctx, item); err != nil {
+			return err
+		}
+	}
+	for _, item := range attachments {
+		if err = b.writeItem(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeItem записывает данные аккаунта с предыдущими версиями.
+func (b *builder) writeItem(ctx context.Context, item model.VaultItem) error {
+	history, err := b.storage.ListBackupHistory(ctx, item.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get item history: %w", err)
+	}
+	rec := &itemRecord{
+		ID:             item.ID,
+		UserID:         item.UserID,
+		Type:           item.Type,
+		EncryptData:    item.EncryptData,
+		EncryptMeta:    item.EncryptMeta,
+		MetaIndex:      item.MetaIndex,
+		Meta:           item.Meta,
+		Revision:       item.Revision,
+		Chunked:        item.Chunked,
+		Size:           item.Size,
+		ParentID:       item.ParentID,
+		CreatedAt:      item.CreatedAt,
+		UpdatedAt:      item.UpdatedAt,
+		DeletedAt:      item.DeletedAt,
+		ExpiresAt:      item.ExpiresAt,
+		EncryptKey:     item.EncryptKey,
+		SharedMeta:     item.SharedMeta,
+		FolderID:       item.FolderID,
+		TagIDs:         item.TagIDs,
+		Favorite:       item.Favorite,
+		LastAccessedAt: item.LastAccessedAt,
+		AccessCount:    item.AccessCount,
+	}
+	for _, rev := range history {
+		rec.History = append(rec.History, revisionRecord{
+			Revision:    rev.Revision,
+			EncryptData: rev.EncryptData,
+			EncryptMeta: rev.EncryptMeta,
+			MetaIndex:   rev.MetaIndex,
+			Meta:        rev.Meta,
+			Chunked:     rev.Chunked,
+			Size:        rev.Size,
+			SharedMeta:  rev.SharedMeta,
+			CreatedAt:   rev.CreatedAt,
+		})
+	}
+	if err = b.write(&record{Item: rec}); err != nil {
+		return err
+	}
+	b.summary.Items++
+	b.summary.Revisions += int64(len(rec.History))
+	return nil
+}
+
+// writeShares записывает доступы к данным аккаунта, предоставленные другим пользователям,
+// включая доступы к данным в корзине.
+func (b *builder) writeShares(ctx context.Context, userID string) error {
+	shares, err := b.storage.ListBackupShares(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to list shares: %w", err)
+	}
+	for _, share := range shares {
+		if err = b.write(&record{Share: &shareRecord{
+			ItemID:      share.ItemID,
+			OwnerID:     share.OwnerID,
+			RecipientID: share.RecipientID,
+			Access:      share.Access,
+			EncryptKey:  share.EncryptKey,
+			CreatedAt:   share.CreatedAt,
+		}}); err != nil {
+			return err
+		}
+		b.summary.Shares++
+	}
+	return nil
+}
+
+// writeAudit записывает журнал аудита аккаунта и его последнее событие.
+func (b *builder) writeAudit(ctx context.Context, userID string) error {
+	params := model.ListAuditParams{Limit: auditPage}
+	for {
+		events, err := b.storage.ListAuditEvents(ctx, userID, params)
+		if err != nil {
+			return fmt.Errorf("failed to list audit events: %w", err)
+		}
+		for _, event := range events {
+			if err = b.write(&record{Audit: &auditRecord{
+				UserID:    event.UserID,
+				Seq:       event.Seq,
+				Action:    event.Action,
+				ItemID:    event.ItemID,
+				Peer:      event.Peer,
+				Code:      event.Code,
+				CreatedAt: event.CreatedAt,
+				PrevHash:  event.PrevHash,
+				Hash:      event.Hash,
+			}}); err != nil {
+				return err
+			}
+			b.summary.AuditEvents++
+			params.AfterSeq = event.Seq
+		}
+		if len(events) < auditPage {
+			break
+		}
+	}
+	head, err := b.storage.GetAuditHead(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get audit head: %w", err)
+	}
+	if head.Seq == 0 {
+		return nil
+	}
+	return b.write(&record{AuditHead: &auditHeadRecord{UserID: userID, Seq: head.Seq, Tag: head.Tag}})
+}
+
+// write записывает запись в архив.
+func (b *builder) write(rec *record) error {
+	if err := b.enc.Encode(rec); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	return nil
+}
+
+// Restore проверяет целостность резервной копии, зашифрованной ключом key, и загружает ее в пустое
+// хранилище s и хранилище блоков blobs. Данные загружаются в одной транзакции: если архив поврежден
+// или не может быть загружен, хранилище остается пустым (записанные блоки файлов удаляются сборщиком
+// неиспользуемых блоков). Все объекты сохраняют свои id, номера версий и время.
+func Restore(ctx context.Context, s storage.Storage, blobs blob.Store, r io.Reader, key string) (*Summary, error) {
+	dec, err := newDecryptReader(r, key)
+	if err != nil {
+		return nil, err
+	}
+	var summary *Summary
+	err = s.WithTx(ctx, func(tx storage.Storage) error {
+		users, err := tx.ListUsers(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list users: %w", err)
+		}
+		if len(users) > 0 {
+			return ErrNotEmpty
+		}
+		gz, err := gzip.NewReader(dec)
+		if err != nil {
+			return archiveError(err)
+		}
+		l := &loader{
+			storage:    tx,
+			blobs:      blobs,
+			dec:        json.NewDecoder(gz),
+			accounts:   make(map[string]bool),
+			folders:    make(map[string]string),
+			tags:       make(map[string]string),
+			items:      make(map[string]string),
+			auditSeq:   make(map[string]int64),
+			auditHeads: make(map[string]bool),
+		}
+		summary, err = l.load(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// loader загружает записи архива в хранилище и проверяет ссылки между ними.
+type loader struct {
+	storage    storage.Storage
+	blobs      blob.Store
+	dec        *json.Decoder
+	accounts   map[string]bool   // Загруженные пользователи и аккаунты организаций.
+	folders    map[string]string // Аккаунты загруженных папок по id папки.
+	tags       map[string]string // Аккаунты загруженных меток по id метки.
+	items      map[string]string // Аккаунты загруженных данных по id данных.
+	auditSeq   map[string]int64  // Номер последнего загруженного события журнала аудита по id аккаунта.
+	auditHeads map[string]bool   // Аккаунты, последнее событие журнала которых загружено.
+	summary    Summary
+}
+
+// load загружает записи архива и проверяет, что количество загруженных объектов совпадает с итоговым.
+func (l *loader) load(ctx context.Context) (*Summary, error) {
+	var head record
+	if err := l.dec.Decode(&head); err != nil {
+		return nil, archiveError(err)
+	}
+	if head.Header == nil {
+		return nil, fmt.Errorf("%w: missing header", ErrCorrupted)
+	}
+	if head.Header.Version != version {
+		return nil, fmt.Errorf("unsupported backup version %d", head.Header.Version)
+	}
+	for {
+		var rec record
+		if err := l.dec.Decode(&rec); err != nil {
+			return nil, archiveError(err)
+		}
+		var err error
+		switch {
+		case rec.User != nil:
+			err = l.loadUser(ctx, rec.User)
+		case rec.Org != nil:
+			err = l.loadOrg(ctx, rec.Org)
+		case rec.Chunk != nil:
+			err = l.loadChunk(ctx, rec.Chunk)
+		case rec.Folder != nil:
+			err = l.loadFolder(ctx, rec.Folder)
+		case rec.Tag != nil:
+			err = l.loadTag(ctx, rec.Tag)
+		case rec.Item != nil:
+			err = l.loadItem(ctx, rec.Item)
+		case rec.Share != nil:
+			err = l.loadShare(ctx, rec.Share)
+		case rec.Audit != nil:
+			err = l.loadAudit(ctx, rec.Audit)
+		case rec.AuditHead != nil:
+			err = l.loadAuditHead(ctx, rec.AuditHead)
+		case rec.End != nil:
+			if *rec.End != l.summary {
+				return nil, fmt.Errorf("%w: objects count mismatch", ErrCorrupted)
+			}
+			// Чтение до конца архива проверяет контрольную сумму сжатых данных и последний кадр.
+			if _, err = l.dec.Token(); !errors.Is(err, io.EOF) {
+				return nil, archiveError(err)
+			}
+			return &l.summary, nil
+		default:
+			err = fmt.Errorf("%w: unknown record", ErrCorrupted)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// loadUser сохраняет пользователя.
+func (l *loader) loadUser(ctx context.Context, rec *userRecord) error {
+	if rec.ID == "" || l.accounts[rec.ID] || strings.HasPrefix(rec.Login, model.OrgLoginPrefix) {
+		return fmt.Errorf("%w: invalid user %s", ErrCorrupted, rec.ID)
+	}
+	if err := l.storage.RestoreUser(ctx, &model.User{
+		ID:                rec.ID,
+		Login:             rec.Login,
+		PasswordHash:      rec.PasswordHash,
+		EncryptedSecret:   rec.EncryptedSecret,
+		PublicKey:         rec.PublicKey,
+		EncryptPrivateKey: rec.EncryptPrivateKey,
+	}); err != nil {
+		return fmt.Errorf("failed to restore user %s: %w", rec.Login, err)
+	}
+	l.accounts[rec.ID] = true
+	l.summary.Users++
+	return nil
+}
+
+// loadOrg сохраняет аккаунт организации, саму организацию и ее участников.
+func (l *loader) loadOrg(ctx context.Context, rec *orgRecord) error {
+	if rec.ID == "" || l.accounts[rec.ID] {
+		return fmt.Errorf("%w: invalid organization %s", ErrCorrupted, rec.ID)
+	}
+	hasOwner := false
+	for _, member := range rec.Members {
+		if !l.accounts[member.UserID] || !member.Role.Valid() {
+			return fmt.Errorf("%w: invalid member of organization %s", ErrCorrupted, rec.ID)
+		}
+		hasOwner = hasOwner || member.Role == model.OrgRoleOwner
+	}
+	if !hasOwner {
+		return fmt.Errorf("%w: organization %s has no owner", ErrCorrupted, rec.ID)
+	}
+	if err := l.storage.RestoreUser(ctx, &model.User{
+		ID:                rec.ID,
+		Login:             model.OrgLoginPrefix + rec.ID,
+		EncryptedSecret:   rec.EncryptedSecret,
+		PublicKey:         rec.PublicKey,
+		EncryptPrivateKey: rec.EncryptPrivateKey,
+	}); err != nil {
+		return fmt.Errorf("failed to restore organization account: %w", err)
+	}
+	if err := l.storage.RestoreOrg(ctx, &model.Organization{
+		ID:              rec.ID,
+		EncryptName:     rec.EncryptName,
+		EncryptedSecret: rec.EncryptedSecret,
+		CreatedAt:       rec.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("failed to restore organization: %w", err)
+	}
+	for _, member := range rec.Members {
+		if err := l.storage.RestoreOrgMember(ctx, &model.OrgMember{
+			OrgID:      rec.ID,
+			UserID:     member.UserID,
+			Role:       member.Role,
+			EncryptKey: member.EncryptKey,
+			CreatedAt:  member.CreatedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to restore organization member: %w", err)
+		}
+	}
+	l.accounts[rec.ID] = true
+	l.summary.Orgs++
+	return nil
+}
+
+// loadChunk записывает блок файла аккаунта в хранилище блоков.
+func (l *loader) loadChunk(ctx context.Context, rec *chunkRecord) error {
+	if !l.accounts[rec.UserID] {
+		return fmt.Errorf("%w: chunk of unknown user %s", ErrCorrupted, rec.UserID)
+	}
+	if err := l.blobs.Put(ctx, rec.UserID, rec.Key, rec.Data); err != nil {
+		return fmt.Errorf("failed to put chunk: %w", err)
+	}
+	l.summary.Chunks++
+	return nil
+}
+
+// loadFolder сохраняет папку аккаунта.
+func (l *loader) loadFolder(ctx context.Context, rec *folderRecord) error {
+	if !l.accounts[rec.UserID] {
+		return fmt.Errorf("%w: folder of unknown user %s", ErrCorrupted, rec.UserID)
+	}
+	if _, ok := l.folders[rec.ID]; ok || rec.ID == "" {
+		return fmt.Errorf("%w: invalid folder %s", ErrCorrupted, rec.ID)
+	}
+	if rec.ParentID != "" && l.folders[rec.ParentID] != rec.UserID {
+		return fmt.Errorf("%w: folder %s in unknown folder", ErrCorrupted, rec.ID)
+	}
+	if err := l.storage.RestoreFolder(ctx, &model.Folder{
+		ID:          rec.ID,
+		UserID:      rec.UserID,
+		ParentID:    rec.ParentID,
+		EncryptName: rec.EncryptName,
+		CreatedAt:   rec.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("failed to restore folder: %w", err)
+	}
+	l.folders[rec.ID] = rec.UserID
+	l.summary.Folders++
+	return nil
+}
+
+// loadTag сохраняет метку аккаунта.
+func (l *loader) loadTag(ctx context.Context, rec *tagRecord) error {
+	if !l.accounts[rec.UserID] {
+		return fmt.Errorf("%w: tag of unknown user %s", ErrCorrupted, rec.UserID)
+	}
+	if _, ok := l.tags[rec.ID]; ok || rec.ID == "" {
+		return fmt.Errorf("%w: invalid tag %s", ErrCorrupted, rec.ID)
+	}
+	if err := l.storage.RestoreTag(ctx, &model.Tag{
+		ID:          rec.ID,
+		UserID:      rec.UserID,
+		EncryptName: rec.EncryptName,
+		NameIndex:   rec.NameIndex,
+	}); err != nil {
+		return fmt.Errorf("failed to restore tag: %w", err)
+	}
+	l.tags[rec.ID] = rec.UserID
+	l.summary.Tags++
+	return nil
+}
+
+// loadItem сохраняет данные аккаунта с предыдущими версиями, проверяя, что папка, метки и данные,
+// к которым прикреплено вложение, принадлежат тому же аккаунту, а версии предшествуют текущей.
+func (l *loader) loadItem(ctx context.Context, rec *itemRecord) error {
+	if !l.accounts[rec.UserID] {
+		return fmt.Errorf("%w: item of unknown user %s", ErrCorrupted, rec.UserID)
+	}
+	if _, ok := l.items[rec.ID]; ok || rec.ID == "" || rec.Revision < 1 {
+		return fmt.Errorf("%w: invalid item %s", ErrCorrupted, rec.ID)
+	}
+	if rec.ParentID != "" && l.items[rec.ParentID] != rec.UserID {
+		return fmt.Errorf("%w: attachment %s of unknown item", ErrCorrupted, rec.ID)
+	}
+	if rec.FolderID != "" && l.folders[rec.FolderID] != rec.UserID {
+		return fmt.Errorf("%w: item %s in unknown folder", ErrCorrupted, rec.ID)
+	}
+	for _, tagID := range rec.TagIDs {
+		if l.tags[tagID] != rec.UserID {
+			return fmt.Errorf("%w: item %s has unknown tag %s", ErrCorrupted, rec.ID, tagID)
+		}
+	}
+	history := make([]model.VaultItemRevision, 0, len(rec.History))
+	var prev int64
+	for _, rev := range rec.History {
+		if rev.Revision <= prev || rev.Revision >= rec.Revision {
+			return fmt.Errorf("%w: item %s has invalid revision %d", ErrCorrupted, rec.ID, rev.Revision)
+		}
+		prev = rev.Revision
+		history = append(history, model.VaultItemRevision{
+			ItemID:      rec.ID,
+			Revision:    rev.Revision,
+			EncryptData: rev.EncryptData,
+			EncryptMeta: rev.EncryptMeta,
+			MetaIndex:   rev.MetaIndex,
+			Meta:        rev.Meta,
+			Chunked:     rev.Chunked,
+			Size:        rev.Size,
+			SharedMeta:  rev.SharedMeta,
+			CreatedAt:   rev.CreatedAt,
+		})
+	}
+	if err := l.storage.RestoreItem(ctx, &model.VaultItem{
+		ID:             rec.ID,
+		UserID:         rec.UserID,
+		EncryptData:    rec.EncryptData,
+		EncryptMeta:    rec.EncryptMeta,
+		MetaIndex:      rec.MetaIndex,
+		Meta:           rec.Meta,
+		Type:           rec.Type,
+		Revision:       rec.Revision,
+		Chunked:        rec.Chunked,
+		Size:           rec.Size,
+		CreatedAt:      rec.CreatedAt,
+		UpdatedAt:      rec.UpdatedAt,
+		DeletedAt:      rec.DeletedAt,
+		FolderID:       rec.FolderID,
+		ParentID:       rec.ParentID,
+		ExpiresAt:      rec.ExpiresAt,
+		TagIDs:         rec.TagIDs,
+		Favorite:       rec.Favorite,
+		LastAccessedAt: rec.LastAccessedAt,
+		AccessCount:    rec.AccessCount,
+		EncryptKey:     rec.EncryptKey,
+		SharedMeta:     rec.SharedMeta,
+	}, history); err != nil {
+		return fmt.Errorf("failed to restore item: %w", err)
+	}
+	l.items[rec.ID] = rec.UserID
+	l.summary.Items++
+	l.summary.Revisions += int64(len(history))
+	return nil
+}
+
+// loadShare сохраняет доступ к данным владельца, предоставленный получателю.
+func (l *loader) loadShare(ctx context.Context, rec *shareRecord) error {
+	if l.items[rec.ItemID] != rec.OwnerID || rec.OwnerID == "" || !l.accounts[rec.RecipientID] {
+		return fmt.Errorf("%w: share of unknown item or user", ErrCorrupted)
+	}
+	if err := l.storage.RestoreShare(ctx, &model.Share{
+		ItemID:      rec.ItemID,
+		OwnerID:     rec.OwnerID,
+		RecipientID: rec.RecipientID,
+		Access:      rec.Access,
+		EncryptKey:  rec.EncryptKey,
+		CreatedAt:   rec.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("failed to restore share: %w", err)
+	}
+	l.summary.Shares++
+	return nil
+}
+
+// loadAudit сохраняет событие журнала аудита аккаунта; события журнала идут подряд по номерам.
+func (l *loader) loadAudit(ctx context.Context, rec *auditRecord) error {
+	if !l.accounts[rec.UserID] || l.auditHeads[rec.UserID] || rec.Seq != l.auditSeq[rec.UserID]+1 {
+		return fmt.Errorf("%w: invalid audit event %d of user %s", ErrCorrupted, rec.Seq, rec.UserID)
+	}
+	if err := l.storage.RestoreAuditEvent(ctx, &model.AuditEvent{
+		UserID:    rec.UserID,
+		Seq:       rec.Seq,
+		Action:    rec.Action,
+		ItemID:    rec.ItemID,
+		Peer:      rec.Peer,
+		Code:      rec.Code,
+		CreatedAt: rec.CreatedAt,
+		PrevHash:  rec.PrevHash,
+		Hash:      rec.Hash,
+	}); err != nil {
+		return fmt.Errorf("failed to restore audit event: %w", err)
+	}
+	l.auditSeq[rec.UserID] = rec.Seq
+	l.summary.AuditEvents++
+	return nil
+}
+
+// loadAuditHead сохраняет последнее событие журнала аудита аккаунта после всех его событий.
+func (l *loader) loadAuditHead(ctx context.Context, rec *auditHeadRecord) error {
+	if !l.accounts[rec.UserID] || l.auditHeads[rec.UserID] || rec.Seq == 0 || rec.Seq != l.auditSeq[rec.UserID] {
+		return fmt.Errorf("%w: invalid audit head of user %s", ErrCorrupted, rec.UserID)
+	}
+	if err := l.storage.RestoreAuditHead(ctx, rec.UserID, model.AuditHead{Seq: rec.Seq, Tag: rec.Tag}); err != nil {
+		return fmt.Errorf("failed to restore audit head: %w", err)
+	}
+	l.auditHeads[rec.UserID] = true
+	return nil
+}
+
+// archiveError возвращает ошибку чтения архива: ошибки расшифровки передаются как есть,
+// неожиданный конец данных и ошибки формата записей означают поврежденный архив.
+func archiveError(err error) error {
+	if errors.Is(err, ErrCorrupted) || errors.Is(err, ErrTruncated) {
+		return err
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return ErrTruncated
+	}
+	return fmt.Errorf("%w: %w", ErrCorrupted, err)
+}
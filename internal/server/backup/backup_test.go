@@ -0,0 +1,415 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/memory"
+)
+
+const (
+	testKey   = "5a1f0c9e8d7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1d0c9b8a7f6e5d4c3b2a19"
+	otherKey  = "8fb2b1ae3e6f6a0d1f4dcb5a7a6c1e2f0b9d8c7e6f5a4b3c2d1e0f9a8b7c6d5e"
+	masterKey = "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	// auditKey ключ журнала аудита.
+	auditKey = "audit key"
+	// aliceSecret ключ пользователя, зашифрованный мастер ключом.
+	aliceSecret = "ce4ef7c0df5d1738675b5f16d7c7bccf5e2267a09d6e8d3115c26fbab619aed0" +
+		"88abd055ba50d550e8d9f578f14ed095804c5fe6014f44e4a4e40665"
+)
+
+// testSource содержит хранилища с данными для резервной копии.
+type testSource struct {
+	storage  storage.Storage
+	blobs    blob.Store
+	blobsDir string
+	aliceID  string
+	orgID    string
+	fileID   string
+	sharedID string
+	trashID  string
+	manifest []byte
+	chunkKey string
+}
+
+func newTestSource(t *testing.T) *testSource {
+	t.Helper()
+	ctx := context.Background()
+	s := memory.NewStorage()
+	dir := t.TempDir()
+	blobs, err := blob.NewFSStore(dir)
+	require.NoError(t, err)
+	src := &testSource{storage: s, blobs: blobs, blobsDir: dir}
+
+	src.aliceID, err = s.CreateUser(ctx, &model.User{
+		Login: "alice", PasswordHash: "hash_alice", EncryptedSecret: aliceSecret,
+		PublicKey: []byte("public_alice"), EncryptPrivateKey: []byte("private_alice"),
+	})
+	require.NoError(t, err)
+	bobID, err := s.CreateUser(ctx, &model.User{Login: "bob", PasswordHash: "hash_bob", EncryptedSecret: "secret_bob"})
+	require.NoError(t, err)
+
+	src.orgID, err = s.CreateOrg(ctx, &model.Organization{EncryptName: []byte("name"), EncryptedSecret: "secret_org"},
+		&model.OrgMember{UserID: src.aliceID, Role: model.OrgRoleOwner, EncryptKey: []byte("key_alice")})
+	require.NoError(t, err)
+	require.NoError(t, s.AddOrgMember(ctx, &model.OrgMember{
+		OrgID: src.orgID, UserID: bobID, Role: model.OrgRoleReader, EncryptKey: []byte("key_bob"),
+	}))
+
+	secret, err := utils.DecryptUserSecret(aliceSecret, masterKey)
+	require.NoError(t, err)
+	w, err := blob.NewWriter(ctx, blobs, src.aliceID, secret, blob.DefaultChunkSize)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("data"))
+	require.NoError(t, err)
+	manifest, err := w.Finish()
+	require.NoError(t, err)
+	src.chunkKey = manifest.Chunks[0].Key
+	src.manifest, err = blob.EncryptManifest(manifest, secret)
+	require.NoError(t, err)
+	// Блок, на который не ссылаются данные, в копию не входит.
+	require.NoError(t, blobs.Put(ctx, src.aliceID, strings.Repeat("ab", 32), []byte("unused")))
+
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	accessed := time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)
+	src.fileID, err = s.CreateItem(ctx, src.aliceID, &model.VaultItem{
+		Type: model.File, EncryptData: src.manifest, EncryptMeta: []byte("meta"),
+		MetaIndex: []string{"index"}, Chunked: true, Size: 4, CreatedAt: created, UpdatedAt: created,
+	})
+	require.NoError(t, err)
+	_, err = s.CreateItem(ctx, src.aliceID, &model.VaultItem{
+		Type: model.Text, EncryptData: []byte("attachment"), ParentID: src.fileID,
+	})
+	require.NoError(t, err)
+	src.sharedID, err = s.CreateItem(ctx, src.aliceID, &model.VaultItem{
+		Type: model.Text, EncryptData: []byte("text"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.SetItemKey(ctx, src.sharedID, src.aliceID, &model.ItemKey{
+		EncryptKey: []byte("item_key"), Revision: 1, EncryptData: []byte("shared_text"), SharedMeta: []byte("shared"),
+	}))
+	require.NoError(t, s.ShareItem(ctx, &model.Share{
+		ItemID: src.sharedID, OwnerID: src.aliceID, RecipientID: bobID, Access: model.ShareWrite,
+		EncryptKey: []byte("key_for_bob"),
+	}))
+	require.NoError(t, s.UpdateItem(ctx, src.fileID, src.aliceID, &model.VaultItem{
+		EncryptData: src.manifest, EncryptMeta: []byte("meta"), MetaIndex: []string{"index"},
+		Chunked: true, Size: 4,
+	}))
+
+	folderID, err := s.CreateFolder(ctx, src.aliceID, &model.Folder{EncryptName: []byte("folder")})
+	require.NoError(t, err)
+	subfolderID, err := s.CreateFolder(ctx, src.aliceID, &model.Folder{ParentID: folderID, EncryptName: []byte("sub")})
+	require.NoError(t, err)
+	require.NoError(t, s.MoveItem(ctx, src.sharedID, src.aliceID, subfolderID))
+	require.NoError(t, s.TagItem(ctx, src.sharedID, src.aliceID, &model.Tag{EncryptName: []byte("tag"), NameIndex: "idx"}))
+	require.NoError(t, s.SetFavorite(ctx, src.sharedID, src.aliceID, true))
+	require.NoError(t, s.RecordAccess(ctx, src.sharedID, src.aliceID, accessed))
+	for _, action := range []string{"GetData", "DeleteData"} {
+		require.NoError(t, s.AppendAuditEvent(ctx, &model.AuditEvent{
+			UserID: src.aliceID, Action: action, ItemID: src.sharedID,
+		}, []byte(auditKey)))
+	}
+	src.trashID, err = s.CreateItem(ctx, src.aliceID, &model.VaultItem{Type: model.Text, EncryptData: []byte("x")})
+	require.NoError(t, err)
+	require.NoError(t, s.TagItem(ctx, src.trashID, src.aliceID, &model.Tag{
+		EncryptName: []byte("trash_tag"), NameIndex: "trash_idx",
+	}))
+	require.NoError(t, s.DeleteItem(ctx, src.trashID, src.aliceID))
+	_, err = s.CreateItem(ctx, src.orgID, &model.VaultItem{Type: model.Text, EncryptData: []byte("org_text")})
+	require.NoError(t, err)
+	return src
+}
+
+// testSummary количество объектов резервной копии testSource.
+var testSummary = &Summary{
+	Users: 2, Orgs: 1, Folders: 2, Tags: 2, Items: 5, Revisions: 1, Shares: 1, AuditEvents: 2, Chunks: 1,
+}
+
+func createBackup(t *testing.T, src *testSource) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	summary, err := Create(context.Background(), src.storage, src.blobs, masterKey, &buf, testKey)
+	require.NoError(t, err)
+	assert.Equal(t, testSummary, summary)
+	return buf.Bytes()
+}
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.Background()
+	src := newTestSource(t)
+	archive := createBackup(t, src)
+	assert.NotContains(t, string(archive), "hash_alice")
+
+	dst := memory.NewStorage()
+	blobs, err := blob.NewFSStore(t.TempDir())
+	require.NoError(t, err)
+	summary, err := Restore(ctx, dst, blobs, bytes.NewReader(archive), testKey)
+	require.NoError(t, err)
+	assert.Equal(t, testSummary, summary)
+
+	alice, err := dst.GetUserByLogin(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, src.aliceID, alice.ID)
+	assert.Equal(t, "hash_alice", alice.PasswordHash)
+	assert.Equal(t, aliceSecret, alice.EncryptedSecret)
+	assert.Equal(t, []byte("public_alice"), alice.PublicKey)
+	assert.Equal(t, []byte("private_alice"), alice.EncryptPrivateKey)
+
+	keys, err := blobs.Keys(ctx, alice.ID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{src.chunkKey}, keys)
+	data, err := src.blobs.Get(ctx, src.aliceID, src.chunkKey)
+	require.NoError(t, err)
+	restored, err := blobs.Get(ctx, alice.ID, src.chunkKey)
+	require.NoError(t, err)
+	assert.Equal(t, data, restored)
+
+	// Все данные, включая данные в корзине, восстанавливаются со всеми полями и предыдущими версиями.
+	for _, userID := range []string{src.aliceID, src.orgID} {
+		want, err := src.storage.ListBackupItems(ctx, userID)
+		require.NoError(t, err)
+		got, err := dst.ListBackupItems(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, utcItems(want), utcItems(got))
+		for _, item := range want {
+			wantHistory, err := src.storage.ListBackupHistory(ctx, item.ID)
+			require.NoError(t, err)
+			gotHistory, err := dst.ListBackupHistory(ctx, item.ID)
+			require.NoError(t, err)
+			assert.Equal(t, wantHistory, gotHistory)
+		}
+	}
+	file, err := dst.GetItem(ctx, src.fileID, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, src.manifest, file.EncryptData)
+	assert.Equal(t, int64(2), file.Revision)
+	assert.True(t, file.CreatedAt.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))
+	history, err := dst.GetItemHistory(ctx, file.ID, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, int64(1), history[0].Revision)
+	attachments, err := dst.ListAttachments(ctx, file.ID, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	deleted, err := dst.GetDeletedItems(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, src.trashID, deleted[0].ID)
+
+	shared, err := dst.GetItem(ctx, src.sharedID, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("item_key"), shared.EncryptKey)
+	assert.Equal(t, []byte("shared_text"), shared.EncryptData)
+	assert.Equal(t, []byte("shared"), shared.SharedMeta)
+	items, err := dst.ListItems(ctx, alice.ID, model.ListItemsParams{})
+	require.NoError(t, err)
+	var sharedListed model.VaultItem
+	for _, item := range items {
+		if item.ID == src.sharedID {
+			sharedListed = item
+		}
+	}
+	assert.True(t, sharedListed.Favorite)
+	assert.Equal(t, int64(1), sharedListed.AccessCount)
+	require.NotNil(t, sharedListed.LastAccessedAt)
+	assert.True(t, sharedListed.LastAccessedAt.Equal(time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)))
+
+	wantFolders, err := src.storage.ListFolders(ctx, alice.ID)
+	require.NoError(t, err)
+	folders, err := dst.ListFolders(ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, utcFolders(wantFolders), utcFolders(folders))
+	assert.Equal(t, folders[1].ID, sharedListed.FolderID)
+	wantTags, err := src.storage.ListTags(ctx, alice.ID)
+	require.NoError(t, err)
+	tags, err := dst.ListTags(ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, wantTags, tags)
+
+	bob, err := dst.GetUserByLogin(ctx, "bob")
+	require.NoError(t, err)
+	share, err := dst.GetShare(ctx, shared.ID, bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID, share.OwnerID)
+	assert.Equal(t, model.ShareWrite, share.Access)
+	assert.Equal(t, []byte("key_for_bob"), share.EncryptKey)
+
+	// Журнал аудита восстанавливается с исходными хэшами и проверяется тем же ключом.
+	events, err := dst.ListAuditEvents(ctx, alice.ID, model.ListAuditParams{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.NoError(t, model.VerifyAuditChain(events, []byte(auditKey)))
+	head, err := dst.GetAuditHead(ctx, alice.ID)
+	require.NoError(t, err)
+	require.NoError(t, head.Verify(events[1], []byte(auditKey)))
+
+	orgs, err := dst.ListUserOrgs(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, orgs, 1)
+	assert.Equal(t, src.orgID, orgs[0].OrgID)
+	assert.Equal(t, []byte("name"), orgs[0].EncryptOrgName)
+	assert.Equal(t, model.OrgRoleOwner, orgs[0].Role)
+	wantMembers, err := src.storage.ListOrgMembers(ctx, src.orgID)
+	require.NoError(t, err)
+	members, err := dst.ListOrgMembers(ctx, src.orgID)
+	require.NoError(t, err)
+	assert.Equal(t, utcMembers(wantMembers), utcMembers(members))
+	account, err := dst.GetUserByID(ctx, src.orgID)
+	require.NoError(t, err)
+	assert.Equal(t, "secret_org", account.EncryptedSecret)
+	assert.Equal(t, model.OrgLoginPrefix+src.orgID, account.Login)
+}
+
+// utcTime приводит время к UTC без показаний монотонных часов, как после чтения из копии.
+func utcTime(t time.Time) time.Time {
+	return t.Round(0).UTC()
+}
+
+func utcTimePtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := utcTime(*t)
+	return &v
+}
+
+func utcItems(items []model.VaultItem) []model.VaultItem {
+	for i := range items {
+		items[i].CreatedAt = utcTime(items[i].CreatedAt)
+		items[i].UpdatedAt = utcTime(items[i].UpdatedAt)
+		items[i].DeletedAt = utcTimePtr(items[i].DeletedAt)
+		items[i].ExpiresAt = utcTimePtr(items[i].ExpiresAt)
+		items[i].LastAccessedAt = utcTimePtr(items[i].LastAccessedAt)
+	}
+	return items
+}
+
+func utcFolders(folders []model.Folder) []model.Folder {
+	for i := range folders {
+		folders[i].CreatedAt = utcTime(folders[i].CreatedAt)
+	}
+	return folders
+}
+
+func utcMembers(members []model.OrgMember) []model.OrgMember {
+	for i := range members {
+		members[i].CreatedAt = utcTime(members[i].CreatedAt)
+	}
+	return members
+}
+
+func TestBackupRewrittenChunk(t *testing.T) {
+	src := newTestSource(t)
+	// Блок, повторно записанный другим файлом во время создания копии, выбирается по манифесту.
+	future := time.Now().Add(time.Hour)
+	err := filepath.WalkDir(src.blobsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		return os.Chtimes(path, future, future)
+	})
+	require.NoError(t, err)
+	createBackup(t, src)
+}
+
+func TestBackupMissingChunk(t *testing.T) {
+	src := newTestSource(t)
+	_, err := src.blobs.Delete(context.Background(), src.aliceID, src.chunkKey, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = Create(context.Background(), src.storage, src.blobs, masterKey, &bytes.Buffer{}, testKey)
+	require.ErrorIs(t, err, blob.ErrNotFound)
+}
+
+func TestRestoreErrors(t *testing.T) {
+	archive := createBackup(t, newTestSource(t))
+	flipped := bytes.Clone(archive)
+	flipped[len(flipped)/2] ^= 0xff
+
+	tests := []struct {
+		name    string
+		archive []byte
+		key     string
+		prepare func(t *testing.T, s storage.Storage)
+		wantErr error
+	}{
+		{
+			name:    "Неверный ключ",
+			archive: archive,
+			key:     otherKey,
+			wantErr: ErrCorrupted,
+		},
+		{
+			name:    "Архив изменен",
+			archive: flipped,
+			key:     testKey,
+			wantErr: ErrCorrupted,
+		},
+		{
+			name:    "Архив обрезан",
+			archive: archive[:len(archive)-10],
+			key:     testKey,
+			wantErr: ErrTruncated,
+		},
+		{
+			name:    "Лишние данные в конце архива",
+			archive: append(bytes.Clone(archive), 0),
+			key:     testKey,
+			wantErr: ErrCorrupted,
+		},
+		{
+			name:    "Не архив",
+			archive: []byte("some data"),
+			key:     testKey,
+			wantErr: ErrInvalidArchive,
+		},
+		{
+			name:    "Хранилище не пустое",
+			archive: archive,
+			key:     testKey,
+			prepare: func(t *testing.T, s storage.Storage) {
+				_, err := s.CreateUser(context.Background(), &model.User{Login: "user"})
+				require.NoError(t, err)
+			},
+			wantErr: ErrNotEmpty,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := memory.NewStorage()
+			if tt.prepare != nil {
+				tt.prepare(t, s)
+			}
+			blobs, err := blob.NewFSStore(t.TempDir())
+			require.NoError(t, err)
+
+			_, err = Restore(context.Background(), s, blobs, bytes.NewReader(tt.archive), tt.key)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.prepare == nil {
+				users, err := s.ListUsers(context.Background())
+				require.NoError(t, err)
+				assert.Empty(t, users)
+			}
+		})
+	}
+}
+
+func TestInvalidKey(t *testing.T) {
+	_, err := Create(context.Background(), memory.NewStorage(), nil, masterKey, &bytes.Buffer{}, "key")
+	require.Error(t, err)
+	_, err = Create(context.Background(), memory.NewStorage(), nil, masterKey, &bytes.Buffer{}, "abcd")
+	require.Error(t, err)
+}
@@ -0,0 +1,199 @@
+// Package blob содержит хранилище зашифрованных блоков, на которые разбиваются большие файлы.
+// В основном хранилище для такого файла сохраняется только зашифрованный манифест со списком блоков.
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+)
+
+// DefaultChunkSize размер блока по умолчанию.
+const DefaultChunkSize = 1 << 20
+
+// Ошибки хранилища блоков.
+var (
+	ErrNotFound  = errors.New("chunk not found")
+	ErrCorrupted = errors.New("chunk is corrupted")
+)
+
+// Store описывает хранилище блоков. Блоки хранятся отдельно для каждого пользователя
+// и адресуются ключом, вычисленным по их содержимому.
+// Put для уже существующего ключа не перезаписывает блок, а только обновляет время его записи,
+// за счет чего одинаковые блоки пользователя хранятся в одном экземпляре.
+type Store interface {
+	Put(ctx context.Context, userID string, key string, data []byte) error
+	Get(ctx context.Context, userID string, key string) ([]byte, error)
+	// Delete удаляет блок, только если он все еще записан раньше before (его не записали повторно после того,
+	// как он был выбран для удаления), и сообщает, удален ли он.
+	Delete(ctx context.Context, userID string, key string, before time.Time) (bool, error)
+	// DeleteUser удаляет все блоки пользователя.
+	DeleteUser(ctx context.Context, userID string) error
+	// Users возвращает id пользователей, у которых есть блоки.
+	Users(ctx context.Context) ([]string, error)
+	// Keys возвращает ключи блоков пользователя, записанных раньше before.
+	Keys(ctx context.Context, userID string, before time.Time) ([]string, error)
+}
+
+// Manifest описывает структуру манифеста файла - упорядоченного списка его блоков.
+type Manifest struct {
+	Size   int64   `json:"size"`
+	Chunks []Chunk `json:"chunks"`
+}
+
+// Chunk описывает блок файла в манифесте.
+type Chunk struct {
+	Key  string `json:"key"`
+	Size int    `json:"size"` // Размер незашифрованных данных блока.
+}
+
+// EncryptManifest сериализует и шифрует манифест ключом пользователя.
+func EncryptManifest(manifest *Manifest, secret string) ([]byte, error) {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	return utils.Encrypt(data, secret)
+}
+
+// DecryptManifest расшифровывает манифест ключом пользователя.
+func DecryptManifest(data []byte, secret string) (*Manifest, error) {
+	decData, err := utils.Decrypt(data, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt manifest: %w", err)
+	}
+	manifest := &Manifest{}
+	if err = json.Unmarshal(decData, manifest); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// Writer разбивает записываемые данные на блоки, шифрует их и сохраняет в хранилище блоков.
+type Writer struct {
+	ctx       context.Context
+	store     Store
+	userID    string
+	secret    string
+	keyHash   []byte
+	chunkSize int
+	buf       []byte
+	manifest  Manifest
+}
+
+// NewWriter создает Writer для сохранения файла пользователя блоками размера chunkSize.
+func NewWriter(ctx context.Context, store Store, userID string, secret string, chunkSize int) (*Writer, error) {
+	if chunkSize <= 0 {
+		return nil, errors.New("chunk size must be positive")
+	}
+	keyHash, err := chunkKeyHash(secret)
+	if err != nil {
+		return nil, err
+	}
+	return &Writer{
+		ctx:       ctx,
+		store:     store,
+		userID:    userID,
+		secret:    secret,
+		keyHash:   keyHash,
+		chunkSize: chunkSize,
+		buf:       make([]byte, 0, chunkSize),
+		manifest:  Manifest{Chunks: []Chunk{}},
+	}, nil
+}
+
+// Write добавляет данные к файлу, сохраняя каждый заполненный блок.
+func (w *Writer) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(w.chunkSize-len(w.buf), len(p))
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+		if len(w.buf) == w.chunkSize {
+			if err := w.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// Finish сохраняет последний блок и возвращает манифест файла.
+func (w *Writer) Finish() (*Manifest, error) {
+	if len(w.buf) > 0 {
+		if err := w.flush(); err != nil {
+			return nil, err
+		}
+	}
+	return &w.manifest, nil
+}
+
+// flush шифрует и сохраняет накопленный блок.
+func (w *Writer) flush() error {
+	key := chunkKey(w.keyHash, w.buf)
+	encData, err := utils.Encrypt(w.buf, w.secret)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt chunk: %w", err)
+	}
+	if err = w.store.Put(w.ctx, w.userID, key, encData); err != nil {
+		return fmt.Errorf("failed to save chunk: %w", err)
+	}
+	w.manifest.Chunks = append(w.manifest.Chunks, Chunk{Key: key, Size: len(w.buf)})
+	w.manifest.Size += int64(len(w.buf))
+	w.buf = w.buf[:0]
+	return nil
+}
+
+// ReadChunks по порядку загружает, расшифровывает и проверяет блоки файла, передавая их в fn.
+func ReadChunks(
+	ctx context.Context, store Store, userID string, secret string, manifest *Manifest, fn func(chunk []byte) error,
+) error {
+	keyHash, err := chunkKeyHash(secret)
+	if err != nil {
+		return err
+	}
+	for _, chunk := range manifest.Chunks {
+		encData, err := store.Get(ctx, userID, chunk.Key)
+		if err != nil {
+			return fmt.Errorf("failed to get chunk %s: %w", chunk.Key, err)
+		}
+		data, err := utils.Decrypt(encData, secret)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt chunk %s: %w", chunk.Key, err)
+		}
+		if len(data) != chunk.Size || !hmac.Equal([]byte(chunkKey(keyHash, data)), []byte(chunk.Key)) {
+			return fmt.Errorf("%w: %s", ErrCorrupted, chunk.Key)
+		}
+		if err = fn(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chunkKeyHash выводит из ключа пользователя отдельный ключ для вычисления ключей блоков.
+func chunkKeyHash(secret string) ([]byte, error) {
+	secretB, err := hex.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode user secret: %w", err)
+	}
+	mac := hmac.New(sha256.New, secretB)
+	mac.Write([]byte("gophkeeper blob chunk key"))
+	return mac.Sum(nil), nil
+}
+
+// chunkKey вычисляет ключ блока как HMAC его содержимого: одинаковые блоки пользователя получают
+// один ключ, но по ключу нельзя проверить предположение о содержимом без ключа пользователя.
+func chunkKey(keyHash []byte, data []byte) string {
+	mac := hmac.New(sha256.New, keyHash)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,108 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+
+// writeFile сохраняет данные блоками и возвращает манифест.
+func writeFile(t *testing.T, store Store, userID string, data []byte, chunkSize int) *Manifest {
+	t.Helper()
+	w, err := NewWriter(context.Background(), store, userID, testSecret, chunkSize)
+	require.NoError(t, err)
+	// Пишем кусками, не совпадающими с размером блока.
+	for _, part := range bytes.Split(data, []byte("|")) {
+		_, err = w.Write(part)
+		require.NoError(t, err)
+	}
+	manifest, err := w.Finish()
+	require.NoError(t, err)
+	return manifest
+}
+
+// readFile собирает файл из блоков.
+func readFile(t *testing.T, store Store, userID string, manifest *Manifest) ([]byte, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	err := ReadChunks(context.Background(), store, userID, testSecret, manifest, func(chunk []byte) error {
+		buf.Write(chunk)
+		return nil
+	})
+	return buf.Bytes(), err
+}
+
+func TestWriterAndReadChunks(t *testing.T) {
+	store, err := NewFSStore(t.TempDir())
+	require.NoError(t, err)
+
+	data := []byte("0123456789abcdef0123456789")
+	manifest := writeFile(t, store, "user", data, 10)
+	assert.Equal(t, int64(len(data)), manifest.Size)
+	require.Len(t, manifest.Chunks, 3)
+	assert.Equal(t, 6, manifest.Chunks[2].Size)
+
+	got, err := readFile(t, store, "user", manifest)
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+
+	// Блоки хранятся зашифрованными.
+	encChunk, err := store.Get(context.Background(), "user", manifest.Chunks[0].Key)
+	require.NoError(t, err)
+	assert.NotContains(t, string(encChunk), "0123456789")
+
+	encManifest, err := EncryptManifest(manifest, testSecret)
+	require.NoError(t, err)
+	decManifest, err := DecryptManifest(encManifest, testSecret)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, decManifest)
+}
+
+func TestWriterDeduplicatesChunks(t *testing.T) {
+	store, err := NewFSStore(t.TempDir())
+	require.NoError(t, err)
+
+	// Одинаковые блоки одного пользователя хранятся один раз.
+	manifest := writeFile(t, store, "user", []byte("aaaaaaaaaa|aaaaaaaaaa|bbbbbbbbbb"), 10)
+	require.Len(t, manifest.Chunks, 3)
+	assert.Equal(t, manifest.Chunks[0].Key, manifest.Chunks[1].Key)
+	keys, err := store.Keys(context.Background(), "user", time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	// У другого пользователя тот же блок получает другой ключ.
+	w, err := NewWriter(context.Background(), store, "other", hex.EncodeToString(make([]byte, 32)), 10)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("aaaaaaaaaa"))
+	require.NoError(t, err)
+	other, err := w.Finish()
+	require.NoError(t, err)
+	assert.NotEqual(t, manifest.Chunks[0].Key, other.Chunks[0].Key)
+}
+
+func TestReadChunksErrors(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFSStore(t.TempDir())
+	require.NoError(t, err)
+	manifest := writeFile(t, store, "user", []byte("0123456789abcdef"), 10)
+
+	t.Run("Подмена блока", func(t *testing.T) {
+		substituted := *manifest
+		substituted.Chunks = []Chunk{{Key: manifest.Chunks[1].Key, Size: manifest.Chunks[0].Size}}
+		_, err := readFile(t, store, "user", &substituted)
+		require.ErrorIs(t, err, ErrCorrupted)
+	})
+	t.Run("Блок отсутствует", func(t *testing.T) {
+		_, err := store.Delete(ctx, "user", manifest.Chunks[0].Key, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		_, err = readFile(t, store, "user", manifest)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,222 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// chunkKeyLen длина ключа блока (hex от SHA-256).
+const chunkKeyLen = 64
+
+// FSStore описывает хранилище блоков в локальной директории.
+// Блок хранится в файле <dir>/<id пользователя>/<первые 2 символа ключа>/<ключ>.
+type FSStore struct {
+	dir   string
+	locks sync.Map // Блокировки пользователей (*sync.Mutex), см. lock.
+}
+
+// NewFSStore создает хранилище блоков в директории dir.
+func NewFSStore(dir string) (*FSStore, error) {
+	if dir == "" {
+		return nil, errors.New("empty blob store directory")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create blob store directory: %w", err)
+	}
+	return &FSStore{dir: dir}, nil
+}
+
+// Put сохраняет блок. Существующий блок не перезаписывается, у него только обновляется время записи.
+// Обновление времени и сохранение нового блока выполняются под блокировкой пользователя,
+// чтобы Delete не удалил блок, записанный после проверки его времени записи.
+func (s *FSStore) Put(_ context.Context, userID string, key string, data []byte) error {
+	path, err := s.chunkPath(userID, key)
+	if err != nil {
+		return err
+	}
+	unlock := s.lock(userID)
+	now := time.Now()
+	err = os.Chtimes(path, now, now)
+	unlock()
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to touch chunk: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err = os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create chunk directory: %w", err)
+	}
+	// Пишем во временный файл и переименовываем, чтобы не оставить недописанный блок под его ключом.
+	tmp, err := os.CreateTemp(dir, key+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to create chunk file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write chunk: %w", err)
+	}
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to sync chunk: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close chunk file: %w", err)
+	}
+	unlock = s.lock(userID)
+	defer unlock()
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to save chunk: %w", err)
+	}
+	return nil
+}
+
+// Get возвращает блок по ключу.
+func (s *FSStore) Get(_ context.Context, userID string, key string) ([]byte, error) {
+	path, err := s.chunkPath(userID, key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to read chunk: %w", err)
+	}
+	return data, nil
+}
+
+// Delete удаляет блок, если он записан раньше before, и сообщает, удален ли он.
+// Удаление отсутствующего блока не является ошибкой.
+func (s *FSStore) Delete(_ context.Context, userID string, key string, before time.Time) (bool, error) {
+	path, err := s.chunkPath(userID, key)
+	if err != nil {
+		return false, err
+	}
+	unlock := s.lock(userID)
+	defer unlock()
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to stat chunk: %w", err)
+	}
+	if !info.ModTime().Before(before) {
+		return false, nil
+	}
+	if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return false, fmt.Errorf("failed to delete chunk: %w", err)
+	}
+	return true, nil
+}
+
+// DeleteUser удаляет директорию блоков пользователя. Отсутствие блоков не является ошибкой.
+func (s *FSStore) DeleteUser(_ context.Context, userID string) error {
+	userDir, err := s.userDir(userID)
+	if err != nil {
+		return err
+	}
+	if err = os.RemoveAll(userDir); err != nil {
+		return fmt.Errorf("failed to delete user chunks: %w", err)
+	}
+	return nil
+}
+
+// Users возвращает id пользователей, у которых есть блоки.
+func (s *FSStore) Users(_ context.Context) ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob store directory: %w", err)
+	}
+	var users []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			users = append(users, entry.Name())
+		}
+	}
+	return users, nil
+}
+
+// Keys возвращает ключи блоков пользователя, записанных раньше before.
+func (s *FSStore) Keys(_ context.Context, userID string, before time.Time) ([]string, error) {
+	userDir, err := s.userDir(userID)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	err = filepath.WalkDir(userDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() || !isChunkKey(entry.Name()) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if info.ModTime().Before(before) {
+			keys = append(keys, entry.Name())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chunks: %w", err)
+	}
+	return keys, nil
+}
+
+// lock блокирует изменение времени записи и удаление блоков пользователя и возвращает функцию разблокировки.
+func (s *FSStore) lock(userID string) func() {
+	mu, _ := s.locks.LoadOrStore(userID, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// userDir возвращает директорию блоков пользователя.
+func (s *FSStore) userDir(userID string) (string, error) {
+	if userID == "" || strings.ContainsAny(userID, `/\`) || !filepath.IsLocal(userID) {
+		return "", fmt.Errorf("invalid user id: %q", userID)
+	}
+	return filepath.Join(s.dir, userID), nil
+}
+
+// chunkPath возвращает путь к файлу блока.
+func (s *FSStore) chunkPath(userID string, key string) (string, error) {
+	if !isChunkKey(key) {
+		return "", fmt.Errorf("invalid chunk key: %q", key)
+	}
+	userDir, err := s.userDir(userID)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userDir, key[:2], key), nil
+}
+
+// isChunkKey проверяет, что строка является ключом блока.
+func isChunkKey(key string) bool {
+	if len(key) != chunkKeyLen {
+		return false
+	}
+	for _, c := range key {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
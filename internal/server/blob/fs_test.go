@@ -0,0 +1,96 @@
+package blob
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFSStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewFSStore(dir)
+	require.NoError(t, err)
+	key := strings.Repeat("ab", 32)
+
+	require.NoError(t, store.Put(ctx, "user", key, []byte("data")))
+	assert.FileExists(t, filepath.Join(dir, "user", "ab", key))
+
+	// Повторная запись не меняет блок, но обновляет время записи.
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "user", "ab", key), old, old))
+	keys, err := store.Keys(ctx, "user", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []string{key}, keys)
+	require.NoError(t, store.Put(ctx, "user", key, []byte("other")))
+	keys, err = store.Keys(ctx, "user", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	data, err := store.Get(ctx, "user", key)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data"), data)
+
+	users, err := store.Users(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user"}, users)
+
+	// Блок, записанный после before, не удаляется.
+	deleted, err := store.Delete(ctx, "user", key, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.False(t, deleted)
+	deleted, err = store.Delete(ctx, "user", key, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	deleted, err = store.Delete(ctx, "user", key, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, deleted)
+	_, err = store.Get(ctx, "user", key)
+	require.ErrorIs(t, err, ErrNotFound)
+	keys, err = store.Keys(ctx, "unknown", time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	require.NoError(t, store.Put(ctx, "user", key, []byte("data")))
+	require.NoError(t, store.DeleteUser(ctx, "user"))
+	require.NoError(t, store.DeleteUser(ctx, "user"))
+	assert.NoDirExists(t, filepath.Join(dir, "user"))
+	users, err = store.Users(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, users)
+}
+
+func TestFSStoreRejectsInvalidPaths(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFSStore(t.TempDir())
+	require.NoError(t, err)
+	key := strings.Repeat("ab", 32)
+
+	tests := []struct {
+		name   string
+		userID string
+		key    string
+	}{
+		{name: "Пустой id пользователя", userID: "", key: key},
+		{name: "Выход из директории", userID: "..", key: key},
+		{name: "Разделитель в id пользователя", userID: "a/b", key: key},
+		{name: "Некорректный ключ", userID: "user", key: "../../etc/passwd"},
+		{name: "Ключ в верхнем регистре", userID: "user", key: strings.ToUpper(key)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Error(t, store.Put(ctx, tt.userID, tt.key, []byte("data")))
+			_, err := store.Get(ctx, tt.userID, tt.key)
+			require.Error(t, err)
+			if tt.userID != "user" {
+				require.Error(t, store.DeleteUser(ctx, tt.userID))
+			}
+		})
+	}
+}
@@ -9,11 +9,19 @@ import (
 
 // ServerConfig определяет структуру конфигурации сервера.
 type ServerConfig struct {
-	MasterKey     string    // Мастер ключ для шифрования.
-	ServerAddress string    // Адрес gRPC сервера.
-	LogLevel      string    // Уровень логирования.
-	DSN           string    // Строка с адресом подключения к БД.
-	JWT           JWTConfig // JWT конфигурация.
+	MasterKey     string        // Мастер ключ для шифрования.
+	BackupKey     string        // Ключ шифрования резервных копий (в hex формате, отличается от мастер ключа).
+	ServerAddress string        // Адрес gRPC сервера.
+	LogLevel      string        // Уровень логирования.
+	DSN           string        // Строка с адресом подключения к БД.
+	ReplicaDSNs   []string      // Строки подключения к репликам PostgreSQL для запросов на чтение.
+	AutoMigrate   bool          // Применять новые миграции БД при запуске сервера.
+	JWT           JWTConfig     // JWT конфигурация.
+	Trash         TrashConfig   // Конфигурация корзины.
+	Expiry        ExpiryConfig  // Конфигурация удаления данных с истекшим сроком хранения.
+	Blob          BlobConfig    // Конфигурация хранилища блоков файлов.
+	Quota         QuotaConfig   // Ограничения объема данных пользователя.
+	Storage       StorageConfig // Конфигурация метрик и повторов запросов к хранилищу.
 }
 
 // JWTConfig определяет структуру конфигурации jwt.
@@ -23,6 +31,39 @@ type JWTConfig struct {
 	MetaKey   string // Название ключа в мета gRPC запроса.
 }
 
+// TrashConfig определяет структуру конфигурации корзины.
+type TrashConfig struct {
+	Retention     int // Срок хранения данных в корзине в часах (0 - автоматическая очистка отключена).
+	PurgeInterval int // Период запуска очистки корзины в минутах.
+}
+
+// ExpiryConfig определяет структуру конфигурации удаления данных с истекшим сроком хранения.
+type ExpiryConfig struct {
+	SweepInterval int // Период удаления данных в минутах (0 - удаление отключено, данные только скрываются).
+}
+
+// BlobConfig определяет структуру конфигурации хранилища блоков файлов.
+type BlobConfig struct {
+	Dir        string // Директория для хранения блоков.
+	GCInterval int    // Период удаления неиспользуемых блоков в минутах (0 - удаление отключено).
+}
+
+// QuotaConfig определяет структуру ограничений объема данных пользователя (0 - ограничение отключено).
+type QuotaConfig struct {
+	MaxBytes    int64 // Суммарный размер данных и мета данных пользователя в байтах.
+	MaxItems    int64 // Количество данных пользователя (включая корзину).
+	MaxItemSize int64 // Размер одного объекта данных в байтах.
+	MaxMetaSize int64 // Размер мета данных одного объекта в байтах.
+}
+
+// StorageConfig определяет структуру конфигурации метрик и повторов запросов к хранилищу.
+type StorageConfig struct {
+	MetricsAddress  string // Адрес HTTP сервера метрик хранилища (пустая строка - метрики не публикуются).
+	MaxRetries      int    // Количество повторов запроса при временной ошибке PostgreSQL (0 - повторы отключены).
+	RetryBackoff    int    // Задержка перед первым повтором в миллисекундах, удваивается с каждым повтором.
+	MaxRetryBackoff int    // Максимальная задержка перед повтором в миллисекундах.
+}
+
 // InitConfig формирует итоговую конфигурацию сервера.
 func InitConfig() (*ServerConfig, error) {
 	// Файл с конфигурацией
@@ -33,19 +74,48 @@ func InitConfig() (*ServerConfig, error) {
 	// Переменные окружения
 	viper.AutomaticEnv()
 	_ = viper.BindEnv("MasterKey", "MASTER_KEY")
+	_ = viper.BindEnv("BackupKey", "BACKUP_KEY")
 	_ = viper.BindEnv("ServerAddress", "SERVER_ADDRESS")
 	_ = viper.BindEnv("LogLevel", "LOG_LEVEL")
 	_ = viper.BindEnv("DSN", "DATABASE_DSN")
+	_ = viper.BindEnv("ReplicaDSNs", "DATABASE_REPLICA_DSNS") // Несколько реплик перечисляются через запятую.
+	_ = viper.BindEnv("AutoMigrate", "AUTO_MIGRATE")
 	_ = viper.BindEnv("JWT.LifeTime", "JWT_LIFE_TIME")
 	_ = viper.BindEnv("JWT.SecretKey", "JWT_SECRET_KEY")
 	_ = viper.BindEnv("JWT.MetaKey", "JWT_META_KEY")
+	_ = viper.BindEnv("Trash.Retention", "TRASH_RETENTION")
+	_ = viper.BindEnv("Trash.PurgeInterval", "TRASH_PURGE_INTERVAL")
+	_ = viper.BindEnv("Expiry.SweepInterval", "EXPIRY_SWEEP_INTERVAL")
+	_ = viper.BindEnv("Blob.Dir", "BLOB_DIR")
+	_ = viper.BindEnv("Blob.GCInterval", "BLOB_GC_INTERVAL")
+	_ = viper.BindEnv("Quota.MaxBytes", "QUOTA_MAX_BYTES")
+	_ = viper.BindEnv("Quota.MaxItems", "QUOTA_MAX_ITEMS")
+	_ = viper.BindEnv("Quota.MaxItemSize", "QUOTA_MAX_ITEM_SIZE")
+	_ = viper.BindEnv("Quota.MaxMetaSize", "QUOTA_MAX_META_SIZE")
+	_ = viper.BindEnv("Storage.MetricsAddress", "STORAGE_METRICS_ADDRESS")
+	_ = viper.BindEnv("Storage.MaxRetries", "STORAGE_MAX_RETRIES")
+	_ = viper.BindEnv("Storage.RetryBackoff", "STORAGE_RETRY_BACKOFF")
+	_ = viper.BindEnv("Storage.MaxRetryBackoff", "STORAGE_MAX_RETRY_BACKOFF")
 
 	// Дефолтные значения
 	viper.SetDefault("ServerAddress", ":8080")
 	viper.SetDefault("LogLevel", "info")
+	viper.SetDefault("AutoMigrate", "true")
 	viper.SetDefault("JWT.LifeTime", "60")
 	viper.SetDefault("JWT.SecretKey", "jwt_secret_key")
 	viper.SetDefault("JWT.MetaKey", "jwt")
+	viper.SetDefault("Trash.Retention", "720")
+	viper.SetDefault("Trash.PurgeInterval", "60")
+	viper.SetDefault("Expiry.SweepInterval", "10")
+	viper.SetDefault("Blob.Dir", "blobs")
+	viper.SetDefault("Blob.GCInterval", "60")
+	viper.SetDefault("Quota.MaxBytes", "1073741824")
+	viper.SetDefault("Quota.MaxItems", "10000")
+	viper.SetDefault("Quota.MaxItemSize", "104857600")
+	viper.SetDefault("Quota.MaxMetaSize", "4096")
+	viper.SetDefault("Storage.MaxRetries", "0")
+	viper.SetDefault("Storage.RetryBackoff", "50")
+	viper.SetDefault("Storage.MaxRetryBackoff", "1000")
 
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, err
@@ -59,6 +129,26 @@ func InitConfig() (*ServerConfig, error) {
 	if severConfig.MasterKey == "" {
 		return nil, errors.New("отсутствует мастер ключ")
 	}
+	if severConfig.Trash.Retention > 0 && severConfig.Trash.PurgeInterval <= 0 {
+		return nil, errors.New("некорректный период очистки корзины")
+	}
+	if severConfig.Expiry.SweepInterval < 0 {
+		return nil, errors.New("некорректный период удаления данных с истекшим сроком хранения")
+	}
 
+	if severConfig.Quota.MaxBytes < 0 || severConfig.Quota.MaxItems < 0 ||
+		severConfig.Quota.MaxItemSize < 0 || severConfig.Quota.MaxMetaSize < 0 {
+		return nil, errors.New("некорректные ограничения объема данных")
+	}
+	if severConfig.Storage.MaxRetries < 0 {
+		return nil, errors.New("некорректное количество повторов запросов к хранилищу")
+	}
+	if severConfig.Storage.MaxRetries > 0 &&
+		(severConfig.Storage.RetryBackoff <= 0 || severConfig.Storage.MaxRetryBackoff < severConfig.Storage.RetryBackoff) {
+		return nil, errors.New("некорректная задержка повторов запросов к хранилищу")
+	}
+	if severConfig.Blob.Dir == "" {
+		return nil, errors.New("отсутствует директория хранилища блоков")
+	}
 	return severConfig, nil
 }
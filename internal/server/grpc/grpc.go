@@ -6,6 +6,8 @@ import (
 	"net"
 
 	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	"github.com/pinbrain/gophkeeper/internal/server/config"
 	"github.com/pinbrain/gophkeeper/internal/server/grpc/handlers"
 	"github.com/pinbrain/gophkeeper/internal/server/grpc/interceptors"
 	"github.com/pinbrain/gophkeeper/internal/server/jwt"
@@ -25,6 +27,7 @@ type Transport struct {
 
 	userHandler  *handlers.GRPCUserHandler
 	vaultHandler *handlers.GRPCVaultHandler
+	orgHandler   *handlers.GRPCOrgHandler
 	log          *logrus.Entry
 }
 
@@ -32,11 +35,12 @@ type Transport struct {
 type TransportConfig struct {
 	MasterKey     string
 	ServerAddress string
+	Quota         config.QuotaConfig
 }
 
 // NewGRPCTransport создает и возвращает новый grpc сервер.
 func NewGRPCTransport(
-	cfg TransportConfig, storage storage.Storage, jwtService jwt.ServiceI, logger *logrus.Logger,
+	cfg TransportConfig, storage storage.Storage, blobs blob.Store, jwtService jwt.ServiceI, logger *logrus.Logger,
 ) (*Transport, error) {
 	tlsCredentials, err := credentials.NewServerTLSFromFile("cert/server-cert.pem", "cert/server-key.pem")
 	if err != nil {
@@ -45,26 +49,41 @@ func NewGRPCTransport(
 
 	log := logger.WithField("instance", "grpcTransport")
 	authInterceptor := interceptors.NewAuthInterceptor(cfg.MasterKey, storage, jwtService, log)
+	auditInterceptor := interceptors.NewAuditInterceptor(cfg.MasterKey, storage, log)
 	s := grpc.NewServer(
 		grpc.Creds(tlsCredentials),
 		grpc.ChainUnaryInterceptor(
 			interceptors.LoggerInterceptor(log),
+			interceptors.PrimaryInterceptor,
 			authInterceptor.AuthenticateUser,
+			auditInterceptor.Audit,
 			authInterceptor.RequireUser,
+			authInterceptor.ScopeOrg,
+		),
+		grpc.ChainStreamInterceptor(
+			interceptors.StreamLoggerInterceptor(log),
+			interceptors.StreamPrimaryInterceptor,
+			authInterceptor.AuthenticateUserStream,
+			auditInterceptor.AuditStream,
+			authInterceptor.RequireUserStream,
+			authInterceptor.ScopeOrgStream,
 		),
 	)
-	userHandler := handlers.NewGRPCUserHandler(cfg.MasterKey, storage, jwtService, log)
-	vaultHandler := handlers.NewGRPCVaultHandler(cfg.MasterKey, storage, log)
+	userHandler := handlers.NewGRPCUserHandler(cfg.MasterKey, storage, blobs, jwtService, log)
+	vaultHandler := handlers.NewGRPCVaultHandler(cfg.MasterKey, storage, blobs, cfg.Quota, log)
+	orgHandler := handlers.NewGRPCOrgHandler(cfg.MasterKey, storage, blobs, log)
 	grpcTransport := &Transport{
 		addr:         cfg.ServerAddress,
 		grpcServer:   s,
 		storage:      storage,
 		userHandler:  userHandler,
 		vaultHandler: vaultHandler,
+		orgHandler:   orgHandler,
 		log:          log,
 	}
 	pb.RegisterUserServiceServer(grpcTransport.grpcServer, grpcTransport.userHandler)
 	pb.RegisterVaultServiceServer(grpcTransport.grpcServer, grpcTransport.vaultHandler)
+	pb.RegisterOrgServiceServer(grpcTransport.grpcServer, grpcTransport.orgHandler)
 	reflection.Register(grpcTransport.grpcServer)
 	return grpcTransport, nil
 }
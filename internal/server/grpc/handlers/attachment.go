@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// checkParentItem проверяет, что к данным можно прикрепить файл: они существуют и сами не являются вложением.
+func (h *GRPCVaultHandler) checkParentItem(ctx context.Context, id string, userID string) error {
+	item, err := h.storage.GetItem(ctx, id, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoData):
+			return status.Error(codes.NotFound, "Данные для прикрепления файла не найдены")
+		default:
+			h.log.WithError(err).Error("Error while getting item")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	if item.ParentID != "" {
+		return status.Error(codes.InvalidArgument, "Нельзя прикрепить файл к вложению")
+	}
+	return nil
+}
+
+// attachments возвращает вложения данных с расшифрованными мета данными.
+func (h *GRPCVaultHandler) attachments(
+	ctx context.Context, user *appCtx.CtxUser, parentID string,
+) ([]*pb.GetDataRes_Attachment, error) {
+	items, err := h.storage.ListAttachments(ctx, parentID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err = decryptItemsMeta(items, user.Secret); err != nil {
+		return nil, err
+	}
+	attachments := make([]*pb.GetDataRes_Attachment, 0, len(items))
+	for _, item := range items {
+		attachments = append(attachments, &pb.GetDataRes_Attachment{
+			Id:        item.ID,
+			Meta:      item.Meta,
+			Size:      item.Size,
+			CreatedAt: timestamppb.New(item.CreatedAt),
+		})
+	}
+	return attachments, nil
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// checkExpiresAt проверяет срок хранения данных из запроса: если он задан, он должен быть в будущем.
+func checkExpiresAt(expiresAt *timestamppb.Timestamp) error {
+	if expiresAt == nil {
+		return nil
+	}
+	if err := expiresAt.CheckValid(); err != nil {
+		return status.Error(codes.InvalidArgument, "Некорректный срок хранения данных")
+	}
+	if !expiresAt.AsTime().After(time.Now()) {
+		return status.Error(codes.InvalidArgument, "Срок хранения данных уже истек")
+	}
+	return nil
+}
+
+// fromTimestamp возвращает время из запроса или nil, если оно не задано.
+func fromTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// toTimestamp возвращает время для ответа или nil, если оно не задано.
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,440 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxImportMemory максимальный объем данных (кроме файлов) и мета данных, которые импорт хранит в памяти
+// до сохранения в одной транзакции. Файлы записываются в хранилище блоков по мере приема.
+const maxImportMemory = 256 << 20
+
+// importUsage учитывает количество и объем данных по мере приема потока импорта.
+type importUsage struct {
+	items  int64 // Количество данных пользователя вместе с принятыми.
+	bytes  int64 // Объем данных пользователя вместе с принятыми (по указанным размерам).
+	memory int64 // Объем принятых данных, хранимых в памяти.
+}
+
+// importRecord описывает данные, получаемые в потоке импорта.
+type importRecord struct {
+	record *pb.VaultRecord
+	parent int          // Номер записи данных, к которым прикреплено вложение; -1 - не вложение.
+	size   int64        // Размер полученных данных.
+	data   []byte       // Полученные данные (кроме файлов).
+	file   *blob.Writer // Запись файла блоками в хранилище блоков.
+	item   *model.VaultItem
+	err    string // Причина, по которой данные не могут быть импортированы.
+	// Данные только проверяются и не сохраняются: импорт уже содержит ошибки и ничего не сохранит.
+	discard bool
+}
+
+// ExportVault передает потоком все данные пользователя в расшифрованном виде.
+// Первое сообщение каждых данных содержит их описание, следующие - сами данные частями.
+// Вложения передаются после всех остальных данных.
+func (h *GRPCVaultHandler) ExportVault(_ *pb.ExportVaultReq, stream pb.VaultService_ExportVaultServer) error {
+	ctx := stream.Context()
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	items, err := h.storage.ListItems(ctx, user.ID, model.ListItemsParams{SortBy: model.SortByCreated})
+	if err != nil {
+		h.log.WithError(err).Error("Error while listing items")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	var attachments []*model.VaultItem
+	for _, listed := range items {
+		item, err := h.storage.GetItem(ctx, listed.ID, user.ID)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoData) {
+				// Данные удалены во время экспорта.
+				continue
+			}
+			h.log.WithError(err).Error("Error while getting item")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+		if item.ParentID != "" {
+			attachments = append(attachments, item)
+			continue
+		}
+		if err = h.exportItem(ctx, stream, user, item); err != nil {
+			return err
+		}
+	}
+	for _, item := range attachments {
+		if err = h.exportItem(ctx, stream, user, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportItem передает в поток описание данных и сами данные частями.
+func (h *GRPCVaultHandler) exportItem(
+	ctx context.Context, stream pb.VaultService_ExportVaultServer, user *appCtx.CtxUser, item *model.VaultItem,
+) error {
+	meta, err := utils.DecryptMeta(item.EncryptMeta, item.Meta, user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while decrypting user meta")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	record := &pb.VaultRecord{
+		Id:        item.ID,
+		Type:      string(item.Type),
+		Meta:      meta,
+		ParentId:  item.ParentID,
+		CreatedAt: timestamppb.New(item.CreatedAt),
+		UpdatedAt: timestamppb.New(item.UpdatedAt),
+		ExpiresAt: toTimestamp(item.ExpiresAt),
+	}
+	if !item.Chunked {
+		key, err := dataKey(user, item)
+		if err != nil {
+			h.log.WithError(err).Error("Error while decrypting item key")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+		data, err := utils.Decrypt(item.EncryptData, key)
+		if err != nil {
+			h.log.WithError(err).Error("Error while decrypting user data")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+		record.Size = int64(len(data))
+		if err = stream.Send(&pb.ExportVaultRes{Record: record}); err != nil {
+			return err
+		}
+		for len(data) > 0 {
+			n := min(blob.DefaultChunkSize, len(data))
+			if err = stream.Send(&pb.ExportVaultRes{Chunk: data[:n]}); err != nil {
+				return err
+			}
+			data = data[n:]
+		}
+		return nil
+	}
+
+	manifest, err := blob.DecryptManifest(item.EncryptData, user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while decrypting file manifest")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	record.Size = manifest.Size
+	if err = stream.Send(&pb.ExportVaultRes{Record: record}); err != nil {
+		return err
+	}
+	var sendErr error
+	err = blob.ReadChunks(ctx, h.blobs, user.ID, user.Secret, manifest, func(chunk []byte) error {
+		sendErr = stream.Send(&pb.ExportVaultRes{Chunk: chunk})
+		return sendErr
+	})
+	if err != nil {
+		if sendErr != nil {
+			return sendErr
+		}
+		h.log.WithError(err).Error("Error while reading file chunks")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	return nil
+}
+
+// ImportVault сохраняет данные, переданные потоком в формате ExportVault.
+// Данные импортируются в одной транзакции: если хотя бы одни данные не могут быть импортированы,
+// не сохраняется ничего, а в ответе перечисляются ошибки по каждым таким данным.
+// Вложения прикрепляются к импортированным данным, переданным раньше в том же потоке.
+// Блоки файлов записываются в хранилище блоков по мере приема, до проверки остальных данных. Если импорт
+// не выполнен, блоки не удаляются: блок с тем же содержимым может использоваться другими данными пользователя.
+// Поэтому ограничения проверяются до записи блоков, после первой ошибки блоки больше не записываются,
+// а уже записанные блоки удаляет фоновая задача удаления неиспользуемых блоков.
+func (h *GRPCVaultHandler) ImportVault(stream pb.VaultService_ImportVaultServer) error {
+	ctx := stream.Context()
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	usage, err := h.startImportUsage(ctx, user.ID)
+	if err != nil {
+		h.log.WithError(err).Error("Error while getting usage")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	var records []*importRecord
+	ids := make(map[string]int)
+	failed := false
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req.GetRecord() != nil {
+			if len(records) > 0 {
+				prev := records[len(records)-1]
+				if err = h.finishImport(prev, user); err != nil {
+					return err
+				}
+				failed = failed || prev.err != ""
+			}
+			rec := h.startImport(req.GetRecord(), records, ids)
+			if err = h.reserveImport(usage, rec); err != nil {
+				return err
+			}
+			rec.discard = failed
+			failed = failed || rec.err != ""
+			if !failed && rec.record.GetType() == string(model.File) {
+				if rec.file, err = blob.NewWriter(ctx, h.blobs, user.ID, user.Secret, blob.DefaultChunkSize); err != nil {
+					h.log.WithError(err).Error("Error while creating file writer")
+					return status.Error(codes.Internal, "Internal server error")
+				}
+			}
+			records = append(records, rec)
+		} else if len(records) == 0 {
+			return status.Error(codes.InvalidArgument, "Отсутствует описание импортируемых данных")
+		}
+		rec := records[len(records)-1]
+		if err = h.importChunk(rec, req.GetChunk()); err != nil {
+			return err
+		}
+		failed = failed || rec.err != ""
+	}
+	if len(records) == 0 {
+		return status.Error(codes.InvalidArgument, "Отсутствуют данные для импорта")
+	}
+	if err = h.finishImport(records[len(records)-1], user); err != nil {
+		return err
+	}
+
+	var failures []*pb.ImportVaultRes_Failure
+	for i, rec := range records {
+		if rec.err != "" {
+			failures = append(failures, &pb.ImportVaultRes_Failure{
+				Index: int64(i), Id: rec.record.GetId(), Error: rec.err,
+			})
+		}
+	}
+	if len(failures) > 0 {
+		return stream.SendAndClose(&pb.ImportVaultRes{Failures: failures})
+	}
+
+	// Ограничения проверяются повторно в транзакции: данные могли измениться во время приема потока.
+	err = h.storage.WithTx(ctx, func(tx storage.Storage) error {
+		var bytes int64
+		for _, rec := range records {
+			bytes += itemBytes(rec.item)
+		}
+		if err := h.checkUsage(ctx, tx, user.ID, int64(len(records)), bytes); err != nil {
+			return err
+		}
+		created := make([]string, len(records))
+		for i, rec := range records {
+			if rec.parent >= 0 {
+				rec.item.ParentID = created[rec.parent]
+			}
+			id, err := tx.CreateItem(ctx, user.ID, rec.item)
+			if err != nil {
+				return err
+			}
+			created[i] = id
+		}
+		return nil
+	})
+	if err != nil {
+		var qErr *quotaError
+		if errors.As(err, &qErr) {
+			return status.Error(codes.ResourceExhausted, qErr.Error())
+		}
+		h.log.WithError(err).Error("Error while importing data")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	return stream.SendAndClose(&pb.ImportVaultRes{Imported: int64(len(records))})
+}
+
+// startImportUsage возвращает количество и объем данных пользователя перед импортом.
+// Если ограничения не заданы, текущий объем не запрашивается.
+func (h *GRPCVaultHandler) startImportUsage(ctx context.Context, userID string) (*importUsage, error) {
+	usage := &importUsage{}
+	if h.quota.MaxItems <= 0 && h.quota.MaxBytes <= 0 {
+		return usage, nil
+	}
+	usages, err := h.storage.GetUsage(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range usages {
+		usage.items += u.Items
+		usage.bytes += u.Bytes
+	}
+	return usage, nil
+}
+
+// reserveImport учитывает очередные импортируемые данные и прерывает импорт, если принятые данные
+// превышают ограничения количества и объема данных пользователя или объема импорта в памяти.
+// Объем учитывается по указанному в описании размеру: больше него importChunk не принимает.
+func (h *GRPCVaultHandler) reserveImport(usage *importUsage, rec *importRecord) error {
+	usage.items++
+	if h.quota.MaxItems > 0 && usage.items > h.quota.MaxItems {
+		return status.Errorf(codes.ResourceExhausted, "Превышено количество данных: максимум %d", h.quota.MaxItems)
+	}
+	meta := int64(len(rec.record.GetMeta()))
+	usage.memory += meta
+	if rec.err == "" {
+		usage.bytes += rec.record.GetSize() + meta
+		if h.quota.MaxBytes > 0 && usage.bytes > h.quota.MaxBytes {
+			return status.Errorf(codes.ResourceExhausted, "Превышен объем хранилища: максимум %d байт", h.quota.MaxBytes)
+		}
+		if rec.record.GetType() != string(model.File) {
+			usage.memory += rec.record.GetSize()
+		}
+	}
+	if usage.memory > maxImportMemory {
+		return status.Errorf(codes.ResourceExhausted,
+			"Превышен объем импорта: максимум %d байт данных, кроме файлов", maxImportMemory)
+	}
+	return nil
+}
+
+// startImport проверяет описание очередных импортируемых данных. Ошибка в данных не прерывает импорт,
+// а записывается в importRecord.err.
+func (h *GRPCVaultHandler) startImport(
+	record *pb.VaultRecord, records []*importRecord, ids map[string]int,
+) *importRecord {
+	rec := &importRecord{record: record, parent: -1}
+	if record.GetId() != "" {
+		if _, ok := ids[record.GetId()]; ok {
+			rec.err = "Повторяющийся id данных"
+			return rec
+		}
+		ids[record.GetId()] = len(records)
+	}
+	if !isValidDataType(record.GetType()) {
+		rec.err = "Неизвестный тип данных"
+		return rec
+	}
+	if record.GetSize() < 0 {
+		rec.err = "Некорректный размер данных"
+		return rec
+	}
+	if err := h.checkItemSize(record.GetMeta(), record.GetSize()); err != nil {
+		rec.err = status.Convert(err).Message()
+		return rec
+	}
+	if err := checkExpiresAt(record.GetExpiresAt()); err != nil {
+		rec.err = status.Convert(err).Message()
+		return rec
+	}
+	for _, ts := range []*timestamppb.Timestamp{record.GetCreatedAt(), record.GetUpdatedAt()} {
+		if ts != nil && ts.CheckValid() != nil {
+			rec.err = "Некорректное время создания или обновления данных"
+			return rec
+		}
+	}
+	if record.GetParentId() != "" {
+		parent, ok := ids[record.GetParentId()]
+		switch {
+		case !ok || parent == len(records):
+			rec.err = "Данные для прикрепления вложения не найдены среди импортируемых"
+			return rec
+		case records[parent].parent >= 0:
+			rec.err = "Вложение нельзя прикрепить к другому вложению"
+			return rec
+		case records[parent].err != "":
+			rec.err = "Данные для прикрепления вложения не импортируются"
+			return rec
+		}
+		rec.parent = parent
+	}
+	return rec
+}
+
+// importChunk принимает очередную часть импортируемых данных. Данные больше указанного размера
+// не принимаются, поэтому принятый объем не превышает учтенного в reserveImport.
+func (h *GRPCVaultHandler) importChunk(rec *importRecord, chunk []byte) error {
+	if rec.err != "" || len(chunk) == 0 {
+		return nil
+	}
+	rec.size += int64(len(chunk))
+	if rec.size > rec.record.GetSize() {
+		rec.err = "Размер данных больше указанного"
+		rec.data, rec.file = nil, nil
+		return nil
+	}
+	if rec.discard {
+		return nil
+	}
+	if rec.file == nil {
+		rec.data = append(rec.data, chunk...)
+		return nil
+	}
+	if _, err := rec.file.Write(chunk); err != nil {
+		h.log.WithError(err).Error("Error while saving file chunk")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	return nil
+}
+
+// finishImport завершает прием импортируемых данных и шифрует их для сохранения.
+func (h *GRPCVaultHandler) finishImport(rec *importRecord, user *appCtx.CtxUser) error {
+	if rec.err != "" {
+		return nil
+	}
+	if rec.size != rec.record.GetSize() {
+		rec.err = "Размер данных меньше указанного"
+		return nil
+	}
+	if rec.discard {
+		return nil
+	}
+	item := &model.VaultItem{
+		UserID:    user.ID,
+		Type:      model.DataType(rec.record.GetType()),
+		Size:      rec.size,
+		ExpiresAt: fromTimestamp(rec.record.GetExpiresAt()),
+	}
+	if ts := rec.record.GetCreatedAt(); ts != nil {
+		item.CreatedAt = ts.AsTime()
+	}
+	if ts := rec.record.GetUpdatedAt(); ts != nil {
+		item.UpdatedAt = ts.AsTime()
+	}
+	var err error
+	item.EncryptMeta, item.MetaIndex, err = utils.EncryptMeta(rec.record.GetMeta(), user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while encrypting user meta")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	if rec.file == nil {
+		item.EncryptData, err = utils.Encrypt(rec.data, user.Secret)
+		if err != nil {
+			h.log.WithError(err).Error("Error while encrypting user data")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+	} else {
+		manifest, err := rec.file.Finish()
+		if err != nil {
+			h.log.WithError(err).Error("Error while saving file chunk")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+		item.EncryptData, err = blob.EncryptManifest(manifest, user.Secret)
+		if err != nil {
+			h.log.WithError(err).Error("Error while encrypting file manifest")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+		item.Chunked = true
+	}
+	rec.item = item
+	rec.data, rec.file = nil, nil
+	return nil
+}
@@ -0,0 +1,342 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	pbMocks "github.com/pinbrain/gophkeeper/internal/proto/mocks"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	"github.com/pinbrain/gophkeeper/internal/server/config"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestExportVault(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	blobs, err := blob.NewFSStore(t.TempDir())
+	require.NoError(t, err)
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, blobs, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey}
+	ctx := appCtx.CtxWithUser(context.Background(), user)
+	content := bytes.Repeat([]byte("file content "), blob.DefaultChunkSize/8)
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	w, err := blob.NewWriter(ctx, blobs, user.ID, user.Secret, blob.DefaultChunkSize)
+	require.NoError(t, err)
+	_, err = w.Write(content)
+	require.NoError(t, err)
+	manifest, err := w.Finish()
+	require.NoError(t, err)
+	encManifest, err := blob.EncryptManifest(manifest, user.Secret)
+	require.NoError(t, err)
+	encText, err := utils.Encrypt([]byte("text"), user.Secret)
+	require.NoError(t, err)
+	encMeta, _, err := utils.EncryptMeta(`{"name":"note"}`, user.Secret)
+	require.NoError(t, err)
+
+	text := &model.VaultItem{
+		ID: "1", Type: model.Text, EncryptMeta: encMeta, EncryptData: encText,
+		CreatedAt: created, UpdatedAt: created.Add(time.Hour),
+	}
+	file := &model.VaultItem{
+		ID: "2", Type: model.File, Meta: "file", EncryptData: encManifest, Chunked: true, ParentID: "1",
+		CreatedAt: created, UpdatedAt: created,
+	}
+
+	t.Run("Экспорт данных", func(t *testing.T) {
+		stream := pbMocks.NewMockVaultService_ExportVaultServer(ctrl)
+		stream.EXPECT().Context().Return(ctx).AnyTimes()
+		var responses []*pb.ExportVaultRes
+		stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(res *pb.ExportVaultRes) error {
+			responses = append(responses, res)
+			return nil
+		}).AnyTimes()
+		// Вложение в списке раньше данных, к которым прикреплено, а данные "3" удалены во время экспорта.
+		mockStorage.EXPECT().ListItems(gomock.Any(), user.ID, model.ListItemsParams{SortBy: model.SortByCreated}).
+			Return([]model.VaultItem{{ID: "2"}, {ID: "3"}, {ID: "1"}}, nil)
+		mockStorage.EXPECT().GetItem(gomock.Any(), "2", user.ID).Return(file, nil)
+		mockStorage.EXPECT().GetItem(gomock.Any(), "3", user.ID).Return(nil, storage.ErrNoData)
+		mockStorage.EXPECT().GetItem(gomock.Any(), "1", user.ID).Return(text, nil)
+
+		require.NoError(t, handler.ExportVault(&pb.ExportVaultReq{}, stream))
+		require.Greater(t, len(responses), 3)
+		assert.Equal(t, &pb.VaultRecord{
+			Id: "1", Type: string(model.Text), Meta: `{"name":"note"}`, Size: 4,
+			CreatedAt: timestamppb.New(created), UpdatedAt: timestamppb.New(created.Add(time.Hour)),
+		}, responses[0].GetRecord())
+		assert.Equal(t, []byte("text"), responses[1].GetChunk())
+		assert.Equal(t, &pb.VaultRecord{
+			Id: "2", Type: string(model.File), Meta: "file", Size: int64(len(content)), ParentId: "1",
+			CreatedAt: timestamppb.New(created), UpdatedAt: timestamppb.New(created),
+		}, responses[2].GetRecord())
+		var data []byte
+		for _, res := range responses[3:] {
+			assert.Nil(t, res.GetRecord())
+			assert.LessOrEqual(t, len(res.GetChunk()), blob.DefaultChunkSize)
+			data = append(data, res.GetChunk()...)
+		}
+		assert.Equal(t, content, data)
+	})
+
+	t.Run("Ошибка БД", func(t *testing.T) {
+		stream := pbMocks.NewMockVaultService_ExportVaultServer(ctrl)
+		stream.EXPECT().Context().Return(ctx).AnyTimes()
+		mockStorage.EXPECT().ListItems(gomock.Any(), user.ID, gomock.Any()).Return(nil, errors.New("db error"))
+		code, _ := status.FromError(handler.ExportVault(&pb.ExportVaultReq{}, stream))
+		assert.Equal(t, codes.Internal, code.Code())
+	})
+
+	t.Run("Ошибка получения пользователя запроса", func(t *testing.T) {
+		stream := pbMocks.NewMockVaultService_ExportVaultServer(ctrl)
+		stream.EXPECT().Context().Return(context.Background()).AnyTimes()
+		code, _ := status.FromError(handler.ExportVault(&pb.ExportVaultReq{}, stream))
+		assert.Equal(t, codes.Internal, code.Code())
+	})
+}
+
+func TestImportVault(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	user := &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey}
+	content := bytes.Repeat([]byte("file content "), blob.DefaultChunkSize/8)
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	mockStorage.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(tx storage.Storage) error) error {
+			return fn(mockStorage)
+		},
+	).AnyTimes()
+
+	valid := []*pb.ImportVaultReq{
+		{Record: &pb.VaultRecord{
+			Id: "a", Type: string(model.Text), Meta: `{"name":"note"}`, Size: 4, CreatedAt: timestamppb.New(created),
+		}},
+		{Chunk: []byte("te")},
+		{Chunk: []byte("xt")},
+		{Record: &pb.VaultRecord{Id: "b", Type: string(model.File), Meta: "file", Size: int64(len(content)), ParentId: "a"}},
+		{Chunk: content},
+	}
+
+	tests := []struct {
+		name     string
+		user     *appCtx.CtxUser
+		quota    config.QuotaConfig
+		requests []*pb.ImportVaultReq
+		usage    []model.TypeUsage
+		storeErr error
+		wantRes  *pb.ImportVaultRes
+		errCode  codes.Code
+		unread   int // Количество сообщений, не прочитанных из потока при ошибке.
+	}{
+		{
+			name:     "Импорт данных с вложением",
+			user:     user,
+			requests: valid,
+			wantRes:  &pb.ImportVaultRes{Imported: 2},
+		},
+		{
+			name: "Ошибки в данных",
+			user: user,
+			requests: []*pb.ImportVaultReq{
+				{Record: &pb.VaultRecord{Id: "a", Type: "UNKNOWN"}},
+				{Record: &pb.VaultRecord{Id: "b", Type: string(model.Text), Size: 10}},
+				{Chunk: []byte("short")},
+				{Record: &pb.VaultRecord{Id: "c", Type: string(model.Text), Size: 1}},
+				{Chunk: []byte("long")},
+				{Record: &pb.VaultRecord{Id: "d", Type: string(model.File), ParentId: "b"}},
+				{Record: &pb.VaultRecord{Id: "e", Type: string(model.File), ParentId: "x"}},
+				{Record: &pb.VaultRecord{Id: "a", Type: string(model.Text)}},
+				{Record: &pb.VaultRecord{Id: "f", Type: string(model.Text)}},
+			},
+			wantRes: &pb.ImportVaultRes{Failures: []*pb.ImportVaultRes_Failure{
+				{Index: 0, Id: "a", Error: "Неизвестный тип данных"},
+				{Index: 1, Id: "b", Error: "Размер данных меньше указанного"},
+				{Index: 2, Id: "c", Error: "Размер данных больше указанного"},
+				{Index: 3, Id: "d", Error: "Данные для прикрепления вложения не импортируются"},
+				{Index: 4, Id: "e", Error: "Данные для прикрепления вложения не найдены среди импортируемых"},
+				{Index: 5, Id: "a", Error: "Повторяющийся id данных"},
+			}},
+		},
+		{
+			name: "Файл после ошибки в данных не записывается",
+			user: user,
+			requests: []*pb.ImportVaultReq{
+				{Record: &pb.VaultRecord{Id: "a", Type: "UNKNOWN"}},
+				{Record: &pb.VaultRecord{Id: "b", Type: string(model.File), Size: int64(len(content))}},
+				{Chunk: content},
+			},
+			wantRes: &pb.ImportVaultRes{Failures: []*pb.ImportVaultRes_Failure{
+				{Index: 0, Id: "a", Error: "Неизвестный тип данных"},
+			}},
+		},
+		{
+			name:  "Превышение размера данных",
+			user:  user,
+			quota: config.QuotaConfig{MaxItemSize: 2},
+			requests: []*pb.ImportVaultReq{
+				{Record: &pb.VaultRecord{Id: "a", Type: string(model.Text), Size: 4}},
+				{Chunk: []byte("text")},
+			},
+			wantRes: &pb.ImportVaultRes{Failures: []*pb.ImportVaultRes_Failure{
+				{Index: 0, Id: "a", Error: "Превышен размер данных: максимум 2 байт"},
+			}},
+		},
+		{
+			name:     "Превышение количества данных",
+			user:     user,
+			quota:    config.QuotaConfig{MaxItems: 2},
+			requests: valid,
+			usage:    []model.TypeUsage{{Type: model.Password, Items: 1}},
+			errCode:  codes.ResourceExhausted,
+			unread:   1,
+		},
+		{
+			name:     "Превышение объема хранилища",
+			user:     user,
+			quota:    config.QuotaConfig{MaxBytes: 100},
+			requests: valid,
+			usage:    []model.TypeUsage{{Type: model.Password, Items: 1, Bytes: 80}},
+			errCode:  codes.ResourceExhausted,
+			unread:   1,
+		},
+		{
+			name: "Превышение объема импорта в памяти",
+			user: user,
+			requests: []*pb.ImportVaultReq{
+				{Record: &pb.VaultRecord{Id: "a", Type: string(model.Text), Size: maxImportMemory + 1}},
+				{Chunk: []byte("text")},
+			},
+			errCode: codes.ResourceExhausted,
+			unread:  1,
+		},
+		{
+			name:     "Ошибка БД",
+			user:     user,
+			requests: valid,
+			storeErr: errors.New("db error"),
+			errCode:  codes.Internal,
+		},
+		{
+			name:     "Часть данных без описания",
+			user:     user,
+			requests: []*pb.ImportVaultReq{{Chunk: []byte("text")}},
+			errCode:  codes.InvalidArgument,
+		},
+		{
+			name:    "Пустой поток",
+			user:    user,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Ошибка получения пользователя запроса",
+			requests: valid,
+			errCode:  codes.Internal,
+			unread:   len(valid),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blobs, err := blob.NewFSStore(t.TempDir())
+			require.NoError(t, err)
+			handler := NewGRPCVaultHandler(masterKey, mockStorage, blobs, tt.quota, log.WithField("instance", "grpcTransport"))
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+			stream := pbMocks.NewMockVaultService_ImportVaultServer(ctrl)
+			stream.EXPECT().Context().Return(ctx).AnyTimes()
+			requests := tt.requests
+			stream.EXPECT().Recv().DoAndReturn(func() (*pb.ImportVaultReq, error) {
+				if len(requests) == 0 {
+					return nil, io.EOF
+				}
+				req := requests[0]
+				requests = requests[1:]
+				return req, nil
+			}).AnyTimes()
+			var res *pb.ImportVaultRes
+			stream.EXPECT().SendAndClose(gomock.Any()).DoAndReturn(func(r *pb.ImportVaultRes) error {
+				res = r
+				return nil
+			}).AnyTimes()
+
+			if tt.usage != nil {
+				mockStorage.EXPECT().GetUsage(gomock.Any(), user.ID).Return(tt.usage, nil)
+			}
+			var saved []*model.VaultItem
+			if tt.storeErr != nil {
+				mockStorage.EXPECT().CreateItem(gomock.Any(), user.ID, gomock.Any()).Return("", tt.storeErr)
+			} else if tt.wantRes.GetImported() > 0 {
+				mockStorage.EXPECT().CreateItem(gomock.Any(), user.ID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, item *model.VaultItem) (string, error) {
+						saved = append(saved, item)
+						return "new" + string(rune('0'+len(saved))), nil
+					},
+				).Times(int(tt.wantRes.GetImported()))
+			}
+
+			err = handler.ImportVault(stream)
+			if tt.storeErr == nil && tt.wantRes.GetImported() == 0 {
+				// Блоки файлов не записываются, если известно, что импорт не будет выполнен.
+				keys, err := blobs.Keys(ctx, user.ID, time.Now().Add(time.Hour))
+				require.NoError(t, err)
+				assert.Empty(t, keys)
+			}
+			if tt.wantRes == nil {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+				assert.Len(t, requests, tt.unread)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRes, res)
+			if len(saved) == 0 {
+				return
+			}
+
+			require.Len(t, saved, 2)
+			assert.Equal(t, model.Text, saved[0].Type)
+			assert.Empty(t, saved[0].ParentID)
+			assert.Equal(t, created, saved[0].CreatedAt)
+			meta, err := utils.DecryptMeta(saved[0].EncryptMeta, saved[0].Meta, user.Secret)
+			require.NoError(t, err)
+			assert.Equal(t, `{"name":"note"}`, meta)
+			data, err := handler.itemData(ctx, user, user.Secret, saved[0].EncryptData, saved[0].Chunked)
+			require.NoError(t, err)
+			assert.Equal(t, []byte("text"), data)
+
+			assert.Equal(t, "new1", saved[1].ParentID)
+			assert.True(t, saved[1].Chunked)
+			assert.Equal(t, int64(len(content)), saved[1].Size)
+			data, err = handler.itemData(ctx, user, user.Secret, saved[1].EncryptData, saved[1].Chunked)
+			require.NoError(t, err)
+			assert.Equal(t, content, data)
+		})
+	}
+}
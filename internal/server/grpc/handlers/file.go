@@ -0,0 +1,260 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UploadFile сохраняет файл, передаваемый потоком частей, блоками в хранилище блоков.
+// В основном хранилище сохраняется только зашифрованный манифест файла.
+// Первое сообщение потока содержит мета данные файла, а при замене существующего файла - его id
+// и версию, на основе которой сделаны изменения (0 - без проверки версии). Новый файл можно
+// прикрепить к другим данным, передав их id в parent_id. Срок хранения (expires_at) при замене файла
+// меняется, только если он передан.
+func (h *GRPCVaultHandler) UploadFile(stream pb.VaultService_UploadFileServer) error {
+	ctx := stream.Context()
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "Отсутствует файл для сохранения")
+		}
+		return err
+	}
+	if err = h.checkItemSize(first.GetMeta(), 0); err != nil {
+		return err
+	}
+	if err = checkExpiresAt(first.GetExpiresAt()); err != nil {
+		return err
+	}
+	if first.GetId() != "" {
+		if first.GetParentId() != "" {
+			return status.Error(codes.InvalidArgument, "Файл прикрепляется к данным только при создании")
+		}
+		if err = h.checkFileItem(ctx, first.GetId(), user.ID); err != nil {
+			return err
+		}
+	}
+	if first.GetParentId() != "" {
+		if err = h.checkParentItem(ctx, first.GetParentId(), user.ID); err != nil {
+			return err
+		}
+	}
+
+	w, err := blob.NewWriter(ctx, h.blobs, user.ID, user.Secret, blob.DefaultChunkSize)
+	if err != nil {
+		h.log.WithError(err).Error("Error while creating file writer")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	var size int64
+	for req := first; ; {
+		size += int64(len(req.GetChunk()))
+		// Размер проверяется до сохранения блоков, чтобы не принимать файл целиком.
+		if err = h.checkItemSize("", size); err != nil {
+			return err
+		}
+		if _, err = w.Write(req.GetChunk()); err != nil {
+			h.log.WithError(err).Error("Error while saving file chunk")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+		req, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	manifest, err := w.Finish()
+	if err != nil {
+		h.log.WithError(err).Error("Error while saving file chunk")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	encManifest, err := blob.EncryptManifest(manifest, user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while encrypting file manifest")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	encMeta, metaIndex, err := utils.EncryptMeta(first.GetMeta(), user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while encrypting user meta")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+
+	item := &model.VaultItem{
+		UserID:      user.ID,
+		EncryptMeta: encMeta,
+		MetaIndex:   metaIndex,
+		Type:        model.File,
+		EncryptData: encManifest,
+		Chunked:     true,
+		Size:        manifest.Size,
+		Revision:    first.GetRevision(),
+		ParentID:    first.GetParentId(),
+		ExpiresAt:   fromTimestamp(first.GetExpiresAt()),
+	}
+	var qErr *quotaError
+	if first.GetId() == "" {
+		id, err := h.createItem(ctx, user.ID, item)
+		if err != nil {
+			if errors.As(err, &qErr) {
+				return status.Error(codes.ResourceExhausted, qErr.Error())
+			}
+			if errors.Is(err, storage.ErrNoData) {
+				// Данные, к которым прикрепляется файл, удалили во время загрузки.
+				return status.Error(codes.NotFound, "Данные для прикрепления файла не найдены")
+			}
+			h.log.WithError(err).Error("Error while saving data")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+		// Новые данные всегда создаются с первой версией.
+		return stream.SendAndClose(&pb.UploadFileRes{Id: id, Revision: 1})
+	}
+	err = h.updateItem(ctx, first.GetId(), user.ID, item)
+	if err != nil {
+		switch {
+		case errors.As(err, &qErr):
+			return status.Error(codes.ResourceExhausted, qErr.Error())
+		case errors.Is(err, storage.ErrNoData):
+			return status.Error(codes.NotFound, "Данные для обновления не найдены")
+		case errors.Is(err, storage.ErrConflict):
+			return status.Error(codes.Aborted, "Данные были изменены, получите актуальную версию и повторите изменение")
+		default:
+			h.log.WithError(err).Error("Error while updating item")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	return stream.SendAndClose(&pb.UploadFileRes{Id: first.GetId(), Revision: item.Revision})
+}
+
+// DownloadFile передает файл потоком частей.
+// Первое сообщение потока содержит мета данные, версию и размер файла.
+func (h *GRPCVaultHandler) DownloadFile(in *pb.DownloadFileReq, stream pb.VaultService_DownloadFileServer) error {
+	if in.GetId() == "" {
+		return status.Error(codes.InvalidArgument, "Отсутствует id данных")
+	}
+	ctx := stream.Context()
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	item, err := h.storage.GetItem(ctx, in.GetId(), user.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoData):
+			return status.Error(codes.NotFound, "Данные не найдены")
+		default:
+			h.log.WithError(err).Error("Error while getting item")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	if item.Type != model.File {
+		return status.Error(codes.InvalidArgument, "Данные не являются файлом")
+	}
+
+	meta, err := utils.DecryptMeta(item.EncryptMeta, item.Meta, user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while decrypting user meta")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	header := &pb.DownloadFileRes{Meta: meta, Revision: item.Revision}
+	if !item.Chunked {
+		// Файл сохранен целиком через AddData.
+		data, err := utils.Decrypt(item.EncryptData, user.Secret)
+		if err != nil {
+			h.log.WithError(err).Error("Error while decrypting user data")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+		header.Size = int64(len(data))
+		if err = stream.Send(header); err != nil {
+			return err
+		}
+		for len(data) > 0 {
+			n := min(blob.DefaultChunkSize, len(data))
+			if err = stream.Send(&pb.DownloadFileRes{Chunk: data[:n]}); err != nil {
+				return err
+			}
+			data = data[n:]
+		}
+		return nil
+	}
+
+	manifest, err := blob.DecryptManifest(item.EncryptData, user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while decrypting file manifest")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	header.Size = manifest.Size
+	if err = stream.Send(header); err != nil {
+		return err
+	}
+	var sendErr error
+	err = blob.ReadChunks(ctx, h.blobs, user.ID, user.Secret, manifest, func(chunk []byte) error {
+		sendErr = stream.Send(&pb.DownloadFileRes{Chunk: chunk})
+		return sendErr
+	})
+	if err != nil {
+		if sendErr != nil {
+			return sendErr
+		}
+		h.log.WithError(err).Error("Error while reading file chunks")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+	return nil
+}
+
+// checkFileItem проверяет, что данные существуют и являются файлом.
+func (h *GRPCVaultHandler) checkFileItem(ctx context.Context, id string, userID string) error {
+	item, err := h.storage.GetItem(ctx, id, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoData):
+			return status.Error(codes.NotFound, "Данные для обновления не найдены")
+		default:
+			h.log.WithError(err).Error("Error while getting item")
+			return status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	if item.Type != model.File {
+		return status.Error(codes.InvalidArgument, "Данные не являются файлом")
+	}
+	return nil
+}
+
+// itemData расшифровывает данные ключом key (см. dataKey). Файл, сохраненный блоками, собирается
+// из хранилища блоков; такие файлы всегда зашифрованы ключом пользователя.
+func (h *GRPCVaultHandler) itemData(
+	ctx context.Context, user *appCtx.CtxUser, key string, encData []byte, chunked bool,
+) ([]byte, error) {
+	if !chunked {
+		return utils.Decrypt(encData, key)
+	}
+	manifest, err := blob.DecryptManifest(encData, user.Secret)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, 0, manifest.Size)
+	err = blob.ReadChunks(ctx, h.blobs, user.ID, user.Secret, manifest, func(chunk []byte) error {
+		data = append(data, chunk...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,292 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	pbMocks "github.com/pinbrain/gophkeeper/internal/proto/mocks"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	"github.com/pinbrain/gophkeeper/internal/server/config"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUploadFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	blobs, err := blob.NewFSStore(t.TempDir())
+	require.NoError(t, err)
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, blobs, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey}
+	content := bytes.Repeat([]byte("file content "), blob.DefaultChunkSize/8)
+
+	tests := []struct {
+		name     string
+		user     *appCtx.CtxUser
+		requests []*pb.UploadFileReq
+		existing *model.VaultItem
+		parent   *model.VaultItem
+		storeErr error
+		wantRes  *pb.UploadFileRes
+		errCode  codes.Code
+	}{
+		{
+			name: "Новый файл",
+			user: user,
+			requests: []*pb.UploadFileReq{
+				{Meta: "meta", Chunk: content[:100]},
+				{Chunk: content[100:]},
+			},
+			wantRes: &pb.UploadFileRes{Id: "new", Revision: 1},
+		},
+		{
+			name: "Замена файла",
+			user: user,
+			requests: []*pb.UploadFileReq{
+				{Id: "1", Revision: 2, Meta: "meta", Chunk: content},
+			},
+			existing: &model.VaultItem{ID: "1", Type: model.File, Revision: 2},
+			wantRes:  &pb.UploadFileRes{Id: "1", Revision: 3},
+		},
+		{
+			name: "Конфликт версий",
+			user: user,
+			requests: []*pb.UploadFileReq{
+				{Id: "1", Revision: 1, Meta: "meta", Chunk: content},
+			},
+			existing: &model.VaultItem{ID: "1", Type: model.File, Revision: 2},
+			storeErr: storage.ErrConflict,
+			errCode:  codes.Aborted,
+		},
+		{
+			name: "Данные не являются файлом",
+			user: user,
+			requests: []*pb.UploadFileReq{
+				{Id: "1", Meta: "meta", Chunk: content},
+			},
+			existing: &model.VaultItem{ID: "1", Type: model.Password, Revision: 2},
+			errCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Файл, прикрепленный к данным",
+			user: user,
+			requests: []*pb.UploadFileReq{
+				{ParentId: "2", Meta: "meta", Chunk: content},
+			},
+			parent:  &model.VaultItem{ID: "2", Type: model.Password},
+			wantRes: &pb.UploadFileRes{Id: "new", Revision: 1},
+		},
+		{
+			name: "Файл, прикрепленный к вложению",
+			user: user,
+			requests: []*pb.UploadFileReq{
+				{ParentId: "2", Meta: "meta", Chunk: content},
+			},
+			parent:  &model.VaultItem{ID: "2", Type: model.File, ParentID: "3"},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Прикрепление при замене файла",
+			user: user,
+			requests: []*pb.UploadFileReq{
+				{Id: "1", ParentId: "2", Meta: "meta", Chunk: content},
+			},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Пустой поток",
+			user:    user,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Ошибка получения пользователя запроса",
+			requests: []*pb.UploadFileReq{
+				{Meta: "meta", Chunk: content},
+			},
+			errCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+			stream := pbMocks.NewMockVaultService_UploadFileServer(ctrl)
+			stream.EXPECT().Context().Return(ctx).AnyTimes()
+			requests := tt.requests
+			stream.EXPECT().Recv().DoAndReturn(func() (*pb.UploadFileReq, error) {
+				if len(requests) == 0 {
+					return nil, io.EOF
+				}
+				req := requests[0]
+				requests = requests[1:]
+				return req, nil
+			}).AnyTimes()
+
+			var saved *model.VaultItem
+			if tt.existing != nil {
+				mockStorage.EXPECT().GetItem(gomock.Any(), tt.existing.ID, user.ID).Return(tt.existing, nil)
+			}
+			if tt.parent != nil {
+				mockStorage.EXPECT().GetItem(gomock.Any(), tt.parent.ID, user.ID).Return(tt.parent, nil)
+			}
+			if tt.wantRes != nil && tt.existing == nil {
+				mockStorage.EXPECT().CreateItem(gomock.Any(), user.ID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, item *model.VaultItem) (string, error) {
+						saved = item
+						return "new", nil
+					},
+				)
+			}
+			if tt.existing != nil && tt.existing.Type == model.File {
+				mockStorage.EXPECT().UpdateItem(gomock.Any(), tt.existing.ID, user.ID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, _ string, item *model.VaultItem) error {
+						if tt.storeErr != nil {
+							return tt.storeErr
+						}
+						assert.Equal(t, tt.existing.Revision, item.Revision)
+						item.Revision++
+						saved = item
+						return nil
+					},
+				)
+			}
+			var res *pb.UploadFileRes
+			stream.EXPECT().SendAndClose(gomock.Any()).DoAndReturn(func(r *pb.UploadFileRes) error {
+				res = r
+				return nil
+			}).AnyTimes()
+
+			err := handler.UploadFile(stream)
+			if tt.wantRes == nil {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRes, res)
+			require.NotNil(t, saved)
+			assert.True(t, saved.Chunked)
+			assert.Equal(t, model.File, saved.Type)
+			assert.Equal(t, tt.requests[0].GetParentId(), saved.ParentID)
+			assert.Empty(t, saved.Meta)
+			meta, err := utils.DecryptMeta(saved.EncryptMeta, saved.Meta, user.Secret)
+			require.NoError(t, err)
+			assert.Equal(t, "meta", meta)
+			data, err := handler.itemData(ctx, user, user.Secret, saved.EncryptData, saved.Chunked)
+			require.NoError(t, err)
+			assert.Equal(t, content, data)
+		})
+	}
+}
+
+func TestDownloadFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	blobs, err := blob.NewFSStore(t.TempDir())
+	require.NoError(t, err)
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, blobs, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey}
+	ctx := appCtx.CtxWithUser(context.Background(), user)
+	content := bytes.Repeat([]byte("file content "), blob.DefaultChunkSize/4)
+
+	w, err := blob.NewWriter(ctx, blobs, user.ID, user.Secret, blob.DefaultChunkSize)
+	require.NoError(t, err)
+	_, err = w.Write(content)
+	require.NoError(t, err)
+	manifest, err := w.Finish()
+	require.NoError(t, err)
+	encManifest, err := blob.EncryptManifest(manifest, user.Secret)
+	require.NoError(t, err)
+	encContent, err := utils.Encrypt(content, user.Secret)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		item    *model.VaultItem
+		err     error
+		errCode codes.Code
+	}{
+		{
+			name: "Файл из блоков",
+			item: &model.VaultItem{
+				ID: "1", Type: model.File, Meta: "meta", Revision: 2, EncryptData: encManifest, Chunked: true,
+			},
+		},
+		{
+			name: "Файл, сохраненный целиком",
+			item: &model.VaultItem{ID: "1", Type: model.File, Meta: "meta", Revision: 2, EncryptData: encContent},
+		},
+		{
+			name:    "Данные не являются файлом",
+			item:    &model.VaultItem{ID: "1", Type: model.Text, EncryptData: encContent},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Данные не найдены",
+			err:     storage.ErrNoData,
+			errCode: codes.NotFound,
+		},
+		{
+			name:    "Ошибка БД",
+			err:     errors.New("db error"),
+			errCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := pbMocks.NewMockVaultService_DownloadFileServer(ctrl)
+			stream.EXPECT().Context().Return(ctx).AnyTimes()
+			var responses []*pb.DownloadFileRes
+			stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(res *pb.DownloadFileRes) error {
+				responses = append(responses, res)
+				return nil
+			}).AnyTimes()
+			mockStorage.EXPECT().GetItem(gomock.Any(), "1", user.ID).Return(tt.item, tt.err)
+
+			err := handler.DownloadFile(&pb.DownloadFileReq{Id: "1"}, stream)
+			if tt.errCode != codes.OK {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+				return
+			}
+			require.NoError(t, err)
+			require.NotEmpty(t, responses)
+			assert.Equal(t, "meta", responses[0].GetMeta())
+			assert.Equal(t, int64(2), responses[0].GetRevision())
+			assert.Equal(t, int64(len(content)), responses[0].GetSize())
+			var data []byte
+			for _, res := range responses[1:] {
+				assert.LessOrEqual(t, len(res.GetChunk()), blob.DefaultChunkSize)
+				data = append(data, res.GetChunk()...)
+			}
+			assert.Equal(t, content, data)
+		})
+	}
+}
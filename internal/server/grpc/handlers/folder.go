@@ -0,0 +1,298 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Максимальная длина названий папки и метки в символах.
+const (
+	maxFolderNameLen = 100
+	maxTagLen        = 50
+)
+
+// tagIndexField имя поля, под которым вычисляется слепой индекс названия метки.
+const tagIndexField = "tag"
+
+// CreateFolder создает папку пользователя. Название папки хранится зашифрованным ключом пользователя.
+func (h *GRPCVaultHandler) CreateFolder(ctx context.Context, in *pb.CreateFolderReq) (*pb.CreateFolderRes, error) {
+	name := strings.TrimSpace(in.GetName())
+	if !isValidName(name, maxFolderNameLen) {
+		return nil, status.Error(codes.InvalidArgument, "Некорректное название папки")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	encName, err := utils.Encrypt([]byte(name), user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while encrypting folder name")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	id, err := h.storage.CreateFolder(ctx, user.ID, &model.Folder{
+		ParentID:    in.GetParentId(),
+		EncryptName: encName,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoFolder) {
+			return nil, status.Error(codes.NotFound, "Родительская папка не найдена")
+		}
+		h.log.WithError(err).Error("Error while creating folder")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	return &pb.CreateFolderRes{FolderId: id}, nil
+}
+
+// ListFolders возвращает папки пользователя в порядке их создания.
+func (h *GRPCVaultHandler) ListFolders(ctx context.Context, _ *pb.ListFoldersReq) (*pb.ListFoldersRes, error) {
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	folders, err := h.storage.ListFolders(ctx, user.ID)
+	if err != nil {
+		h.log.WithError(err).Error("Error while listing folders")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	response := &pb.ListFoldersRes{}
+	for _, folder := range folders {
+		name, err := utils.Decrypt(folder.EncryptName, user.Secret)
+		if err != nil {
+			h.log.WithError(err).Error("Error while decrypting folder name")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+		response.Folders = append(response.Folders, &pb.Folder{
+			Id:        folder.ID,
+			Name:      string(name),
+			ParentId:  folder.ParentID,
+			CreatedAt: timestamppb.New(folder.CreatedAt),
+		})
+	}
+	return response, nil
+}
+
+// DeleteFolder удаляет папку. Данные и вложенные папки удаленной папки переходят в ее родительскую папку.
+func (h *GRPCVaultHandler) DeleteFolder(ctx context.Context, in *pb.DeleteFolderReq) (*pb.DeleteFolderRes, error) {
+	if in.GetFolderId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Отсутствует id папки")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	if err := h.storage.DeleteFolder(ctx, in.GetFolderId(), user.ID); err != nil {
+		if errors.Is(err, storage.ErrNoFolder) {
+			return nil, status.Error(codes.NotFound, "Папка не найдена")
+		}
+		h.log.WithError(err).Error("Error while deleting folder")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	return &pb.DeleteFolderRes{}, nil
+}
+
+// MoveItem перемещает данные в папку; при пустом id папки данные переходят на верхний уровень.
+func (h *GRPCVaultHandler) MoveItem(ctx context.Context, in *pb.MoveItemReq) (*pb.MoveItemRes, error) {
+	if in.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Отсутствует id данных")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	if err := h.storage.MoveItem(ctx, in.GetId(), user.ID, in.GetFolderId()); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoData):
+			return nil, status.Error(codes.NotFound, "Данные не найдены")
+		case errors.Is(err, storage.ErrNoFolder):
+			return nil, status.Error(codes.NotFound, "Папка не найдена")
+		default:
+			h.log.WithError(err).Error("Error while moving item")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	return &pb.MoveItemRes{}, nil
+}
+
+// TagItem добавляет данным метку. Метки не различаются по регистру и пробелам по краям:
+// хранилище находит метку по слепому индексу названия, а название хранится зашифрованным.
+func (h *GRPCVaultHandler) TagItem(ctx context.Context, in *pb.TagItemReq) (*pb.TagItemRes, error) {
+	if in.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Отсутствует id данных")
+	}
+	name := strings.TrimSpace(in.GetTag())
+	if !isValidName(name, maxTagLen) {
+		return nil, status.Error(codes.InvalidArgument, "Некорректная метка")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	nameIndex, err := utils.BlindIndex(user.Secret, tagIndexField, name)
+	if err != nil {
+		h.log.WithError(err).Error("Error while calculating tag index")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	encName, err := utils.Encrypt([]byte(name), user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while encrypting tag name")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	err = h.storage.TagItem(ctx, in.GetId(), user.ID, &model.Tag{EncryptName: encName, NameIndex: nameIndex})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) {
+			return nil, status.Error(codes.NotFound, "Данные не найдены")
+		}
+		h.log.WithError(err).Error("Error while tagging item")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	return &pb.TagItemRes{}, nil
+}
+
+// UntagItem снимает метку с данных.
+func (h *GRPCVaultHandler) UntagItem(ctx context.Context, in *pb.UntagItemReq) (*pb.UntagItemRes, error) {
+	if in.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Отсутствует id данных")
+	}
+	name := strings.TrimSpace(in.GetTag())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "Отсутствует метка")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	nameIndex, err := utils.BlindIndex(user.Secret, tagIndexField, name)
+	if err != nil {
+		h.log.WithError(err).Error("Error while calculating tag index")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	if err = h.storage.UntagItem(ctx, in.GetId(), user.ID, nameIndex); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoData):
+			return nil, status.Error(codes.NotFound, "Данные не найдены")
+		case errors.Is(err, storage.ErrNoTag):
+			return nil, status.Error(codes.NotFound, "У данных нет такой метки")
+		default:
+			h.log.WithError(err).Error("Error while untagging item")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	return &pb.UntagItemRes{}, nil
+}
+
+// ListTags возвращает метки пользователя, упорядоченные по названию, с количеством данных с каждой из них.
+func (h *GRPCVaultHandler) ListTags(ctx context.Context, _ *pb.ListTagsReq) (*pb.ListTagsRes, error) {
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	tags, err := h.storage.ListTags(ctx, user.ID)
+	if err != nil {
+		h.log.WithError(err).Error("Error while listing tags")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	response := &pb.ListTagsRes{}
+	for _, tag := range tags {
+		name, err := utils.Decrypt(tag.EncryptName, user.Secret)
+		if err != nil {
+			h.log.WithError(err).Error("Error while decrypting tag name")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+		response.Tags = append(response.Tags, &pb.ListTagsRes_Tag{Name: string(name), Items: tag.Items})
+	}
+	slices.SortFunc(response.Tags, func(a, b *pb.ListTagsRes_Tag) int {
+		return strings.Compare(strings.ToLower(a.GetName()), strings.ToLower(b.GetName()))
+	})
+	return response, nil
+}
+
+// SetFavorite закрепляет данные в избранном или убирает их оттуда.
+func (h *GRPCVaultHandler) SetFavorite(ctx context.Context, in *pb.SetFavoriteReq) (*pb.SetFavoriteRes, error) {
+	if in.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Отсутствует id данных")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	if err := h.storage.SetFavorite(ctx, in.GetId(), user.ID, in.GetFavorite()); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoData):
+			return nil, status.Error(codes.NotFound, "Данные не найдены")
+		default:
+			h.log.WithError(err).Error("Error while setting item favorite")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	return &pb.SetFavoriteRes{}, nil
+}
+
+// listResponseItems формирует элементы ответа со списком данных.
+// Id меток данных заменяются их названиями; метки запрашиваются, только если они есть у данных.
+func (h *GRPCVaultHandler) listResponseItems(
+	ctx context.Context, user *appCtx.CtxUser, items []model.VaultItem,
+) ([]*pb.ListItemsRes_ListItem, error) {
+	var tagNames map[string]string
+	if slices.ContainsFunc(items, func(item model.VaultItem) bool { return len(item.TagIDs) > 0 }) {
+		tags, err := h.storage.ListTags(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		tagNames = make(map[string]string, len(tags))
+		for _, tag := range tags {
+			name, err := utils.Decrypt(tag.EncryptName, user.Secret)
+			if err != nil {
+				return nil, err
+			}
+			tagNames[tag.ID] = string(name)
+		}
+	}
+	response := make([]*pb.ListItemsRes_ListItem, 0, len(items))
+	for _, item := range items {
+		var tags []string
+		for _, tagID := range item.TagIDs {
+			tags = append(tags, tagNames[tagID])
+		}
+		slices.Sort(tags)
+		response = append(response, &pb.ListItemsRes_ListItem{
+			Id:             item.ID,
+			Type:           string(item.Type),
+			Meta:           item.Meta,
+			CreatedAt:      timestamppb.New(item.CreatedAt),
+			UpdatedAt:      timestamppb.New(item.UpdatedAt),
+			Revision:       item.Revision,
+			FolderId:       item.FolderID,
+			Tags:           tags,
+			ExpiresAt:      toTimestamp(item.ExpiresAt),
+			Favorite:       item.Favorite,
+			LastAccessedAt: toTimestamp(item.LastAccessedAt),
+			AccessCount:    item.AccessCount,
+		})
+	}
+	return response, nil
+}
+
+// isValidName проверяет название папки или метки: непустое, не длиннее maxLen символов и без управляющих символов.
+func isValidName(name string, maxLen int) bool {
+	return name != "" && len([]rune(name)) <= maxLen && strings.IndexFunc(name, unicode.IsControl) < 0
+}
@@ -0,0 +1,670 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/server/config"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// encryptName шифрует название папки или метки ключом пользователя.
+func encryptName(t *testing.T, name string, secret string) []byte {
+	t.Helper()
+	encName, err := utils.Encrypt([]byte(name), secret)
+	require.NoError(t, err)
+	return encName
+}
+
+func TestCreateFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey}
+
+	type Store struct {
+		id  string
+		err error
+	}
+	tests := []struct {
+		name    string
+		user    *appCtx.CtxUser
+		request *pb.CreateFolderReq
+		store   *Store
+		wantErr bool
+		errCode codes.Code
+	}{
+		{
+			name:    "Успешный запрос",
+			user:    user,
+			request: &pb.CreateFolderReq{Name: " work ", ParentId: "parent"},
+			store:   &Store{id: "folder"},
+		},
+		{
+			name:    "Пустое название",
+			user:    user,
+			request: &pb.CreateFolderReq{Name: "  "},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Слишком длинное название",
+			user:    user,
+			request: &pb.CreateFolderReq{Name: strings.Repeat("п", maxFolderNameLen+1)},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Ошибка получения пользователя запроса",
+			request: &pb.CreateFolderReq{Name: "work"},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+		{
+			name:    "Родительская папка не найдена",
+			user:    user,
+			request: &pb.CreateFolderReq{Name: "work", ParentId: "unknown"},
+			store:   &Store{err: storage.ErrNoFolder},
+			wantErr: true,
+			errCode: codes.NotFound,
+		},
+		{
+			name:    "Ошибка БД",
+			user:    user,
+			request: &pb.CreateFolderReq{Name: "work"},
+			store:   &Store{err: errors.New("db error")},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *model.Folder
+			if tt.store != nil {
+				mockStorage.EXPECT().CreateFolder(gomock.Any(), tt.user.ID, gomock.Any()).Times(1).DoAndReturn(
+					func(_ context.Context, _ string, folder *model.Folder) (string, error) {
+						saved = folder
+						return tt.store.id, tt.store.err
+					},
+				)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			response, err := handler.CreateFolder(ctx, tt.request)
+			if tt.wantErr {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.store.id, response.GetFolderId())
+			assert.Equal(t, tt.request.GetParentId(), saved.ParentID)
+			// Название хранится зашифрованным, без пробелов по краям.
+			name, err := utils.Decrypt(saved.EncryptName, tt.user.Secret)
+			require.NoError(t, err)
+			assert.Equal(t, "work", string(name))
+		})
+	}
+}
+
+func TestListFolders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	ctx := appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey})
+
+	now := time.Now()
+	mockStorage.EXPECT().ListFolders(gomock.Any(), "1").Return([]model.Folder{
+		{ID: "work", EncryptName: encryptName(t, "work", masterKey), CreatedAt: now},
+		{ID: "projects", ParentID: "work", EncryptName: encryptName(t, "projects", masterKey), CreatedAt: now},
+	}, nil)
+	response, err := handler.ListFolders(ctx, &pb.ListFoldersReq{})
+	require.NoError(t, err)
+	require.Len(t, response.GetFolders(), 2)
+	assert.Equal(t, "work", response.GetFolders()[0].GetName())
+	assert.Empty(t, response.GetFolders()[0].GetParentId())
+	assert.Equal(t, "projects", response.GetFolders()[1].GetName())
+	assert.Equal(t, "work", response.GetFolders()[1].GetParentId())
+
+	mockStorage.EXPECT().ListFolders(gomock.Any(), "1").Return(nil, errors.New("db error"))
+	_, err = handler.ListFolders(ctx, &pb.ListFoldersReq{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	_, err = handler.ListFolders(context.Background(), &pb.ListFoldersReq{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestDeleteFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{ID: "1", Login: "user"}
+
+	tests := []struct {
+		name     string
+		user     *appCtx.CtxUser
+		request  *pb.DeleteFolderReq
+		storeErr error
+		callDB   bool
+		errCode  codes.Code
+	}{
+		{
+			name:    "Успешный запрос",
+			user:    user,
+			request: &pb.DeleteFolderReq{FolderId: "folder"},
+			callDB:  true,
+			errCode: codes.OK,
+		},
+		{
+			name:    "Нет id папки",
+			user:    user,
+			request: &pb.DeleteFolderReq{},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Ошибка получения пользователя запроса",
+			request: &pb.DeleteFolderReq{FolderId: "folder"},
+			errCode: codes.Internal,
+		},
+		{
+			name:     "Папка не найдена",
+			user:     user,
+			request:  &pb.DeleteFolderReq{FolderId: "folder"},
+			storeErr: storage.ErrNoFolder,
+			callDB:   true,
+			errCode:  codes.NotFound,
+		},
+		{
+			name:     "Ошибка БД",
+			user:     user,
+			request:  &pb.DeleteFolderReq{FolderId: "folder"},
+			storeErr: errors.New("db error"),
+			callDB:   true,
+			errCode:  codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.callDB {
+				mockStorage.EXPECT().DeleteFolder(gomock.Any(), "folder", tt.user.ID).Times(1).Return(tt.storeErr)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			_, err = handler.DeleteFolder(ctx, tt.request)
+			assert.Equal(t, tt.errCode, status.Code(err))
+		})
+	}
+}
+
+func TestMoveItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{ID: "1", Login: "user"}
+
+	tests := []struct {
+		name     string
+		user     *appCtx.CtxUser
+		request  *pb.MoveItemReq
+		storeErr error
+		callDB   bool
+		errCode  codes.Code
+	}{
+		{
+			name:    "Перемещение в папку",
+			user:    user,
+			request: &pb.MoveItemReq{Id: "1", FolderId: "folder"},
+			callDB:  true,
+			errCode: codes.OK,
+		},
+		{
+			name:    "Перемещение на верхний уровень",
+			user:    user,
+			request: &pb.MoveItemReq{Id: "1"},
+			callDB:  true,
+			errCode: codes.OK,
+		},
+		{
+			name:    "Нет id данных",
+			user:    user,
+			request: &pb.MoveItemReq{FolderId: "folder"},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Ошибка получения пользователя запроса",
+			request: &pb.MoveItemReq{Id: "1"},
+			errCode: codes.Internal,
+		},
+		{
+			name:     "Данные не найдены",
+			user:     user,
+			request:  &pb.MoveItemReq{Id: "1", FolderId: "folder"},
+			storeErr: storage.ErrNoData,
+			callDB:   true,
+			errCode:  codes.NotFound,
+		},
+		{
+			name:     "Папка не найдена",
+			user:     user,
+			request:  &pb.MoveItemReq{Id: "1", FolderId: "folder"},
+			storeErr: storage.ErrNoFolder,
+			callDB:   true,
+			errCode:  codes.NotFound,
+		},
+		{
+			name:     "Ошибка БД",
+			user:     user,
+			request:  &pb.MoveItemReq{Id: "1"},
+			storeErr: errors.New("db error"),
+			callDB:   true,
+			errCode:  codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.callDB {
+				mockStorage.EXPECT().
+					MoveItem(gomock.Any(), tt.request.GetId(), tt.user.ID, tt.request.GetFolderId()).
+					Times(1).
+					Return(tt.storeErr)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			_, err = handler.MoveItem(ctx, tt.request)
+			assert.Equal(t, tt.errCode, status.Code(err))
+		})
+	}
+}
+
+func TestSetFavorite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{ID: "1", Login: "user"}
+
+	tests := []struct {
+		name     string
+		user     *appCtx.CtxUser
+		request  *pb.SetFavoriteReq
+		storeErr error
+		callDB   bool
+		errCode  codes.Code
+	}{
+		{
+			name:    "Добавление в избранное",
+			user:    user,
+			request: &pb.SetFavoriteReq{Id: "1", Favorite: true},
+			callDB:  true,
+			errCode: codes.OK,
+		},
+		{
+			name:    "Удаление из избранного",
+			user:    user,
+			request: &pb.SetFavoriteReq{Id: "1"},
+			callDB:  true,
+			errCode: codes.OK,
+		},
+		{
+			name:    "Нет id данных",
+			user:    user,
+			request: &pb.SetFavoriteReq{Favorite: true},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Ошибка получения пользователя запроса",
+			request: &pb.SetFavoriteReq{Id: "1", Favorite: true},
+			errCode: codes.Internal,
+		},
+		{
+			name:     "Данные не найдены",
+			user:     user,
+			request:  &pb.SetFavoriteReq{Id: "1", Favorite: true},
+			storeErr: storage.ErrNoData,
+			callDB:   true,
+			errCode:  codes.NotFound,
+		},
+		{
+			name:     "Ошибка БД",
+			user:     user,
+			request:  &pb.SetFavoriteReq{Id: "1", Favorite: true},
+			storeErr: errors.New("db error"),
+			callDB:   true,
+			errCode:  codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.callDB {
+				mockStorage.EXPECT().
+					SetFavorite(gomock.Any(), tt.request.GetId(), tt.user.ID, tt.request.GetFavorite()).
+					Times(1).
+					Return(tt.storeErr)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			_, err = handler.SetFavorite(ctx, tt.request)
+			assert.Equal(t, tt.errCode, status.Code(err))
+		})
+	}
+}
+
+func TestTagItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey}
+	workIndex, err := utils.BlindIndex(masterKey, tagIndexField, "work")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		user     *appCtx.CtxUser
+		request  *pb.TagItemReq
+		storeErr error
+		callDB   bool
+		errCode  codes.Code
+	}{
+		{
+			name:    "Успешный запрос",
+			user:    user,
+			request: &pb.TagItemReq{Id: "1", Tag: " Work "},
+			callDB:  true,
+			errCode: codes.OK,
+		},
+		{
+			name:    "Нет id данных",
+			user:    user,
+			request: &pb.TagItemReq{Tag: "work"},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Пустая метка",
+			user:    user,
+			request: &pb.TagItemReq{Id: "1", Tag: " "},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Метка с управляющими символами",
+			user:    user,
+			request: &pb.TagItemReq{Id: "1", Tag: "wo\nrk"},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Ошибка получения пользователя запроса",
+			request: &pb.TagItemReq{Id: "1", Tag: "work"},
+			errCode: codes.Internal,
+		},
+		{
+			name:     "Данные не найдены",
+			user:     user,
+			request:  &pb.TagItemReq{Id: "1", Tag: "work"},
+			storeErr: storage.ErrNoData,
+			callDB:   true,
+			errCode:  codes.NotFound,
+		},
+		{
+			name:     "Ошибка БД",
+			user:     user,
+			request:  &pb.TagItemReq{Id: "1", Tag: "work"},
+			storeErr: errors.New("db error"),
+			callDB:   true,
+			errCode:  codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var saved *model.Tag
+			if tt.callDB {
+				mockStorage.EXPECT().TagItem(gomock.Any(), tt.request.GetId(), tt.user.ID, gomock.Any()).Times(1).DoAndReturn(
+					func(_ context.Context, _ string, _ string, tag *model.Tag) error {
+						saved = tag
+						return tt.storeErr
+					},
+				)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			_, err = handler.TagItem(ctx, tt.request)
+			assert.Equal(t, tt.errCode, status.Code(err))
+			if tt.errCode != codes.OK {
+				return
+			}
+			// Метка находится по индексу без учета регистра, а название сохраняется как введено.
+			assert.Equal(t, workIndex, saved.NameIndex)
+			name, err := utils.Decrypt(saved.EncryptName, masterKey)
+			require.NoError(t, err)
+			assert.Equal(t, "Work", string(name))
+		})
+	}
+}
+
+func TestUntagItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey}
+	workIndex, err := utils.BlindIndex(masterKey, tagIndexField, "work")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		user     *appCtx.CtxUser
+		request  *pb.UntagItemReq
+		storeErr error
+		callDB   bool
+		errCode  codes.Code
+	}{
+		{
+			name:    "Успешный запрос",
+			user:    user,
+			request: &pb.UntagItemReq{Id: "1", Tag: "WORK"},
+			callDB:  true,
+			errCode: codes.OK,
+		},
+		{
+			name:    "Нет id данных",
+			user:    user,
+			request: &pb.UntagItemReq{Tag: "work"},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Пустая метка",
+			user:    user,
+			request: &pb.UntagItemReq{Id: "1"},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Ошибка получения пользователя запроса",
+			request: &pb.UntagItemReq{Id: "1", Tag: "work"},
+			errCode: codes.Internal,
+		},
+		{
+			name:     "Данные не найдены",
+			user:     user,
+			request:  &pb.UntagItemReq{Id: "1", Tag: "work"},
+			storeErr: storage.ErrNoData,
+			callDB:   true,
+			errCode:  codes.NotFound,
+		},
+		{
+			name:     "У данных нет метки",
+			user:     user,
+			request:  &pb.UntagItemReq{Id: "1", Tag: "work"},
+			storeErr: storage.ErrNoTag,
+			callDB:   true,
+			errCode:  codes.NotFound,
+		},
+		{
+			name:     "Ошибка БД",
+			user:     user,
+			request:  &pb.UntagItemReq{Id: "1", Tag: "work"},
+			storeErr: errors.New("db error"),
+			callDB:   true,
+			errCode:  codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.callDB {
+				mockStorage.EXPECT().
+					UntagItem(gomock.Any(), tt.request.GetId(), tt.user.ID, workIndex).
+					Times(1).
+					Return(tt.storeErr)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			_, err = handler.UntagItem(ctx, tt.request)
+			assert.Equal(t, tt.errCode, status.Code(err))
+		})
+	}
+}
+
+func TestListTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	ctx := appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey})
+
+	mockStorage.EXPECT().ListTags(gomock.Any(), "1").Return([]model.Tag{
+		{ID: "1", EncryptName: encryptName(t, "work", masterKey), Items: 2},
+		{ID: "2", EncryptName: encryptName(t, "Home", masterKey), Items: 1},
+	}, nil)
+	response, err := handler.ListTags(ctx, &pb.ListTagsReq{})
+	require.NoError(t, err)
+	require.Len(t, response.GetTags(), 2)
+	// Метки упорядочены по названию без учета регистра.
+	assert.Equal(t, "Home", response.GetTags()[0].GetName())
+	assert.Equal(t, int64(1), response.GetTags()[0].GetItems())
+	assert.Equal(t, "work", response.GetTags()[1].GetName())
+	assert.Equal(t, int64(2), response.GetTags()[1].GetItems())
+
+	mockStorage.EXPECT().ListTags(gomock.Any(), "1").Return(nil, errors.New("db error"))
+	_, err = handler.ListTags(ctx, &pb.ListTagsReq{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestListItemsByFolderAndTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	ctx := appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey})
+	workIndex, err := utils.BlindIndex(masterKey, tagIndexField, "work")
+	require.NoError(t, err)
+
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	items := []model.VaultItem{
+		{ID: "1", Type: model.Text, CreatedAt: created, FolderID: "folder", TagIDs: []string{"t1", "t2"}},
+		{ID: "2", Type: model.Text, CreatedAt: created.Add(time.Second), FolderID: "folder", TagIDs: []string{"t1"}},
+	}
+	mockStorage.EXPECT().ListItems(gomock.Any(), "1", model.ListItemsParams{
+		SortBy:   model.SortByCreated,
+		Limit:    2,
+		FolderID: "folder",
+		TagIndex: workIndex,
+	}).Return(items, nil)
+	mockStorage.EXPECT().ListTags(gomock.Any(), "1").Return([]model.Tag{
+		{ID: "t1", EncryptName: encryptName(t, "work", masterKey)},
+		{ID: "t2", EncryptName: encryptName(t, "important", masterKey)},
+	}, nil)
+	response, err := handler.ListItems(ctx, &pb.ListItemsReq{PageSize: 1, FolderId: "folder", Tag: " Work "})
+	require.NoError(t, err)
+	require.Len(t, response.GetItems(), 1)
+	assert.Equal(t, "folder", response.GetItems()[0].GetFolderId())
+	assert.Equal(t, []string{"important", "work"}, response.GetItems()[0].GetTags())
+	require.NotEmpty(t, response.GetNextPageToken())
+
+	// Токен страницы нельзя использовать с другой папкой или меткой.
+	_, err = handler.ListItems(ctx, &pb.ListItemsReq{PageToken: response.GetNextPageToken(), Tag: "work"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = handler.ListItems(ctx, &pb.ListItemsReq{PageToken: response.GetNextPageToken(), FolderId: "folder"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
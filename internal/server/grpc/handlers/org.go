@@ -0,0 +1,448 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errLastOwner возвращается при попытке удалить или понизить единственного владельца организации.
+var errLastOwner = errors.New("organization must have an owner")
+
+// GRPCOrgHandler определяет структуру обработчика grpc запросов в части работы с организациями.
+type GRPCOrgHandler struct {
+	pb.UnimplementedOrgServiceServer
+	masterKey string
+	storage   storage.Storage
+	blobs     blob.Store // Хранилище блоков файлов, удаляемых вместе с организацией.
+	log       *logrus.Entry
+}
+
+// NewGRPCOrgHandler создает и возвращает новый обработчик grpc запросов в части работы с организациями.
+func NewGRPCOrgHandler(
+	masterKey string, storage storage.Storage, blobs blob.Store, log *logrus.Entry,
+) *GRPCOrgHandler {
+	return &GRPCOrgHandler{
+		masterKey: masterKey,
+		storage:   storage,
+		blobs:     blobs,
+		log:       log,
+	}
+}
+
+// CreateOrg создает организацию, в которой пользователь становится владельцем.
+// Ключ организации шифруется мастер ключом (для фоновых задач сервера) и открытым ключом каждого участника.
+func (h *GRPCOrgHandler) CreateOrg(ctx context.Context, in *pb.CreateOrgReq) (*pb.CreateOrgRes, error) {
+	if in.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Некорректные входные данные")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	account, err := h.account(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if len(account.PublicKey) == 0 {
+		return nil, status.Error(codes.FailedPrecondition,
+			"У вас еще нет ключей шифрования: войдите в систему повторно")
+	}
+	key, encKey, err := utils.GenerateItemKey(h.masterKey)
+	if err != nil {
+		h.log.WithError(err).Error("Error while creating organization - failed to generate organization key")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	sealed, err := utils.SealKey(key, account.PublicKey)
+	if err != nil {
+		h.log.WithError(err).Error("Error while creating organization - failed to seal organization key")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	encName, err := utils.Encrypt([]byte(in.GetName()), key)
+	if err != nil {
+		h.log.WithError(err).Error("Error while creating organization - failed to encrypt name")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	id, err := h.storage.CreateOrg(ctx, &model.Organization{
+		EncryptName:     encName,
+		EncryptedSecret: hex.EncodeToString(encKey),
+	}, &model.OrgMember{
+		UserID:     user.ID,
+		Role:       model.OrgRoleOwner,
+		EncryptKey: sealed,
+	})
+	if err != nil {
+		h.log.WithError(err).Error("Error while creating organization")
+		return nil, status.Error(codes.Internal, "Не удалось создать организацию")
+	}
+	return &pb.CreateOrgRes{OrgId: id}, nil
+}
+
+// ListOrgs возвращает организации пользователя с его ролью в каждой из них.
+func (h *GRPCOrgHandler) ListOrgs(ctx context.Context, _ *pb.ListOrgsReq) (*pb.ListOrgsRes, error) {
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	members, err := h.storage.ListUserOrgs(ctx, user.ID)
+	if err != nil {
+		h.log.WithError(err).Error("Error while listing user organizations")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	response := &pb.ListOrgsRes{}
+	if len(members) == 0 {
+		return response, nil
+	}
+	account, err := h.account(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		key, err := utils.OpenKey(member.EncryptKey, account.EncryptPrivateKey, user.Secret)
+		if err != nil {
+			h.log.WithError(err).WithField("org", member.OrgID).Error("Error while opening organization key")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+		name, err := utils.Decrypt(member.EncryptOrgName, key)
+		if err != nil {
+			h.log.WithError(err).WithField("org", member.OrgID).Error("Error while decrypting organization name")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+		response.Orgs = append(response.Orgs, &pb.ListOrgsRes_Org{
+			OrgId:     member.OrgID,
+			Name:      string(name),
+			Role:      string(member.Role),
+			CreatedAt: timestamppb.New(member.CreatedAt),
+		})
+	}
+	return response, nil
+}
+
+// DeleteOrg удаляет организацию вместе со всеми ее данными и файлами. Доступно только владельцу.
+func (h *GRPCOrgHandler) DeleteOrg(ctx context.Context, in *pb.DeleteOrgReq) (*pb.DeleteOrgRes, error) {
+	if in.GetOrgId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Некорректные входные данные")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	actor, err := h.member(ctx, in.GetOrgId(), user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if actor.Role != model.OrgRoleOwner {
+		return nil, status.Error(codes.PermissionDenied, "Удалить организацию может только владелец")
+	}
+	if err = h.storage.DeleteUser(ctx, in.GetOrgId()); err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoUser):
+			return nil, status.Error(codes.NotFound, "Организация не найдена")
+		default:
+			h.log.WithError(err).Error("Error while deleting organization")
+			return nil, status.Error(codes.Internal, "Не удалось удалить организацию")
+		}
+	}
+	if err = h.blobs.DeleteUser(ctx, in.GetOrgId()); err != nil {
+		// Блоки удаленной организации позже удалит сборщик неиспользуемых блоков.
+		h.log.WithError(err).WithField("org", in.GetOrgId()).Warn("Error while deleting organization blobs")
+	}
+	return &pb.DeleteOrgRes{}, nil
+}
+
+// AddOrgMember добавляет в организацию пользователя с указанной ролью.
+// Ключ организации шифруется открытым ключом нового участника.
+func (h *GRPCOrgHandler) AddOrgMember(ctx context.Context, in *pb.AddOrgMemberReq) (*pb.AddOrgMemberRes, error) {
+	role := model.OrgRole(in.GetRole())
+	if in.GetOrgId() == "" || in.GetLogin() == "" || !role.Valid() {
+		return nil, status.Error(codes.InvalidArgument, "Некорректные входные данные")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	actor, err := h.member(ctx, in.GetOrgId(), user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage(actor.Role, role) {
+		return nil, status.Error(codes.PermissionDenied, "Недостаточно прав для назначения этой роли")
+	}
+	recipient, err := h.userByLogin(ctx, in.GetLogin())
+	if err != nil {
+		return nil, err
+	}
+	if len(recipient.PublicKey) == 0 {
+		return nil, status.Error(codes.FailedPrecondition,
+			"У пользователя еще нет ключей шифрования: ему нужно войти в систему")
+	}
+	key, err := h.orgKey(ctx, user, actor)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := utils.SealKey(key, recipient.PublicKey)
+	if err != nil {
+		h.log.WithError(err).Error("Error while adding organization member - failed to seal organization key")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	err = h.storage.AddOrgMember(ctx, &model.OrgMember{
+		OrgID:      in.GetOrgId(),
+		UserID:     recipient.ID,
+		Role:       role,
+		EncryptKey: sealed,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrIsMember):
+			return nil, status.Error(codes.AlreadyExists, "Пользователь уже участник организации")
+		case errors.Is(err, storage.ErrNoOrg):
+			return nil, status.Error(codes.NotFound, "Организация не найдена")
+		case errors.Is(err, storage.ErrNoUser):
+			return nil, status.Error(codes.NotFound, "Пользователь с таким логином не найден")
+		default:
+			h.log.WithError(err).Error("Error while adding organization member")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	return &pb.AddOrgMemberRes{}, nil
+}
+
+// SetOrgMemberRole меняет роль участника организации.
+// В организации всегда остается хотя бы один владелец.
+func (h *GRPCOrgHandler) SetOrgMemberRole(
+	ctx context.Context, in *pb.SetOrgMemberRoleReq,
+) (*pb.SetOrgMemberRoleRes, error) {
+	role := model.OrgRole(in.GetRole())
+	if in.GetOrgId() == "" || in.GetLogin() == "" || !role.Valid() {
+		return nil, status.Error(codes.InvalidArgument, "Некорректные входные данные")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	target, err := h.userByLogin(ctx, in.GetLogin())
+	if err != nil {
+		return nil, err
+	}
+	err = h.storage.WithTx(ctx, func(tx storage.Storage) error {
+		members, err := tx.ListOrgMembers(ctx, in.GetOrgId())
+		if err != nil {
+			return err
+		}
+		actor, member := findMember(members, user.ID), findMember(members, target.ID)
+		if actor == nil || member == nil {
+			return storage.ErrNoMember
+		}
+		if !canManage(actor.Role, member.Role) || !canManage(actor.Role, role) {
+			return status.Error(codes.PermissionDenied, "Недостаточно прав для изменения роли участника")
+		}
+		if role != model.OrgRoleOwner && isLastOwner(members, member) {
+			return errLastOwner
+		}
+		return tx.SetOrgMemberRole(ctx, in.GetOrgId(), target.ID, role)
+	})
+	if err != nil {
+		return nil, h.memberError(err, "Error while setting organization member role")
+	}
+	return &pb.SetOrgMemberRoleRes{}, nil
+}
+
+// RemoveOrgMember удаляет участника из организации. Любой участник может выйти из организации сам.
+// Ключ организации при этом не меняется: удаленный участник теряет доступ к ее данным вместе с участием.
+func (h *GRPCOrgHandler) RemoveOrgMember(
+	ctx context.Context, in *pb.RemoveOrgMemberReq,
+) (*pb.RemoveOrgMemberRes, error) {
+	if in.GetOrgId() == "" || in.GetLogin() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Некорректные входные данные")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	target, err := h.userByLogin(ctx, in.GetLogin())
+	if err != nil {
+		return nil, err
+	}
+	err = h.storage.WithTx(ctx, func(tx storage.Storage) error {
+		members, err := tx.ListOrgMembers(ctx, in.GetOrgId())
+		if err != nil {
+			return err
+		}
+		actor, member := findMember(members, user.ID), findMember(members, target.ID)
+		if actor == nil || member == nil {
+			return storage.ErrNoMember
+		}
+		if actor.UserID != member.UserID && !canManage(actor.Role, member.Role) {
+			return status.Error(codes.PermissionDenied, "Недостаточно прав для удаления участника")
+		}
+		if isLastOwner(members, member) {
+			return errLastOwner
+		}
+		return tx.RemoveOrgMember(ctx, in.GetOrgId(), target.ID)
+	})
+	if err != nil {
+		return nil, h.memberError(err, "Error while removing organization member")
+	}
+	return &pb.RemoveOrgMemberRes{}, nil
+}
+
+// ListOrgMembers возвращает участников организации в порядке их добавления.
+func (h *GRPCOrgHandler) ListOrgMembers(
+	ctx context.Context, in *pb.ListOrgMembersReq,
+) (*pb.ListOrgMembersRes, error) {
+	if in.GetOrgId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Некорректные входные данные")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	members, err := h.storage.ListOrgMembers(ctx, in.GetOrgId())
+	if err != nil {
+		h.log.WithError(err).Error("Error while listing organization members")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	if findMember(members, user.ID) == nil {
+		return nil, status.Error(codes.NotFound, "Организация не найдена")
+	}
+	response := &pb.ListOrgMembersRes{}
+	for _, member := range members {
+		response.Members = append(response.Members, &pb.ListOrgMembersRes_Member{
+			Login:     member.Login,
+			Role:      string(member.Role),
+			CreatedAt: timestamppb.New(member.CreatedAt),
+		})
+	}
+	return response, nil
+}
+
+// account возвращает аккаунт пользователя запроса с его ключами.
+func (h *GRPCOrgHandler) account(ctx context.Context, user *appCtx.CtxUser) (*model.User, error) {
+	account, err := h.storage.GetUserByID(ctx, user.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoUser):
+			return nil, status.Error(codes.NotFound, "Пользователь не найден")
+		default:
+			h.log.WithError(err).Error("Error while getting user account")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	return account, nil
+}
+
+// userByLogin возвращает пользователя по логину.
+func (h *GRPCOrgHandler) userByLogin(ctx context.Context, login string) (*model.User, error) {
+	user, err := h.storage.GetUserByLogin(ctx, login)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoUser):
+			return nil, status.Error(codes.NotFound, "Пользователь с таким логином не найден")
+		default:
+			h.log.WithError(err).Error("Error while getting user by login")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	return user, nil
+}
+
+// member возвращает участие пользователя в организации.
+// Если пользователь не участник, организация для него не существует.
+func (h *GRPCOrgHandler) member(ctx context.Context, orgID string, userID string) (*model.OrgMember, error) {
+	member, err := h.storage.GetOrgMember(ctx, orgID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoMember):
+			return nil, status.Error(codes.NotFound, "Организация не найдена")
+		default:
+			h.log.WithError(err).Error("Error while getting organization member")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	return member, nil
+}
+
+// orgKey расшифровывает ключ организации закрытым ключом участника.
+func (h *GRPCOrgHandler) orgKey(ctx context.Context, user *appCtx.CtxUser, member *model.OrgMember) (string, error) {
+	account, err := h.account(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	key, err := utils.OpenKey(member.EncryptKey, account.EncryptPrivateKey, user.Secret)
+	if err != nil {
+		h.log.WithError(err).WithField("org", member.OrgID).Error("Error while opening organization key")
+		return "", status.Error(codes.Internal, "Internal server error")
+	}
+	return key, nil
+}
+
+// memberError преобразует ошибку изменения участника организации в ошибку grpc.
+func (h *GRPCOrgHandler) memberError(err error, msg string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, storage.ErrNoMember):
+		return status.Error(codes.NotFound, "Участник организации не найден")
+	case errors.Is(err, errLastOwner):
+		return status.Error(codes.FailedPrecondition,
+			"В организации должен остаться владелец: назначьте другого владельца или удалите организацию")
+	default:
+		h.log.WithError(err).Error(msg)
+		return status.Error(codes.Internal, "Internal server error")
+	}
+}
+
+// canManage проверяет, может ли участник с ролью actor назначать роль role и управлять участниками с ней.
+// Владелец управляет всеми участниками, администратор - участниками с ролями MEMBER и READER.
+func canManage(actor model.OrgRole, role model.OrgRole) bool {
+	switch actor {
+	case model.OrgRoleOwner:
+		return true
+	case model.OrgRoleAdmin:
+		return role == model.OrgRoleMember || role == model.OrgRoleReader
+	default:
+		return false
+	}
+}
+
+// findMember возвращает участника с id пользователя userID или nil, если его нет.
+func findMember(members []model.OrgMember, userID string) *model.OrgMember {
+	for i := range members {
+		if members[i].UserID == userID {
+			return &members[i]
+		}
+	}
+	return nil
+}
+
+// isLastOwner проверяет, что участник - единственный владелец организации.
+func isLastOwner(members []model.OrgMember, member *model.OrgMember) bool {
+	if member.Role != model.OrgRoleOwner {
+		return false
+	}
+	for _, m := range members {
+		if m.Role == model.OrgRoleOwner && m.UserID != member.UserID {
+			return false
+		}
+	}
+	return true
+}
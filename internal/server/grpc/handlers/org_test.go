@@ -0,0 +1,399 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateOrg(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCOrgHandler(masterKey, mockStorage, nil, log.WithField("instance", "grpcTransport"))
+	user, account := shareUser(t, "1", "owner", masterKey)
+	ctx := appCtx.CtxWithUser(context.Background(), user)
+
+	t.Run("Успешный запрос", func(t *testing.T) {
+		mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(account, nil)
+		mockStorage.EXPECT().CreateOrg(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, org *model.Organization, owner *model.OrgMember) (string, error) {
+				assert.Equal(t, user.ID, owner.UserID)
+				assert.Equal(t, model.OrgRoleOwner, owner.Role)
+				// Ключ организации доступен владельцу и фоновым задачам сервера.
+				key, err := utils.OpenKey(owner.EncryptKey, account.EncryptPrivateKey, user.Secret)
+				require.NoError(t, err)
+				secret, err := utils.DecryptUserSecret(org.EncryptedSecret, masterKey)
+				require.NoError(t, err)
+				assert.Equal(t, key, secret)
+				assert.Equal(t, "team", decrypt(t, org.EncryptName, key))
+				return "org", nil
+			},
+		)
+		res, err := handler.CreateOrg(ctx, &pb.CreateOrgReq{Name: "team"})
+		require.NoError(t, err)
+		assert.Equal(t, "org", res.GetOrgId())
+	})
+
+	t.Run("Нет названия", func(t *testing.T) {
+		_, err := handler.CreateOrg(ctx, &pb.CreateOrgReq{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("У пользователя нет ключей", func(t *testing.T) {
+		mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(&model.User{ID: user.ID}, nil)
+		_, err := handler.CreateOrg(ctx, &pb.CreateOrgReq{Name: "team"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("Ошибка БД", func(t *testing.T) {
+		mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(account, nil)
+		mockStorage.EXPECT().CreateOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("db error"))
+		_, err := handler.CreateOrg(ctx, &pb.CreateOrgReq{Name: "team"})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestListOrgs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCOrgHandler(masterKey, mockStorage, nil, log.WithField("instance", "grpcTransport"))
+	user, account := shareUser(t, "1", "user", masterKey)
+	ctx := appCtx.CtxWithUser(context.Background(), user)
+
+	key, _, err := utils.GenerateItemKey(masterKey)
+	require.NoError(t, err)
+	sealed, err := utils.SealKey(key, account.PublicKey)
+	require.NoError(t, err)
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	mockStorage.EXPECT().ListUserOrgs(gomock.Any(), user.ID).Return([]model.OrgMember{
+		{
+			OrgID: "org", UserID: user.ID, Role: model.OrgRoleReader, EncryptKey: sealed,
+			EncryptOrgName: encrypt(t, "team", key), CreatedAt: created,
+		},
+	}, nil)
+	mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(account, nil)
+
+	res, err := handler.ListOrgs(ctx, &pb.ListOrgsReq{})
+	require.NoError(t, err)
+	require.Len(t, res.GetOrgs(), 1)
+	assert.Equal(t, "org", res.GetOrgs()[0].GetOrgId())
+	assert.Equal(t, "team", res.GetOrgs()[0].GetName())
+	assert.Equal(t, string(model.OrgRoleReader), res.GetOrgs()[0].GetRole())
+	assert.Equal(t, created, res.GetOrgs()[0].GetCreatedAt().AsTime())
+
+	// Без организаций ключи пользователя не нужны.
+	mockStorage.EXPECT().ListUserOrgs(gomock.Any(), user.ID).Return(nil, nil)
+	res, err = handler.ListOrgs(ctx, &pb.ListOrgsReq{})
+	require.NoError(t, err)
+	assert.Empty(t, res.GetOrgs())
+}
+
+func TestDeleteOrg(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	dir := t.TempDir()
+	blobs, err := blob.NewFSStore(dir)
+	require.NoError(t, err)
+	handler := NewGRPCOrgHandler("", mockStorage, blobs, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{ID: "1", Login: "user"}
+
+	tests := []struct {
+		name      string
+		member    *model.OrgMember
+		memberErr error
+		deleteErr error
+		errCode   codes.Code
+	}{
+		{
+			name:   "Успешный запрос",
+			member: &model.OrgMember{OrgID: "org", UserID: "1", Role: model.OrgRoleOwner},
+		},
+		{
+			name:    "Не владелец",
+			member:  &model.OrgMember{OrgID: "org", UserID: "1", Role: model.OrgRoleAdmin},
+			errCode: codes.PermissionDenied,
+		},
+		{
+			name:      "Не участник",
+			memberErr: storage.ErrNoMember,
+			errCode:   codes.NotFound,
+		},
+		{
+			name:      "Ошибка БД",
+			member:    &model.OrgMember{OrgID: "org", UserID: "1", Role: model.OrgRoleOwner},
+			deleteErr: errors.New("db error"),
+			errCode:   codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := appCtx.CtxWithUser(context.Background(), user)
+			require.NoError(t, blobs.Put(ctx, "org", strings.Repeat("ab", 32), []byte("chunk")))
+			mockStorage.EXPECT().GetOrgMember(gomock.Any(), "org", user.ID).Return(tt.member, tt.memberErr)
+			if tt.member != nil && tt.member.Role == model.OrgRoleOwner {
+				mockStorage.EXPECT().DeleteUser(gomock.Any(), "org").Return(tt.deleteErr)
+			}
+
+			_, err := handler.DeleteOrg(ctx, &pb.DeleteOrgReq{OrgId: "org"})
+			if tt.errCode != codes.OK {
+				assert.Equal(t, tt.errCode, status.Code(err))
+				assert.DirExists(t, filepath.Join(dir, "org"))
+				return
+			}
+			require.NoError(t, err)
+			assert.NoDirExists(t, filepath.Join(dir, "org"))
+		})
+	}
+}
+
+func TestAddOrgMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCOrgHandler(masterKey, mockStorage, nil, log.WithField("instance", "grpcTransport"))
+	user, account := shareUser(t, "1", "admin", masterKey)
+	recipientUser, recipient := shareUser(t, "2", "recipient", masterKey)
+	ctx := appCtx.CtxWithUser(context.Background(), user)
+
+	key, _, err := utils.GenerateItemKey(masterKey)
+	require.NoError(t, err)
+	sealed, err := utils.SealKey(key, account.PublicKey)
+	require.NoError(t, err)
+	mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(account, nil).AnyTimes()
+
+	tests := []struct {
+		name      string
+		actor     model.OrgRole
+		request   *pb.AddOrgMemberReq
+		recipient *model.User
+		addErr    error
+		errCode   codes.Code
+	}{
+		{
+			name:      "Успешный запрос",
+			actor:     model.OrgRoleAdmin,
+			request:   &pb.AddOrgMemberReq{OrgId: "org", Login: "recipient", Role: string(model.OrgRoleReader)},
+			recipient: recipient,
+		},
+		{
+			name:    "Неизвестная роль",
+			request: &pb.AddOrgMemberReq{OrgId: "org", Login: "recipient", Role: "GUEST"},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Администратор назначает владельца",
+			actor:   model.OrgRoleAdmin,
+			request: &pb.AddOrgMemberReq{OrgId: "org", Login: "recipient", Role: string(model.OrgRoleOwner)},
+			errCode: codes.PermissionDenied,
+		},
+		{
+			name:    "Участник без прав управления",
+			actor:   model.OrgRoleMember,
+			request: &pb.AddOrgMemberReq{OrgId: "org", Login: "recipient", Role: string(model.OrgRoleReader)},
+			errCode: codes.PermissionDenied,
+		},
+		{
+			name:      "У пользователя нет ключей",
+			actor:     model.OrgRoleOwner,
+			request:   &pb.AddOrgMemberReq{OrgId: "org", Login: "recipient", Role: string(model.OrgRoleMember)},
+			recipient: &model.User{ID: "2", Login: "recipient"},
+			errCode:   codes.FailedPrecondition,
+		},
+		{
+			name:      "Уже участник",
+			actor:     model.OrgRoleOwner,
+			request:   &pb.AddOrgMemberReq{OrgId: "org", Login: "recipient", Role: string(model.OrgRoleMember)},
+			recipient: recipient,
+			addErr:    storage.ErrIsMember,
+			errCode:   codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.actor != "" {
+				mockStorage.EXPECT().GetOrgMember(gomock.Any(), "org", user.ID).Return(&model.OrgMember{
+					OrgID: "org", UserID: user.ID, Role: tt.actor, EncryptKey: sealed,
+				}, nil)
+			}
+			if tt.recipient != nil {
+				mockStorage.EXPECT().GetUserByLogin(gomock.Any(), "recipient").Return(tt.recipient, nil)
+			}
+			if tt.recipient != nil && len(tt.recipient.PublicKey) > 0 {
+				mockStorage.EXPECT().AddOrgMember(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, member *model.OrgMember) error {
+						assert.Equal(t, recipient.ID, member.UserID)
+						assert.Equal(t, model.OrgRole(tt.request.GetRole()), member.Role)
+						// Новый участник открывает тот же ключ организации своим закрытым ключом.
+						opened, err := utils.OpenKey(member.EncryptKey, recipient.EncryptPrivateKey, recipientUser.Secret)
+						require.NoError(t, err)
+						assert.Equal(t, key, opened)
+						return tt.addErr
+					},
+				)
+			}
+
+			_, err := handler.AddOrgMember(ctx, tt.request)
+			assert.Equal(t, tt.errCode, status.Code(err))
+		})
+	}
+}
+
+func TestChangeOrgMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	handler := NewGRPCOrgHandler("", mockStorage, nil, log.WithField("instance", "grpcTransport"))
+	mockStorage.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(tx storage.Storage) error) error {
+			return fn(mockStorage)
+		},
+	).AnyTimes()
+
+	logins := map[string]string{"1": "owner", "2": "admin", "3": "member", "4": "owner2"}
+	for id, login := range logins {
+		mockStorage.EXPECT().GetUserByLogin(gomock.Any(), login).Return(&model.User{ID: id, Login: login}, nil).AnyTimes()
+	}
+	members := []model.OrgMember{
+		{OrgID: "org", UserID: "1", Role: model.OrgRoleOwner},
+		{OrgID: "org", UserID: "2", Role: model.OrgRoleAdmin},
+		{OrgID: "org", UserID: "3", Role: model.OrgRoleMember},
+	}
+	withOwner := append([]model.OrgMember{{OrgID: "org", UserID: "4", Role: model.OrgRoleOwner}}, members...)
+
+	tests := []struct {
+		name    string
+		actorID string
+		login   string
+		role    model.OrgRole // Пустая роль - удаление участника.
+		members []model.OrgMember
+		errCode codes.Code
+	}{
+		{name: "Владелец назначает администратора", actorID: "1", login: "member", role: model.OrgRoleAdmin},
+		{name: "Администратор понижает участника", actorID: "2", login: "member", role: model.OrgRoleReader},
+		{
+			name: "Администратор меняет роль администратора", actorID: "2", login: "admin",
+			role: model.OrgRoleMember, errCode: codes.PermissionDenied,
+		},
+		{
+			name: "Понижение единственного владельца", actorID: "1", login: "owner",
+			role: model.OrgRoleAdmin, errCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Понижение одного из владельцев", actorID: "1", login: "owner",
+			role: model.OrgRoleAdmin, members: withOwner,
+		},
+		{
+			name: "Участник меняет роли", actorID: "3", login: "member",
+			role: model.OrgRoleAdmin, errCode: codes.PermissionDenied,
+		},
+		{name: "Пользователь не участник", actorID: "1", login: "owner2", role: model.OrgRoleMember, errCode: codes.NotFound},
+		{name: "Администратор удаляет участника", actorID: "2", login: "member"},
+		{name: "Участник выходит из организации", actorID: "3", login: "member"},
+		{name: "Участник удаляет администратора", actorID: "3", login: "admin", errCode: codes.PermissionDenied},
+		{name: "Единственный владелец выходит", actorID: "1", login: "owner", errCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: tt.actorID})
+			orgMembers := members
+			if tt.members != nil {
+				orgMembers = tt.members
+			}
+			mockStorage.EXPECT().ListOrgMembers(gomock.Any(), "org").Return(orgMembers, nil)
+			var targetID string
+			for id, login := range logins {
+				if login == tt.login {
+					targetID = id
+				}
+			}
+
+			if tt.role != "" {
+				if tt.errCode == codes.OK {
+					mockStorage.EXPECT().SetOrgMemberRole(gomock.Any(), "org", targetID, tt.role).Return(nil)
+				}
+				_, err := handler.SetOrgMemberRole(ctx, &pb.SetOrgMemberRoleReq{
+					OrgId: "org", Login: tt.login, Role: string(tt.role),
+				})
+				assert.Equal(t, tt.errCode, status.Code(err))
+				return
+			}
+			if tt.errCode == codes.OK {
+				mockStorage.EXPECT().RemoveOrgMember(gomock.Any(), "org", targetID).Return(nil)
+			}
+			_, err := handler.RemoveOrgMember(ctx, &pb.RemoveOrgMemberReq{OrgId: "org", Login: tt.login})
+			assert.Equal(t, tt.errCode, status.Code(err))
+		})
+	}
+}
+
+func TestListOrgMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	handler := NewGRPCOrgHandler("", mockStorage, nil, log.WithField("instance", "grpcTransport"))
+
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	members := []model.OrgMember{
+		{OrgID: "org", UserID: "1", Login: "owner", Role: model.OrgRoleOwner, CreatedAt: created},
+		{OrgID: "org", UserID: "2", Login: "reader", Role: model.OrgRoleReader, CreatedAt: created},
+	}
+	mockStorage.EXPECT().ListOrgMembers(gomock.Any(), "org").Return(members, nil).Times(2)
+
+	ctx := appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: "2"})
+	res, err := handler.ListOrgMembers(ctx, &pb.ListOrgMembersReq{OrgId: "org"})
+	require.NoError(t, err)
+	require.Len(t, res.GetMembers(), 2)
+	assert.Equal(t, "owner", res.GetMembers()[0].GetLogin())
+	assert.Equal(t, string(model.OrgRoleOwner), res.GetMembers()[0].GetRole())
+	assert.Equal(t, "reader", res.GetMembers()[1].GetLogin())
+	assert.Equal(t, created, res.GetMembers()[1].GetCreatedAt().AsTime())
+
+	// Участники видны только участникам организации.
+	ctx = appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: "3"})
+	_, err = handler.ListOrgMembers(ctx, &pb.ListOrgMembersReq{OrgId: "org"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
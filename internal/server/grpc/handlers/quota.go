@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// quotaError описывает превышение ограничения объема данных пользователя.
+// Текст ошибки возвращается клиенту.
+type quotaError struct {
+	msg string
+}
+
+// Error возвращает текст ошибки.
+func (e *quotaError) Error() string {
+	return e.msg
+}
+
+// GetUsage возвращает занятый пользователем объем по типам данных и действующие ограничения.
+func (h *GRPCVaultHandler) GetUsage(ctx context.Context, _ *pb.GetUsageReq) (*pb.GetUsageRes, error) {
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	usages, err := h.storage.GetUsage(ctx, user.ID)
+	if err != nil {
+		h.log.WithError(err).Error("Error while getting usage")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	response := &pb.GetUsageRes{
+		Quota: &pb.GetUsageRes_Quota{
+			MaxBytes:    h.quota.MaxBytes,
+			MaxItems:    h.quota.MaxItems,
+			MaxItemSize: h.quota.MaxItemSize,
+			MaxMetaSize: h.quota.MaxMetaSize,
+		},
+	}
+	for _, usage := range usages {
+		response.Usage = append(response.Usage, &pb.GetUsageRes_TypeUsage{
+			Type:  string(usage.Type),
+			Items: usage.Items,
+			Bytes: usage.Bytes,
+		})
+	}
+	return response, nil
+}
+
+// checkItemSize проверяет размер мета данных и данных одного объекта.
+// Возвращает ошибку grpc с кодом ResourceExhausted при превышении ограничения.
+func (h *GRPCVaultHandler) checkItemSize(meta string, size int64) error {
+	if h.quota.MaxMetaSize > 0 && int64(len(meta)) > h.quota.MaxMetaSize {
+		return status.Errorf(codes.ResourceExhausted,
+			"Превышен размер мета данных: максимум %d байт", h.quota.MaxMetaSize)
+	}
+	if h.quota.MaxItemSize > 0 && size > h.quota.MaxItemSize {
+		return status.Errorf(codes.ResourceExhausted,
+			"Превышен размер данных: максимум %d байт", h.quota.MaxItemSize)
+	}
+	return nil
+}
+
+// checkUsage проверяет, что после добавления addItems объектов и addBytes байт
+// данные пользователя не превысят ограничений. Уменьшение объема разрешено всегда.
+// Должна вызываться в одной транзакции с сохранением данных: объем данных пользователя блокируется
+// до конца транзакции, чтобы параллельные запросы не превысили ограничения вместе.
+func (h *GRPCVaultHandler) checkUsage(
+	ctx context.Context, st storage.Storage, userID string, addItems int64, addBytes int64,
+) error {
+	checkItems := h.quota.MaxItems > 0 && addItems > 0
+	checkBytes := h.quota.MaxBytes > 0 && addBytes > 0
+	if !checkItems && !checkBytes {
+		return nil
+	}
+	if err := st.LockUsage(ctx, userID); err != nil {
+		return fmt.Errorf("failed to lock usage: %w", err)
+	}
+	usages, err := st.GetUsage(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get usage: %w", err)
+	}
+	var items, bytes int64
+	for _, usage := range usages {
+		items += usage.Items
+		bytes += usage.Bytes
+	}
+	if checkItems && items+addItems > h.quota.MaxItems {
+		return &quotaError{msg: fmt.Sprintf("Превышено количество данных: максимум %d", h.quota.MaxItems)}
+	}
+	if checkBytes && bytes+addBytes > h.quota.MaxBytes {
+		return &quotaError{msg: fmt.Sprintf("Превышен объем хранилища: максимум %d байт", h.quota.MaxBytes)}
+	}
+	return nil
+}
+
+// createItem сохраняет новые данные, проверяя ограничения количества и объема данных пользователя.
+func (h *GRPCVaultHandler) createItem(ctx context.Context, userID string, item *model.VaultItem) (string, error) {
+	if h.quota.MaxItems <= 0 && h.quota.MaxBytes <= 0 {
+		return h.storage.CreateItem(ctx, userID, item)
+	}
+	var id string
+	err := h.storage.WithTx(ctx, func(tx storage.Storage) error {
+		if err := h.checkUsage(ctx, tx, userID, 1, itemBytes(item)); err != nil {
+			return err
+		}
+		var err error
+		id, err = tx.CreateItem(ctx, userID, item)
+		return err
+	})
+	return id, err
+}
+
+// updateItem обновляет данные, проверяя, что новая версия не превышает объем хранилища пользователя.
+// Предыдущая версия остается в истории и продолжает занимать объем.
+func (h *GRPCVaultHandler) updateItem(ctx context.Context, id string, userID string, item *model.VaultItem) error {
+	if h.quota.MaxBytes <= 0 {
+		return h.storage.UpdateItem(ctx, id, userID, item)
+	}
+	return h.storage.WithTx(ctx, func(tx storage.Storage) error {
+		if err := h.checkUsage(ctx, tx, userID, 0, itemBytes(item)); err != nil {
+			return err
+		}
+		return tx.UpdateItem(ctx, id, userID, item)
+	})
+}
+
+// restoreRevision восстанавливает данные из версии, проверяя, что ее копия
+// не превышает объем хранилища пользователя.
+func (h *GRPCVaultHandler) restoreRevision(ctx context.Context, id string, userID string, revision int64) error {
+	if h.quota.MaxBytes <= 0 {
+		return h.storage.RestoreItemRevision(ctx, id, userID, revision)
+	}
+	return h.storage.WithTx(ctx, func(tx storage.Storage) error {
+		rev, err := tx.GetItemRevision(ctx, id, userID, revision)
+		if err != nil {
+			return err
+		}
+		restored := &model.VaultItem{EncryptMeta: rev.EncryptMeta, Meta: rev.Meta, Size: rev.Size}
+		if err = h.checkUsage(ctx, tx, userID, 0, itemBytes(restored)); err != nil {
+			return err
+		}
+		return tx.RestoreItemRevision(ctx, id, userID, revision)
+	})
+}
+
+// itemBytes возвращает объем, который данные занимают в квоте пользователя.
+// Учитывается размер хранимых мета данных: зашифрованных или сохраненных до включения шифрования.
+func itemBytes(item *model.VaultItem) int64 {
+	return item.Size + int64(len(item.EncryptMeta)) + int64(len(item.Meta))
+}
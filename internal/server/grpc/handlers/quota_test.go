@@ -0,0 +1,254 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/server/config"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAddDataQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	quota := config.QuotaConfig{MaxBytes: 100, MaxItems: 3, MaxItemSize: 50, MaxMetaSize: 20}
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, quota, log.WithField("instance", "grpcTransport"))
+	ctx := appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey})
+	mockStorage.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(tx storage.Storage) error) error {
+			return fn(mockStorage)
+		},
+	).AnyTimes()
+
+	tests := []struct {
+		name     string
+		data     string
+		meta     string
+		usage    []model.TypeUsage
+		usageErr error
+		created  bool
+		errCode  codes.Code
+	}{
+		{
+			name: "Успешный запрос",
+			data: "data",
+			meta: "meta",
+			// Данные и зашифрованные мета данные занимают 4 + 32 байта.
+			usage:   []model.TypeUsage{{Type: model.Text, Items: 2, Bytes: 64}},
+			created: true,
+		},
+		{
+			name:    "Превышен размер мета данных",
+			data:    "data",
+			meta:    strings.Repeat("m", 21),
+			errCode: codes.ResourceExhausted,
+		},
+		{
+			name:    "Превышен размер данных",
+			data:    strings.Repeat("d", 51),
+			meta:    "meta",
+			errCode: codes.ResourceExhausted,
+		},
+		{
+			name: "Превышено количество данных",
+			data: "data",
+			meta: "meta",
+			usage: []model.TypeUsage{
+				{Type: model.Text, Items: 2, Bytes: 10},
+				{Type: model.Password, Items: 1, Bytes: 10},
+			},
+			errCode: codes.ResourceExhausted,
+		},
+		{
+			name:    "Превышен объем хранилища",
+			data:    "data",
+			meta:    "meta",
+			usage:   []model.TypeUsage{{Type: model.Text, Items: 1, Bytes: 65}},
+			errCode: codes.ResourceExhausted,
+		},
+		{
+			name:     "Ошибка БД",
+			data:     "data",
+			meta:     "meta",
+			usageErr: errors.New("db error"),
+			errCode:  codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.usage != nil || tt.usageErr != nil {
+				// Объем данных пользователя блокируется до его получения.
+				gomock.InOrder(
+					mockStorage.EXPECT().LockUsage(gomock.Any(), "1").Return(nil),
+					mockStorage.EXPECT().GetUsage(gomock.Any(), "1").Return(tt.usage, tt.usageErr),
+				)
+			}
+			if tt.created {
+				mockStorage.EXPECT().CreateItem(gomock.Any(), "1", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, item *model.VaultItem) (string, error) {
+						assert.Equal(t, int64(len(tt.data)), item.Size)
+						return "2", nil
+					},
+				)
+			}
+			_, err := handler.AddData(ctx, &pb.AddDataReq{
+				Item: &pb.Item{Data: []byte(tt.data), Type: string(model.Text), Meta: tt.meta},
+			})
+			if tt.created {
+				require.NoError(t, err)
+				return
+			}
+			code, _ := status.FromError(err)
+			assert.Equal(t, tt.errCode, code.Code())
+		})
+	}
+}
+
+func TestUpdateDataQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	quota := config.QuotaConfig{MaxBytes: 100}
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, quota, log.WithField("instance", "grpcTransport"))
+	ctx := appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey})
+	mockStorage.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(tx storage.Storage) error) error {
+			return fn(mockStorage)
+		},
+	).AnyTimes()
+
+	tests := []struct {
+		name    string
+		data    string
+		usage   int64
+		updated bool
+		errCode codes.Code
+	}{
+		{
+			// Новая версия занимает 20 + 32 байта.
+			name:    "Новая версия в пределах объема",
+			data:    strings.Repeat("d", 20),
+			usage:   48,
+			updated: true,
+		},
+		{
+			name:    "Превышен объем хранилища",
+			data:    strings.Repeat("d", 21),
+			usage:   48,
+			errCode: codes.ResourceExhausted,
+		},
+		{
+			// Предыдущая версия остается в истории, поэтому уменьшение данных тоже занимает объем.
+			name:    "Уменьшение данных при заполненном объеме",
+			data:    "d",
+			usage:   92,
+			errCode: codes.ResourceExhausted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Текущие данные читаются для выбора ключа шифрования.
+			mockStorage.EXPECT().GetItem(gomock.Any(), "1", "1").
+				Return(&model.VaultItem{ID: "1", Meta: "meta", Size: 40, Revision: 1}, nil)
+			mockStorage.EXPECT().LockUsage(gomock.Any(), "1").Return(nil).MaxTimes(1)
+			mockStorage.EXPECT().GetUsage(gomock.Any(), "1").
+				Return([]model.TypeUsage{{Type: model.Text, Items: 1, Bytes: tt.usage}}, nil).MaxTimes(1)
+			if tt.updated {
+				mockStorage.EXPECT().UpdateItem(gomock.Any(), "1", "1", gomock.Any()).Return(nil)
+			}
+			_, err := handler.UpdateData(ctx, &pb.UpdateDataReq{Id: "1", Data: []byte(tt.data), Meta: "meta"})
+			if tt.updated {
+				require.NoError(t, err)
+				return
+			}
+			code, _ := status.FromError(err)
+			assert.Equal(t, tt.errCode, code.Code())
+		})
+	}
+}
+
+func TestGetUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	quota := config.QuotaConfig{MaxBytes: 100, MaxItems: 3, MaxItemSize: 50, MaxMetaSize: 20}
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, quota, log.WithField("instance", "grpcTransport"))
+
+	tests := []struct {
+		name    string
+		user    *appCtx.CtxUser
+		usage   []model.TypeUsage
+		err     error
+		errCode codes.Code
+	}{
+		{
+			name:  "Успешный запрос",
+			user:  &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey},
+			usage: []model.TypeUsage{{Type: model.File, Items: 2, Bytes: 30}, {Type: model.Text, Items: 1, Bytes: 5}},
+		},
+		{
+			name:    "Ошибка получения пользователя запроса",
+			errCode: codes.Internal,
+		},
+		{
+			name:    "Ошибка БД",
+			user:    &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey},
+			err:     errors.New("db error"),
+			errCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+				mockStorage.EXPECT().GetUsage(gomock.Any(), tt.user.ID).Return(tt.usage, tt.err)
+			}
+			res, err := handler.GetUsage(ctx, &pb.GetUsageReq{})
+			if tt.errCode != codes.OK {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res.GetUsage(), len(tt.usage))
+			for i, usage := range tt.usage {
+				assert.Equal(t, string(usage.Type), res.GetUsage()[i].GetType())
+				assert.Equal(t, usage.Items, res.GetUsage()[i].GetItems())
+				assert.Equal(t, usage.Bytes, res.GetUsage()[i].GetBytes())
+			}
+			assert.Equal(t, quota.MaxBytes, res.GetQuota().GetMaxBytes())
+			assert.Equal(t, quota.MaxItems, res.GetQuota().GetMaxItems())
+			assert.Equal(t, quota.MaxItemSize, res.GetQuota().GetMaxItemSize())
+			assert.Equal(t, quota.MaxMetaSize, res.GetQuota().GetMaxMetaSize())
+		})
+	}
+}
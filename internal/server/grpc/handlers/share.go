@@ -0,0 +1,445 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errShareFile возвращается при попытке предоставить доступ к файлу.
+var errShareFile = errors.New("sharing files is not supported")
+
+// ShareItem предоставляет другому пользователю доступ к данным (на чтение или на изменение).
+// При первом предоставлении доступа данные переводятся на собственный ключ, который шифруется
+// открытым ключом получателя; ключ пользователя получателю не передается.
+func (h *GRPCVaultHandler) ShareItem(ctx context.Context, in *pb.ShareItemReq) (*pb.ShareItemRes, error) {
+	if in.GetId() == "" || in.GetLogin() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Некорректные входные данные")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	recipient, err := h.storage.GetUserByLogin(ctx, in.GetLogin())
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoUser):
+			return nil, status.Error(codes.NotFound, "Пользователь с таким логином не найден")
+		default:
+			h.log.WithError(err).Error("Error while getting share recipient")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	if recipient.ID == user.ID {
+		return nil, status.Error(codes.InvalidArgument, "Нельзя предоставить доступ к данным самому себе")
+	}
+	if len(recipient.PublicKey) == 0 {
+		return nil, status.Error(codes.FailedPrecondition,
+			"У пользователя еще нет ключей шифрования: ему нужно войти в систему")
+	}
+	access := model.ShareRead
+	if in.GetWrite() {
+		access = model.ShareWrite
+	}
+	err = h.storage.WithTx(ctx, func(tx storage.Storage) error {
+		item, err := tx.GetItem(ctx, in.GetId(), user.ID)
+		if err != nil {
+			return err
+		}
+		key, err := h.itemKey(ctx, tx, user, item)
+		if err != nil {
+			return err
+		}
+		sealed, err := utils.SealKey(key, recipient.PublicKey)
+		if err != nil {
+			return err
+		}
+		return tx.ShareItem(ctx, &model.Share{
+			ItemID:      item.ID,
+			OwnerID:     user.ID,
+			RecipientID: recipient.ID,
+			Access:      access,
+			EncryptKey:  sealed,
+		})
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoData):
+			return nil, status.Error(codes.NotFound, "Данные не найдены")
+		case errors.Is(err, errShareFile):
+			return nil, status.Error(codes.FailedPrecondition, "Совместный доступ к файлам не поддерживается")
+		case errors.Is(err, storage.ErrConflict):
+			return nil, status.Error(codes.Aborted, "Данные были изменены, повторите попытку")
+		default:
+			h.log.WithError(err).Error("Error while sharing item")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	return &pb.ShareItemRes{}, nil
+}
+
+// RevokeShare отзывает предоставленный пользователю доступ к данным. Ключ данных, известный получателю,
+// заменяется новым, который шифруется открытыми ключами оставшихся получателей. Доступ к данным в корзине
+// не отзывается (как и в списке доступов, такой доступ не найден): данные нужно сначала восстановить.
+func (h *GRPCVaultHandler) RevokeShare(ctx context.Context, in *pb.RevokeShareReq) (*pb.RevokeShareRes, error) {
+	if in.GetId() == "" || in.GetLogin() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Некорректные входные данные")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	recipient, err := h.storage.GetUserByLogin(ctx, in.GetLogin())
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoUser):
+			return nil, status.Error(codes.NotFound, "Пользователь с таким логином не найден")
+		default:
+			h.log.WithError(err).Error("Error while getting share recipient")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	err = h.storage.WithTx(ctx, func(tx storage.Storage) error {
+		if err := tx.RevokeShare(ctx, in.GetId(), user.ID, recipient.ID); err != nil {
+			return err
+		}
+		item, err := tx.GetItem(ctx, in.GetId(), user.ID)
+		if err != nil {
+			if errors.Is(err, storage.ErrNoData) {
+				return storage.ErrNoShare
+			}
+			return err
+		}
+		return h.rotateItemKey(ctx, tx, user, item)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoShare):
+			return nil, status.Error(codes.NotFound, "Доступ к данным не найден")
+		case errors.Is(err, storage.ErrConflict):
+			return nil, status.Error(codes.Aborted, "Данные были изменены, повторите попытку")
+		default:
+			h.log.WithError(err).Error("Error while revoking share")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	return &pb.RevokeShareRes{}, nil
+}
+
+// ListShared возвращает данные других пользователей, к которым пользователю открыт доступ,
+// и доступы к своим данным, предоставленные им другим пользователям.
+func (h *GRPCVaultHandler) ListShared(ctx context.Context, _ *pb.ListSharedReq) (*pb.ListSharedRes, error) {
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	shares, err := h.storage.ListShares(ctx, user.ID)
+	if err != nil {
+		h.log.WithError(err).Error("Error while listing shares")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	response := &pb.ListSharedRes{}
+	var account *model.User
+	for _, share := range shares {
+		if share.OwnerID == user.ID {
+			response.SharedByMe = append(response.SharedByMe, &pb.ListSharedRes_Outgoing{
+				Id:        share.ItemID,
+				Login:     share.RecipientLogin,
+				Type:      string(share.ItemType),
+				Write:     share.Access == model.ShareWrite,
+				CreatedAt: timestamppb.New(share.CreatedAt),
+			})
+			continue
+		}
+		if account == nil {
+			if account, err = h.storage.GetUserByID(ctx, user.ID); err != nil {
+				h.log.WithError(err).Error("Error while getting user keys")
+				return nil, status.Error(codes.Internal, "Internal server error")
+			}
+		}
+		meta, err := sharedMeta(account, user, &share)
+		if err != nil {
+			h.log.WithError(err).Error("Error while decrypting shared meta")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+		response.SharedWithMe = append(response.SharedWithMe, &pb.ListSharedRes_Incoming{
+			Id:        share.ItemID,
+			Owner:     share.OwnerLogin,
+			Type:      string(share.ItemType),
+			Meta:      meta,
+			Write:     share.Access == model.ShareWrite,
+			CreatedAt: timestamppb.New(share.CreatedAt),
+		})
+	}
+	return response, nil
+}
+
+// getSharedData возвращает данные другого пользователя, к которым пользователю открыт доступ.
+func (h *GRPCVaultHandler) getSharedData(
+	ctx context.Context, user *appCtx.CtxUser, id string,
+) (*pb.GetDataRes, error) {
+	share, key, err := h.openShare(ctx, user, id)
+	if err != nil {
+		return nil, err
+	}
+	item, err := h.storage.GetItem(ctx, id, share.OwnerID)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoData):
+			return nil, status.Error(codes.NotFound, "Данные не найдены")
+		default:
+			h.log.WithError(err).Error("Error while getting shared item")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	data, err := utils.Decrypt(item.EncryptData, key)
+	if err != nil {
+		h.log.WithError(err).Error("Error while decrypting shared data")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	meta, err := utils.Decrypt(item.SharedMeta, key)
+	if err != nil {
+		h.log.WithError(err).Error("Error while decrypting shared meta")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	return &pb.GetDataRes{
+		Id: item.ID,
+		Item: &pb.Item{
+			Data: data,
+			Type: string(item.Type),
+			Meta: string(meta),
+		},
+		Revision:  item.Revision,
+		ExpiresAt: toTimestamp(item.ExpiresAt),
+	}, nil
+}
+
+// updateSharedData изменяет данные другого пользователя, предоставившего доступ на изменение.
+// Изменения учитываются в квоте владельца, мета данные шифруются его ключом.
+func (h *GRPCVaultHandler) updateSharedData(
+	ctx context.Context, user *appCtx.CtxUser, in *pb.UpdateDataReq,
+) (*pb.UpdateDataRes, error) {
+	share, key, err := h.openShare(ctx, user, in.GetId())
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "Данные для обновления не найдены")
+		}
+		return nil, err
+	}
+	if share.Access != model.ShareWrite {
+		return nil, status.Error(codes.PermissionDenied, "Доступ к данным предоставлен только на чтение")
+	}
+	if in.GetExpiresAt() != nil {
+		return nil, status.Error(codes.PermissionDenied, "Срок хранения данных может изменить только их владелец")
+	}
+	owner, err := h.storage.GetUserByID(ctx, share.OwnerID)
+	if err != nil {
+		h.log.WithError(err).Error("Error while getting item owner")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	ownerSecret, err := utils.DecryptUserSecret(owner.EncryptedSecret, h.masterKey)
+	if err != nil {
+		h.log.WithError(err).Error("Error while decrypting item owner secret")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	current, err := h.storage.GetItem(ctx, in.GetId(), owner.ID)
+	if err != nil {
+		return nil, h.updateDataError(err)
+	}
+	item, err := encryptItem(in, key, ownerSecret, current.EncryptKey)
+	if err != nil {
+		h.log.WithError(err).Error("Error while encrypting shared data")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	item.UserID = owner.ID
+	if err = h.updateItem(ctx, in.GetId(), owner.ID, item); err != nil {
+		return nil, h.updateDataError(err)
+	}
+	return &pb.UpdateDataRes{Revision: item.Revision}, nil
+}
+
+// openShare возвращает доступ пользователя к данным другого пользователя и расшифрованный ключ данных.
+// Ошибки возвращаются в виде ошибок grpc.
+func (h *GRPCVaultHandler) openShare(
+	ctx context.Context, user *appCtx.CtxUser, id string,
+) (*model.Share, string, error) {
+	share, err := h.storage.GetShare(ctx, id, user.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoShare):
+			return nil, "", status.Error(codes.NotFound, "Данные не найдены")
+		default:
+			h.log.WithError(err).Error("Error while getting share")
+			return nil, "", status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	account, err := h.storage.GetUserByID(ctx, user.ID)
+	if err != nil {
+		h.log.WithError(err).Error("Error while getting user keys")
+		return nil, "", status.Error(codes.Internal, "Internal server error")
+	}
+	key, err := utils.OpenKey(share.EncryptKey, account.EncryptPrivateKey, user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while opening shared item key")
+		return nil, "", status.Error(codes.Internal, "Internal server error")
+	}
+	return share, key, nil
+}
+
+// itemKey возвращает собственный ключ данных владельца, при необходимости переводя данные на него.
+// Должна вызываться в транзакции tx.
+func (h *GRPCVaultHandler) itemKey(
+	ctx context.Context, tx storage.Storage, user *appCtx.CtxUser, item *model.VaultItem,
+) (string, error) {
+	if item.Type == model.File || item.Chunked {
+		return "", errShareFile
+	}
+	if item.EncryptKey != nil {
+		return utils.DecryptItemKey(item.EncryptKey, user.Secret)
+	}
+	return h.newItemKey(ctx, tx, user, item, user.Secret)
+}
+
+// rotateItemKey заменяет ключ данных новым и шифрует его открытыми ключами получателей,
+// у которых остался доступ к данным. Должна вызываться в транзакции tx.
+func (h *GRPCVaultHandler) rotateItemKey(
+	ctx context.Context, tx storage.Storage, user *appCtx.CtxUser, item *model.VaultItem,
+) error {
+	if item.EncryptKey == nil {
+		return nil
+	}
+	oldKey, err := utils.DecryptItemKey(item.EncryptKey, user.Secret)
+	if err != nil {
+		return err
+	}
+	key, err := h.newItemKey(ctx, tx, user, item, oldKey)
+	if err != nil {
+		return err
+	}
+	shares, err := tx.ListShares(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	for _, share := range shares {
+		if share.OwnerID != user.ID || share.ItemID != item.ID {
+			continue
+		}
+		recipient, err := tx.GetUserByID(ctx, share.RecipientID)
+		if err != nil {
+			return err
+		}
+		sealed, err := utils.SealKey(key, recipient.PublicKey)
+		if err != nil {
+			return err
+		}
+		err = tx.ShareItem(ctx, &model.Share{
+			ItemID:      item.ID,
+			OwnerID:     user.ID,
+			RecipientID: share.RecipientID,
+			Access:      share.Access,
+			EncryptKey:  sealed,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newItemKey переводит данные на новый собственный ключ: содержимое текущей и всех предыдущих версий,
+// зашифрованное ключом oldKey, перешифровывается новым ключом, мета данные шифруются им для пользователей
+// с доступом к данным. Должна вызываться в транзакции tx.
+func (h *GRPCVaultHandler) newItemKey(
+	ctx context.Context, tx storage.Storage, user *appCtx.CtxUser, item *model.VaultItem, oldKey string,
+) (string, error) {
+	key, encKey, err := utils.GenerateItemKey(user.Secret)
+	if err != nil {
+		return "", err
+	}
+	itemKey := &model.ItemKey{EncryptKey: encKey, PrevKey: item.EncryptKey, Revision: item.Revision}
+	itemKey.EncryptData, itemKey.SharedMeta, err = reencrypt(item.EncryptData, item.EncryptMeta, item.Meta,
+		oldKey, user.Secret, key)
+	if err != nil {
+		return "", err
+	}
+	history, err := tx.GetItemHistory(ctx, item.ID, user.ID)
+	if err != nil {
+		return "", err
+	}
+	for _, info := range history {
+		rev, err := tx.GetItemRevision(ctx, item.ID, user.ID, info.Revision)
+		if err != nil {
+			return "", err
+		}
+		if rev.Chunked {
+			return "", errShareFile
+		}
+		revKey := model.ItemKeyRevision{Revision: rev.Revision}
+		revKey.EncryptData, revKey.SharedMeta, err = reencrypt(rev.EncryptData, rev.EncryptMeta, rev.Meta,
+			oldKey, user.Secret, key)
+		if err != nil {
+			return "", err
+		}
+		itemKey.History = append(itemKey.History, revKey)
+	}
+	if err = tx.SetItemKey(ctx, item.ID, user.ID, itemKey); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// reencrypt перешифровывает содержимое данных с ключа oldKey на ключ данных key
+// и шифрует ключом данных их мета данные, зашифрованные ключом пользователя secret.
+func reencrypt(
+	encData []byte, encMeta []byte, legacyMeta string, oldKey string, secret string, key string,
+) ([]byte, []byte, error) {
+	data, err := utils.Decrypt(encData, oldKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	meta, err := utils.DecryptMeta(encMeta, legacyMeta, secret)
+	if err != nil {
+		return nil, nil, err
+	}
+	if encData, err = utils.Encrypt(data, key); err != nil {
+		return nil, nil, err
+	}
+	sharedMeta, err := utils.Encrypt([]byte(meta), key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encData, sharedMeta, nil
+}
+
+// dataKey возвращает ключ, которым зашифровано содержимое данных пользователя:
+// собственный ключ данных, если к ним предоставлялся доступ, иначе ключ пользователя.
+func dataKey(user *appCtx.CtxUser, item *model.VaultItem) (string, error) {
+	if item.EncryptKey == nil {
+		return user.Secret, nil
+	}
+	return utils.DecryptItemKey(item.EncryptKey, user.Secret)
+}
+
+// sharedMeta расшифровывает мета данные из доступа, предоставленного пользователю account.
+func sharedMeta(account *model.User, user *appCtx.CtxUser, share *model.Share) (string, error) {
+	key, err := utils.OpenKey(share.EncryptKey, account.EncryptPrivateKey, user.Secret)
+	if err != nil {
+		return "", err
+	}
+	meta, err := utils.Decrypt(share.SharedMeta, key)
+	if err != nil {
+		return "", err
+	}
+	return string(meta), nil
+}
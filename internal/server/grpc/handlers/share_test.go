@@ -0,0 +1,598 @@
+package handlers
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/server/config"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// shareUser создает пользователя с ключом, зашифрованным мастер ключом, и парой ключей
+// для совместного доступа. Возвращает пользователя запроса и пользователя хранилища.
+func shareUser(t *testing.T, id string, login string, masterKey string) (*appCtx.CtxUser, *model.User) {
+	t.Helper()
+	secretB, err := utils.GenerateUserKey()
+	require.NoError(t, err)
+	encSecret, err := utils.Encrypt(secretB, masterKey)
+	require.NoError(t, err)
+	secret := hex.EncodeToString(secretB)
+	publicKey, encPrivateKey, err := utils.GenerateKeyPair(secret)
+	require.NoError(t, err)
+	return &appCtx.CtxUser{ID: id, Login: login, Secret: secret}, &model.User{
+		ID:                id,
+		Login:             login,
+		EncryptedSecret:   hex.EncodeToString(encSecret),
+		PublicKey:         publicKey,
+		EncryptPrivateKey: encPrivateKey,
+	}
+}
+
+// encrypt шифрует данные ключом key.
+func encrypt(t *testing.T, data string, key string) []byte {
+	t.Helper()
+	enc, err := utils.Encrypt([]byte(data), key)
+	require.NoError(t, err)
+	return enc
+}
+
+// decrypt расшифровывает данные ключом key.
+func decrypt(t *testing.T, enc []byte, key string) string {
+	t.Helper()
+	data, err := utils.Decrypt(enc, key)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestShareItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	owner, ownerAccount := shareUser(t, "1", "owner", masterKey)
+	_, recipient := shareUser(t, "2", "recipient", masterKey)
+	mockStorage.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(tx storage.Storage) error) error {
+			return fn(mockStorage)
+		},
+	).AnyTimes()
+
+	tests := []struct {
+		name      string
+		user      *appCtx.CtxUser
+		request   *pb.ShareItemReq
+		recipient *model.User
+		loginErr  error
+		item      *model.VaultItem
+		itemErr   error
+		errCode   codes.Code
+	}{
+		{
+			name:    "Нет логина получателя",
+			user:    owner,
+			request: &pb.ShareItemReq{Id: "1"},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Ошибка получения пользователя запроса",
+			request: &pb.ShareItemReq{Id: "1", Login: "recipient"},
+			errCode: codes.Internal,
+		},
+		{
+			name:     "Получатель не найден",
+			user:     owner,
+			request:  &pb.ShareItemReq{Id: "1", Login: "unknown"},
+			loginErr: storage.ErrNoUser,
+			errCode:  codes.NotFound,
+		},
+		{
+			name:      "Доступ самому себе",
+			user:      owner,
+			request:   &pb.ShareItemReq{Id: "1", Login: "owner"},
+			recipient: ownerAccount,
+			errCode:   codes.InvalidArgument,
+		},
+		{
+			name:      "У получателя нет ключей",
+			user:      owner,
+			request:   &pb.ShareItemReq{Id: "1", Login: "recipient"},
+			recipient: &model.User{ID: "2", Login: "recipient"},
+			errCode:   codes.FailedPrecondition,
+		},
+		{
+			name:      "Данные не найдены",
+			user:      owner,
+			request:   &pb.ShareItemReq{Id: "1", Login: "recipient"},
+			recipient: recipient,
+			itemErr:   storage.ErrNoData,
+			errCode:   codes.NotFound,
+		},
+		{
+			name:      "Файл",
+			user:      owner,
+			request:   &pb.ShareItemReq{Id: "1", Login: "recipient"},
+			recipient: recipient,
+			item:      &model.VaultItem{ID: "1", Type: model.File, Chunked: true},
+			errCode:   codes.FailedPrecondition,
+		},
+		{
+			name:      "Ошибка БД",
+			user:      owner,
+			request:   &pb.ShareItemReq{Id: "1", Login: "recipient"},
+			recipient: recipient,
+			itemErr:   errors.New("db error"),
+			errCode:   codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.recipient != nil || tt.loginErr != nil {
+				mockStorage.EXPECT().GetUserByLogin(gomock.Any(), tt.request.GetLogin()).Return(tt.recipient, tt.loginErr)
+			}
+			if tt.item != nil || tt.itemErr != nil {
+				mockStorage.EXPECT().GetItem(gomock.Any(), tt.request.GetId(), tt.user.ID).Return(tt.item, tt.itemErr)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			_, err := handler.ShareItem(ctx, tt.request)
+			assert.Equal(t, tt.errCode, status.Code(err))
+		})
+	}
+}
+
+func TestShareItemKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	owner, _ := shareUser(t, "1", "owner", masterKey)
+	recipientUser, recipient := shareUser(t, "2", "recipient", masterKey)
+	ctx := appCtx.CtxWithUser(context.Background(), owner)
+	mockStorage.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(tx storage.Storage) error) error {
+			return fn(mockStorage)
+		},
+	).AnyTimes()
+	mockStorage.EXPECT().GetUserByLogin(gomock.Any(), "recipient").Return(recipient, nil).AnyTimes()
+
+	encMeta, metaIndex, err := utils.EncryptMeta(`{"resource":"wifi"}`, owner.Secret)
+	require.NoError(t, err)
+	item := &model.VaultItem{
+		ID:          "1",
+		Type:        model.Password,
+		EncryptData: encrypt(t, "password 2", owner.Secret),
+		EncryptMeta: encMeta,
+		MetaIndex:   metaIndex,
+		Revision:    2,
+	}
+
+	// Первое предоставление доступа переводит текущую и предыдущие версии на собственный ключ данных.
+	var itemKey *model.ItemKey
+	var share *model.Share
+	mockStorage.EXPECT().GetItem(gomock.Any(), "1", owner.ID).Return(item, nil)
+	mockStorage.EXPECT().GetItemHistory(gomock.Any(), "1", owner.ID).
+		Return([]model.VaultItemRevision{{ItemID: "1", Revision: 1}}, nil)
+	mockStorage.EXPECT().GetItemRevision(gomock.Any(), "1", owner.ID, int64(1)).Return(&model.VaultItemRevision{
+		ItemID:      "1",
+		Revision:    1,
+		EncryptData: encrypt(t, "password 1", owner.Secret),
+		Meta:        `{"resource":"old"}`,
+	}, nil)
+	mockStorage.EXPECT().SetItemKey(gomock.Any(), "1", owner.ID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ string, key *model.ItemKey) error {
+			itemKey = key
+			return nil
+		},
+	)
+	mockStorage.EXPECT().ShareItem(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, s *model.Share) error {
+			share = s
+			return nil
+		},
+	).Times(2)
+
+	_, err = handler.ShareItem(ctx, &pb.ShareItemReq{Id: "1", Login: "recipient"})
+	require.NoError(t, err)
+	require.NotNil(t, itemKey)
+	key, err := utils.DecryptItemKey(itemKey.EncryptKey, owner.Secret)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), itemKey.Revision)
+	assert.Equal(t, "password 2", decrypt(t, itemKey.EncryptData, key))
+	assert.JSONEq(t, `{"resource":"wifi"}`, decrypt(t, itemKey.SharedMeta, key))
+	require.Len(t, itemKey.History, 1)
+	assert.Equal(t, "password 1", decrypt(t, itemKey.History[0].EncryptData, key))
+	assert.JSONEq(t, `{"resource":"old"}`, decrypt(t, itemKey.History[0].SharedMeta, key))
+
+	// Получатель открывает ключ данных своим закрытым ключом.
+	assert.Equal(t, owner.ID, share.OwnerID)
+	assert.Equal(t, recipient.ID, share.RecipientID)
+	assert.Equal(t, model.ShareRead, share.Access)
+	opened, err := utils.OpenKey(share.EncryptKey, recipient.EncryptPrivateKey, recipientUser.Secret)
+	require.NoError(t, err)
+	assert.Equal(t, key, opened)
+
+	// Данные уже на собственном ключе: повторно не перешифровываются.
+	item.EncryptKey = itemKey.EncryptKey
+	mockStorage.EXPECT().GetItem(gomock.Any(), "1", owner.ID).Return(item, nil)
+	_, err = handler.ShareItem(ctx, &pb.ShareItemReq{Id: "1", Login: "recipient", Write: true})
+	require.NoError(t, err)
+	assert.Equal(t, model.ShareWrite, share.Access)
+	opened, err = utils.OpenKey(share.EncryptKey, recipient.EncryptPrivateKey, recipientUser.Secret)
+	require.NoError(t, err)
+	assert.Equal(t, key, opened)
+}
+
+func TestRevokeShareKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	owner, _ := shareUser(t, "1", "owner", masterKey)
+	recipientUser, recipient := shareUser(t, "2", "recipient", masterKey)
+	_, revoked := shareUser(t, "3", "revoked", masterKey)
+	ctx := appCtx.CtxWithUser(context.Background(), owner)
+	mockStorage.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(tx storage.Storage) error) error {
+			return fn(mockStorage)
+		},
+	)
+
+	oldKey, encKey, err := utils.GenerateItemKey(owner.Secret)
+	require.NoError(t, err)
+	encMeta, metaIndex, err := utils.EncryptMeta(`{"resource":"wifi"}`, owner.Secret)
+	require.NoError(t, err)
+	item := &model.VaultItem{
+		ID:          "1",
+		Type:        model.Password,
+		EncryptData: encrypt(t, "password 2", oldKey),
+		EncryptMeta: encMeta,
+		MetaIndex:   metaIndex,
+		EncryptKey:  encKey,
+		SharedMeta:  encrypt(t, `{"resource":"wifi"}`, oldKey),
+		Revision:    2,
+	}
+
+	// Отзыв доступа заменяет ключ данных, известный получателю, новым.
+	var itemKey *model.ItemKey
+	var share *model.Share
+	mockStorage.EXPECT().GetUserByLogin(gomock.Any(), "revoked").Return(revoked, nil)
+	mockStorage.EXPECT().RevokeShare(gomock.Any(), "1", owner.ID, revoked.ID).Return(nil)
+	mockStorage.EXPECT().GetItem(gomock.Any(), "1", owner.ID).Return(item, nil)
+	mockStorage.EXPECT().GetItemHistory(gomock.Any(), "1", owner.ID).
+		Return([]model.VaultItemRevision{{ItemID: "1", Revision: 1}}, nil)
+	mockStorage.EXPECT().GetItemRevision(gomock.Any(), "1", owner.ID, int64(1)).Return(&model.VaultItemRevision{
+		ItemID:      "1",
+		Revision:    1,
+		EncryptData: encrypt(t, "password 1", oldKey),
+		Meta:        `{"resource":"old"}`,
+	}, nil)
+	mockStorage.EXPECT().SetItemKey(gomock.Any(), "1", owner.ID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ string, key *model.ItemKey) error {
+			itemKey = key
+			return nil
+		},
+	)
+	// Новый ключ шифруется только для оставшихся получателей этих данных.
+	mockStorage.EXPECT().ListShares(gomock.Any(), owner.ID).Return([]model.Share{
+		{ItemID: "1", OwnerID: owner.ID, RecipientID: recipient.ID, Access: model.ShareWrite},
+		{ItemID: "2", OwnerID: owner.ID, RecipientID: recipient.ID, Access: model.ShareRead},
+		{ItemID: "1", OwnerID: "4", RecipientID: owner.ID, Access: model.ShareRead},
+	}, nil)
+	mockStorage.EXPECT().GetUserByID(gomock.Any(), recipient.ID).Return(recipient, nil)
+	mockStorage.EXPECT().ShareItem(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, s *model.Share) error {
+			share = s
+			return nil
+		},
+	)
+
+	_, err = handler.RevokeShare(ctx, &pb.RevokeShareReq{Id: "1", Login: "revoked"})
+	require.NoError(t, err)
+	require.NotNil(t, itemKey)
+	assert.Equal(t, encKey, itemKey.PrevKey)
+	key, err := utils.DecryptItemKey(itemKey.EncryptKey, owner.Secret)
+	require.NoError(t, err)
+	assert.NotEqual(t, oldKey, key)
+	assert.Equal(t, int64(2), itemKey.Revision)
+	assert.Equal(t, "password 2", decrypt(t, itemKey.EncryptData, key))
+	assert.JSONEq(t, `{"resource":"wifi"}`, decrypt(t, itemKey.SharedMeta, key))
+	require.Len(t, itemKey.History, 1)
+	assert.Equal(t, "password 1", decrypt(t, itemKey.History[0].EncryptData, key))
+	assert.JSONEq(t, `{"resource":"old"}`, decrypt(t, itemKey.History[0].SharedMeta, key))
+	_, err = utils.Decrypt(itemKey.EncryptData, oldKey)
+	assert.Error(t, err)
+
+	require.NotNil(t, share)
+	assert.Equal(t, "1", share.ItemID)
+	assert.Equal(t, recipient.ID, share.RecipientID)
+	assert.Equal(t, model.ShareWrite, share.Access)
+	opened, err := utils.OpenKey(share.EncryptKey, recipient.EncryptPrivateKey, recipientUser.Secret)
+	require.NoError(t, err)
+	assert.Equal(t, key, opened)
+}
+
+func TestSharedData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	owner, ownerAccount := shareUser(t, "1", "owner", masterKey)
+	recipientUser, recipient := shareUser(t, "2", "recipient", masterKey)
+	ctx := appCtx.CtxWithUser(context.Background(), recipientUser)
+
+	key, encKey, err := utils.GenerateItemKey(owner.Secret)
+	require.NoError(t, err)
+	sealed, err := utils.SealKey(key, recipient.PublicKey)
+	require.NoError(t, err)
+	item := &model.VaultItem{
+		ID:          "1",
+		UserID:      owner.ID,
+		Type:        model.Text,
+		EncryptData: encrypt(t, "wifi password", key),
+		EncryptKey:  encKey,
+		SharedMeta:  encrypt(t, `{"name":"wifi"}`, key),
+		Revision:    3,
+	}
+	mockStorage.EXPECT().GetItem(gomock.Any(), "1", recipient.ID).Return(nil, storage.ErrNoData).AnyTimes()
+	mockStorage.EXPECT().GetItem(gomock.Any(), "1", owner.ID).Return(item, nil).AnyTimes()
+	mockStorage.EXPECT().ListAttachments(gomock.Any(), "1", owner.ID).Return(nil, nil).AnyTimes()
+	mockStorage.EXPECT().GetUserByID(gomock.Any(), recipient.ID).Return(recipient, nil).AnyTimes()
+	mockStorage.EXPECT().GetUserByID(gomock.Any(), owner.ID).Return(ownerAccount, nil).AnyTimes()
+
+	t.Run("Чтение чужих данных", func(t *testing.T) {
+		mockStorage.EXPECT().GetShare(gomock.Any(), "1", recipient.ID).Return(&model.Share{
+			ItemID: "1", OwnerID: owner.ID, RecipientID: recipient.ID, Access: model.ShareRead, EncryptKey: sealed,
+		}, nil)
+		res, err := handler.GetData(ctx, &pb.GetDataReq{Id: "1"})
+		require.NoError(t, err)
+		assert.Equal(t, []byte("wifi password"), res.GetItem().GetData())
+		assert.Equal(t, `{"name":"wifi"}`, res.GetItem().GetMeta())
+		assert.Equal(t, int64(3), res.GetRevision())
+	})
+
+	t.Run("Изменение при доступе только на чтение", func(t *testing.T) {
+		mockStorage.EXPECT().GetShare(gomock.Any(), "1", recipient.ID).Return(&model.Share{
+			ItemID: "1", OwnerID: owner.ID, RecipientID: recipient.ID, Access: model.ShareRead, EncryptKey: sealed,
+		}, nil)
+		_, err := handler.UpdateData(ctx, &pb.UpdateDataReq{Id: "1", Data: []byte("new"), Meta: `{"name":"wifi"}`})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Изменение чужих данных", func(t *testing.T) {
+		mockStorage.EXPECT().GetShare(gomock.Any(), "1", recipient.ID).Return(&model.Share{
+			ItemID: "1", OwnerID: owner.ID, RecipientID: recipient.ID, Access: model.ShareWrite, EncryptKey: sealed,
+		}, nil)
+		mockStorage.EXPECT().UpdateItem(gomock.Any(), "1", owner.ID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ string, updated *model.VaultItem) error {
+				// Содержимое шифруется ключом данных, мета данные владельца - его ключом.
+				assert.Equal(t, "new password", decrypt(t, updated.EncryptData, key))
+				assert.Equal(t, `{"name":"home wifi"}`, decrypt(t, updated.SharedMeta, key))
+				meta, err := utils.DecryptMeta(updated.EncryptMeta, updated.Meta, owner.Secret)
+				require.NoError(t, err)
+				assert.Equal(t, `{"name":"home wifi"}`, meta)
+				assert.Equal(t, encKey, updated.EncryptKey)
+				assert.Equal(t, int64(3), updated.Revision)
+				updated.Revision = 4
+				return nil
+			},
+		)
+		res, err := handler.UpdateData(ctx, &pb.UpdateDataReq{
+			Id: "1", Data: []byte("new password"), Meta: `{"name":"home wifi"}`, Revision: 3,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), res.GetRevision())
+	})
+
+	t.Run("Изменение срока хранения чужих данных", func(t *testing.T) {
+		mockStorage.EXPECT().GetShare(gomock.Any(), "1", recipient.ID).Return(&model.Share{
+			ItemID: "1", OwnerID: owner.ID, RecipientID: recipient.ID, Access: model.ShareWrite, EncryptKey: sealed,
+		}, nil)
+		_, err := handler.UpdateData(ctx, &pb.UpdateDataReq{
+			Id: "1", Data: []byte("new"), Meta: `{"name":"wifi"}`, ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("Нет доступа", func(t *testing.T) {
+		mockStorage.EXPECT().GetShare(gomock.Any(), "1", recipient.ID).Return(nil, storage.ErrNoShare).Times(2)
+		_, err := handler.GetData(ctx, &pb.GetDataReq{Id: "1"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = handler.UpdateData(ctx, &pb.UpdateDataReq{Id: "1", Data: []byte("new")})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Владелец читает данные на собственном ключе", func(t *testing.T) {
+		// Учитывается только получение своих данных, чтение получателем не меняет статистику владельца.
+		mockStorage.EXPECT().RecordAccess(gomock.Any(), "1", owner.ID, gomock.Any()).Return(nil)
+		res, err := handler.GetData(appCtx.CtxWithUser(context.Background(), owner), &pb.GetDataReq{Id: "1"})
+		require.NoError(t, err)
+		assert.Equal(t, []byte("wifi password"), res.GetItem().GetData())
+	})
+}
+
+func TestRevokeShare(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{ID: "1", Login: "owner", Secret: masterKey}
+	mockStorage.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(tx storage.Storage) error) error {
+			return fn(mockStorage)
+		},
+	).AnyTimes()
+
+	tests := []struct {
+		name     string
+		user     *appCtx.CtxUser
+		request  *pb.RevokeShareReq
+		loginErr error
+		callDB   bool
+		storeErr error
+		itemErr  error // Ошибка получения данных после отзыва доступа.
+		errCode  codes.Code
+	}{
+		{
+			name:    "Успешный запрос",
+			user:    user,
+			request: &pb.RevokeShareReq{Id: "1", Login: "recipient"},
+			callDB:  true,
+			errCode: codes.OK,
+		},
+		{
+			name:    "Нет id данных",
+			user:    user,
+			request: &pb.RevokeShareReq{Login: "recipient"},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Получатель не найден",
+			user:     user,
+			request:  &pb.RevokeShareReq{Id: "1", Login: "unknown"},
+			loginErr: storage.ErrNoUser,
+			errCode:  codes.NotFound,
+		},
+		{
+			name:     "Доступ не найден",
+			user:     user,
+			request:  &pb.RevokeShareReq{Id: "1", Login: "recipient"},
+			callDB:   true,
+			storeErr: storage.ErrNoShare,
+			errCode:  codes.NotFound,
+		},
+		{
+			name:    "Данные в корзине",
+			user:    user,
+			request: &pb.RevokeShareReq{Id: "1", Login: "recipient"},
+			callDB:  true,
+			itemErr: storage.ErrNoData,
+			errCode: codes.NotFound,
+		},
+		{
+			name:    "Данные изменены во время отзыва доступа",
+			user:    user,
+			request: &pb.RevokeShareReq{Id: "1", Login: "recipient"},
+			callDB:  true,
+			itemErr: storage.ErrConflict,
+			errCode: codes.Aborted,
+		},
+		{
+			name:     "Ошибка БД",
+			user:     user,
+			request:  &pb.RevokeShareReq{Id: "1", Login: "recipient"},
+			callDB:   true,
+			storeErr: errors.New("db error"),
+			errCode:  codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.callDB || tt.loginErr != nil {
+				var recipient *model.User
+				if tt.loginErr == nil {
+					recipient = &model.User{ID: "2", Login: tt.request.GetLogin()}
+				}
+				mockStorage.EXPECT().GetUserByLogin(gomock.Any(), tt.request.GetLogin()).Return(recipient, tt.loginErr)
+			}
+			if tt.callDB {
+				mockStorage.EXPECT().RevokeShare(gomock.Any(), tt.request.GetId(), tt.user.ID, "2").Return(tt.storeErr)
+			}
+			if tt.callDB && tt.storeErr == nil {
+				// Данные без собственного ключа: ключ не заменяется.
+				var item *model.VaultItem
+				if tt.itemErr == nil {
+					item = &model.VaultItem{ID: tt.request.GetId(), Type: model.Text}
+				}
+				mockStorage.EXPECT().GetItem(gomock.Any(), tt.request.GetId(), tt.user.ID).Return(item, tt.itemErr)
+			}
+
+			_, err := handler.RevokeShare(appCtx.CtxWithUser(context.Background(), tt.user), tt.request)
+			assert.Equal(t, tt.errCode, status.Code(err))
+		})
+	}
+}
+
+func TestListShared(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user, account := shareUser(t, "1", "user", masterKey)
+
+	key, _, err := utils.GenerateItemKey(masterKey)
+	require.NoError(t, err)
+	sealed, err := utils.SealKey(key, account.PublicKey)
+	require.NoError(t, err)
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	mockStorage.EXPECT().ListShares(gomock.Any(), user.ID).Return([]model.Share{
+		{
+			ItemID: "10", OwnerID: user.ID, OwnerLogin: "user", RecipientID: "2", RecipientLogin: "friend",
+			Access: model.ShareWrite, ItemType: model.Password, CreatedAt: created,
+		},
+		{
+			ItemID: "20", OwnerID: "3", OwnerLogin: "family", RecipientID: user.ID, RecipientLogin: "user",
+			Access: model.ShareRead, EncryptKey: sealed, ItemType: model.BankCard, CreatedAt: created,
+			SharedMeta: encrypt(t, `{"bank":"bank"}`, key),
+		},
+	}, nil)
+	mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(account, nil)
+
+	res, err := handler.ListShared(appCtx.CtxWithUser(context.Background(), user), &pb.ListSharedReq{})
+	require.NoError(t, err)
+	require.Len(t, res.GetSharedByMe(), 1)
+	assert.Equal(t, "10", res.GetSharedByMe()[0].GetId())
+	assert.Equal(t, "friend", res.GetSharedByMe()[0].GetLogin())
+	assert.True(t, res.GetSharedByMe()[0].GetWrite())
+	assert.Equal(t, created, res.GetSharedByMe()[0].GetCreatedAt().AsTime())
+	require.Len(t, res.GetSharedWithMe(), 1)
+	assert.Equal(t, "20", res.GetSharedWithMe()[0].GetId())
+	assert.Equal(t, "family", res.GetSharedWithMe()[0].GetOwner())
+	assert.Equal(t, string(model.BankCard), res.GetSharedWithMe()[0].GetType())
+	assert.Equal(t, `{"bank":"bank"}`, res.GetSharedWithMe()[0].GetMeta())
+	assert.False(t, res.GetSharedWithMe()[0].GetWrite())
+}
@@ -4,16 +4,20 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/pinbrain/gophkeeper/internal/model"
 	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
 	"github.com/pinbrain/gophkeeper/internal/server/jwt"
 	"github.com/pinbrain/gophkeeper/internal/server/utils"
 	"github.com/pinbrain/gophkeeper/internal/storage"
-	"github.com/pinbrain/gophkeeper/internal/storage/postgres"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // GRPCUserHandler определяет структуру обработчика grpc запросов в части работы с пользователями.
@@ -21,17 +25,19 @@ type GRPCUserHandler struct {
 	pb.UnimplementedUserServiceServer
 	masterKey  string
 	storage    storage.Storage
+	blobs      blob.Store // Хранилище блоков файлов, удаляемых вместе с аккаунтом.
 	jwtService jwt.ServiceI
 	log        *logrus.Entry
 }
 
 // NewGRPCUserHandler создает и возвращает новый обработчик grpc запросов в части работы с пользователями.
 func NewGRPCUserHandler(
-	masterKey string, storage storage.Storage, jwtService jwt.ServiceI, log *logrus.Entry,
+	masterKey string, storage storage.Storage, blobs blob.Store, jwtService jwt.ServiceI, log *logrus.Entry,
 ) *GRPCUserHandler {
 	return &GRPCUserHandler{
 		masterKey:  masterKey,
 		storage:    storage,
+		blobs:      blobs,
 		jwtService: jwtService,
 		log:        log,
 	}
@@ -42,6 +48,10 @@ func (h *GRPCUserHandler) Register(ctx context.Context, in *pb.RegisterReq) (*pb
 	if in.GetLogin() == "" || in.GetPassword() == "" {
 		return nil, status.Error(codes.InvalidArgument, "Некорректные входные данные")
 	}
+	if strings.HasPrefix(strings.ToLower(in.GetLogin()), model.OrgLoginPrefix) {
+		// Такие логины занимают аккаунты организаций.
+		return nil, status.Error(codes.InvalidArgument, "Недопустимый логин")
+	}
 	passwordHash, err := utils.GeneratePasswordHash(in.GetPassword())
 	if err != nil {
 		h.log.WithError(err).Error("Error while creating new user - failed to generate password hash")
@@ -58,16 +68,23 @@ func (h *GRPCUserHandler) Register(ctx context.Context, in *pb.RegisterReq) (*pb
 		h.log.WithError(err).Error("Error while creating new user - failed to encrypt user secret key")
 		return nil, status.Error(codes.Internal, "Internal server error")
 	}
+	publicKey, encPrivateKey, err := utils.GenerateKeyPair(hex.EncodeToString(secretKey))
+	if err != nil {
+		h.log.WithError(err).Error("Error while creating new user - failed to generate user key pair")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
 
 	user := &model.User{
-		Login:           in.GetLogin(),
-		PasswordHash:    passwordHash,
-		EncryptedSecret: hex.EncodeToString(encSecretKey),
+		Login:             in.GetLogin(),
+		PasswordHash:      passwordHash,
+		EncryptedSecret:   hex.EncodeToString(encSecretKey),
+		PublicKey:         publicKey,
+		EncryptPrivateKey: encPrivateKey,
 	}
 	id, err := h.storage.CreateUser(ctx, user)
 	if err != nil {
 		switch {
-		case errors.Is(err, postgres.ErrLoginTaken):
+		case errors.Is(err, storage.ErrLoginTaken):
 			return nil, status.Error(codes.AlreadyExists, "Пользователь с таким логином уже существует")
 		default:
 			h.log.WithError(err).Error("Error while creating new user - failed to save user in DB")
@@ -95,7 +112,7 @@ func (h *GRPCUserHandler) Login(ctx context.Context, in *pb.LoginReq) (*pb.Login
 	user, err := h.storage.GetUserByLogin(ctx, in.GetLogin())
 	if err != nil {
 		switch {
-		case errors.Is(err, postgres.ErrNoUser):
+		case errors.Is(err, storage.ErrNoUser):
 			return nil, status.Error(codes.NotFound, "Пользователь с таким логином не найден")
 		default:
 			h.log.WithError(err).Error("Error while login user")
@@ -105,6 +122,13 @@ func (h *GRPCUserHandler) Login(ctx context.Context, in *pb.LoginReq) (*pb.Login
 	if isPwdOk := utils.ComparePwdAndHash(in.GetPassword(), user.PasswordHash); !isPwdOk {
 		return nil, status.Error(codes.Unauthenticated, "Неверные логин/пароль")
 	}
+	if len(user.PublicKey) == 0 {
+		// Пользователи, зарегистрированные до появления совместного доступа, получают ключи при входе.
+		// Без ключей вход не блокируется: они будут созданы при следующем входе.
+		if err = h.createUserKeys(ctx, user); err != nil {
+			h.log.WithError(err).Error("Error while login user - failed to create user key pair")
+		}
+	}
 	jwt, err := h.jwtService.BuildJWTSting(user)
 	if err != nil {
 		h.log.WithError(err).Error("Error while login user")
@@ -115,3 +139,225 @@ func (h *GRPCUserHandler) Login(ctx context.Context, in *pb.LoginReq) (*pb.Login
 	}
 	return response, nil
 }
+
+// ListAuditEvents возвращает страницу журнала аудита пользователя в порядке номеров событий.
+// Следующая страница запрашивается с номером последнего полученного события.
+// События страницы проверяются ключом журнала; если журнал изменен, возвращается ошибка DataLoss.
+func (h *GRPCUserHandler) ListAuditEvents(
+	ctx context.Context, in *pb.ListAuditEventsReq,
+) (*pb.ListAuditEventsRes, error) {
+	// Сервис пользователей не требует авторизации, поэтому пользователь проверяется здесь.
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil || user.ID == "" {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+	pageSize := int(in.GetPageSize())
+	switch {
+	case pageSize < 0 || in.GetAfterSeq() < 0:
+		return nil, status.Error(codes.InvalidArgument, "Некорректные входные данные")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+	key, err := utils.AuditKey(h.masterKey)
+	if err != nil {
+		h.log.WithError(err).Error("Error while listing audit events")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	afterSeq := in.GetAfterSeq()
+	params := model.ListAuditParams{Limit: pageSize}
+	if afterSeq > 0 {
+		// Событие afterSeq читается вместе со страницей, чтобы проверить связь с ним первого события страницы.
+		params = model.ListAuditParams{AfterSeq: afterSeq - 1, Limit: pageSize + 1}
+	}
+	events, err := h.storage.ListAuditEvents(ctx, user.ID, params)
+	if err == nil {
+		err = h.verifyAuditPage(ctx, user.ID, max(afterSeq, 1), events, len(events) < params.Limit, key)
+	}
+	if err != nil {
+		h.log.WithError(err).Error("Error while listing audit events")
+		if errors.Is(err, model.ErrAuditChainBroken) {
+			return nil, status.Error(codes.DataLoss, "Журнал аудита поврежден")
+		}
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	if afterSeq > 0 && len(events) > 0 {
+		events = events[1:]
+	}
+	response := &pb.ListAuditEventsRes{}
+	for _, event := range events {
+		response.Events = append(response.Events, &pb.ListAuditEventsRes_Event{
+			UserId:    event.UserID,
+			Seq:       event.Seq,
+			Action:    event.Action,
+			ItemId:    event.ItemID,
+			Peer:      event.Peer,
+			Code:      event.Code,
+			CreatedAt: timestamppb.New(event.CreatedAt),
+			PrevHash:  event.PrevHash,
+			Hash:      event.Hash,
+		})
+	}
+	return response, nil
+}
+
+// verifyAuditPage проверяет ключом журнала события страницы журнала аудита пользователя: события связаны
+// между собой и первое из них имеет номер from. На последней странице (complete) последнее событие должно
+// совпадать с последним событием журнала, сохраненным хранилищем (см. model.AuditHead), иначе события
+// удалены с конца журнала.
+func (h *GRPCUserHandler) verifyAuditPage(
+	ctx context.Context, userID string, from int64, events []model.AuditEvent, complete bool, key []byte,
+) error {
+	if err := verifyAuditEvents(events, from, key); err != nil {
+		return err
+	}
+	if !complete {
+		return nil
+	}
+	// Последнее событие журнала читается после страницы, поэтому в журнале есть все события до него.
+	head, err := h.storage.GetAuditHead(ctx, userID)
+	if err != nil {
+		return err
+	}
+	var last *model.AuditEvent
+	next := from
+	if len(events) > 0 {
+		last = &events[len(events)-1]
+		next = last.Seq + 1
+	}
+	if head.Seq >= next {
+		// События добавлены после чтения страницы или удалены с конца журнала.
+		more, err := h.storage.ListAuditEvents(ctx, userID, model.ListAuditParams{
+			AfterSeq: next - 1,
+			Limit:    int(head.Seq - next + 1),
+		})
+		if err != nil {
+			return err
+		}
+		if last != nil {
+			more = append([]model.AuditEvent{*last}, more...)
+			next = last.Seq
+		}
+		if err = verifyAuditEvents(more, next, key); err != nil {
+			return err
+		}
+		if len(more) == 0 {
+			return fmt.Errorf("%w: event %d is missing", model.ErrAuditChainBroken, next)
+		}
+		last = &more[len(more)-1]
+	}
+	if last == nil {
+		// Журнал пуст или страница запрошена после его конца.
+		return nil
+	}
+	return head.Verify(*last, key)
+}
+
+// verifyAuditEvents проверяет ключом журнала непрерывную последовательность событий, начинающуюся с номера from.
+func verifyAuditEvents(events []model.AuditEvent, from int64, key []byte) error {
+	if len(events) > 0 && events[0].Seq != from {
+		return fmt.Errorf("%w: event %d is missing", model.ErrAuditChainBroken, from)
+	}
+	return model.VerifyAuditChain(events, key)
+}
+
+// DeleteAccount удаляет аккаунт пользователя вместе со всеми его данными, файлами и журналом аудита,
+// а также организации, в которых он единственный участник. Удаление подтверждается паролем пользователя.
+func (h *GRPCUserHandler) DeleteAccount(ctx context.Context, in *pb.DeleteAccountReq) (*pb.DeleteAccountRes, error) {
+	ctxUser := appCtx.GetCtxUser(ctx)
+	if ctxUser == nil || ctxUser.ID == "" {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+	if in.GetPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Некорректные входные данные")
+	}
+	user, err := h.storage.GetUserByID(ctx, ctxUser.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoUser):
+			return nil, status.Error(codes.NotFound, "Пользователь не найден")
+		default:
+			h.log.WithError(err).Error("Error while deleting user account")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	if isPwdOk := utils.ComparePwdAndHash(in.GetPassword(), user.PasswordHash); !isPwdOk {
+		// Не Unauthenticated: клиент при этом коде удаляет сохраненный jwt.
+		return nil, status.Error(codes.PermissionDenied, "Неверный пароль")
+	}
+	var orgs []string
+	err = h.storage.WithTx(ctx, func(tx storage.Storage) error {
+		var err error
+		if orgs, err = deleteOwnOrgs(ctx, tx, user.ID); err != nil {
+			return err
+		}
+		return tx.DeleteUser(ctx, user.ID)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoUser):
+			return nil, status.Error(codes.NotFound, "Пользователь не найден")
+		case errors.Is(err, errLastOwner):
+			return nil, status.Error(codes.FailedPrecondition,
+				"Вы единственный владелец организации с другими участниками: "+
+					"назначьте другого владельца или удалите организацию")
+		default:
+			h.log.WithError(err).Error("Error while deleting user account")
+			return nil, status.Error(codes.Internal, "Не удалось удалить аккаунт")
+		}
+	}
+	if err = h.blobs.DeleteUser(ctx, user.ID); err != nil {
+		// Блоки удаленного пользователя позже удалит сборщик неиспользуемых блоков.
+		h.log.WithError(err).WithField("user", user.ID).Warn("Error while deleting user blobs")
+	}
+	for _, orgID := range orgs {
+		if err = h.blobs.DeleteUser(ctx, orgID); err != nil {
+			h.log.WithError(err).WithField("org", orgID).Warn("Error while deleting organization blobs")
+		}
+	}
+	return &pb.DeleteAccountRes{}, nil
+}
+
+// deleteOwnOrgs удаляет организации, в которых пользователь - единственный участник, и возвращает их id.
+// Если пользователь - единственный владелец организации с другими участниками, возвращает errLastOwner.
+func deleteOwnOrgs(ctx context.Context, tx storage.Storage, userID string) ([]string, error) {
+	memberships, err := tx.ListUserOrgs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var deleted []string
+	for _, membership := range memberships {
+		if membership.Role != model.OrgRoleOwner {
+			continue
+		}
+		members, err := tx.ListOrgMembers(ctx, membership.OrgID)
+		if err != nil {
+			return nil, err
+		}
+		if len(members) > 1 {
+			if isLastOwner(members, &membership) {
+				return nil, errLastOwner
+			}
+			continue
+		}
+		if err = tx.DeleteUser(ctx, membership.OrgID); err != nil {
+			return nil, err
+		}
+		deleted = append(deleted, membership.OrgID)
+	}
+	return deleted, nil
+}
+
+// createUserKeys создает и сохраняет пару ключей пользователя для совместного доступа к данным.
+func (h *GRPCUserHandler) createUserKeys(ctx context.Context, user *model.User) error {
+	secret, err := utils.DecryptUserSecret(user.EncryptedSecret, h.masterKey)
+	if err != nil {
+		return err
+	}
+	publicKey, encPrivateKey, err := utils.GenerateKeyPair(secret)
+	if err != nil {
+		return err
+	}
+	return h.storage.SetUserKeys(ctx, user.ID, publicKey, encPrivateKey)
+}
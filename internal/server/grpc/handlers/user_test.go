@@ -2,18 +2,23 @@ package handlers
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pinbrain/gophkeeper/internal/logger"
 	"github.com/pinbrain/gophkeeper/internal/model"
 	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
 	"github.com/pinbrain/gophkeeper/internal/server/config"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
 	"github.com/pinbrain/gophkeeper/internal/server/jwt"
 	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
 	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
-	"github.com/pinbrain/gophkeeper/internal/storage/postgres"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -33,7 +38,7 @@ func TestRegister(t *testing.T) {
 	log, err := logger.NewLogger("info")
 	require.NoError(t, err)
 	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
-	handler := NewGRPCUserHandler(masterKey, mockStorage, jwtService, log.WithField("instance", "grpcTransport"))
+	handler := NewGRPCUserHandler(masterKey, mockStorage, nil, jwtService, log.WithField("instance", "grpcTransport"))
 
 	type Store struct {
 		err    error
@@ -66,7 +71,7 @@ func TestRegister(t *testing.T) {
 				Password: "password",
 			},
 			store: &Store{
-				err: postgres.ErrLoginTaken,
+				err: storage.ErrLoginTaken,
 			},
 			wantErr: true,
 			errCode: codes.AlreadyExists,
@@ -83,12 +88,28 @@ func TestRegister(t *testing.T) {
 			wantErr: true,
 			errCode: codes.Internal,
 		},
+		{
+			name: "Логин аккаунта организации",
+			request: &pb.RegisterReq{
+				Login:    "Org:6f1c2a9e-3d4b-4a7e-9c51-0b8e2f6d7a10",
+				Password: "password",
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.store != nil {
-				mockStorage.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Times(1).Return(tt.store.userID, tt.store.err)
+				mockStorage.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+					func(_ context.Context, user *model.User) (string, error) {
+						// Пара ключей для совместного доступа создается при регистрации.
+						assert.NotEmpty(t, user.PublicKey)
+						assert.NotEmpty(t, user.EncryptPrivateKey)
+						return tt.store.userID, tt.store.err
+					},
+				)
 			} else {
 				mockStorage.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Times(0)
 			}
@@ -120,13 +141,14 @@ func TestLogin(t *testing.T) {
 	log, err := logger.NewLogger("info")
 	require.NoError(t, err)
 	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
-	handler := NewGRPCUserHandler(masterKey, mockStorage, jwtService, log.WithField("instance", "grpcTransport"))
+	handler := NewGRPCUserHandler(masterKey, mockStorage, nil, jwtService, log.WithField("instance", "grpcTransport"))
 
 	type Store struct {
 		err      error
 		login    string
 		user     *model.User
 		calcHash bool
+		setKeys  bool
 	}
 	tests := []struct {
 		name    string
@@ -148,8 +170,27 @@ func TestLogin(t *testing.T) {
 					ID:              "1",
 					Login:           "user",
 					EncryptedSecret: "secret",
+					PublicKey:       []byte("public"),
+				},
+				calcHash: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Создание ключей при входе",
+			request: &pb.LoginReq{
+				Login:    "user",
+				Password: "password",
+			},
+			store: &Store{
+				err:   nil,
+				login: "user",
+				user: &model.User{
+					ID:    "1",
+					Login: "user",
 				},
 				calcHash: true,
+				setKeys:  true,
 			},
 			wantErr: false,
 		},
@@ -179,7 +220,7 @@ func TestLogin(t *testing.T) {
 				Password: "password",
 			},
 			store: &Store{
-				err:      postgres.ErrNoUser,
+				err:      storage.ErrNoUser,
 				login:    "user",
 				calcHash: false,
 			},
@@ -196,6 +237,21 @@ func TestLogin(t *testing.T) {
 					require.NoError(t, err)
 					tt.store.user.PasswordHash = hash
 				}
+				if tt.store.setKeys {
+					secret, err := utils.GenerateUserKey()
+					require.NoError(t, err)
+					encSecret, err := utils.Encrypt(secret, masterKey)
+					require.NoError(t, err)
+					tt.store.user.EncryptedSecret = hex.EncodeToString(encSecret)
+					mockStorage.EXPECT().SetUserKeys(gomock.Any(), tt.store.user.ID, gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ string, publicKey []byte, encPrivateKey []byte) error {
+							_, err := utils.Decrypt(encPrivateKey, hex.EncodeToString(secret))
+							require.NoError(t, err)
+							assert.Len(t, publicKey, 32)
+							return nil
+						},
+					)
+				}
 				mockStorage.EXPECT().GetUserByLogin(gomock.Any(), tt.store.login).Times(1).Return(tt.store.user, tt.store.err)
 			} else {
 				mockStorage.EXPECT().GetUserByLogin(gomock.Any(), gomock.Any()).Times(0)
@@ -214,3 +270,343 @@ func TestLogin(t *testing.T) {
 		})
 	}
 }
+
+func TestListAuditEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCUserHandler(masterKey, mockStorage, nil, nil, log.WithField("instance", "grpcTransport"))
+	key, err := utils.AuditKey(masterKey)
+	require.NoError(t, err)
+
+	chain := func(key []byte, actions ...string) []model.AuditEvent {
+		events := make([]model.AuditEvent, len(actions))
+		for i, action := range actions {
+			events[i] = model.AuditEvent{UserID: "1", Action: action, ItemID: "item", Peer: "127.0.0.1:5000", Code: "OK"}
+			if i == 0 {
+				events[i].Chain(nil, key)
+			} else {
+				events[i].Chain(&events[i-1], key)
+			}
+		}
+		return events
+	}
+	events := chain(key, "Login", "GetData", "DeleteData", "ListItems")
+	head := model.AuditHead{Seq: 4, Tag: events[3].HeadTag(key)}
+	// Событие изменено, а хэши пересчитаны без ключа журнала.
+	tampered := chain([]byte("other key"), "Login", "GetData", "UpdateData", "ListItems")
+
+	type tail struct {
+		params model.ListAuditParams
+		events []model.AuditEvent
+	}
+	tests := []struct {
+		name       string
+		user       *appCtx.CtxUser
+		request    *pb.ListAuditEventsReq
+		wantParams *model.ListAuditParams
+		events     []model.AuditEvent
+		err        error
+		head       *model.AuditHead
+		tail       *tail
+		wantSeq    []int64
+		errCode    codes.Code
+	}{
+		{
+			name:       "Успешный запрос",
+			user:       &appCtx.CtxUser{ID: "1", Login: "user"},
+			request:    &pb.ListAuditEventsReq{AfterSeq: 2, PageSize: 1},
+			wantParams: &model.ListAuditParams{AfterSeq: 1, Limit: 2},
+			events:     events[1:3],
+			wantSeq:    []int64{3},
+		},
+		{
+			name:       "Размер страницы по умолчанию",
+			user:       &appCtx.CtxUser{ID: "1", Login: "user"},
+			request:    &pb.ListAuditEventsReq{},
+			wantParams: &model.ListAuditParams{Limit: defaultPageSize},
+			events:     events,
+			head:       &head,
+			wantSeq:    []int64{1, 2, 3, 4},
+		},
+		{
+			name:       "Размер страницы больше максимального",
+			user:       &appCtx.CtxUser{ID: "1", Login: "user"},
+			request:    &pb.ListAuditEventsReq{PageSize: maxPageSize + 1},
+			wantParams: &model.ListAuditParams{Limit: maxPageSize},
+			events:     events,
+			head:       &head,
+			wantSeq:    []int64{1, 2, 3, 4},
+		},
+		{
+			name:       "Последняя страница",
+			user:       &appCtx.CtxUser{ID: "1", Login: "user"},
+			request:    &pb.ListAuditEventsReq{AfterSeq: 2, PageSize: 5},
+			wantParams: &model.ListAuditParams{AfterSeq: 1, Limit: 6},
+			events:     events[1:],
+			head:       &head,
+			wantSeq:    []int64{3, 4},
+		},
+		{
+			name:       "Пустой журнал",
+			user:       &appCtx.CtxUser{ID: "1", Login: "user"},
+			request:    &pb.ListAuditEventsReq{},
+			wantParams: &model.ListAuditParams{Limit: defaultPageSize},
+			head:       &model.AuditHead{},
+		},
+		{
+			name:       "Событие добавлено после чтения страницы",
+			user:       &appCtx.CtxUser{ID: "1", Login: "user"},
+			request:    &pb.ListAuditEventsReq{},
+			wantParams: &model.ListAuditParams{Limit: defaultPageSize},
+			events:     events[:3],
+			head:       &head,
+			tail:       &tail{params: model.ListAuditParams{AfterSeq: 3, Limit: 1}, events: events[3:]},
+			wantSeq:    []int64{1, 2, 3},
+		},
+		{
+			name:       "Событие изменено и хэши пересчитаны",
+			user:       &appCtx.CtxUser{ID: "1", Login: "user"},
+			request:    &pb.ListAuditEventsReq{AfterSeq: 2, PageSize: 1},
+			wantParams: &model.ListAuditParams{AfterSeq: 1, Limit: 2},
+			events:     tampered[1:3],
+			errCode:    codes.DataLoss,
+		},
+		{
+			name:       "Удалены события с конца журнала",
+			user:       &appCtx.CtxUser{ID: "1", Login: "user"},
+			request:    &pb.ListAuditEventsReq{},
+			wantParams: &model.ListAuditParams{Limit: defaultPageSize},
+			events:     events[:2],
+			head:       &head,
+			tail:       &tail{params: model.ListAuditParams{AfterSeq: 2, Limit: 2}},
+			errCode:    codes.DataLoss,
+		},
+		{
+			name:       "Удалено последнее событие журнала",
+			user:       &appCtx.CtxUser{ID: "1", Login: "user"},
+			request:    &pb.ListAuditEventsReq{},
+			wantParams: &model.ListAuditParams{Limit: defaultPageSize},
+			events:     events[:3],
+			head:       &model.AuditHead{Seq: 3, Tag: head.Tag},
+			errCode:    codes.DataLoss,
+		},
+		{
+			name:       "Удалено начало журнала",
+			user:       &appCtx.CtxUser{ID: "1", Login: "user"},
+			request:    &pb.ListAuditEventsReq{PageSize: 2},
+			wantParams: &model.ListAuditParams{Limit: 2},
+			events:     events[2:],
+			errCode:    codes.DataLoss,
+		},
+		{
+			name:    "Пользователь не авторизован",
+			request: &pb.ListAuditEventsReq{},
+			errCode: codes.Unauthenticated,
+		},
+		{
+			name:    "Некорректный номер события",
+			user:    &appCtx.CtxUser{ID: "1", Login: "user"},
+			request: &pb.ListAuditEventsReq{AfterSeq: -1},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:       "Ошибка БД",
+			user:       &appCtx.CtxUser{ID: "1", Login: "user"},
+			request:    &pb.ListAuditEventsReq{},
+			wantParams: &model.ListAuditParams{Limit: defaultPageSize},
+			err:        errors.New("db error"),
+			errCode:    codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+			if tt.wantParams != nil {
+				mockStorage.EXPECT().ListAuditEvents(gomock.Any(), tt.user.ID, *tt.wantParams).Return(tt.events, tt.err)
+			}
+			if tt.head != nil {
+				mockStorage.EXPECT().GetAuditHead(gomock.Any(), tt.user.ID).Return(*tt.head, nil)
+			}
+			if tt.tail != nil {
+				mockStorage.EXPECT().ListAuditEvents(gomock.Any(), tt.user.ID, tt.tail.params).Return(tt.tail.events, nil)
+			}
+			res, err := handler.ListAuditEvents(ctx, tt.request)
+			if tt.errCode != codes.OK {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res.GetEvents(), len(tt.wantSeq))
+			for i, seq := range tt.wantSeq {
+				event := events[seq-1]
+				assert.Equal(t, event.UserID, res.GetEvents()[i].GetUserId())
+				assert.Equal(t, event.Seq, res.GetEvents()[i].GetSeq())
+				assert.Equal(t, event.Action, res.GetEvents()[i].GetAction())
+				assert.Equal(t, event.ItemID, res.GetEvents()[i].GetItemId())
+				assert.Equal(t, event.Peer, res.GetEvents()[i].GetPeer())
+				assert.Equal(t, event.Code, res.GetEvents()[i].GetCode())
+				assert.Equal(t, event.Hash, res.GetEvents()[i].GetHash())
+			}
+		})
+	}
+}
+
+func TestDeleteAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	dir := t.TempDir()
+	blobs, err := blob.NewFSStore(dir)
+	require.NoError(t, err)
+	handler := NewGRPCUserHandler("", mockStorage, blobs, nil, log.WithField("instance", "grpcTransport"))
+
+	hash, err := utils.GeneratePasswordHash("password")
+	require.NoError(t, err)
+	user := &model.User{ID: "1", Login: "user", PasswordHash: hash}
+	ctxUser := &appCtx.CtxUser{ID: "1", Login: "user"}
+
+	type Store struct {
+		user      *model.User
+		getErr    error
+		deleted   bool
+		orgs      []model.OrgMember
+		members   []model.OrgMember // Участники каждой из организаций orgs с ролью OWNER.
+		deleteErr error
+	}
+	tests := []struct {
+		name    string
+		user    *appCtx.CtxUser
+		request *pb.DeleteAccountReq
+		store   *Store
+		errCode codes.Code
+	}{
+		{
+			name:    "Успешный запрос",
+			user:    ctxUser,
+			request: &pb.DeleteAccountReq{Password: "password"},
+			store:   &Store{user: user, deleted: true},
+		},
+		{
+			name:    "Неверный пароль",
+			user:    ctxUser,
+			request: &pb.DeleteAccountReq{Password: "wrong"},
+			store:   &Store{user: user},
+			errCode: codes.PermissionDenied,
+		},
+		{
+			name:    "Пустой пароль",
+			user:    ctxUser,
+			request: &pb.DeleteAccountReq{},
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Пользователь не авторизован",
+			request: &pb.DeleteAccountReq{Password: "password"},
+			errCode: codes.Unauthenticated,
+		},
+		{
+			name:    "Пользователь не найден",
+			user:    ctxUser,
+			request: &pb.DeleteAccountReq{Password: "password"},
+			store:   &Store{getErr: storage.ErrNoUser},
+			errCode: codes.NotFound,
+		},
+		{
+			name:    "Ошибка БД при удалении",
+			user:    ctxUser,
+			request: &pb.DeleteAccountReq{Password: "password"},
+			store:   &Store{user: user, deleted: true, deleteErr: errors.New("db error")},
+			errCode: codes.Internal,
+		},
+		{
+			name:    "Удаление организации без других участников",
+			user:    ctxUser,
+			request: &pb.DeleteAccountReq{Password: "password"},
+			store: &Store{
+				user:    user,
+				deleted: true,
+				orgs: []model.OrgMember{
+					{OrgID: "org", UserID: "1", Role: model.OrgRoleOwner},
+					{OrgID: "other", UserID: "1", Role: model.OrgRoleMember},
+				},
+				members: []model.OrgMember{{OrgID: "org", UserID: "1", Role: model.OrgRoleOwner}},
+			},
+		},
+		{
+			name:    "Единственный владелец организации с участниками",
+			user:    ctxUser,
+			request: &pb.DeleteAccountReq{Password: "password"},
+			store: &Store{
+				user:    user,
+				deleted: true,
+				orgs:    []model.OrgMember{{OrgID: "org", UserID: "1", Role: model.OrgRoleOwner}},
+				members: []model.OrgMember{
+					{OrgID: "org", UserID: "1", Role: model.OrgRoleOwner},
+					{OrgID: "org", UserID: "2", Role: model.OrgRoleAdmin},
+				},
+			},
+			errCode: codes.FailedPrecondition,
+		},
+	}
+	mockStorage.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(tx storage.Storage) error) error {
+			return fn(mockStorage)
+		},
+	).AnyTimes()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+			require.NoError(t, blobs.Put(ctx, "1", strings.Repeat("ab", 32), []byte("chunk")))
+			require.NoError(t, blobs.Put(ctx, "org", strings.Repeat("ab", 32), []byte("chunk")))
+			if tt.store != nil {
+				mockStorage.EXPECT().GetUserByID(gomock.Any(), "1").Return(tt.store.user, tt.store.getErr)
+				if tt.store.deleted {
+					mockStorage.EXPECT().ListUserOrgs(gomock.Any(), "1").Return(tt.store.orgs, nil)
+					if tt.store.members != nil {
+						mockStorage.EXPECT().ListOrgMembers(gomock.Any(), "org").Return(tt.store.members, nil)
+					}
+					if len(tt.store.members) == 1 {
+						mockStorage.EXPECT().DeleteUser(gomock.Any(), "org").Return(nil)
+					}
+					if tt.errCode != codes.FailedPrecondition {
+						mockStorage.EXPECT().DeleteUser(gomock.Any(), "1").Return(tt.store.deleteErr)
+					}
+				}
+			}
+
+			_, err := handler.DeleteAccount(ctx, tt.request)
+			if tt.errCode != codes.OK {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+				// Без удаления аккаунта блоки файлов сохраняются.
+				assert.DirExists(t, filepath.Join(dir, "1"))
+				return
+			}
+			require.NoError(t, err)
+			assert.NoDirExists(t, filepath.Join(dir, "1"))
+			// Блоки файлов организации удаляются только вместе с ней.
+			if len(tt.store.members) == 1 {
+				assert.NoDirExists(t, filepath.Join(dir, "org"))
+			} else {
+				assert.DirExists(t, filepath.Join(dir, "org"))
+			}
+		})
+	}
+}
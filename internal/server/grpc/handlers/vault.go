@@ -2,37 +2,85 @@ package handlers
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/pinbrain/gophkeeper/internal/model"
 	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	"github.com/pinbrain/gophkeeper/internal/server/config"
 	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
 	"github.com/pinbrain/gophkeeper/internal/server/utils"
 	"github.com/pinbrain/gophkeeper/internal/storage"
-	"github.com/pinbrain/gophkeeper/internal/storage/postgres"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Ограничения размера страницы списка данных и результатов поиска.
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// maxSearchQueryLen максимальная длина строки поиска в символах.
+const maxSearchQueryLen = 100
+
+// maxScanItems максимальное количество данных, мета данные которых расшифровываются сервером
+// для поиска по вхождению строки и сортировки по полю мета данных.
+const maxScanItems = 5000
+
+// errTooManyItems возвращается, если для сортировки по полю мета данных или поиска по вхождению строки
+// нужно расшифровать больше maxScanItems данных.
+var errTooManyItems = errors.New("too many items to decrypt")
+
+// pageToken описывает содержимое токена следующей страницы списка данных.
+// Вместе с позицией сохраняются параметры запроса, чтобы токен нельзя было применить к другой выборке.
+type pageToken struct {
+	Type           string    `json:"type"`
+	SortBy         string    `json:"sortBy"`
+	MetaField      string    `json:"metaField"`
+	Desc           bool      `json:"desc"`
+	FolderID       string    `json:"folderId"`
+	Tag            string    `json:"tag"` // Слепой индекс метки.
+	Time           time.Time `json:"time"`
+	Meta           string    `json:"meta"`
+	ID             string    `json:"id"`
+	FavoritesFirst bool      `json:"favoritesFirst"`
+	Favorite       bool      `json:"favorite"`
+}
+
 // GRPCVaultHandler определяет структуру обработчика grpc запросов в части работы с данными.
 type GRPCVaultHandler struct {
 	pb.UnimplementedVaultServiceServer
 	masterKey string
 	storage   storage.Storage
+	blobs     blob.Store // Хранилище блоков файлов, загружаемых через UploadFile.
+	quota     config.QuotaConfig
 	log       *logrus.Entry
 }
 
 // NewGRPCVaultHandler создает и возвращает новый обработчик grpc запросов в части работы с данными.
-func NewGRPCVaultHandler(masterKey string, storage storage.Storage, log *logrus.Entry) *GRPCVaultHandler {
+func NewGRPCVaultHandler(
+	masterKey string, storage storage.Storage, blobs blob.Store, quota config.QuotaConfig, log *logrus.Entry,
+) *GRPCVaultHandler {
 	return &GRPCVaultHandler{
 		masterKey: masterKey,
 		storage:   storage,
+		blobs:     blobs,
+		quota:     quota,
 		log:       log,
 	}
 }
 
 // AddData добавляет новые данные в хранилище.
+// Данные с заданным сроком хранения (expires_at) перестают возвращаться после его истечения
+// и удаляются фоновой задачей сервера.
 func (h *GRPCVaultHandler) AddData(ctx context.Context, in *pb.AddDataReq) (*pb.AddDataRes, error) {
 	reqItem := in.GetItem()
 	if reqItem == nil {
@@ -47,28 +95,49 @@ func (h *GRPCVaultHandler) AddData(ctx context.Context, in *pb.AddDataReq) (*pb.
 	if !isValidDataType(dataType) {
 		return nil, status.Error(codes.InvalidArgument, "Неизвестный тип данных")
 	}
+	size := int64(len(reqItem.GetData()))
+	if err := h.checkItemSize(reqItem.GetMeta(), size); err != nil {
+		return nil, err
+	}
+	if err := checkExpiresAt(in.GetExpiresAt()); err != nil {
+		return nil, err
+	}
 
 	encData, err := utils.Encrypt(reqItem.GetData(), user.Secret)
 	if err != nil {
 		h.log.WithError(err).Error("Error while encrypting user data")
 		return nil, status.Error(codes.Internal, "Internal server error")
 	}
+	encMeta, metaIndex, err := utils.EncryptMeta(reqItem.GetMeta(), user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while encrypting user meta")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
 
 	item := &model.VaultItem{
 		UserID:      user.ID,
-		Meta:        reqItem.GetMeta(),
+		EncryptMeta: encMeta,
+		MetaIndex:   metaIndex,
 		Type:        model.DataType(dataType),
 		EncryptData: encData,
+		Size:        size,
+		ExpiresAt:   fromTimestamp(in.GetExpiresAt()),
 	}
-	_, err = h.storage.CreateItem(ctx, user.ID, item)
+	id, err := h.createItem(ctx, user.ID, item)
 	if err != nil {
+		var qErr *quotaError
+		if errors.As(err, &qErr) {
+			return nil, status.Error(codes.ResourceExhausted, qErr.Error())
+		}
 		h.log.WithError(err).Error("Error while saving data")
 		return nil, status.Error(codes.Internal, "Internal server error")
 	}
-	return &pb.AddDataRes{}, nil
+	return &pb.AddDataRes{Id: id}, nil
 }
 
-// GetData возвращает данные из хранилища по id.
+// GetData возвращает данные из хранилища по id вместе со списком прикрепленных к ним файлов.
+// При skip_file_content содержимое файла не передается, его можно загрузить потоком через DownloadFile.
+// Получение своих данных учитывается в статистике (время последнего получения и их количество).
 func (h *GRPCVaultHandler) GetData(ctx context.Context, in *pb.GetDataReq) (*pb.GetDataRes, error) {
 	if in.GetId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "Отсутствует id данных")
@@ -81,16 +150,35 @@ func (h *GRPCVaultHandler) GetData(ctx context.Context, in *pb.GetDataReq) (*pb.
 	data, err := h.storage.GetItem(ctx, in.GetId(), user.ID)
 	if err != nil {
 		switch {
-		case errors.Is(err, postgres.ErrNoData):
-			return nil, status.Error(codes.NotFound, "Данные не найдены")
+		case errors.Is(err, storage.ErrNoData):
+			// Данные могут принадлежать другому пользователю, предоставившему к ним доступ.
+			return h.getSharedData(ctx, user, in.GetId())
 		default:
 			h.log.WithError(err).Error("Error while getting item")
 			return nil, status.Error(codes.Internal, "Internal server error")
 		}
 	}
-	decData, err := utils.Decrypt(data.EncryptData, user.Secret)
+	meta, err := utils.DecryptMeta(data.EncryptMeta, data.Meta, user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while decrypting user meta")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	key, err := dataKey(user, data)
 	if err != nil {
-		h.log.WithError(err).Error("Error while decrypting user data")
+		h.log.WithError(err).Error("Error while decrypting item key")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	var decData []byte
+	if data.Type != model.File || !in.GetSkipFileContent() {
+		decData, err = h.itemData(ctx, user, key, data.EncryptData, data.Chunked)
+		if err != nil {
+			h.log.WithError(err).Error("Error while reading user data")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	attachments, err := h.attachments(ctx, user, data.ID)
+	if err != nil {
+		h.log.WithError(err).Error("Error while getting item attachments")
 		return nil, status.Error(codes.Internal, "Internal server error")
 	}
 	response := &pb.GetDataRes{
@@ -98,13 +186,20 @@ func (h *GRPCVaultHandler) GetData(ctx context.Context, in *pb.GetDataReq) (*pb.
 		Item: &pb.Item{
 			Data: decData,
 			Type: string(data.Type),
-			Meta: data.Meta,
+			Meta: meta,
 		},
+		Revision:    data.Revision,
+		Attachments: attachments,
+		ExpiresAt:   toTimestamp(data.ExpiresAt),
+	}
+	// Статистика получения не влияет на ответ: ошибка ее записи только логируется.
+	if err = h.storage.RecordAccess(ctx, data.ID, user.ID, time.Now()); err != nil {
+		h.log.WithError(err).Warn("Error while recording item access")
 	}
 	return response, nil
 }
 
-// DeleteData удаляет данные из хранилища.
+// DeleteData перемещает данные в корзину вместе с прикрепленными к ним файлами.
 func (h *GRPCVaultHandler) DeleteData(ctx context.Context, in *pb.DeleteDataReq) (*pb.DeleteDataRes, error) {
 	if in.GetId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "Отсутствует id данных")
@@ -117,7 +212,7 @@ func (h *GRPCVaultHandler) DeleteData(ctx context.Context, in *pb.DeleteDataReq)
 	err := h.storage.DeleteItem(ctx, in.GetId(), user.ID)
 	if err != nil {
 		switch {
-		case errors.Is(err, postgres.ErrNoData):
+		case errors.Is(err, storage.ErrNoData):
 			return nil, status.Error(codes.NotFound, "Данные для удаления не найдены")
 		default:
 			h.log.WithError(err).Error("Error while deleting item")
@@ -143,6 +238,10 @@ func (h *GRPCVaultHandler) GetAllByType(ctx context.Context, in *pb.GetAllByType
 		h.log.WithError(err).Error("Error while deleting item")
 		return nil, status.Error(codes.Internal, "Internal server error")
 	}
+	if err = decryptItemsMeta(items, user.Secret); err != nil {
+		h.log.WithError(err).Error("Error while decrypting user meta")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
 	var responseItems []*pb.GetAllByTypeRes_TypeItem
 	for _, item := range items {
 		responseItems = append(responseItems, &pb.GetAllByTypeRes_TypeItem{
@@ -155,7 +254,149 @@ func (h *GRPCVaultHandler) GetAllByType(ctx context.Context, in *pb.GetAllByType
 	}, nil
 }
 
+// ListItems возвращает страницу списка данных пользователя.
+func (h *GRPCVaultHandler) ListItems(ctx context.Context, in *pb.ListItemsReq) (*pb.ListItemsRes, error) {
+	dataType := in.GetType()
+	if dataType != "" && !isValidDataType(dataType) {
+		return nil, status.Error(codes.InvalidArgument, "Неизвестный тип данных")
+	}
+	params := model.ListItemsParams{
+		Type:           model.DataType(dataType),
+		Desc:           in.GetDesc(),
+		FavoritesFirst: in.GetFavoritesFirst(),
+	}
+	switch in.GetSortBy() {
+	case pb.ListItemsReq_CREATED_AT:
+		params.SortBy = model.SortByCreated
+	case pb.ListItemsReq_UPDATED_AT:
+		params.SortBy = model.SortByUpdated
+	case pb.ListItemsReq_ACCESSED:
+		params.SortBy = model.SortByAccessed
+	case pb.ListItemsReq_META:
+		if !isSortableMetaField(in.GetMetaField()) {
+			return nil, status.Error(codes.InvalidArgument, "Недопустимое поле мета данных для сортировки")
+		}
+		params.SortBy = model.SortByMeta
+		params.MetaField = in.GetMetaField()
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Неизвестное поле сортировки")
+	}
+	pageSize := int(in.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Error(codes.InvalidArgument, "Некорректный размер страницы")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+	// Запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница.
+	params.Limit = pageSize + 1
+
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	params.FolderID = in.GetFolderId()
+	if tag := strings.TrimSpace(in.GetTag()); tag != "" {
+		tagIndex, err := utils.BlindIndex(user.Secret, tagIndexField, tag)
+		if err != nil {
+			h.log.WithError(err).Error("Error while calculating tag index")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+		params.TagIndex = tagIndex
+	}
+	if in.GetPageToken() != "" {
+		cursor, err := decodePageToken(in.GetPageToken(), params)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Некорректный токен страницы")
+		}
+		params.After = cursor
+	}
+
+	items, err := h.listItems(ctx, user, params)
+	if err != nil {
+		if errors.Is(err, errTooManyItems) {
+			return nil, status.Error(codes.FailedPrecondition,
+				"Слишком много данных для сортировки по полю мета данных, уточните тип, папку или метку")
+		}
+		h.log.WithError(err).Error("Error while listing items")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+
+	response := &pb.ListItemsRes{}
+	if len(items) > pageSize {
+		items = items[:pageSize]
+		last := items[len(items)-1]
+		response.NextPageToken, err = encodePageToken(
+			model.NewItemsCursor(last, params), params,
+		)
+		if err != nil {
+			h.log.WithError(err).Error("Error while encoding page token")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	if response.Items, err = h.listResponseItems(ctx, user, items); err != nil {
+		h.log.WithError(err).Error("Error while listing items")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	return response, nil
+}
+
+// SearchItems ищет данные пользователя по мета данным.
+// При exact ищется точное совпадение значения одного из индексируемых полей: поиск выполняет хранилище
+// по слепым индексам. Иначе ищется вхождение (или начало) строки: мета данные зашифрованы,
+// поэтому они расшифровываются и проверяются на сервере (не более maxScanItems последних измененных данных;
+// если среди них найдено меньше запрошенного, а данных больше, возвращается codes.FailedPrecondition).
+func (h *GRPCVaultHandler) SearchItems(ctx context.Context, in *pb.SearchItemsReq) (*pb.SearchItemsRes, error) {
+	query := strings.TrimSpace(in.GetQuery())
+	if query == "" {
+		return nil, status.Error(codes.InvalidArgument, "Отсутствует строка поиска")
+	}
+	if len([]rune(query)) > maxSearchQueryLen || strings.IndexFunc(query, unicode.IsControl) >= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Некорректная строка поиска")
+	}
+	limit := int(in.GetLimit())
+	switch {
+	case limit < 0:
+		return nil, status.Error(codes.InvalidArgument, "Некорректное количество результатов")
+	case limit == 0:
+		limit = defaultPageSize
+	case limit > maxPageSize:
+		limit = maxPageSize
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	var items []model.VaultItem
+	var err error
+	if in.GetExact() {
+		items, err = h.searchExact(ctx, user, query, limit)
+	} else {
+		items, err = h.searchSubstring(ctx, user, query, in.GetPrefix(), limit)
+	}
+	if err != nil {
+		if errors.Is(err, errTooManyItems) {
+			return nil, status.Error(codes.FailedPrecondition,
+				"Слишком много данных для поиска по вхождению строки, уточните строку или используйте точный поиск")
+		}
+		h.log.WithError(err).Error("Error while searching items")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	response := &pb.SearchItemsRes{}
+	if response.Items, err = h.listResponseItems(ctx, user, items); err != nil {
+		h.log.WithError(err).Error("Error while searching items")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	return response, nil
+}
+
 // UpdateData обновляет данные в хранилище.
+// Если в запросе указана версия данных, а в хранилище уже более новая, возвращается codes.Aborted.
+// Срок хранения меняется, только если он передан в запросе; изменить его может только владелец данных.
 func (h *GRPCVaultHandler) UpdateData(ctx context.Context, in *pb.UpdateDataReq) (*pb.UpdateDataRes, error) {
 	if in.GetId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "Отсутствует id данных")
@@ -165,29 +406,440 @@ func (h *GRPCVaultHandler) UpdateData(ctx context.Context, in *pb.UpdateDataReq)
 		h.log.Error("failed to get user from context")
 		return nil, status.Error(codes.Internal, "Internal server error")
 	}
-	encData, err := utils.Encrypt(in.GetData(), user.Secret)
+	size := int64(len(in.GetData()))
+	if err := h.checkItemSize(in.GetMeta(), size); err != nil {
+		return nil, err
+	}
+	if err := checkExpiresAt(in.GetExpiresAt()); err != nil {
+		return nil, err
+	}
+	current, err := h.storage.GetItem(ctx, in.GetId(), user.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoData):
+			// Данные могут принадлежать другому пользователю, предоставившему к ним доступ на изменение.
+			return h.updateSharedData(ctx, user, in)
+		default:
+			h.log.WithError(err).Error("Error while getting item")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	key, err := dataKey(user, current)
+	if err != nil {
+		h.log.WithError(err).Error("Error while decrypting item key")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	item, err := encryptItem(in, key, user.Secret, current.EncryptKey)
 	if err != nil {
 		h.log.WithError(err).Error("Error while encrypting user data")
 		return nil, status.Error(codes.Internal, "Internal server error")
 	}
+	item.UserID = user.ID
+	if err = h.updateItem(ctx, in.GetId(), user.ID, item); err != nil {
+		return nil, h.updateDataError(err)
+	}
+	return &pb.UpdateDataRes{Revision: item.Revision}, nil
+}
 
+// encryptItem шифрует данные из запроса на изменение: содержимое - ключом данных key, мета данные -
+// ключом владельца ownerSecret. Если данные на собственном ключе (encKey), мета данные также шифруются
+// ключом данных для пользователей с доступом к ним.
+func encryptItem(in *pb.UpdateDataReq, key string, ownerSecret string, encKey []byte) (*model.VaultItem, error) {
+	encData, err := utils.Encrypt(in.GetData(), key)
+	if err != nil {
+		return nil, err
+	}
+	encMeta, metaIndex, err := utils.EncryptMeta(in.GetMeta(), ownerSecret)
+	if err != nil {
+		return nil, err
+	}
 	item := &model.VaultItem{
 		ID:          in.GetId(),
-		UserID:      user.ID,
-		Meta:        in.GetMeta(),
+		EncryptMeta: encMeta,
+		MetaIndex:   metaIndex,
 		EncryptData: encData,
+		Size:        int64(len(in.GetData())),
+		Revision:    in.GetRevision(),
+		EncryptKey:  encKey,
+		ExpiresAt:   fromTimestamp(in.GetExpiresAt()),
+	}
+	if encKey != nil {
+		if item.SharedMeta, err = utils.Encrypt([]byte(in.GetMeta()), key); err != nil {
+			return nil, err
+		}
+	}
+	return item, nil
+}
+
+// updateDataError преобразует ошибку изменения данных в ошибку grpc.
+func (h *GRPCVaultHandler) updateDataError(err error) error {
+	var qErr *quotaError
+	switch {
+	case errors.As(err, &qErr):
+		return status.Error(codes.ResourceExhausted, qErr.Error())
+	case errors.Is(err, storage.ErrNoData):
+		return status.Error(codes.NotFound, "Данные для обновления не найдены")
+	case errors.Is(err, storage.ErrConflict):
+		return status.Error(codes.Aborted, "Данные были изменены, получите актуальную версию и повторите изменение")
+	default:
+		h.log.WithError(err).Error("Error while updating item")
+		return status.Error(codes.Internal, "Internal server error")
+	}
+}
+
+// GetDataHistory возвращает список сохраненных версий данных.
+func (h *GRPCVaultHandler) GetDataHistory(ctx context.Context, in *pb.GetDataHistoryReq) (*pb.GetDataHistoryRes, error) {
+	if in.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Отсутствует id данных")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	history, err := h.storage.GetItemHistory(ctx, in.GetId(), user.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoData):
+			return nil, status.Error(codes.NotFound, "Данные не найдены")
+		default:
+			h.log.WithError(err).Error("Error while getting item history")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	var revisions []*pb.GetDataHistoryRes_Revision
+	for _, rev := range history {
+		revisions = append(revisions, &pb.GetDataHistoryRes_Revision{
+			Revision:  rev.Revision,
+			CreatedAt: timestamppb.New(rev.CreatedAt),
+		})
+	}
+	return &pb.GetDataHistoryRes{
+		Revisions: revisions,
+	}, nil
+}
+
+// GetDataRevision возвращает указанную версию данных.
+func (h *GRPCVaultHandler) GetDataRevision(ctx context.Context, in *pb.GetDataRevisionReq) (*pb.GetDataRevisionRes, error) {
+	if in.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Отсутствует id данных")
+	}
+	if in.GetRevision() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Некорректный номер версии")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
 	}
-	err = h.storage.UpdateItem(ctx, in.GetId(), user.ID, item)
+	item, err := h.storage.GetItem(ctx, in.GetId(), user.ID)
 	if err != nil {
 		switch {
-		case errors.Is(err, postgres.ErrNoData):
-			return nil, status.Error(codes.NotFound, "Данные для обновления не найдены")
+		case errors.Is(err, storage.ErrNoData):
+			return nil, status.Error(codes.NotFound, "Данные не найдены")
+		default:
+			h.log.WithError(err).Error("Error while getting item")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	rev, err := h.storage.GetItemRevision(ctx, in.GetId(), user.ID, in.GetRevision())
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoData):
+			return nil, status.Error(codes.NotFound, "Данные не найдены")
+		case errors.Is(err, storage.ErrNoRevision):
+			return nil, status.Error(codes.NotFound, "Версия не найдена")
+		default:
+			h.log.WithError(err).Error("Error while getting item revision")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	key, err := dataKey(user, item)
+	if err != nil {
+		h.log.WithError(err).Error("Error while decrypting item key")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	decData, err := h.itemData(ctx, user, key, rev.EncryptData, rev.Chunked)
+	if err != nil {
+		h.log.WithError(err).Error("Error while reading user data")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	meta, err := utils.DecryptMeta(rev.EncryptMeta, rev.Meta, user.Secret)
+	if err != nil {
+		h.log.WithError(err).Error("Error while decrypting user meta")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	return &pb.GetDataRevisionRes{
+		Id:       rev.ItemID,
+		Revision: rev.Revision,
+		Item: &pb.Item{
+			Data: decData,
+			Type: string(item.Type),
+			Meta: meta,
+		},
+	}, nil
+}
+
+// RestoreData восстанавливает указанную версию данных.
+// Текущее содержимое при этом сохраняется в истории как новая версия.
+func (h *GRPCVaultHandler) RestoreData(ctx context.Context, in *pb.RestoreDataReq) (*pb.RestoreDataRes, error) {
+	if in.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Отсутствует id данных")
+	}
+	if in.GetRevision() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Некорректный номер версии")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	err := h.restoreRevision(ctx, in.GetId(), user.ID, in.GetRevision())
+	if err != nil {
+		var qErr *quotaError
+		switch {
+		case errors.As(err, &qErr):
+			return nil, status.Error(codes.ResourceExhausted, qErr.Error())
+		case errors.Is(err, storage.ErrNoData):
+			return nil, status.Error(codes.NotFound, "Данные для восстановления не найдены")
+		case errors.Is(err, storage.ErrNoRevision):
+			return nil, status.Error(codes.NotFound, "Версия не найдена")
 		default:
-			h.log.WithError(err).Error("Error while updating item")
+			h.log.WithError(err).Error("Error while restoring item revision")
 			return nil, status.Error(codes.Internal, "Internal server error")
 		}
 	}
-	return &pb.UpdateDataRes{}, nil
+	return &pb.RestoreDataRes{}, nil
+}
+
+// GetTrash возвращает список данных в корзине.
+func (h *GRPCVaultHandler) GetTrash(ctx context.Context, _ *pb.GetTrashReq) (*pb.GetTrashRes, error) {
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	items, err := h.storage.GetDeletedItems(ctx, user.ID)
+	if err != nil {
+		h.log.WithError(err).Error("Error while getting deleted items")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	if err = decryptItemsMeta(items, user.Secret); err != nil {
+		h.log.WithError(err).Error("Error while decrypting user meta")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	var responseItems []*pb.GetTrashRes_TrashItem
+	for _, item := range items {
+		trashItem := &pb.GetTrashRes_TrashItem{
+			Id:   item.ID,
+			Type: string(item.Type),
+			Meta: item.Meta,
+		}
+		if item.DeletedAt != nil {
+			trashItem.DeletedAt = timestamppb.New(*item.DeletedAt)
+		}
+		responseItems = append(responseItems, trashItem)
+	}
+	return &pb.GetTrashRes{
+		Items: responseItems,
+	}, nil
+}
+
+// RestoreFromTrash восстанавливает данные из корзины вместе с файлами, удаленными одновременно с ними.
+func (h *GRPCVaultHandler) RestoreFromTrash(
+	ctx context.Context, in *pb.RestoreFromTrashReq,
+) (*pb.RestoreFromTrashRes, error) {
+	if in.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "Отсутствует id данных")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	err := h.storage.RestoreDeletedItem(ctx, in.GetId(), user.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoData):
+			return nil, status.Error(codes.NotFound, "Данные в корзине не найдены")
+		case errors.Is(err, storage.ErrParentInTrash):
+			return nil, status.Error(
+				codes.FailedPrecondition, "Данные, к которым прикреплен файл, в корзине: сначала восстановите их",
+			)
+		default:
+			h.log.WithError(err).Error("Error while restoring deleted item")
+			return nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}
+	return &pb.RestoreFromTrashRes{}, nil
+}
+
+// EmptyTrash безвозвратно удаляет все данные из корзины.
+func (h *GRPCVaultHandler) EmptyTrash(ctx context.Context, _ *pb.EmptyTrashReq) (*pb.EmptyTrashRes, error) {
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil {
+		h.log.Error("failed to get user from context")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	deleted, err := h.storage.PurgeDeletedItems(ctx, user.ID)
+	if err != nil {
+		h.log.WithError(err).Error("Error while purging deleted items")
+		return nil, status.Error(codes.Internal, "Internal server error")
+	}
+	return &pb.EmptyTrashRes{
+		Deleted: deleted,
+	}, nil
+}
+
+// listItems возвращает страницу списка данных с расшифрованными мета данными.
+// Мета данные в хранилище зашифрованы, поэтому для сортировки по ним расшифровываются
+// и упорядочиваются все данные выборки, а страница выбирается на сервере.
+// Если в выборке больше maxScanItems данных, возвращается errTooManyItems.
+func (h *GRPCVaultHandler) listItems(
+	ctx context.Context, user *appCtx.CtxUser, params model.ListItemsParams,
+) ([]model.VaultItem, error) {
+	if params.SortBy != model.SortByMeta {
+		items, err := h.storage.ListItems(ctx, user.ID, params)
+		if err != nil {
+			return nil, err
+		}
+		return items, decryptItemsMeta(items, user.Secret)
+	}
+	items, err := h.storage.ListItems(ctx, user.ID, model.ListItemsParams{
+		Type:     params.Type,
+		SortBy:   model.SortByCreated,
+		FolderID: params.FolderID,
+		TagIndex: params.TagIndex,
+		Limit:    maxScanItems + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(items) > maxScanItems {
+		return nil, errTooManyItems
+	}
+	if err = decryptItemsMeta(items, user.Secret); err != nil {
+		return nil, err
+	}
+	return model.PageItems(items, params), nil
+}
+
+// searchExact ищет данные, у которых значение одного из индексируемых полей мета данных совпадает со строкой.
+func (h *GRPCVaultHandler) searchExact(
+	ctx context.Context, user *appCtx.CtxUser, query string, limit int,
+) ([]model.VaultItem, error) {
+	params := model.SearchItemsParams{Limit: limit}
+	for _, field := range model.IndexedMetaFields() {
+		token, err := utils.BlindIndex(user.Secret, field, query)
+		if err != nil {
+			return nil, err
+		}
+		params.Index = append(params.Index, token)
+	}
+	items, err := h.storage.SearchItems(ctx, user.ID, params)
+	if err != nil {
+		return nil, err
+	}
+	return items, decryptItemsMeta(items, user.Secret)
+}
+
+// searchSubstring ищет данные, в поля мета данных которых входит строка (при prefix - с начала значения).
+// Проверяются только maxScanItems последних измененных данных: если среди них найдено меньше limit данных,
+// а у пользователя есть и более старые, возвращается errTooManyItems, чтобы не вернуть неполный результат.
+// Результат упорядочен по времени обновления, начиная с последних.
+func (h *GRPCVaultHandler) searchSubstring(
+	ctx context.Context, user *appCtx.CtxUser, query string, prefix bool, limit int,
+) ([]model.VaultItem, error) {
+	items, err := h.storage.ListItems(ctx, user.ID, model.ListItemsParams{
+		SortBy: model.SortByUpdated,
+		Desc:   true,
+		Limit:  maxScanItems + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	truncated := len(items) > maxScanItems
+	if truncated {
+		items = items[:maxScanItems]
+	}
+	if err = decryptItemsMeta(items, user.Secret); err != nil {
+		return nil, err
+	}
+	query = strings.ToLower(query)
+	found := make([]model.VaultItem, 0, limit)
+	for _, item := range items {
+		if len(found) == limit {
+			break
+		}
+		if model.MatchMeta(item, query, prefix) {
+			found = append(found, item)
+		}
+	}
+	if truncated && len(found) < limit {
+		return nil, errTooManyItems
+	}
+	return found, nil
+}
+
+// decryptItemsMeta расшифровывает мета данные списка данных и записывает их в поле Meta.
+func decryptItemsMeta(items []model.VaultItem, secret string) error {
+	for i := range items {
+		meta, err := utils.DecryptMeta(items[i].EncryptMeta, items[i].Meta, secret)
+		if err != nil {
+			return err
+		}
+		items[i].Meta = meta
+	}
+	return nil
+}
+
+// encodePageToken формирует токен страницы из позиции в списке и параметров выборки.
+func encodePageToken(cursor *model.ItemsCursor, params model.ListItemsParams) (string, error) {
+	token, err := json.Marshal(pageToken{
+		Type:           string(params.Type),
+		SortBy:         string(params.SortBy),
+		MetaField:      params.MetaField,
+		Desc:           params.Desc,
+		FolderID:       params.FolderID,
+		Tag:            params.TagIndex,
+		Time:           cursor.Time,
+		Meta:           cursor.Meta,
+		ID:             cursor.ID,
+		FavoritesFirst: params.FavoritesFirst,
+		Favorite:       cursor.Favorite,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// decodePageToken разбирает токен страницы и проверяет, что он выдан для тех же параметров выборки.
+func decodePageToken(token string, params model.ListItemsParams) (*model.ItemsCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	var decoded pageToken
+	if err = json.Unmarshal(raw, &decoded); err != nil {
+		return nil, err
+	}
+	if decoded.ID == "" ||
+		decoded.Type != string(params.Type) ||
+		decoded.SortBy != string(params.SortBy) ||
+		decoded.MetaField != params.MetaField ||
+		decoded.Desc != params.Desc ||
+		decoded.FolderID != params.FolderID ||
+		decoded.Tag != params.TagIndex ||
+		decoded.FavoritesFirst != params.FavoritesFirst {
+		return nil, errors.New("page token does not match request")
+	}
+	return &model.ItemsCursor{
+		Favorite: decoded.Favorite,
+		Time:     decoded.Time,
+		Meta:     decoded.Meta,
+		ID:       decoded.ID,
+	}, nil
 }
 
 // isValidDataType валидирует корректность типа данных.
@@ -198,3 +850,12 @@ func isValidDataType(dataType string) bool {
 	}
 	return false
 }
+
+// isSortableMetaField проверяет, допускается ли сортировка списка по полю мета данных.
+func isSortableMetaField(field string) bool {
+	switch field {
+	case "resource", "login", "name", "bank", "comment", "extension":
+		return true
+	}
+	return false
+}
@@ -3,20 +3,24 @@ package handlers
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pinbrain/gophkeeper/internal/logger"
 	"github.com/pinbrain/gophkeeper/internal/model"
 	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/server/config"
 	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
 	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
 	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
-	"github.com/pinbrain/gophkeeper/internal/storage/postgres"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestAddData(t *testing.T) {
@@ -27,7 +31,7 @@ func TestAddData(t *testing.T) {
 	log, err := logger.NewLogger("info")
 	require.NoError(t, err)
 	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
-	handler := NewGRPCVaultHandler(masterKey, mockStorage, log.WithField("instance", "grpcTransport"))
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
 
 	type Store struct {
 		err error
@@ -104,6 +108,42 @@ func TestAddData(t *testing.T) {
 			wantErr: true,
 			errCode: codes.InvalidArgument,
 		},
+		{
+			name: "Данные со сроком хранения",
+			user: &appCtx.CtxUser{
+				ID:     "1",
+				Login:  "user",
+				Secret: masterKey,
+			},
+			request: &pb.AddDataReq{
+				Item: &pb.Item{
+					Data: []byte("123"),
+					Type: string(model.Password),
+					Meta: "some meta info",
+				},
+				ExpiresAt: timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			store:   &Store{},
+			wantErr: false,
+		},
+		{
+			name: "Истекший срок хранения",
+			user: &appCtx.CtxUser{
+				ID:     "1",
+				Login:  "user",
+				Secret: masterKey,
+			},
+			request: &pb.AddDataReq{
+				Item: &pb.Item{
+					Data: []byte("123"),
+					Type: string(model.Password),
+					Meta: "some meta info",
+				},
+				ExpiresAt: timestamppb.New(time.Now().Add(-time.Minute)),
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
 		{
 			name: "Ошибка сохранения данных в БД",
 			user: &appCtx.CtxUser{
@@ -134,11 +174,18 @@ func TestAddData(t *testing.T) {
 						if len(item.EncryptData) == 0 {
 							t.Errorf("EncryptData is nil or empty")
 						}
+						meta, err := utils.DecryptMeta(item.EncryptMeta, item.Meta, tt.user.Secret)
+						require.NoError(t, err)
 						if userID != tt.user.ID ||
-							item.Meta != tt.request.GetItem().GetMeta() ||
+							item.Meta != "" ||
+							meta != tt.request.GetItem().GetMeta() ||
 							item.Type != model.DataType(tt.request.GetItem().GetType()) {
 							t.Errorf("Unexpected VaultItem data: got %+v", item)
 						}
+						assert.Equal(t, tt.request.GetExpiresAt() != nil, item.ExpiresAt != nil)
+						if item.ExpiresAt != nil {
+							assert.True(t, item.ExpiresAt.Equal(tt.request.GetExpiresAt().AsTime()))
+						}
 						return "1", tt.store.err
 					},
 				)
@@ -168,12 +215,15 @@ func TestGetData(t *testing.T) {
 	log, err := logger.NewLogger("info")
 	require.NoError(t, err)
 	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
-	handler := NewGRPCVaultHandler(masterKey, mockStorage, log.WithField("instance", "grpcTransport"))
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
 
 	type Store struct {
-		err     error
-		resItem *model.VaultItem
+		err         error
+		resItem     *model.VaultItem
+		attachments []model.VaultItem
+		accessErr   error
 	}
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
 	tests := []struct {
 		name    string
 		user    *appCtx.CtxUser
@@ -197,11 +247,38 @@ func TestGetData(t *testing.T) {
 			store: &Store{
 				err: nil,
 				resItem: &model.VaultItem{
-					ID:     "1",
-					UserID: "1",
-					Meta:   "some data meta",
-					Type:   "PASSWORD",
+					ID:       "1",
+					UserID:   "1",
+					Meta:     "some data meta",
+					Type:     "PASSWORD",
+					Revision: 3,
+				},
+				attachments: []model.VaultItem{
+					{ID: "2", Meta: `{"name":"codes.txt"}`, Size: 42, CreatedAt: created, ParentID: "1"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Ошибка записи статистики получения не влияет на ответ",
+			user: &appCtx.CtxUser{
+				ID:     "1",
+				Login:  "password",
+				Secret: masterKey,
+			},
+			request: &pb.GetDataReq{
+				Id: "1",
+			},
+			data: []byte("some stored data"),
+			store: &Store{
+				resItem: &model.VaultItem{
+					ID:       "1",
+					UserID:   "1",
+					Meta:     "some data meta",
+					Type:     "PASSWORD",
+					Revision: 1,
 				},
+				accessErr: errors.New("db error"),
 			},
 			wantErr: false,
 		},
@@ -230,7 +307,7 @@ func TestGetData(t *testing.T) {
 				Id: "1",
 			},
 			store: &Store{
-				err: postgres.ErrNoData,
+				err: storage.ErrNoData,
 			},
 			wantErr: true,
 			errCode: codes.NotFound,
@@ -267,6 +344,17 @@ func TestGetData(t *testing.T) {
 						return tt.store.resItem, nil
 					},
 				)
+				if errors.Is(tt.store.err, storage.ErrNoData) {
+					// Данных нет у пользователя и к ним не предоставлялся доступ.
+					mockStorage.EXPECT().GetShare(gomock.Any(), tt.request.GetId(), tt.user.ID).
+						Return(nil, storage.ErrNoShare)
+				}
+				if tt.store.err == nil {
+					mockStorage.EXPECT().ListAttachments(gomock.Any(), tt.request.GetId(), tt.user.ID).
+						Return(tt.store.attachments, nil)
+					mockStorage.EXPECT().RecordAccess(gomock.Any(), tt.request.GetId(), tt.user.ID, gomock.Any()).
+						Return(tt.store.accessErr)
+				}
 			}
 
 			ctx := context.Background()
@@ -279,6 +367,14 @@ func TestGetData(t *testing.T) {
 				require.NoError(t, err)
 				assert.Equal(t, tt.data, response.GetItem().GetData())
 				assert.Equal(t, tt.store.resItem.Meta, response.GetItem().GetMeta())
+				assert.Equal(t, tt.store.resItem.Revision, response.GetRevision())
+				require.Len(t, response.GetAttachments(), len(tt.store.attachments))
+				for i, attachment := range tt.store.attachments {
+					assert.Equal(t, attachment.ID, response.GetAttachments()[i].GetId())
+					assert.Equal(t, attachment.Meta, response.GetAttachments()[i].GetMeta())
+					assert.Equal(t, attachment.Size, response.GetAttachments()[i].GetSize())
+					assert.Equal(t, attachment.CreatedAt, response.GetAttachments()[i].GetCreatedAt().AsTime())
+				}
 			} else {
 				code, _ := status.FromError(err)
 				assert.Equal(t, tt.errCode, code.Code())
@@ -295,7 +391,7 @@ func TestDeleteData(t *testing.T) {
 	log, err := logger.NewLogger("info")
 	require.NoError(t, err)
 	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
-	handler := NewGRPCVaultHandler(masterKey, mockStorage, log.WithField("instance", "grpcTransport"))
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
 
 	type Store struct {
 		err error
@@ -350,7 +446,7 @@ func TestDeleteData(t *testing.T) {
 				Id: "1",
 			},
 			store: &Store{
-				err: postgres.ErrNoData,
+				err: storage.ErrNoData,
 			},
 			wantErr: true,
 			errCode: codes.NotFound,
@@ -402,7 +498,7 @@ func TestGetAllByType(t *testing.T) {
 	log, err := logger.NewLogger("info")
 	require.NoError(t, err)
 	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
-	handler := NewGRPCVaultHandler(masterKey, mockStorage, log.WithField("instance", "grpcTransport"))
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
 
 	type Store struct {
 		err error
@@ -504,10 +600,11 @@ func TestUpdateData(t *testing.T) {
 	log, err := logger.NewLogger("info")
 	require.NoError(t, err)
 	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
-	handler := NewGRPCVaultHandler(masterKey, mockStorage, log.WithField("instance", "grpcTransport"))
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
 
 	type Store struct {
-		err error
+		revision int64
+		err      error
 	}
 	tests := []struct {
 		name    string
@@ -525,15 +622,70 @@ func TestUpdateData(t *testing.T) {
 				Secret: masterKey,
 			},
 			request: &pb.UpdateDataReq{
-				Id:   "1",
-				Data: []byte("some data"),
-				Meta: "some meta",
+				Id:       "1",
+				Data:     []byte("some data"),
+				Meta:     "some meta",
+				Revision: 2,
 			},
 			store: &Store{
-				err: nil,
+				revision: 3,
+				err:      nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Изменение срока хранения",
+			user: &appCtx.CtxUser{
+				ID:     "1",
+				Login:  "user",
+				Secret: masterKey,
+			},
+			request: &pb.UpdateDataReq{
+				Id:        "1",
+				Data:      []byte("some data"),
+				Meta:      "some meta",
+				ExpiresAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+			},
+			store: &Store{
+				revision: 2,
 			},
 			wantErr: false,
 		},
+		{
+			name: "Истекший срок хранения",
+			user: &appCtx.CtxUser{
+				ID:     "1",
+				Login:  "user",
+				Secret: masterKey,
+			},
+			request: &pb.UpdateDataReq{
+				Id:        "1",
+				Data:      []byte("some data"),
+				Meta:      "some meta",
+				ExpiresAt: timestamppb.New(time.Now()),
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Конфликт версий",
+			user: &appCtx.CtxUser{
+				ID:     "1",
+				Login:  "user",
+				Secret: masterKey,
+			},
+			request: &pb.UpdateDataReq{
+				Id:       "1",
+				Data:     []byte("some data"),
+				Meta:     "some meta",
+				Revision: 1,
+			},
+			store: &Store{
+				err: storage.ErrConflict,
+			},
+			wantErr: true,
+			errCode: codes.Aborted,
+		},
 		{
 			name: "Нет id в запросе",
 			user: &appCtx.CtxUser{
@@ -568,7 +720,7 @@ func TestUpdateData(t *testing.T) {
 				Meta: "some meta",
 			},
 			store: &Store{
-				err: postgres.ErrNoData,
+				err: storage.ErrNoData,
 			},
 			wantErr: true,
 			errCode: codes.NotFound,
@@ -596,15 +748,23 @@ func TestUpdateData(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.store != nil {
+				mockStorage.EXPECT().GetItem(gomock.Any(), tt.request.GetId(), tt.user.ID).
+					Return(&model.VaultItem{ID: tt.request.GetId(), UserID: tt.user.ID}, nil)
 				mockStorage.EXPECT().UpdateItem(gomock.Any(), tt.request.GetId(), tt.user.ID, gomock.Any()).DoAndReturn(
 					func(ctx context.Context, id string, userID string, item *model.VaultItem) error {
 						if len(item.EncryptData) == 0 {
 							t.Errorf("EncryptData is nil or empty")
 						}
+						meta, err := utils.DecryptMeta(item.EncryptMeta, item.Meta, tt.user.Secret)
+						require.NoError(t, err)
 						if userID != tt.user.ID ||
-							item.Meta != tt.request.GetMeta() {
+							item.Meta != "" ||
+							meta != tt.request.GetMeta() ||
+							item.Revision != tt.request.GetRevision() {
 							t.Errorf("Unexpected VaultItem data: got %+v", item)
 						}
+						assert.Equal(t, tt.request.GetExpiresAt() != nil, item.ExpiresAt != nil)
+						item.Revision = tt.store.revision
 						return tt.store.err
 					},
 				)
@@ -615,9 +775,1218 @@ func TestUpdateData(t *testing.T) {
 				ctx = appCtx.CtxWithUser(ctx, tt.user)
 			}
 
-			_, err = handler.UpdateData(ctx, tt.request)
+			res, err := handler.UpdateData(ctx, tt.request)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				assert.Equal(t, tt.store.revision, res.GetRevision())
+			} else {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+			}
+		})
+	}
+}
+
+func TestGetDataHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+
+	type Store struct {
+		err     error
+		history []model.VaultItemRevision
+	}
+	tests := []struct {
+		name    string
+		user    *appCtx.CtxUser
+		request *pb.GetDataHistoryReq
+		store   *Store
+		wantErr bool
+		errCode codes.Code
+	}{
+		{
+			name: "Успешный запрос",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			request: &pb.GetDataHistoryReq{
+				Id: "1",
+			},
+			store: &Store{
+				history: []model.VaultItemRevision{
+					{ItemID: "1", Revision: 1, CreatedAt: time.Now()},
+					{ItemID: "1", Revision: 2, CreatedAt: time.Now()},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Нет id в запросе",
+			request: &pb.GetDataHistoryReq{},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Ошибка получения пользователя запроса",
+			request: &pb.GetDataHistoryReq{
+				Id: "1",
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+		{
+			name: "Данные не найдены",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			request: &pb.GetDataHistoryReq{
+				Id: "1",
+			},
+			store: &Store{
+				err: storage.ErrNoData,
+			},
+			wantErr: true,
+			errCode: codes.NotFound,
+		},
+		{
+			name: "Ошибка БД",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			request: &pb.GetDataHistoryReq{
+				Id: "1",
+			},
+			store: &Store{
+				err: errors.New("db error"),
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.store != nil {
+				mockStorage.EXPECT().GetItemHistory(gomock.Any(), tt.request.GetId(), tt.user.ID).
+					Return(tt.store.history, tt.store.err)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			response, err := handler.GetDataHistory(ctx, tt.request)
 			if !tt.wantErr {
 				require.NoError(t, err)
+				require.Len(t, response.GetRevisions(), len(tt.store.history))
+				for i, rev := range tt.store.history {
+					assert.Equal(t, rev.Revision, response.GetRevisions()[i].GetRevision())
+				}
+			} else {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+			}
+		})
+	}
+}
+
+func TestGetDataRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+
+	type Store struct {
+		itemErr error
+		revErr  error
+		meta    string
+	}
+	tests := []struct {
+		name    string
+		user    *appCtx.CtxUser
+		request *pb.GetDataRevisionReq
+		data    []byte
+		store   *Store
+		wantErr bool
+		errCode codes.Code
+	}{
+		{
+			name: "Успешный запрос",
+			user: &appCtx.CtxUser{
+				ID:     "1",
+				Login:  "user",
+				Secret: masterKey,
+			},
+			request: &pb.GetDataRevisionReq{
+				Id:       "1",
+				Revision: 1,
+			},
+			data: []byte("old data"),
+			store: &Store{
+				meta: "old meta",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Нет id в запросе",
+			request: &pb.GetDataRevisionReq{
+				Revision: 1,
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Некорректный номер версии",
+			request: &pb.GetDataRevisionReq{
+				Id: "1",
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Ошибка получения пользователя запроса",
+			request: &pb.GetDataRevisionReq{
+				Id:       "1",
+				Revision: 1,
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+		{
+			name: "Данные не найдены",
+			user: &appCtx.CtxUser{
+				ID:     "1",
+				Login:  "user",
+				Secret: masterKey,
+			},
+			request: &pb.GetDataRevisionReq{
+				Id:       "1",
+				Revision: 1,
+			},
+			store: &Store{
+				itemErr: storage.ErrNoData,
+			},
+			wantErr: true,
+			errCode: codes.NotFound,
+		},
+		{
+			name: "Версия не найдена",
+			user: &appCtx.CtxUser{
+				ID:     "1",
+				Login:  "user",
+				Secret: masterKey,
+			},
+			request: &pb.GetDataRevisionReq{
+				Id:       "1",
+				Revision: 5,
+			},
+			store: &Store{
+				revErr: storage.ErrNoRevision,
+			},
+			wantErr: true,
+			errCode: codes.NotFound,
+		},
+		{
+			name: "Ошибка БД",
+			user: &appCtx.CtxUser{
+				ID:     "1",
+				Login:  "user",
+				Secret: masterKey,
+			},
+			request: &pb.GetDataRevisionReq{
+				Id:       "1",
+				Revision: 1,
+			},
+			store: &Store{
+				revErr: errors.New("db error"),
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.store != nil {
+				var item *model.VaultItem
+				if tt.store.itemErr == nil {
+					item = &model.VaultItem{ID: "1", UserID: "1", Type: model.Password}
+				}
+				mockStorage.EXPECT().GetItem(gomock.Any(), tt.request.GetId(), tt.user.ID).
+					Return(item, tt.store.itemErr)
+				if tt.store.itemErr == nil {
+					mockStorage.EXPECT().GetItemRevision(gomock.Any(), tt.request.GetId(), tt.user.ID, tt.request.GetRevision()).DoAndReturn(
+						func(ctx context.Context, id string, userID string, revision int64) (*model.VaultItemRevision, error) {
+							if tt.store.revErr != nil {
+								return nil, tt.store.revErr
+							}
+							encData, err := utils.Encrypt(tt.data, tt.user.Secret)
+							require.NoError(t, err)
+							return &model.VaultItemRevision{
+								ItemID:      id,
+								Revision:    revision,
+								EncryptData: encData,
+								Meta:        tt.store.meta,
+							}, nil
+						},
+					)
+				}
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			response, err := handler.GetDataRevision(ctx, tt.request)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				assert.Equal(t, tt.data, response.GetItem().GetData())
+				assert.Equal(t, tt.store.meta, response.GetItem().GetMeta())
+				assert.Equal(t, string(model.Password), response.GetItem().GetType())
+				assert.Equal(t, tt.request.GetRevision(), response.GetRevision())
+			} else {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+			}
+		})
+	}
+}
+
+func TestRestoreData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+
+	type Store struct {
+		err error
+	}
+	tests := []struct {
+		name    string
+		user    *appCtx.CtxUser
+		request *pb.RestoreDataReq
+		store   *Store
+		wantErr bool
+		errCode codes.Code
+	}{
+		{
+			name: "Успешный запрос",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			request: &pb.RestoreDataReq{
+				Id:       "1",
+				Revision: 1,
+			},
+			store:   &Store{},
+			wantErr: false,
+		},
+		{
+			name: "Нет id в запросе",
+			request: &pb.RestoreDataReq{
+				Revision: 1,
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Некорректный номер версии",
+			request: &pb.RestoreDataReq{
+				Id:       "1",
+				Revision: -1,
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Ошибка получения пользователя запроса",
+			request: &pb.RestoreDataReq{
+				Id:       "1",
+				Revision: 1,
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+		{
+			name: "Данные не найдены",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			request: &pb.RestoreDataReq{
+				Id:       "1",
+				Revision: 1,
+			},
+			store: &Store{
+				err: storage.ErrNoData,
+			},
+			wantErr: true,
+			errCode: codes.NotFound,
+		},
+		{
+			name: "Версия не найдена",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			request: &pb.RestoreDataReq{
+				Id:       "1",
+				Revision: 3,
+			},
+			store: &Store{
+				err: storage.ErrNoRevision,
+			},
+			wantErr: true,
+			errCode: codes.NotFound,
+		},
+		{
+			name: "Ошибка БД",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			request: &pb.RestoreDataReq{
+				Id:       "1",
+				Revision: 1,
+			},
+			store: &Store{
+				err: errors.New("db error"),
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.store != nil {
+				mockStorage.EXPECT().RestoreItemRevision(gomock.Any(), tt.request.GetId(), tt.user.ID, tt.request.GetRevision()).
+					Return(tt.store.err)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			_, err := handler.RestoreData(ctx, tt.request)
+			if !tt.wantErr {
+				require.NoError(t, err)
+			} else {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+			}
+		})
+	}
+}
+
+func TestGetTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+
+	deletedAt := time.Now()
+	type Store struct {
+		err   error
+		items []model.VaultItem
+	}
+	tests := []struct {
+		name    string
+		user    *appCtx.CtxUser
+		store   *Store
+		wantErr bool
+		errCode codes.Code
+	}{
+		{
+			name: "Успешный запрос",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			store: &Store{
+				items: []model.VaultItem{
+					{ID: "1", Type: model.Text, Meta: "some meta", DeletedAt: &deletedAt},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Ошибка получения пользователя запроса",
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+		{
+			name: "Ошибка БД",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			store: &Store{
+				err: errors.New("db error"),
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.store != nil {
+				mockStorage.EXPECT().GetDeletedItems(gomock.Any(), tt.user.ID).Return(tt.store.items, tt.store.err)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			response, err := handler.GetTrash(ctx, &pb.GetTrashReq{})
+			if !tt.wantErr {
+				require.NoError(t, err)
+				require.Len(t, response.GetItems(), len(tt.store.items))
+				assert.Equal(t, "1", response.GetItems()[0].GetId())
+				assert.Equal(t, string(model.Text), response.GetItems()[0].GetType())
+				assert.True(t, deletedAt.Equal(response.GetItems()[0].GetDeletedAt().AsTime()))
+			} else {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+			}
+		})
+	}
+}
+
+func TestRestoreFromTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+
+	type Store struct {
+		err error
+	}
+	tests := []struct {
+		name    string
+		user    *appCtx.CtxUser
+		request *pb.RestoreFromTrashReq
+		store   *Store
+		wantErr bool
+		errCode codes.Code
+	}{
+		{
+			name: "Успешный запрос",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			request: &pb.RestoreFromTrashReq{
+				Id: "1",
+			},
+			store:   &Store{},
+			wantErr: false,
+		},
+		{
+			name:    "Нет id в запросе",
+			request: &pb.RestoreFromTrashReq{},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Ошибка получения пользователя запроса",
+			request: &pb.RestoreFromTrashReq{
+				Id: "1",
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+		{
+			name: "Данные не найдены",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			request: &pb.RestoreFromTrashReq{
+				Id: "1",
+			},
+			store: &Store{
+				err: storage.ErrNoData,
+			},
+			wantErr: true,
+			errCode: codes.NotFound,
+		},
+		{
+			name: "Данные вложения в корзине",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			request: &pb.RestoreFromTrashReq{
+				Id: "1",
+			},
+			store: &Store{
+				err: storage.ErrParentInTrash,
+			},
+			wantErr: true,
+			errCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Ошибка БД",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			request: &pb.RestoreFromTrashReq{
+				Id: "1",
+			},
+			store: &Store{
+				err: errors.New("db error"),
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.store != nil {
+				mockStorage.EXPECT().RestoreDeletedItem(gomock.Any(), tt.request.GetId(), tt.user.ID).Return(tt.store.err)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			_, err := handler.RestoreFromTrash(ctx, tt.request)
+			if !tt.wantErr {
+				require.NoError(t, err)
+			} else {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+			}
+		})
+	}
+}
+
+func TestEmptyTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+
+	type Store struct {
+		deleted int64
+		err     error
+	}
+	tests := []struct {
+		name    string
+		user    *appCtx.CtxUser
+		store   *Store
+		wantErr bool
+		errCode codes.Code
+	}{
+		{
+			name: "Успешный запрос",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			store: &Store{
+				deleted: 3,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Ошибка получения пользователя запроса",
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+		{
+			name: "Ошибка БД",
+			user: &appCtx.CtxUser{
+				ID:    "1",
+				Login: "user",
+			},
+			store: &Store{
+				err: errors.New("db error"),
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.store != nil {
+				mockStorage.EXPECT().PurgeDeletedItems(gomock.Any(), tt.user.ID).Return(tt.store.deleted, tt.store.err)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			response, err := handler.EmptyTrash(ctx, &pb.EmptyTrashReq{})
+			if !tt.wantErr {
+				require.NoError(t, err)
+				assert.Equal(t, tt.store.deleted, response.GetDeleted())
+			} else {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+			}
+		})
+	}
+}
+
+func TestListItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{
+		ID:    "1",
+		Login: "user",
+	}
+
+	now := time.Now()
+	items := []model.VaultItem{
+		{ID: "1", Type: model.Password, Meta: `{"resource":"a"}`, CreatedAt: now, UpdatedAt: now},
+		{ID: "2", Type: model.Password, Meta: `{"resource":"b"}`, CreatedAt: now, UpdatedAt: now},
+		{ID: "3", Type: model.Password, Meta: `{"resource":"c"}`, CreatedAt: now, UpdatedAt: now},
+	}
+
+	type Store struct {
+		params model.ListItemsParams
+		items  []model.VaultItem
+		err    error
+	}
+	tests := []struct {
+		name         string
+		user         *appCtx.CtxUser
+		request      *pb.ListItemsReq
+		store        *Store
+		wantItems    int
+		wantNextPage bool
+		wantErr      bool
+		errCode      codes.Code
+	}{
+		{
+			name: "Последняя страница",
+			user: user,
+			request: &pb.ListItemsReq{
+				PageSize: 5,
+			},
+			store: &Store{
+				params: model.ListItemsParams{SortBy: model.SortByCreated, Limit: 6},
+				items:  items,
+			},
+			wantItems:    3,
+			wantNextPage: false,
+		},
+		{
+			name: "Есть следующая страница",
+			user: user,
+			request: &pb.ListItemsReq{
+				Type:      string(model.Password),
+				SortBy:    pb.ListItemsReq_META,
+				MetaField: "resource",
+				Desc:      true,
+				PageSize:  2,
+			},
+			store: &Store{
+				// Для сортировки по мета данным из хранилища выбираются все данные (не более maxScanItems).
+				params: model.ListItemsParams{Type: model.Password, SortBy: model.SortByCreated, Limit: maxScanItems + 1},
+				items:  items,
+			},
+			wantItems:    2,
+			wantNextPage: true,
+		},
+		{
+			name: "Размер страницы по умолчанию",
+			user: user,
+			request: &pb.ListItemsReq{
+				SortBy: pb.ListItemsReq_UPDATED_AT,
+			},
+			store: &Store{
+				params: model.ListItemsParams{SortBy: model.SortByUpdated, Limit: defaultPageSize + 1},
+			},
+			wantItems: 0,
+		},
+		{
+			name: "Неизвестный тип данных",
+			request: &pb.ListItemsReq{
+				Type: "UNKNOWN",
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Недопустимое поле сортировки",
+			request: &pb.ListItemsReq{
+				SortBy:    pb.ListItemsReq_META,
+				MetaField: "password",
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Некорректный размер страницы",
+			request: &pb.ListItemsReq{
+				PageSize: -1,
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Некорректный токен страницы",
+			user: user,
+			request: &pb.ListItemsReq{
+				PageToken: "not a token",
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Ошибка получения пользователя запроса",
+			request: &pb.ListItemsReq{},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+		{
+			name:    "Ошибка БД",
+			user:    user,
+			request: &pb.ListItemsReq{},
+			store: &Store{
+				params: model.ListItemsParams{SortBy: model.SortByCreated, Limit: defaultPageSize + 1},
+				err:    errors.New("db error"),
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.store != nil {
+				mockStorage.EXPECT().ListItems(gomock.Any(), tt.user.ID, tt.store.params).Return(tt.store.items, tt.store.err)
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			response, err := handler.ListItems(ctx, tt.request)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				assert.Len(t, response.GetItems(), tt.wantItems)
+				assert.Equal(t, tt.wantNextPage, response.GetNextPageToken() != "")
+			} else {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+			}
+		})
+	}
+}
+
+func TestListItemsPageToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	ctx := appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: "1", Login: "user"})
+
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	items := []model.VaultItem{
+		{ID: "1", Type: model.Text, Meta: `{}`, CreatedAt: created},
+		{ID: "2", Type: model.Text, Meta: `{}`, CreatedAt: created.Add(time.Second)},
+	}
+	mockStorage.EXPECT().ListItems(gomock.Any(), "1", model.ListItemsParams{
+		SortBy: model.SortByCreated,
+		Limit:  2,
+	}).Return(items, nil)
+	response, err := handler.ListItems(ctx, &pb.ListItemsReq{PageSize: 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, response.GetNextPageToken())
+
+	// Токен следующей страницы содержит позицию последней выданной записи.
+	mockStorage.EXPECT().ListItems(gomock.Any(), "1", model.ListItemsParams{
+		SortBy: model.SortByCreated,
+		Limit:  2,
+		After:  &model.ItemsCursor{Time: created, ID: "1"},
+	}).Return(items[1:], nil)
+	response, err = handler.ListItems(ctx, &pb.ListItemsReq{PageSize: 1, PageToken: response.GetNextPageToken()})
+	require.NoError(t, err)
+	require.Len(t, response.GetItems(), 1)
+	assert.Equal(t, "2", response.GetItems()[0].GetId())
+	assert.Empty(t, response.GetNextPageToken())
+
+	// Токен нельзя использовать с другими параметрами выборки.
+	token, err := encodePageToken(&model.ItemsCursor{ID: "1"}, model.ListItemsParams{SortBy: model.SortByCreated})
+	require.NoError(t, err)
+	_, err = handler.ListItems(ctx, &pb.ListItemsReq{SortBy: pb.ListItemsReq_UPDATED_AT, PageToken: token})
+	code, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, code.Code())
+}
+
+func TestListItemsRecentFavorites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	ctx := appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: "1", Login: "user"})
+
+	accessed := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	items := []model.VaultItem{
+		{ID: "1", Type: model.Text, Meta: `{}`, Favorite: true, LastAccessedAt: &accessed, AccessCount: 5},
+		{ID: "2", Type: model.Text, Meta: `{}`},
+	}
+	params := model.ListItemsParams{
+		SortBy:         model.SortByAccessed,
+		Desc:           true,
+		FavoritesFirst: true,
+		Limit:          2,
+	}
+	mockStorage.EXPECT().ListItems(gomock.Any(), "1", params).Return(items, nil)
+	request := &pb.ListItemsReq{SortBy: pb.ListItemsReq_ACCESSED, Desc: true, FavoritesFirst: true, PageSize: 1}
+	response, err := handler.ListItems(ctx, request)
+	require.NoError(t, err)
+	require.Len(t, response.GetItems(), 1)
+	assert.True(t, response.GetItems()[0].GetFavorite())
+	assert.Equal(t, accessed, response.GetItems()[0].GetLastAccessedAt().AsTime())
+	assert.Equal(t, int64(5), response.GetItems()[0].GetAccessCount())
+
+	// Позиция следующей страницы учитывает, что последняя выданная запись - избранная.
+	params.After = &model.ItemsCursor{Favorite: true, Time: accessed, ID: "1"}
+	mockStorage.EXPECT().ListItems(gomock.Any(), "1", params).Return(items[1:], nil)
+	request.PageToken = response.GetNextPageToken()
+	response, err = handler.ListItems(ctx, request)
+	require.NoError(t, err)
+	require.Len(t, response.GetItems(), 1)
+	assert.False(t, response.GetItems()[0].GetFavorite())
+	assert.Nil(t, response.GetItems()[0].GetLastAccessedAt())
+
+	// Токен, выданный без избранных в начале списка, не подходит для такой выборки.
+	_, err = handler.ListItems(ctx, &pb.ListItemsReq{
+		SortBy: pb.ListItemsReq_ACCESSED, Desc: true, PageToken: request.GetPageToken(),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListItemsByMeta(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	ctx := appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: "1", Login: "user", Secret: masterKey})
+
+	items := func() []model.VaultItem {
+		return []model.VaultItem{
+			encryptedItem(t, "1", `{"resource":"b"}`, masterKey),
+			encryptedItem(t, "2", `{"resource":"c"}`, masterKey),
+			// Мета данные, сохраненные до включения шифрования.
+			{ID: "3", Type: model.Password, Meta: `{"resource":"a"}`},
+		}
+	}
+	params := model.ListItemsParams{SortBy: model.SortByCreated, Limit: maxScanItems + 1}
+	request := &pb.ListItemsReq{SortBy: pb.ListItemsReq_META, MetaField: "resource", Desc: true, PageSize: 2}
+
+	mockStorage.EXPECT().ListItems(gomock.Any(), "1", params).Return(items(), nil)
+	response, err := handler.ListItems(ctx, request)
+	require.NoError(t, err)
+	require.Len(t, response.GetItems(), 2)
+	assert.Equal(t, "2", response.GetItems()[0].GetId())
+	assert.JSONEq(t, `{"resource":"c"}`, response.GetItems()[0].GetMeta())
+	assert.Equal(t, "1", response.GetItems()[1].GetId())
+	require.NotEmpty(t, response.GetNextPageToken())
+
+	mockStorage.EXPECT().ListItems(gomock.Any(), "1", params).Return(items(), nil)
+	request.PageToken = response.GetNextPageToken()
+	response, err = handler.ListItems(ctx, request)
+	require.NoError(t, err)
+	require.Len(t, response.GetItems(), 1)
+	assert.Equal(t, "3", response.GetItems()[0].GetId())
+	assert.JSONEq(t, `{"resource":"a"}`, response.GetItems()[0].GetMeta())
+	assert.Empty(t, response.GetNextPageToken())
+
+	// Сортировка выборки, в которой больше maxScanItems данных, отклоняется.
+	mockStorage.EXPECT().ListItems(gomock.Any(), "1", params).Return(make([]model.VaultItem, maxScanItems+1), nil)
+	request.PageToken = ""
+	_, err = handler.ListItems(ctx, request)
+	code, _ := status.FromError(err)
+	assert.Equal(t, codes.FailedPrecondition, code.Code())
+}
+
+// encryptedItem возвращает данные с мета данными, зашифрованными ключом пользователя.
+func encryptedItem(t *testing.T, id string, meta string, secret string) model.VaultItem {
+	t.Helper()
+	encMeta, index, err := utils.EncryptMeta(meta, secret)
+	require.NoError(t, err)
+	return model.VaultItem{ID: id, Type: model.Password, EncryptMeta: encMeta, MetaIndex: index}
+}
+
+func TestSearchItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	handler := NewGRPCVaultHandler(masterKey, mockStorage, nil, config.QuotaConfig{}, log.WithField("instance", "grpcTransport"))
+	user := &appCtx.CtxUser{
+		ID:     "1",
+		Login:  "user",
+		Secret: masterKey,
+	}
+	items := []model.VaultItem{
+		encryptedItem(t, "1", `{"resource":"GitHub.com","login":"dev"}`, masterKey),
+		encryptedItem(t, "2", `{"resource":"my gitlab","login":"git"}`, masterKey),
+		encryptedItem(t, "3", `{"bank":"Сбербанк","comment":"Зарплатная"}`, masterKey),
+	}
+	// Данных больше, чем сервер расшифровывает для поиска по вхождению строки.
+	manyItems := append(append([]model.VaultItem{}, items...), make([]model.VaultItem, maxScanItems+1-len(items))...)
+	// Слепые индексы значения "github.com" во всех индексируемых полях.
+	var githubIndex []string
+	for _, field := range model.IndexedMetaFields() {
+		token, err := utils.BlindIndex(masterKey, field, "github.com")
+		require.NoError(t, err)
+		githubIndex = append(githubIndex, token)
+	}
+
+	type Store struct {
+		search *model.SearchItemsParams // Параметры поиска в хранилище; nil - выбираются все данные.
+		items  []model.VaultItem
+		err    error
+	}
+	tests := []struct {
+		name    string
+		user    *appCtx.CtxUser
+		request *pb.SearchItemsReq
+		store   *Store
+		wantIDs []string
+		wantErr bool
+		errCode codes.Code
+	}{
+		{
+			name: "Поиск по началу значения поля",
+			user: user,
+			request: &pb.SearchItemsReq{
+				Query:  "  git ",
+				Prefix: true,
+				Limit:  10,
+			},
+			store: &Store{
+				items: items,
+			},
+			wantIDs: []string{"1", "2"},
+		},
+		{
+			name: "Поиск подстроки без учета регистра",
+			user: user,
+			request: &pb.SearchItemsReq{
+				Query: "БАНК",
+			},
+			store: &Store{
+				items: items,
+			},
+			wantIDs: []string{"3"},
+		},
+		{
+			name: "Ограничение количества результатов",
+			user: user,
+			request: &pb.SearchItemsReq{
+				Query: "git",
+				Limit: 1,
+			},
+			store: &Store{
+				items: items,
+			},
+			wantIDs: []string{"1"},
+		},
+		{
+			// Найденные данные - последние измененные, поэтому более старые данные на результат не влияют.
+			name: "Результат найден среди последних измененных данных",
+			user: user,
+			request: &pb.SearchItemsReq{
+				Query: "git",
+				Limit: 2,
+			},
+			store: &Store{
+				items: manyItems,
+			},
+			wantIDs: []string{"1", "2"},
+		},
+		{
+			name: "Результат неполон из-за количества данных",
+			user: user,
+			request: &pb.SearchItemsReq{
+				Query: "git",
+			},
+			store: &Store{
+				items: manyItems,
+			},
+			wantErr: true,
+			errCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Поиск по точному совпадению",
+			user: user,
+			request: &pb.SearchItemsReq{
+				Query: " GitHub.com",
+				Exact: true,
+			},
+			store: &Store{
+				search: &model.SearchItemsParams{Index: githubIndex, Limit: defaultPageSize},
+				items:  items[:1],
+			},
+			wantIDs: []string{"1"},
+		},
+		{
+			name: "Ничего не найдено",
+			user: user,
+			request: &pb.SearchItemsReq{
+				Query: "missing",
+			},
+			store:   &Store{},
+			wantIDs: nil,
+		},
+		{
+			name: "Пустая строка поиска",
+			request: &pb.SearchItemsReq{
+				Query: "   ",
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Управляющие символы в строке поиска",
+			request: &pb.SearchItemsReq{
+				Query: "a\x1fb",
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Слишком длинная строка поиска",
+			request: &pb.SearchItemsReq{
+				Query: strings.Repeat("a", maxSearchQueryLen+1),
+			},
+			wantErr: true,
+			errCode: codes.InvalidArgument,
+		},
+		{
+			name: "Ошибка получения пользователя запроса",
+			request: &pb.SearchItemsReq{
+				Query: "git",
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+		{
+			name: "Ошибка БД",
+			user: user,
+			request: &pb.SearchItemsReq{
+				Query: "git",
+			},
+			store: &Store{
+				err: errors.New("db error"),
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+		{
+			name: "Ошибка БД при поиске по точному совпадению",
+			user: user,
+			request: &pb.SearchItemsReq{
+				Query: "github.com",
+				Exact: true,
+			},
+			store: &Store{
+				search: &model.SearchItemsParams{Index: githubIndex, Limit: defaultPageSize},
+				err:    errors.New("db error"),
+			},
+			wantErr: true,
+			errCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.store != nil {
+				if tt.store.search != nil {
+					mockStorage.EXPECT().SearchItems(gomock.Any(), tt.user.ID, *tt.store.search).
+						Return(tt.store.items, tt.store.err)
+				} else {
+					mockStorage.EXPECT().ListItems(gomock.Any(), tt.user.ID, model.ListItemsParams{
+						SortBy: model.SortByUpdated,
+						Desc:   true,
+						Limit:  maxScanItems + 1,
+					}).Return(tt.store.items, tt.store.err)
+				}
+			}
+
+			ctx := context.Background()
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+
+			response, err := handler.SearchItems(ctx, tt.request)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				var ids []string
+				for _, item := range response.GetItems() {
+					ids = append(ids, item.GetId())
+					assert.NotEmpty(t, item.GetMeta())
+				}
+				assert.Equal(t, tt.wantIDs, ids)
 			} else {
 				code, _ := status.FromError(err)
 				assert.Equal(t, tt.errCode, code.Code())
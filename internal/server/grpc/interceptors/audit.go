@@ -0,0 +1,148 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// AuditInterceptor описывает структуру перехватчика, записывающего запросы в журнал аудита.
+type AuditInterceptor struct {
+	masterKey string // Мастер ключ, из которого получается ключ журнала (см. utils.AuditKey).
+	storage   storage.Storage
+	log       *logrus.Entry
+}
+
+// NewAuditInterceptor создает перехватчик, записывающий запросы в журнал аудита.
+func NewAuditInterceptor(masterKey string, storage storage.Storage, log *logrus.Entry) *AuditInterceptor {
+	return &AuditInterceptor{
+		masterKey: masterKey,
+		storage:   storage,
+		log:       log,
+	}
+}
+
+// Audit записывает запрос и результат его выполнения в журнал аудита.
+// Должен выполняться после аутентификации пользователя.
+// Если событие не удалось записать, ошибка записывается в лог, а клиент получает результат запроса:
+// к этому моменту изменения данных уже сохранены.
+// Успешное удаление аккаунта и запросы неизвестных пользователей не записываются.
+func (i *AuditInterceptor) Audit(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if info.FullMethod == pb.UserService_DeleteAccount_FullMethodName && err == nil {
+		// Журнал удаленного аккаунта удален вместе с ним, поэтому удаление в журнал не записывается.
+		return resp, nil
+	}
+	itemID := auditItemID(req)
+	if itemID == "" {
+		// Id созданных данных есть только в ответе.
+		itemID = auditItemID(resp)
+	}
+	i.record(ctx, info.FullMethod, req, itemID, err)
+	return resp, err
+}
+
+// AuditStream записывает потоковый запрос и результат его выполнения в журнал аудита.
+// Данные запроса определяются по первому сообщению потока, в котором указан их id.
+func (i *AuditInterceptor) AuditStream(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	stream := &auditServerStream{ServerStream: ss}
+	err := handler(srv, stream)
+	i.record(ss.Context(), info.FullMethod, nil, stream.itemID, err)
+	return err
+}
+
+// record записывает событие о выполненном запросе.
+// Запросы, пользователь которых не определен (например, неудачный вход), не записываются:
+// журнал ведется по пользователям и удаляется вместе с ними.
+func (i *AuditInterceptor) record(ctx context.Context, fullMethod string, req any, itemID string, err error) {
+	userID := i.userID(ctx, req, err)
+	if userID == "" {
+		return
+	}
+	event := &model.AuditEvent{
+		UserID:    userID,
+		Action:    fullMethod[strings.LastIndex(fullMethod, "/")+1:],
+		ItemID:    itemID,
+		Code:      status.Code(err).String(),
+		CreatedAt: time.Now(),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		event.Peer = p.Addr.String()
+	}
+	key, auditErr := utils.AuditKey(i.masterKey)
+	if auditErr == nil {
+		// Событие записывается, даже если клиент уже отменил запрос.
+		auditErr = i.storage.AppendAuditEvent(context.WithoutCancel(ctx), event, key)
+	}
+	// Журнал пользователя, удаленного во время запроса, удален вместе с ним.
+	if auditErr != nil && !errors.Is(auditErr, storage.ErrNoUser) {
+		i.log.WithError(auditErr).WithField("method", fullMethod).Error("failed to write audit event")
+	}
+}
+
+// userID возвращает id пользователя запроса.
+// Для запросов без jwt (регистрация, вход) пользователь определяется по логину из запроса, только если запрос
+// выполнен успешно: иначе любой клиент мог бы дописывать события в журнал чужого пользователя.
+func (i *AuditInterceptor) userID(ctx context.Context, req any, reqErr error) string {
+	if user := appCtx.GetCtxUser(ctx); user != nil {
+		return user.ID
+	}
+	r, ok := req.(interface{ GetLogin() string })
+	if !ok || r.GetLogin() == "" || reqErr != nil {
+		return ""
+	}
+	user, err := i.storage.GetUserByLogin(ctx, r.GetLogin())
+	if err != nil {
+		if !errors.Is(err, storage.ErrNoUser) {
+			i.log.WithError(err).Error("failed to get user for audit event")
+		}
+		return ""
+	}
+	return user.ID
+}
+
+// auditItemID возвращает id данных из сообщения запроса или ответа, если в нем он есть.
+func auditItemID(msg any) string {
+	if m, ok := msg.(interface{ GetId() string }); ok {
+		return m.GetId()
+	}
+	return ""
+}
+
+// auditServerStream запоминает id данных из сообщений потока.
+type auditServerStream struct {
+	grpc.ServerStream
+	itemID string
+}
+
+// RecvMsg получает сообщение клиента.
+func (s *auditServerStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.itemID == "" {
+		s.itemID = auditItemID(m)
+	}
+	return err
+}
+
+// SendMsg отправляет сообщение клиенту.
+func (s *auditServerStream) SendMsg(m any) error {
+	if s.itemID == "" {
+		s.itemID = auditItemID(m)
+	}
+	return s.ServerStream.SendMsg(m)
+}
@@ -0,0 +1,250 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	auditInterceptor := NewAuditInterceptor(masterKey, mockStorage, log.WithField("instance", "grpcTransport"))
+	addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 5000}
+
+	type lookup struct {
+		user *model.User
+		err  error
+	}
+	tests := []struct {
+		name       string
+		method     string
+		user       *appCtx.CtxUser
+		req        any
+		resp       any
+		handlerErr error
+		lookup     *lookup
+		auditErr   error
+		skipEvent  bool
+		wantEvent  model.AuditEvent
+		errCode    codes.Code
+	}{
+		{
+			name:      "Запрос к данным",
+			method:    proto.VaultService_GetData_FullMethodName,
+			user:      &appCtx.CtxUser{ID: "1"},
+			req:       &proto.GetDataReq{Id: "item"},
+			resp:      &proto.GetDataRes{Id: "item"},
+			wantEvent: model.AuditEvent{UserID: "1", Action: "GetData", ItemID: "item", Code: "OK"},
+		},
+		{
+			name:      "Id созданных данных берется из ответа",
+			method:    proto.VaultService_AddData_FullMethodName,
+			user:      &appCtx.CtxUser{ID: "1"},
+			req:       &proto.AddDataReq{},
+			resp:      &proto.AddDataRes{Id: "new"},
+			wantEvent: model.AuditEvent{UserID: "1", Action: "AddData", ItemID: "new", Code: "OK"},
+		},
+		{
+			name:       "Ошибка обработки запроса",
+			method:     proto.VaultService_DeleteData_FullMethodName,
+			user:       &appCtx.CtxUser{ID: "1"},
+			req:        &proto.DeleteDataReq{Id: "item"},
+			handlerErr: status.Error(codes.NotFound, "Данные не найдены"),
+			wantEvent:  model.AuditEvent{UserID: "1", Action: "DeleteData", ItemID: "item", Code: "NotFound"},
+			errCode:    codes.NotFound,
+		},
+		{
+			name:      "Вход пользователя определяется по логину",
+			method:    proto.UserService_Login_FullMethodName,
+			req:       &proto.LoginReq{Login: "user"},
+			resp:      &proto.LoginRes{},
+			lookup:    &lookup{user: &model.User{ID: "1", Login: "user"}},
+			wantEvent: model.AuditEvent{UserID: "1", Action: "Login", Code: "OK"},
+		},
+		{
+			// Иначе любой клиент мог бы дописывать события в журнал чужого пользователя.
+			name:       "Неудачный вход не записывается",
+			method:     proto.UserService_Login_FullMethodName,
+			req:        &proto.LoginReq{Login: "user"},
+			handlerErr: status.Error(codes.Unauthenticated, "Неверные логин/пароль"),
+			skipEvent:  true,
+			errCode:    codes.Unauthenticated,
+		},
+		{
+			name:       "Регистрация с занятым логином не записывается",
+			method:     proto.UserService_Register_FullMethodName,
+			req:        &proto.RegisterReq{Login: "user"},
+			handlerErr: status.Error(codes.AlreadyExists, "Логин занят"),
+			skipEvent:  true,
+			errCode:    codes.AlreadyExists,
+		},
+		{
+			name:       "Вход с неизвестным логином не записывается",
+			method:     proto.UserService_Login_FullMethodName,
+			req:        &proto.LoginReq{Login: "unknown"},
+			handlerErr: status.Error(codes.NotFound, "Пользователь с таким логином не найден"),
+			skipEvent:  true,
+			errCode:    codes.NotFound,
+		},
+		{
+			name:      "Ошибка записи события не меняет ответ",
+			method:    proto.VaultService_GetData_FullMethodName,
+			user:      &appCtx.CtxUser{ID: "1"},
+			req:       &proto.GetDataReq{Id: "item"},
+			resp:      &proto.GetDataRes{Id: "item"},
+			auditErr:  errors.New("db error"),
+			wantEvent: model.AuditEvent{UserID: "1", Action: "GetData", ItemID: "item", Code: "OK"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+			if tt.user != nil {
+				ctx = appCtx.CtxWithUser(ctx, tt.user)
+			}
+			if tt.lookup != nil {
+				mockStorage.EXPECT().GetUserByLogin(gomock.Any(), tt.req.(*proto.LoginReq).GetLogin()).
+					Return(tt.lookup.user, tt.lookup.err)
+			}
+			var event *model.AuditEvent
+			if !tt.skipEvent {
+				mockStorage.EXPECT().AppendAuditEvent(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e *model.AuditEvent, _ []byte) error {
+						event = e
+						return tt.auditErr
+					})
+			}
+			handler := func(_ context.Context, _ any) (any, error) {
+				return tt.resp, tt.handlerErr
+			}
+
+			resp, err := auditInterceptor.Audit(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if tt.skipEvent {
+				assert.Nil(t, event)
+				assert.Equal(t, tt.errCode, status.Code(err))
+				return
+			}
+			require.NotNil(t, event)
+			assert.Equal(t, tt.wantEvent.UserID, event.UserID)
+			assert.Equal(t, tt.wantEvent.Action, event.Action)
+			assert.Equal(t, tt.wantEvent.ItemID, event.ItemID)
+			assert.Equal(t, tt.wantEvent.Code, event.Code)
+			assert.Equal(t, addr.String(), event.Peer)
+			assert.False(t, event.CreatedAt.IsZero())
+			if tt.errCode != codes.OK {
+				code, _ := status.FromError(err)
+				assert.Equal(t, tt.errCode, code.Code())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.resp, resp)
+		})
+	}
+}
+
+func TestAuditDeleteAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	auditInterceptor := NewAuditInterceptor(masterKey, mockStorage, log.WithField("instance", "grpcTransport"))
+	ctx := appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: "1"})
+	info := &grpc.UnaryServerInfo{FullMethod: proto.UserService_DeleteAccount_FullMethodName}
+
+	// Журнал удаленного аккаунта удален вместе с ним, событие не записывается.
+	resp, err := auditInterceptor.Audit(ctx, &proto.DeleteAccountReq{}, info, func(_ context.Context, _ any) (any, error) {
+		return &proto.DeleteAccountRes{}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &proto.DeleteAccountRes{}, resp)
+
+	// Неудачная попытка удаления записывается.
+	var event *model.AuditEvent
+	mockStorage.EXPECT().AppendAuditEvent(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e *model.AuditEvent, _ []byte) error {
+			event = e
+			return nil
+		})
+	_, err = auditInterceptor.Audit(ctx, &proto.DeleteAccountReq{}, info, func(_ context.Context, _ any) (any, error) {
+		return nil, status.Error(codes.PermissionDenied, "Неверный пароль")
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.NotNil(t, event)
+	assert.Equal(t, "1", event.UserID)
+	assert.Equal(t, "DeleteAccount", event.Action)
+	assert.Equal(t, "PermissionDenied", event.Code)
+}
+
+func TestAuditStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	auditInterceptor := NewAuditInterceptor(masterKey, mockStorage, log.WithField("instance", "grpcTransport"))
+
+	auditKey, err := utils.AuditKey(masterKey)
+	require.NoError(t, err)
+
+	ctx := appCtx.CtxWithUser(context.Background(), &appCtx.CtxUser{ID: "1"})
+	var event *model.AuditEvent
+	// События подписываются ключом журнала, полученным из мастер ключа.
+	mockStorage.EXPECT().AppendAuditEvent(gomock.Any(), gomock.Any(), auditKey).
+		DoAndReturn(func(_ context.Context, e *model.AuditEvent, _ []byte) error {
+			event = e
+			return nil
+		})
+	// Id новых данных, загруженных потоком, есть только в ответе сервера.
+	handler := func(_ any, ss grpc.ServerStream) error {
+		return ss.SendMsg(&proto.UploadFileRes{Id: "new"})
+	}
+	info := &grpc.StreamServerInfo{FullMethod: proto.VaultService_UploadFile_FullMethodName}
+	err = auditInterceptor.AuditStream(nil, &testServerStream{ctx: ctx}, info, handler)
+	require.NoError(t, err)
+	require.NotNil(t, event)
+	assert.Equal(t, "1", event.UserID)
+	assert.Equal(t, "UploadFile", event.Action)
+	assert.Equal(t, "new", event.ItemID)
+	assert.Equal(t, "OK", event.Code)
+}
+
+// testServerStream реализует поток сервера, отбрасывающий отправленные сообщения.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testServerStream) SendMsg(_ any) error {
+	return nil
+}
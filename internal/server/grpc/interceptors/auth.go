@@ -2,7 +2,6 @@ package interceptors
 
 import (
 	"context"
-	"encoding/hex"
 	"errors"
 	"strings"
 
@@ -11,7 +10,6 @@ import (
 	"github.com/pinbrain/gophkeeper/internal/server/jwt"
 	"github.com/pinbrain/gophkeeper/internal/server/utils"
 	"github.com/pinbrain/gophkeeper/internal/storage"
-	"github.com/pinbrain/gophkeeper/internal/storage/postgres"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -40,6 +38,7 @@ func NewAuthInterceptor(
 		jwtService: jwtService,
 		protectedServices: map[string]bool{
 			pb.VaultService_ServiceDesc.ServiceName: true,
+			pb.OrgService_ServiceDesc.ServiceName:   true,
 		},
 		log: log,
 	}
@@ -49,57 +48,105 @@ func NewAuthInterceptor(
 func (i *AuthInterceptor) AuthenticateUser(
 	ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
 ) (interface{}, error) {
-	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		values := md.Get(i.jwtService.GetMdJWTKey())
-		if len(values) > 0 {
-			jwt := values[0]
-			userData, err := i.jwtService.GetJWTClaims(jwt)
-			if err != nil {
-				i.log.WithError(err).Error("failed to get claims from jwt")
-				return nil, status.Error(codes.Unauthenticated, "Invalid jwt")
-			}
-			user, err := i.storage.GetUserByLogin(ctx, userData.Login)
-			if err != nil {
-				switch {
-				case errors.Is(err, postgres.ErrNoUser):
-					return nil, status.Error(codes.NotFound, "Пользователь не найден")
-				default:
-					i.log.WithError(err).Error("error while authenticating user by jwt")
-					return nil, status.Error(codes.Internal, "Не удалось получить данные пользователя из БД")
-				}
-			}
-			encUserSecretB, err := hex.DecodeString(user.EncryptedSecret)
-			if err != nil {
-				i.log.WithError(err).Error("error while decoding user secret key")
-				return nil, status.Error(codes.Internal, "Internal Server Error")
-			}
-			userSecret, err := utils.Decrypt(encUserSecretB, i.masterKey)
-			if err != nil {
-				i.log.WithError(err).Error("error while decrypting user secret key")
-				return nil, status.Error(codes.Internal, "Internal Server Error")
-			}
-			ctx = appCtx.CtxWithUser(ctx, &appCtx.CtxUser{
-				ID:     user.ID,
-				Login:  user.Login,
-				Secret: hex.EncodeToString(userSecret),
-			})
-		}
+	ctx, err := i.authenticate(ctx)
+	if err != nil {
+		return nil, err
 	}
-
 	return handler(ctx, req)
 }
 
+// AuthenticateUserStream аутентифицирует пользователя потокового запроса.
+func (i *AuthInterceptor) AuthenticateUserStream(
+	srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	ctx, err := i.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &ctxServerStream{ServerStream: ss, ctx: ctx})
+}
+
 // RequireUser проверяет что пользователь авторизован.
 // В противном случае прерывает обработку запроса и возвращает ошибку Unauthorized.
 func (i *AuthInterceptor) RequireUser(
 	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
 ) (interface{}, error) {
-	if !i.protectedServices[strings.Split(info.FullMethod, "/")[1]] {
-		return handler(ctx, req)
+	if err := i.checkUser(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// RequireUserStream проверяет что пользователь потокового запроса авторизован.
+func (i *AuthInterceptor) RequireUserStream(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	if err := i.checkUser(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// authenticate добавляет в контекст пользователя, jwt которого передан в метаданных запроса.
+func (i *AuthInterceptor) authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	values := md.Get(i.jwtService.GetMdJWTKey())
+	if len(values) == 0 {
+		return ctx, nil
+	}
+	userData, err := i.jwtService.GetJWTClaims(values[0])
+	if err != nil {
+		i.log.WithError(err).Error("failed to get claims from jwt")
+		return nil, status.Error(codes.Unauthenticated, "Invalid jwt")
+	}
+	user, err := i.storage.GetUserByLogin(ctx, userData.Login)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoUser):
+			return nil, status.Error(codes.NotFound, "Пользователь не найден")
+		default:
+			i.log.WithError(err).Error("error while authenticating user by jwt")
+			return nil, status.Error(codes.Internal, "Не удалось получить данные пользователя из БД")
+		}
+	}
+	if user.ID != userData.UserID {
+		// Аккаунт, для которого выдан jwt, удален, а его логин занял другой пользователь.
+		return nil, status.Error(codes.Unauthenticated, "Invalid jwt")
+	}
+	userSecret, err := utils.DecryptUserSecret(user.EncryptedSecret, i.masterKey)
+	if err != nil {
+		i.log.WithError(err).Error("error while decrypting user secret key")
+		return nil, status.Error(codes.Internal, "Internal Server Error")
+	}
+	return appCtx.CtxWithUser(ctx, &appCtx.CtxUser{
+		ID:     user.ID,
+		Login:  user.Login,
+		Secret: userSecret,
+	}), nil
+}
+
+// checkUser проверяет наличие авторизованного пользователя для методов защищенных сервисов.
+func (i *AuthInterceptor) checkUser(ctx context.Context, fullMethod string) error {
+	if !i.protectedServices[strings.Split(fullMethod, "/")[1]] {
+		return nil
 	}
 	user := appCtx.GetCtxUser(ctx)
 	if user == nil || user.ID == "" {
-		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+		return status.Error(codes.Unauthenticated, "Unauthorized")
 	}
-	return handler(ctx, req)
+	return nil
+}
+
+// ctxServerStream подменяет контекст потока.
+type ctxServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context возвращает контекст потока.
+func (s *ctxServerStream) Context() context.Context {
+	return s.ctx
 }
@@ -12,8 +12,8 @@ import (
 	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
 	"github.com/pinbrain/gophkeeper/internal/server/jwt"
 	jwt_mocks "github.com/pinbrain/gophkeeper/internal/server/jwt/mocks"
+	appStorage "github.com/pinbrain/gophkeeper/internal/storage"
 	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
-	"github.com/pinbrain/gophkeeper/internal/storage/postgres"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -88,7 +88,7 @@ func TestAuth(t *testing.T) {
 		{
 			name: "Пользователь не найден",
 			storage: &storage{
-				err: postgres.ErrNoUser,
+				err: appStorage.ErrNoUser,
 			},
 			jwt: "some_jwt",
 			jwtService: jwtService{
@@ -101,6 +101,21 @@ func TestAuth(t *testing.T) {
 			wantErr: true,
 			errCode: codes.NotFound,
 		},
+		{
+			name: "jwt удаленного аккаунта с тем же логином",
+			storage: &storage{
+				user: &model.User{ID: "2", Login: "user"},
+			},
+			jwt: "some_jwt",
+			jwtService: jwtService{
+				userData: &jwt.Claims{
+					UserID: "1",
+					Login:  "user",
+				},
+			},
+			wantErr: true,
+			errCode: codes.Unauthenticated,
+		},
 		{
 			name: "Ошибка чтения данных из БД",
 			storage: &storage{
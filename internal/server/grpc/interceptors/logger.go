@@ -29,3 +29,24 @@ func LoggerInterceptor(
 		return resp, err
 	}
 }
+
+// StreamLoggerInterceptor логирует входящие потоковые запросы.
+func StreamLoggerInterceptor(
+	log *logrus.Entry,
+) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		duration := time.Since(start).Seconds()
+		status, _ := status.FromError(err)
+
+		log.WithFields(logrus.Fields{
+			"method":   info.FullMethod,
+			"duration": duration,
+			"code":     status.Code().String(),
+		}).Info("gRPC stream")
+		return err
+	}
+}
@@ -0,0 +1,122 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// orgMethods методы хранилища, доступные с данными организации, и признак того, что метод изменяет данные.
+// Совместный доступ к данным организации не поддерживается.
+var orgMethods = map[string]bool{
+	pb.VaultService_GetData_FullMethodName:          false,
+	pb.VaultService_GetAllByType_FullMethodName:     false,
+	pb.VaultService_ListItems_FullMethodName:        false,
+	pb.VaultService_SearchItems_FullMethodName:      false,
+	pb.VaultService_GetDataHistory_FullMethodName:   false,
+	pb.VaultService_GetDataRevision_FullMethodName:  false,
+	pb.VaultService_GetTrash_FullMethodName:         false,
+	pb.VaultService_DownloadFile_FullMethodName:     false,
+	pb.VaultService_GetUsage_FullMethodName:         false,
+	pb.VaultService_ListFolders_FullMethodName:      false,
+	pb.VaultService_ListTags_FullMethodName:         false,
+	pb.VaultService_ExportVault_FullMethodName:      false,
+	pb.VaultService_AddData_FullMethodName:          true,
+	pb.VaultService_DeleteData_FullMethodName:       true,
+	pb.VaultService_UpdateData_FullMethodName:       true,
+	pb.VaultService_RestoreData_FullMethodName:      true,
+	pb.VaultService_RestoreFromTrash_FullMethodName: true,
+	pb.VaultService_EmptyTrash_FullMethodName:       true,
+	pb.VaultService_UploadFile_FullMethodName:       true,
+	pb.VaultService_CreateFolder_FullMethodName:     true,
+	pb.VaultService_DeleteFolder_FullMethodName:     true,
+	pb.VaultService_MoveItem_FullMethodName:         true,
+	pb.VaultService_TagItem_FullMethodName:          true,
+	pb.VaultService_UntagItem_FullMethodName:        true,
+	pb.VaultService_ImportVault_FullMethodName:      true,
+}
+
+// ScopeOrg переключает запрос к хранилищу на данные организации, id которой передан в метаданных запроса.
+// Должен выполняться после аутентификации и проверки пользователя: в журнал аудита запрос записывается
+// от имени участника, а обработчик работает с аккаунтом и ключом организации.
+func (i *AuthInterceptor) ScopeOrg(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, err := i.scopeOrg(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// ScopeOrgStream переключает потоковый запрос к хранилищу на данные организации.
+func (i *AuthInterceptor) ScopeOrgStream(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	ctx, err := i.scopeOrg(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &ctxServerStream{ServerStream: ss, ctx: ctx})
+}
+
+// scopeOrg заменяет пользователя запроса аккаунтом организации, если пользователь ее участник
+// и его роль позволяет выполнить метод. Ключ организации расшифровывается закрытым ключом участника.
+// Запросы к остальным сервисам не меняются.
+func (i *AuthInterceptor) scopeOrg(ctx context.Context, fullMethod string) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || strings.Split(fullMethod, "/")[1] != pb.VaultService_ServiceDesc.ServiceName {
+		return ctx, nil
+	}
+	values := md.Get(model.OrgMDKey)
+	if len(values) == 0 || values[0] == "" {
+		return ctx, nil
+	}
+	orgID := values[0]
+	write, ok := orgMethods[fullMethod]
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "Запрос недоступен для данных организации")
+	}
+	user := appCtx.GetCtxUser(ctx)
+	if user == nil || user.ID == "" {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+	member, err := i.storage.GetOrgMember(ctx, orgID, user.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNoMember):
+			return nil, status.Error(codes.NotFound, "Организация не найдена")
+		default:
+			i.log.WithError(err).Error("error while getting organization member")
+			return nil, status.Error(codes.Internal, "Internal Server Error")
+		}
+	}
+	if write && member.Role == model.OrgRoleReader {
+		return nil, status.Error(codes.PermissionDenied, "Роль READER позволяет только читать данные организации")
+	}
+	account, err := i.storage.GetUserByID(ctx, user.ID)
+	if err != nil {
+		i.log.WithError(err).Error("error while getting organization member account")
+		return nil, status.Error(codes.Internal, "Internal Server Error")
+	}
+	orgSecret, err := utils.OpenKey(member.EncryptKey, account.EncryptPrivateKey, user.Secret)
+	if err != nil {
+		i.log.WithError(err).WithField("org", orgID).Error("error while opening organization key")
+		return nil, status.Error(codes.Internal, "Internal Server Error")
+	}
+	return appCtx.CtxWithUser(ctx, &appCtx.CtxUser{
+		ID:     orgID,
+		Login:  model.OrgLoginPrefix + orgID,
+		Secret: orgSecret,
+	}), nil
+}
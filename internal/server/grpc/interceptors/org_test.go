@@ -0,0 +1,145 @@
+package interceptors
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	appCtx "github.com/pinbrain/gophkeeper/internal/server/context"
+	jwt_mocks "github.com/pinbrain/gophkeeper/internal/server/jwt/mocks"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	appStorage "github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestScopeOrg(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	mockJWT := jwt_mocks.NewMockServiceI(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	authInterceptor := NewAuthInterceptor(masterKey, mockStorage, mockJWT, log.WithField("instance", "grpcTransport"))
+
+	secretB, err := utils.GenerateUserKey()
+	require.NoError(t, err)
+	secret := hex.EncodeToString(secretB)
+	publicKey, encPrivateKey, err := utils.GenerateKeyPair(secret)
+	require.NoError(t, err)
+	account := &model.User{ID: "1", Login: "user", PublicKey: publicKey, EncryptPrivateKey: encPrivateKey}
+	user := &appCtx.CtxUser{ID: "1", Login: "user", Secret: secret}
+	orgKey, _, err := utils.GenerateItemKey(masterKey)
+	require.NoError(t, err)
+	sealed, err := utils.SealKey(orgKey, publicKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		method    string
+		org       string
+		member    *model.OrgMember
+		memberErr error
+		wantUser  *appCtx.CtxUser
+		errCode   codes.Code
+	}{
+		{
+			name:     "Запрос без организации",
+			method:   proto.VaultService_AddData_FullMethodName,
+			wantUser: user,
+		},
+		{
+			name:     "Запрос к другому сервису",
+			method:   proto.OrgService_ListOrgs_FullMethodName,
+			org:      "org",
+			wantUser: user,
+		},
+		{
+			name:   "Запись участником",
+			method: proto.VaultService_AddData_FullMethodName,
+			org:    "org",
+			member: &model.OrgMember{OrgID: "org", UserID: "1", Role: model.OrgRoleMember, EncryptKey: sealed},
+			wantUser: &appCtx.CtxUser{
+				ID: "org", Login: model.OrgLoginPrefix + "org", Secret: orgKey,
+			},
+		},
+		{
+			name:   "Чтение читателем",
+			method: proto.VaultService_GetData_FullMethodName,
+			org:    "org",
+			member: &model.OrgMember{OrgID: "org", UserID: "1", Role: model.OrgRoleReader, EncryptKey: sealed},
+			wantUser: &appCtx.CtxUser{
+				ID: "org", Login: model.OrgLoginPrefix + "org", Secret: orgKey,
+			},
+		},
+		{
+			name:    "Запись читателем",
+			method:  proto.VaultService_UpdateData_FullMethodName,
+			org:     "org",
+			member:  &model.OrgMember{OrgID: "org", UserID: "1", Role: model.OrgRoleReader, EncryptKey: sealed},
+			errCode: codes.PermissionDenied,
+		},
+		{
+			name:    "Совместный доступ к данным организации",
+			method:  proto.VaultService_ShareItem_FullMethodName,
+			org:     "org",
+			errCode: codes.PermissionDenied,
+		},
+		{
+			name:      "Не участник",
+			method:    proto.VaultService_GetData_FullMethodName,
+			org:       "org",
+			memberErr: appStorage.ErrNoMember,
+			errCode:   codes.NotFound,
+		},
+		{
+			name:      "Ошибка БД",
+			method:    proto.VaultService_GetData_FullMethodName,
+			org:       "org",
+			memberErr: errors.New("db error"),
+			errCode:   codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := appCtx.CtxWithUser(context.Background(), user)
+			if tt.org != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(model.OrgMDKey, tt.org))
+			}
+			if tt.member != nil || tt.memberErr != nil {
+				mockStorage.EXPECT().GetOrgMember(gomock.Any(), tt.org, user.ID).Return(tt.member, tt.memberErr)
+			}
+			if tt.wantUser != nil && tt.member != nil {
+				mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(account, nil)
+			}
+
+			var gotUser *appCtx.CtxUser
+			handler := func(ctx context.Context, req any) (any, error) {
+				gotUser = appCtx.GetCtxUser(ctx)
+				return req, nil
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+			_, err := authInterceptor.ScopeOrg(ctx, nil, info, handler)
+			if tt.errCode != codes.OK {
+				assert.Equal(t, tt.errCode, status.Code(err))
+				assert.Nil(t, gotUser)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUser, gotUser)
+		})
+	}
+}
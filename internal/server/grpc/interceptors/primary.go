@@ -0,0 +1,58 @@
+package interceptors
+
+import (
+	"context"
+
+	pb "github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"google.golang.org/grpc"
+)
+
+// replicaMethods методы, которые только читают данные, поэтому могут читать их с реплик БД.
+// Остальные методы читают данные с основного сервера (см. storage.WithPrimary): прочитанное ими передается
+// в изменение, и отставание реплики приводило бы к ложным конфликтам версий и ключей данных.
+var replicaMethods = map[string]struct{}{
+	pb.VaultService_GetData_FullMethodName:         {},
+	pb.VaultService_GetAllByType_FullMethodName:    {},
+	pb.VaultService_ListItems_FullMethodName:       {},
+	pb.VaultService_SearchItems_FullMethodName:     {},
+	pb.VaultService_GetDataHistory_FullMethodName:  {},
+	pb.VaultService_GetDataRevision_FullMethodName: {},
+	pb.VaultService_GetTrash_FullMethodName:        {},
+	pb.VaultService_DownloadFile_FullMethodName:    {},
+	pb.VaultService_GetUsage_FullMethodName:        {},
+	pb.VaultService_ListFolders_FullMethodName:     {},
+	pb.VaultService_ListTags_FullMethodName:        {},
+	pb.VaultService_ListShared_FullMethodName:      {},
+	pb.VaultService_ExportVault_FullMethodName:     {},
+	pb.OrgService_ListOrgs_FullMethodName:          {},
+	pb.OrgService_ListOrgMembers_FullMethodName:    {},
+	pb.UserService_ListAuditEvents_FullMethodName:  {},
+}
+
+// PrimaryInterceptor направляет чтение данных на основной сервер БД для методов, изменяющих данные.
+func PrimaryInterceptor(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	return handler(primaryCtx(ctx, info.FullMethod), req)
+}
+
+// StreamPrimaryInterceptor направляет чтение данных на основной сервер БД для потоковых методов,
+// изменяющих данные.
+func StreamPrimaryInterceptor(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	ctx := ss.Context()
+	if primary := primaryCtx(ctx, info.FullMethod); primary != ctx {
+		ss = &ctxServerStream{ServerStream: ss, ctx: primary}
+	}
+	return handler(srv, ss)
+}
+
+// primaryCtx возвращает контекст с чтением с основного сервера БД, если метод не только читает данные.
+func primaryCtx(ctx context.Context, fullMethod string) context.Context {
+	if _, ok := replicaMethods[fullMethod]; ok {
+		return ctx
+	}
+	return storage.WithPrimary(ctx)
+}
@@ -0,0 +1,71 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/proto"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestPrimary(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		stream      bool
+		wantPrimary bool
+	}{
+		{
+			name:   "Получение данных",
+			method: proto.VaultService_GetData_FullMethodName,
+		},
+		{
+			name:        "Изменение данных",
+			method:      proto.VaultService_UpdateData_FullMethodName,
+			wantPrimary: true,
+		},
+		{
+			name:        "Отзыв доступа",
+			method:      proto.VaultService_RevokeShare_FullMethodName,
+			wantPrimary: true,
+		},
+		{
+			name:   "Экспорт данных",
+			method: proto.VaultService_ExportVault_FullMethodName,
+			stream: true,
+		},
+		{
+			name:        "Импорт данных",
+			method:      proto.VaultService_ImportVault_FullMethodName,
+			stream:      true,
+			wantPrimary: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var primary bool
+			if tt.stream {
+				handler := func(_ any, ss grpc.ServerStream) error {
+					primary = storage.IsPrimary(ss.Context())
+					return nil
+				}
+				info := &grpc.StreamServerInfo{FullMethod: tt.method}
+				err := StreamPrimaryInterceptor(nil, &testServerStream{ctx: context.Background()}, info, handler)
+				require.NoError(t, err)
+			} else {
+				handler := func(ctx context.Context, _ any) (any, error) {
+					primary = storage.IsPrimary(ctx)
+					return nil, nil
+				}
+				info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+				_, err := PrimaryInterceptor(context.Background(), nil, info, handler)
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantPrimary, primary)
+		})
+	}
+}
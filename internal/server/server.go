@@ -3,13 +3,27 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/pinbrain/gophkeeper/internal/server/backup"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
 	"github.com/pinbrain/gophkeeper/internal/server/config"
 	"github.com/pinbrain/gophkeeper/internal/server/grpc"
 	"github.com/pinbrain/gophkeeper/internal/server/jwt"
+	"github.com/pinbrain/gophkeeper/internal/server/worker"
 	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/instrument"
+	"github.com/pinbrain/gophkeeper/internal/storage/memory"
+	"github.com/pinbrain/gophkeeper/internal/storage/migrate"
 	"github.com/pinbrain/gophkeeper/internal/storage/postgres"
+	"github.com/pinbrain/gophkeeper/internal/storage/sqlite"
+	"github.com/pressly/goose/v3"
 	"github.com/sirupsen/logrus"
 )
 
@@ -17,6 +31,15 @@ import (
 type Server struct {
 	storage   storage.Storage
 	transport *grpc.Transport
+	purger    *worker.TrashPurger
+	sweeper   *worker.ExpirySweeper
+	collector *worker.BlobCollector
+	encryptor *worker.MetaEncryptor
+	metrics   *http.Server
+
+	workersCtx    context.Context
+	cancelWorkers context.CancelFunc
+	workers       sync.WaitGroup
 
 	log *logrus.Entry
 }
@@ -25,30 +48,231 @@ type Server struct {
 func NewServer(ctx context.Context, cfg *config.ServerConfig, logger *logrus.Logger) (*Server, error) {
 	log := logger.WithField("instance", "server")
 
-	storage, err := postgres.NewStorage(ctx, cfg.DSN, logger)
+	blobs, err := blob.NewFSStore(cfg.Blob.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blob store: %w", err)
+	}
+
+	storage, err := newStorage(ctx, cfg, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to run storage: %w", err)
 	}
+	storage, metrics := instrumentStorage(storage, cfg, logger)
 
 	jwtService := jwt.NewJWTService(cfg.JWT)
 
 	transport, err := grpc.NewGRPCTransport(grpc.TransportConfig{
 		MasterKey:     cfg.MasterKey,
 		ServerAddress: cfg.ServerAddress,
-	}, storage, jwtService, logger)
+		Quota:         cfg.Quota,
+	}, storage, blobs, jwtService, logger)
 	if err != nil {
+		storage.Close()
 		return nil, fmt.Errorf("failed to create grpc transport: %w", err)
 	}
 
+	var purger *worker.TrashPurger
+	if cfg.Trash.Retention > 0 {
+		purger = worker.NewTrashPurger(
+			storage,
+			time.Duration(cfg.Trash.Retention)*time.Hour,
+			time.Duration(cfg.Trash.PurgeInterval)*time.Minute,
+			logger,
+		)
+	} else {
+		log.Info("Automatic trash purge is disabled")
+	}
+
+	var sweeper *worker.ExpirySweeper
+	if cfg.Expiry.SweepInterval > 0 {
+		sweeper = worker.NewExpirySweeper(storage, time.Duration(cfg.Expiry.SweepInterval)*time.Minute, logger)
+	} else {
+		log.Info("Expired items sweeping is disabled")
+	}
+
+	var collector *worker.BlobCollector
+	if cfg.Blob.GCInterval > 0 {
+		collector = worker.NewBlobCollector(
+			storage, blobs, cfg.MasterKey, time.Duration(cfg.Blob.GCInterval)*time.Minute, logger,
+		)
+	} else {
+		log.Info("Unused blobs collection is disabled")
+	}
+
+	workersCtx, cancelWorkers := context.WithCancel(ctx)
+
 	return &Server{
-		storage:   storage,
-		transport: transport,
-		log:       log,
+		storage:       storage,
+		transport:     transport,
+		purger:        purger,
+		sweeper:       sweeper,
+		collector:     collector,
+		encryptor:     worker.NewMetaEncryptor(storage, cfg.MasterKey, logger),
+		metrics:       metrics,
+		workersCtx:    workersCtx,
+		cancelWorkers: cancelWorkers,
+		log:           log,
 	}, nil
 }
 
-// Run запускает сервер.
+// newStorage создает хранилище в зависимости от схемы DSN.
+// Реплики поддерживаются только хранилищем PostgreSQL.
+func newStorage(ctx context.Context, cfg *config.ServerConfig, logger *logrus.Logger) (storage.Storage, error) {
+	switch {
+	case strings.HasPrefix(cfg.DSN, memory.Scheme):
+		logger.WithField("instance", "server").Warn("Using in-memory storage, data will be lost on shutdown")
+		return memory.NewStorage(), nil
+	case strings.HasPrefix(cfg.DSN, sqlite.Scheme):
+		if len(cfg.ReplicaDSNs) > 0 {
+			return nil, errors.New("replicas are not supported by sqlite storage")
+		}
+		return sqlite.NewStorage(ctx, cfg.DSN, cfg.AutoMigrate, logger)
+	default:
+		return postgres.NewStorage(ctx, cfg.DSN, cfg.ReplicaDSNs, cfg.AutoMigrate, logger)
+	}
+}
+
+// instrumentStorage оборачивает хранилище для сбора метрик и повторов запросов, если они включены
+// в конфигурации, и возвращает HTTP сервер метрик (nil, если адрес метрик не задан).
+// Запросы повторяются только хранилищем PostgreSQL.
+func instrumentStorage(
+	next storage.Storage, cfg *config.ServerConfig, logger *logrus.Logger,
+) (storage.Storage, *http.Server) {
+	if cfg.Storage.MetricsAddress == "" && cfg.Storage.MaxRetries == 0 {
+		return next, nil
+	}
+	instrumentCfg := instrument.Config{
+		MaxRetries: cfg.Storage.MaxRetries,
+		Backoff:    time.Duration(cfg.Storage.RetryBackoff) * time.Millisecond,
+		MaxBackoff: time.Duration(cfg.Storage.MaxRetryBackoff) * time.Millisecond,
+	}
+	if strings.HasPrefix(cfg.DSN, memory.Scheme) || strings.HasPrefix(cfg.DSN, sqlite.Scheme) {
+		if cfg.Storage.MaxRetries > 0 {
+			logger.WithField("instance", "server").Warn("Storage retries are supported only by postgres storage")
+		}
+	} else {
+		instrumentCfg.Retryable = postgres.IsTransient
+	}
+	metrics := instrument.NewMetrics()
+	var metricsServer *http.Server
+	if cfg.Storage.MetricsAddress != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics/storage", metrics)
+		metricsServer = &http.Server{
+			Addr:              cfg.Storage.MetricsAddress,
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+		}
+	}
+	return instrument.New(next, instrumentCfg, metrics), metricsServer
+}
+
+// Migrate выполняет команду управления миграциями БД хранилища, выбранного по схеме DSN,
+// и выводит результат в out.
+func Migrate(ctx context.Context, dsn string, command migrate.Command, out io.Writer) error {
+	var provider *goose.Provider
+	var err error
+	switch {
+	case strings.HasPrefix(dsn, memory.Scheme):
+		return errors.New("storage in memory has no migrations")
+	case strings.HasPrefix(dsn, sqlite.Scheme):
+		provider, err = sqlite.NewMigrationProvider(ctx, dsn)
+	default:
+		provider, err = postgres.NewMigrationProvider(dsn)
+	}
+	if err != nil {
+		return err
+	}
+	defer provider.Close()
+	return migrate.Run(ctx, provider, command, out)
+}
+
+// Backup записывает в w резервную копию хранилища и блоков файлов сервера, зашифрованную ключом cfg.BackupKey.
+func Backup(
+	ctx context.Context, cfg *config.ServerConfig, w io.Writer, logger *logrus.Logger,
+) (*backup.Summary, error) {
+	storage, blobs, err := openBackupStorage(ctx, cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	defer storage.Close()
+	return backup.Create(ctx, storage, blobs, cfg.MasterKey, w, cfg.BackupKey)
+}
+
+// Restore загружает резервную копию из r в пустое хранилище и хранилище блоков файлов сервера.
+func Restore(
+	ctx context.Context, cfg *config.ServerConfig, r io.Reader, logger *logrus.Logger,
+) (*backup.Summary, error) {
+	storage, blobs, err := openBackupStorage(ctx, cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	defer storage.Close()
+	return backup.Restore(ctx, storage, blobs, r, cfg.BackupKey)
+}
+
+// openBackupStorage проверяет ключ резервного копирования и открывает хранилище и хранилище блоков
+// файлов сервера.
+func openBackupStorage(
+	ctx context.Context, cfg *config.ServerConfig, logger *logrus.Logger,
+) (storage.Storage, blob.Store, error) {
+	if cfg.BackupKey == "" {
+		return nil, nil, errors.New("backup key is not set")
+	}
+	if strings.EqualFold(cfg.BackupKey, cfg.MasterKey) {
+		return nil, nil, errors.New("backup key must differ from master key")
+	}
+	if strings.HasPrefix(cfg.DSN, memory.Scheme) {
+		return nil, nil, errors.New("storage in memory can not be backed up")
+	}
+	blobs, err := blob.NewFSStore(cfg.Blob.Dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create blob store: %w", err)
+	}
+	storage, err := newStorage(ctx, cfg, logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to run storage: %w", err)
+	}
+	return storage, blobs, nil
+}
+
+// Run запускает фоновые задачи и сервер.
 func (s *Server) Run() error {
+	if s.purger != nil {
+		s.workers.Add(1)
+		go func() {
+			defer s.workers.Done()
+			s.purger.Run(s.workersCtx)
+		}()
+	}
+	if s.sweeper != nil {
+		s.workers.Add(1)
+		go func() {
+			defer s.workers.Done()
+			s.sweeper.Run(s.workersCtx)
+		}()
+	}
+	if s.collector != nil {
+		s.workers.Add(1)
+		go func() {
+			defer s.workers.Done()
+			s.collector.Run(s.workersCtx)
+		}()
+	}
+	if s.metrics != nil {
+		go func() {
+			s.log.Infof("Storage metrics server is listening on %s", s.metrics.Addr)
+			if err := s.metrics.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.log.WithError(err).Error("Storage metrics server failed")
+			}
+		}()
+	}
+	// Мета данные, сохраненные до включения шифрования, шифруются один раз при запуске.
+	s.workers.Add(1)
+	go func() {
+		defer s.workers.Done()
+		s.encryptor.Run(s.workersCtx)
+	}()
 	return s.transport.Run()
 }
 
@@ -58,6 +282,14 @@ func (s *Server) Stop() error {
 		s.log.Errorf("an error occurred during grpc server shutdown: %v", err)
 	}
 	s.log.Info("gRPC server stopped")
+	if s.metrics != nil {
+		if err := s.metrics.Close(); err != nil {
+			s.log.Errorf("an error occurred during metrics server shutdown: %v", err)
+		}
+	}
+	s.cancelWorkers()
+	s.workers.Wait()
+	s.log.Info("Background workers stopped")
 	s.storage.Close()
 	s.log.Info("Storage closed")
 	return nil
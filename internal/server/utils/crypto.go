@@ -4,7 +4,9 @@ package utils
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 
@@ -94,3 +96,33 @@ func Decrypt(data []byte, key string) ([]byte, error) {
 	}
 	return plain, nil
 }
+
+// DecryptUserSecret расшифровывает хранимый в hex формате ключ пользователя мастер ключом.
+// Возвращает ключ пользователя в hex формате.
+func DecryptUserSecret(encryptedSecret string, masterKey string) (string, error) {
+	encSecret, err := hex.DecodeString(encryptedSecret)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode user secret: %w", err)
+	}
+	secret, err := Decrypt(encSecret, masterKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt user secret: %w", err)
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+// auditKeyInfo используется для получения ключа журнала аудита из мастер ключа,
+// чтобы мастер ключ не применялся и для шифрования, и для хэшей журнала.
+const auditKeyInfo = "gophkeeper audit log"
+
+// AuditKey возвращает ключ журнала аудита - HMAC-SHA256 от auditKeyInfo на мастер ключе.
+// Ключом вычисляются хэши событий журнала (см. model.AuditEvent.ComputeHash).
+func AuditKey(masterKey string) ([]byte, error) {
+	keyB, err := hex.DecodeString(masterKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode master key: %w", err)
+	}
+	mac := hmac.New(sha256.New, keyB)
+	mac.Write([]byte(auditKeyInfo))
+	return mac.Sum(nil), nil
+}
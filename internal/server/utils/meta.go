@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// metaIndexKeyInfo используется для получения ключа слепых индексов из ключа пользователя,
+// чтобы один и тот же ключ не применялся и для шифрования, и для индексов.
+const metaIndexKeyInfo = "gophkeeper meta index"
+
+// BlindIndex вычисляет слепой индекс значения поля мета данных - HMAC-SHA256 от имени поля
+// и значения (без пробелов по краям, в нижнем регистре) на ключе, полученном из ключа пользователя.
+// Одинаковые значения поля дают одинаковый индекс, поэтому хранилище находит данные по точному
+// совпадению, не зная самого значения.
+func BlindIndex(key string, field string, value string) (string, error) {
+	keyB, err := hex.DecodeString(key)
+	if err != nil {
+		return "", err
+	}
+	keyMac := hmac.New(sha256.New, keyB)
+	keyMac.Write([]byte(metaIndexKeyInfo))
+
+	mac := hmac.New(sha256.New, keyMac.Sum(nil))
+	mac.Write([]byte(field))
+	// Нулевой байт разделяет имя поля и значение, чтобы их нельзя было сдвинуть друг в друга.
+	mac.Write([]byte{0})
+	mac.Write([]byte(strings.ToLower(strings.TrimSpace(value))))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// MetaIndex вычисляет слепые индексы непустых полей мета данных из model.IndexedMetaFields.
+func MetaIndex(meta string, key string) ([]string, error) {
+	item := model.VaultItem{Meta: meta}
+	var index []string
+	for _, field := range model.IndexedMetaFields() {
+		value := item.MetaValue(field)
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		token, err := BlindIndex(key, field, value)
+		if err != nil {
+			return nil, err
+		}
+		index = append(index, token)
+	}
+	return index, nil
+}
+
+// EncryptMeta шифрует мета данные ключом пользователя и вычисляет слепые индексы их полей.
+func EncryptMeta(meta string, key string) ([]byte, []string, error) {
+	encMeta, err := Encrypt([]byte(meta), key)
+	if err != nil {
+		return nil, nil, err
+	}
+	index, err := MetaIndex(meta, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encMeta, index, nil
+}
+
+// DecryptMeta возвращает мета данные в открытом виде: расшифровывает encMeta ключом пользователя,
+// а если мета данные не зашифрованы (сохранены до включения шифрования), возвращает meta.
+func DecryptMeta(encMeta []byte, meta string, key string) (string, error) {
+	if encMeta == nil {
+		return meta, nil
+	}
+	decMeta, err := Decrypt(encMeta, key)
+	if err != nil {
+		return "", err
+	}
+	return string(decMeta), nil
+}
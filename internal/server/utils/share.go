@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// sealKeyInfo используется при получении ключа шифрования из общего секрета X25519.
+const sealKeyInfo = "gophkeeper sealed key"
+
+// gcmOverhead размер вектора инициализации и тега AES-GCM, которые Encrypt добавляет к шифру.
+const gcmOverhead = 12 + 16
+
+// ErrInvalidSealedKey возвращается, если зашифрованный открытым ключом ключ поврежден.
+var ErrInvalidSealedKey = errors.New("invalid sealed key")
+
+// GenerateKeyPair создает пару ключей X25519 пользователя.
+// Закрытый ключ возвращается зашифрованным ключом пользователя secret.
+func GenerateKeyPair(secret string) (publicKey []byte, encPrivateKey []byte, err error) {
+	private, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate key pair: %w", err)
+	}
+	encPrivateKey, err = Encrypt(private.Bytes(), secret)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encrypt private key: %w", err)
+	}
+	return private.PublicKey().Bytes(), encPrivateKey, nil
+}
+
+// GenerateItemKey создает собственный ключ данных (в hex формате)
+// и возвращает его вместе с его копией, зашифрованной ключом владельца secret.
+func GenerateItemKey(secret string) (key string, encKey []byte, err error) {
+	keyB, err := GenerateUserKey()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate item key: %w", err)
+	}
+	encKey, err = Encrypt(keyB, secret)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encrypt item key: %w", err)
+	}
+	return hex.EncodeToString(keyB), encKey, nil
+}
+
+// DecryptItemKey расшифровывает ключ данных ключом владельца. Возвращает ключ в hex формате.
+func DecryptItemKey(encKey []byte, secret string) (string, error) {
+	key, err := Decrypt(encKey, secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt item key: %w", err)
+	}
+	return hex.EncodeToString(key), nil
+}
+
+// SealKey шифрует ключ (в hex формате) открытым ключом получателя: из общего секрета одноразовой
+// пары ключей и ключа получателя и обоих открытых ключей выводится ключ AES-GCM, одноразовый открытый ключ
+// пишется перед шифром.
+func SealKey(key string, publicKey []byte) ([]byte, error) {
+	recipient, err := ecdh.X25519().NewPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+	ephemeral, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate ephemeral key: %w", err)
+	}
+	shared, err := ephemeral.ECDH(recipient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute shared secret: %w", err)
+	}
+	sealKey := deriveSealKey(shared, ephemeral.PublicKey(), recipient)
+	keyB, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := Encrypt(keyB, sealKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to seal key: %w", err)
+	}
+	return append(ephemeral.PublicKey().Bytes(), sealed...), nil
+}
+
+// OpenKey расшифровывает зашифрованный SealKey ключ закрытым ключом получателя,
+// который хранится зашифрованным ключом пользователя secret. Возвращает ключ в hex формате.
+func OpenKey(sealed []byte, encPrivateKey []byte, secret string) (string, error) {
+	privateB, err := Decrypt(encPrivateKey, secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt private key: %w", err)
+	}
+	private, err := ecdh.X25519().NewPrivateKey(privateB)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse private key: %w", err)
+	}
+	pubSize := len(private.PublicKey().Bytes())
+	if len(sealed) < pubSize+gcmOverhead {
+		return "", ErrInvalidSealedKey
+	}
+	ephemeral, err := ecdh.X25519().NewPublicKey(sealed[:pubSize])
+	if err != nil {
+		return "", ErrInvalidSealedKey
+	}
+	shared, err := private.ECDH(ephemeral)
+	if err != nil {
+		return "", fmt.Errorf("failed to compute shared secret: %w", err)
+	}
+	key, err := Decrypt(sealed[pubSize:], deriveSealKey(shared, ephemeral, private.PublicKey()))
+	if err != nil {
+		return "", fmt.Errorf("failed to open key: %w", err)
+	}
+	return hex.EncodeToString(key), nil
+}
+
+// deriveSealKey выводит из общего секрета X25519 ключ AES-256 (в hex формате),
+// привязанный к одноразовому открытому ключу и открытому ключу получателя.
+func deriveSealKey(shared []byte, ephemeral *ecdh.PublicKey, recipient *ecdh.PublicKey) string {
+	h := sha256.New()
+	h.Write([]byte(sealKeyInfo))
+	h.Write(shared)
+	h.Write(ephemeral.Bytes())
+	h.Write(recipient.Bytes())
+	return hex.EncodeToString(h.Sum(nil))
+}
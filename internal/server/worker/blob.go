@@ -0,0 +1,139 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// BlobGracePeriod время, в течение которого новый блок не удаляется, даже если на него нет ссылок.
+// Защищает блоки файла, загрузка которого еще не завершена.
+const BlobGracePeriod = 24 * time.Hour
+
+// BlobCollector периодически удаляет из хранилища блоков блоки, на которые не ссылается
+// ни одна версия данных пользователя.
+type BlobCollector struct {
+	storage   storage.Storage
+	blobs     blob.Store
+	masterKey string
+	interval  time.Duration
+	log       *logrus.Entry
+}
+
+// NewBlobCollector создает и возвращает новую задачу удаления неиспользуемых блоков.
+func NewBlobCollector(
+	storage storage.Storage, blobs blob.Store, masterKey string, interval time.Duration, logger *logrus.Logger,
+) *BlobCollector {
+	return &BlobCollector{
+		storage:   storage,
+		blobs:     blobs,
+		masterKey: masterKey,
+		interval:  interval,
+		log:       logger.WithField("instance", "blobCollector"),
+	}
+}
+
+// Run запускает периодическое удаление неиспользуемых блоков и блокируется до отмены контекста.
+func (c *BlobCollector) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		c.Collect(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Collect удаляет неиспользуемые блоки всех пользователей.
+func (c *BlobCollector) Collect(ctx context.Context) {
+	users, err := c.blobs.Users(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			c.log.WithError(err).Error("Error while listing blob users")
+		}
+		return
+	}
+	before := time.Now().Add(-BlobGracePeriod)
+	for _, userID := range users {
+		deleted, err := c.collectUser(ctx, userID, before)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.log.WithError(err).WithField("user", userID).Error("Error while collecting user blobs")
+			continue
+		}
+		if deleted > 0 {
+			c.log.WithFields(logrus.Fields{"user": userID, "deleted": deleted}).Info("Unused blobs deleted")
+		}
+	}
+}
+
+// collectUser удаляет неиспользуемые блоки пользователя, записанные раньше before.
+func (c *BlobCollector) collectUser(ctx context.Context, userID string, before time.Time) (int, error) {
+	// Ключи получаем до чтения манифестов: блок, записанный после этого, не попадет в список на удаление.
+	keys, err := c.blobs.Keys(ctx, userID, before)
+	if err != nil || len(keys) == 0 {
+		return 0, err
+	}
+	used, err := c.usedKeys(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, key := range keys {
+		if used[key] {
+			continue
+		}
+		// Блок, повторно записанный загрузкой после получения ключей, не удаляется: манифест этой загрузки
+		// мог быть сохранен уже после чтения используемых блоков.
+		ok, err := c.blobs.Delete(ctx, userID, key, before)
+		if err != nil {
+			return deleted, err
+		}
+		if ok {
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// usedKeys возвращает ключи блоков, на которые ссылаются данные пользователя.
+// Для удаленного пользователя используемых блоков нет.
+func (c *BlobCollector) usedKeys(ctx context.Context, userID string) (map[string]bool, error) {
+	used := make(map[string]bool)
+	user, err := c.storage.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNoUser) {
+			return used, nil
+		}
+		return nil, err
+	}
+	secret, err := utils.DecryptUserSecret(user.EncryptedSecret, c.masterKey)
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := c.storage.ListChunkedData(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, encManifest := range manifests {
+		manifest, err := blob.DecryptManifest(encManifest, secret)
+		if err != nil {
+			// Без полного списка используемых блоков удалять ничего нельзя.
+			return nil, err
+		}
+		for _, chunk := range manifest.Chunks {
+			used[chunk.Key] = true
+		}
+	}
+	return used, nil
+}
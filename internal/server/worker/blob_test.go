@@ -0,0 +1,128 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/server/blob"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobCollector(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	user := &model.User{
+		ID:    "1",
+		Login: "user",
+		EncryptedSecret: "ce4ef7c0df5d1738675b5f16d7c7bccf5e2267a09d6e8d3115c26fbab619aed0" +
+			"88abd055ba50d550e8d9f578f14ed095804c5fe6014f44e4a4e40665",
+	}
+	secret, err := utils.DecryptUserSecret(user.EncryptedSecret, masterKey)
+	require.NoError(t, err)
+
+	// saveFile сохраняет файл блоками и возвращает его зашифрованный манифест.
+	saveFile := func(t *testing.T, blobs blob.Store, data string) []byte {
+		w, err := blob.NewWriter(context.Background(), blobs, user.ID, secret, 4)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(data))
+		require.NoError(t, err)
+		manifest, err := w.Finish()
+		require.NoError(t, err)
+		encManifest, err := blob.EncryptManifest(manifest, secret)
+		require.NoError(t, err)
+		return encManifest
+	}
+	// age делает все блоки старше периода защиты новых блоков.
+	age := func(t *testing.T, dir string) {
+		old := time.Now().Add(-2 * BlobGracePeriod)
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			return os.Chtimes(path, old, old)
+		})
+		require.NoError(t, err)
+	}
+	keys := func(t *testing.T, blobs blob.Store) []string {
+		keys, err := blobs.Keys(context.Background(), user.ID, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		return keys
+	}
+
+	t.Run("Удаление неиспользуемых блоков", func(t *testing.T) {
+		dir := t.TempDir()
+		blobs, err := blob.NewFSStore(dir)
+		require.NoError(t, err)
+		used := saveFile(t, blobs, "usedfile")
+		saveFile(t, blobs, "unusedfl")
+		age(t, dir)
+		// Блок, записанный недавно, не удаляется, даже если на него нет ссылок.
+		saveFile(t, blobs, "newf")
+
+		mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(user, nil)
+		mockStorage.EXPECT().ListChunkedData(gomock.Any(), user.ID).Return([][]byte{used}, nil)
+		NewBlobCollector(mockStorage, blobs, masterKey, time.Hour, log).Collect(context.Background())
+		assert.Len(t, keys(t, blobs), 3)
+	})
+
+	t.Run("Блок, повторно записанный во время удаления, не удаляется", func(t *testing.T) {
+		dir := t.TempDir()
+		blobs, err := blob.NewFSStore(dir)
+		require.NoError(t, err)
+		saveFile(t, blobs, "somefile")
+		age(t, dir)
+
+		mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(user, nil)
+		// Загрузка того же файла повторно записывает блоки после получения их ключей,
+		// а ее манифест сохраняется уже после чтения используемых блоков.
+		mockStorage.EXPECT().ListChunkedData(gomock.Any(), user.ID).DoAndReturn(
+			func(_ context.Context, _ string) ([][]byte, error) {
+				saveFile(t, blobs, "somefile")
+				return nil, nil
+			})
+		NewBlobCollector(mockStorage, blobs, masterKey, time.Hour, log).Collect(context.Background())
+		assert.Len(t, keys(t, blobs), 2)
+	})
+
+	t.Run("Удаление блоков удаленного пользователя", func(t *testing.T) {
+		dir := t.TempDir()
+		blobs, err := blob.NewFSStore(dir)
+		require.NoError(t, err)
+		saveFile(t, blobs, "somefile")
+		age(t, dir)
+
+		mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(nil, storage.ErrNoUser)
+		NewBlobCollector(mockStorage, blobs, masterKey, time.Hour, log).Collect(context.Background())
+		assert.Empty(t, keys(t, blobs))
+	})
+
+	t.Run("Ошибка БД", func(t *testing.T) {
+		dir := t.TempDir()
+		blobs, err := blob.NewFSStore(dir)
+		require.NoError(t, err)
+		saveFile(t, blobs, "somefile")
+		age(t, dir)
+
+		mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(user, nil)
+		mockStorage.EXPECT().ListChunkedData(gomock.Any(), user.ID).Return(nil, errors.New("db error"))
+		NewBlobCollector(mockStorage, blobs, masterKey, time.Hour, log).Collect(context.Background())
+		assert.Len(t, keys(t, blobs), 2)
+	})
+}
@@ -0,0 +1,2 @@
+// Package worker содержит фоновые задачи сервера.
+package worker
@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// ExpirySweeper периодически безвозвратно удаляет данные, срок хранения которых истек.
+type ExpirySweeper struct {
+	storage  storage.VaultStorage
+	interval time.Duration
+	log      *logrus.Entry
+}
+
+// NewExpirySweeper создает и возвращает новую задачу удаления данных с истекшим сроком хранения.
+func NewExpirySweeper(storage storage.VaultStorage, interval time.Duration, logger *logrus.Logger) *ExpirySweeper {
+	return &ExpirySweeper{
+		storage:  storage,
+		interval: interval,
+		log:      logger.WithField("instance", "expirySweeper"),
+	}
+}
+
+// Run запускает периодическое удаление данных с истекшим сроком хранения и блокируется до отмены контекста.
+func (s *ExpirySweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.Sweep(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep удаляет данные, срок хранения которых истек, вместе с их вложениями.
+func (s *ExpirySweeper) Sweep(ctx context.Context) {
+	swept, err := s.storage.PurgeExpiredItems(ctx, time.Now())
+	if err != nil {
+		if ctx.Err() == nil {
+			s.log.WithError(err).Error("Error while sweeping expired items")
+		}
+		return
+	}
+	if swept > 0 {
+		s.log.WithField("swept", swept).Info("Expired items swept")
+	}
+}
@@ -0,0 +1,81 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpirySweeper(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	sweeper := NewExpirySweeper(mockStorage, time.Minute, log)
+
+	tests := []struct {
+		name  string
+		err   error
+		count int64
+	}{
+		{
+			name:  "Успешное удаление",
+			count: 3,
+		},
+		{
+			name: "Ошибка БД",
+			err:  errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStorage.EXPECT().PurgeExpiredItems(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, now time.Time) (int64, error) {
+					assert.WithinDuration(t, time.Now(), now, time.Minute)
+					return tt.count, tt.err
+				},
+			)
+			sweeper.Sweep(context.Background())
+		})
+	}
+}
+
+func TestExpirySweeperRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	sweeper := NewExpirySweeper(mockStorage, time.Hour, log)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	// Первое удаление выполняется сразу при запуске.
+	mockStorage.EXPECT().PurgeExpiredItems(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ time.Time) (int64, error) {
+			cancel()
+			return 0, nil
+		},
+	)
+
+	done := make(chan struct{})
+	go func() {
+		sweeper.Run(ctx)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("sweeper did not stop after context cancellation")
+	}
+}
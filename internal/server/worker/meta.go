@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// metaBatchSize количество мета данных, шифруемых за один проход.
+const metaBatchSize = 100
+
+// MetaEncryptor шифрует мета данные, сохраненные в открытом виде до включения шифрования,
+// ключами их пользователей и вычисляет для них слепые индексы.
+type MetaEncryptor struct {
+	storage   storage.Storage
+	masterKey string
+	log       *logrus.Entry
+}
+
+// NewMetaEncryptor создает и возвращает новую задачу шифрования мета данных в открытом виде.
+func NewMetaEncryptor(storage storage.Storage, masterKey string, logger *logrus.Logger) *MetaEncryptor {
+	return &MetaEncryptor{
+		storage:   storage,
+		masterKey: masterKey,
+		log:       logger.WithField("instance", "metaEncryptor"),
+	}
+}
+
+// Run шифрует все мета данные в открытом виде и завершается.
+// При ошибке шифрование прекращается и продолжится при следующем запуске сервера.
+func (e *MetaEncryptor) Run(ctx context.Context) {
+	secrets := make(map[string]string)
+	encrypted := 0
+	for {
+		metas, err := e.storage.ListLegacyMeta(ctx, metaBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				e.log.WithError(err).Error("Error while listing plaintext meta")
+			}
+			return
+		}
+		if len(metas) == 0 {
+			break
+		}
+		for _, meta := range metas {
+			secret, ok := secrets[meta.UserID]
+			if !ok {
+				user, err := e.storage.GetUserByID(ctx, meta.UserID)
+				if err != nil {
+					e.log.WithError(err).WithField("user", meta.UserID).Error("Error while getting user")
+					return
+				}
+				if secret, err = utils.DecryptUserSecret(user.EncryptedSecret, e.masterKey); err != nil {
+					e.log.WithError(err).WithField("user", meta.UserID).Error("Error while decrypting user secret")
+					return
+				}
+				secrets[meta.UserID] = secret
+			}
+			if meta.EncryptMeta, meta.MetaIndex, err = utils.EncryptMeta(meta.Meta, secret); err != nil {
+				e.log.WithError(err).WithField("user", meta.UserID).Error("Error while encrypting user meta")
+				return
+			}
+			if err = e.storage.EncryptLegacyMeta(ctx, meta); err != nil {
+				if ctx.Err() == nil {
+					e.log.WithError(err).WithField("item", meta.ItemID).Error("Error while saving encrypted meta")
+				}
+				return
+			}
+			encrypted++
+		}
+	}
+	if encrypted > 0 {
+		e.log.WithField("encrypted", encrypted).Info("Plaintext meta encrypted")
+	}
+}
@@ -0,0 +1,90 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/server/utils"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetaEncryptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	masterKey := "1d0e95ed9e11b59ba42200720c252f98d4cd440412926a0c15b6a95e03ab4480"
+	user := &model.User{
+		ID:    "1",
+		Login: "user",
+		EncryptedSecret: "ce4ef7c0df5d1738675b5f16d7c7bccf5e2267a09d6e8d3115c26fbab619aed0" +
+			"88abd055ba50d550e8d9f578f14ed095804c5fe6014f44e4a4e40665",
+	}
+	secret, err := utils.DecryptUserSecret(user.EncryptedSecret, masterKey)
+	require.NoError(t, err)
+	encryptor := NewMetaEncryptor(mockStorage, masterKey, log)
+
+	t.Run("Шифрование мета данных в открытом виде", func(t *testing.T) {
+		metas := []model.LegacyMeta{
+			{UserID: user.ID, ItemID: "1", Meta: `{"resource":"github.com"}`},
+			{UserID: user.ID, ItemID: "1", Revision: 1, Meta: `{"resource":"gitlab.com"}`},
+		}
+		index, err := utils.MetaIndex(`{"resource":"github.com"}`, secret)
+		require.NoError(t, err)
+
+		gomock.InOrder(
+			mockStorage.EXPECT().ListLegacyMeta(gomock.Any(), metaBatchSize).Return(metas, nil),
+			mockStorage.EXPECT().ListLegacyMeta(gomock.Any(), metaBatchSize).Return(nil, nil),
+		)
+		// Секрет пользователя расшифровывается один раз.
+		mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(user, nil)
+		var saved []model.LegacyMeta
+		mockStorage.EXPECT().EncryptLegacyMeta(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, meta model.LegacyMeta) error {
+				saved = append(saved, meta)
+				return nil
+			},
+		).Times(2)
+
+		encryptor.Run(context.Background())
+		require.Len(t, saved, 2)
+		for i, meta := range saved {
+			decMeta, err := utils.DecryptMeta(meta.EncryptMeta, "", secret)
+			require.NoError(t, err)
+			assert.Equal(t, metas[i].Meta, decMeta)
+			assert.Equal(t, metas[i].Revision, meta.Revision)
+		}
+		assert.Equal(t, index, saved[0].MetaIndex)
+	})
+
+	t.Run("Ошибка получения пользователя", func(t *testing.T) {
+		mockStorage.EXPECT().ListLegacyMeta(gomock.Any(), metaBatchSize).
+			Return([]model.LegacyMeta{{UserID: "2", ItemID: "2", Meta: "{}"}}, nil)
+		mockStorage.EXPECT().GetUserByID(gomock.Any(), "2").Return(nil, errors.New("db error"))
+
+		encryptor.Run(context.Background())
+	})
+
+	t.Run("Ошибка сохранения", func(t *testing.T) {
+		mockStorage.EXPECT().ListLegacyMeta(gomock.Any(), metaBatchSize).
+			Return([]model.LegacyMeta{{UserID: user.ID, ItemID: "1", Meta: "{}"}}, nil)
+		mockStorage.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(user, nil)
+		mockStorage.EXPECT().EncryptLegacyMeta(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+		encryptor.Run(context.Background())
+	})
+
+	t.Run("Ошибка БД", func(t *testing.T) {
+		mockStorage.EXPECT().ListLegacyMeta(gomock.Any(), metaBatchSize).Return(nil, errors.New("db error"))
+
+		encryptor.Run(context.Background())
+	})
+}
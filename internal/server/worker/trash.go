@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// TrashPurger периодически безвозвратно удаляет данные, которые находятся в корзине дольше срока хранения.
+type TrashPurger struct {
+	storage   storage.VaultStorage
+	retention time.Duration
+	interval  time.Duration
+	log       *logrus.Entry
+}
+
+// NewTrashPurger создает и возвращает новую задачу очистки корзины.
+func NewTrashPurger(
+	storage storage.VaultStorage, retention time.Duration, interval time.Duration, logger *logrus.Logger,
+) *TrashPurger {
+	return &TrashPurger{
+		storage:   storage,
+		retention: retention,
+		interval:  interval,
+		log:       logger.WithField("instance", "trashPurger"),
+	}
+}
+
+// Run запускает периодическую очистку корзины и блокируется до отмены контекста.
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.Purge(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge удаляет из корзины данные, срок хранения которых истек.
+func (p *TrashPurger) Purge(ctx context.Context) {
+	purged, err := p.storage.PurgeDeletedBefore(ctx, time.Now().Add(-p.retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			p.log.WithError(err).Error("Error while purging trash")
+		}
+		return
+	}
+	if purged > 0 {
+		p.log.WithField("purged", purged).Info("Trash purged")
+	}
+}
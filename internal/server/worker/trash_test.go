@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrashPurger(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	retention := 24 * time.Hour
+	purger := NewTrashPurger(mockStorage, retention, time.Hour, log)
+
+	tests := []struct {
+		name  string
+		err   error
+		count int64
+	}{
+		{
+			name:  "Успешная очистка",
+			count: 2,
+		},
+		{
+			name: "Ошибка БД",
+			err:  errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStorage.EXPECT().PurgeDeletedBefore(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, before time.Time) (int64, error) {
+					assert.WithinDuration(t, time.Now().Add(-retention), before, time.Minute)
+					return tt.count, tt.err
+				},
+			)
+			purger.Purge(context.Background())
+		})
+	}
+}
+
+func TestTrashPurgerRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorage(ctrl)
+	log, err := logger.NewLogger("info")
+	require.NoError(t, err)
+	purger := NewTrashPurger(mockStorage, time.Hour, time.Hour, log)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	// Первая очистка выполняется сразу при запуске.
+	mockStorage.EXPECT().PurgeDeletedBefore(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ time.Time) (int64, error) {
+			cancel()
+			return 0, nil
+		},
+	)
+
+	done := make(chan struct{})
+	go func() {
+		purger.Run(ctx)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("purger did not stop after context cancellation")
+	}
+}
@@ -0,0 +1,88 @@
+// Package instrument содержит обертку хранилища, которая собирает метрики времени выполнения и ошибок
+// каждого метода storage.Storage и повторяет запросы, завершившиеся временной ошибкой БД.
+package instrument
+
+import (
+	"context"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// RetryableFunc проверяет, что запрос завершился временной ошибкой и его можно повторить.
+// readOnly - запрос только читает данные.
+type RetryableFunc func(err error, readOnly bool) bool
+
+// Config определяет структуру настроек повторов запросов.
+type Config struct {
+	MaxRetries int           // Максимальное количество повторов одного запроса (0 - без повторов).
+	Backoff    time.Duration // Задержка перед первым повтором, удваивается с каждым следующим.
+	MaxBackoff time.Duration // Максимальная задержка перед повтором (0 - не ограничена).
+	Retryable  RetryableFunc // Проверка временной ошибки; nil - запросы не повторяются.
+}
+
+// Storage оборачивает хранилище: учитывает каждый вызов его методов в метриках и повторяет вызовы,
+// завершившиеся временной ошибкой. Вызовы внутри транзакции не повторяются, повторяется транзакция целиком.
+type Storage struct {
+	next    storage.Storage
+	cfg     Config
+	metrics *Metrics
+}
+
+var _ storage.Storage = (*Storage)(nil)
+
+// New создает и возвращает обертку хранилища next, записывающую метрики в metrics.
+func New(next storage.Storage, cfg Config, metrics *Metrics) *Storage {
+	return &Storage{next: next, cfg: cfg, metrics: metrics}
+}
+
+// Close закрывает обернутое хранилище.
+func (s *Storage) Close() error {
+	return s.next.Close()
+}
+
+// WithTx выполняет fn в транзакции обернутого хранилища. Вызовы хранилища внутри fn учитываются
+// в метриках, но не повторяются: при временной ошибке повторяется вся транзакция, поэтому fn должна
+// допускать повторный запуск.
+func (s *Storage) WithTx(ctx context.Context, fn func(tx storage.Storage) error) error {
+	return s.exec(ctx, "WithTx", false, func() error {
+		return s.next.WithTx(ctx, func(tx storage.Storage) error {
+			return fn(&Storage{next: tx, metrics: s.metrics})
+		})
+	})
+}
+
+// exec вызывает метод хранилища, не возвращающий результата (см. call).
+func (s *Storage) exec(ctx context.Context, method string, readOnly bool, fn func() error) error {
+	_, err := call(ctx, s, method, readOnly, func() (struct{}, error) {
+		return struct{}{}, fn()
+	})
+	return err
+}
+
+// call вызывает метод хранилища, учитывая время выполнения и ошибку в метриках метода,
+// и повторяет вызов с увеличивающейся задержкой, пока ошибка временная и не исчерпаны повторы.
+func call[T any](ctx context.Context, s *Storage, method string, readOnly bool, fn func() (T, error)) (T, error) {
+	stats := s.metrics.method(method)
+	backoff := s.cfg.Backoff
+	for attempt := 0; ; attempt++ {
+		start := time.Now()
+		res, err := fn()
+		stats.observe(time.Since(start), err)
+		if err == nil || attempt >= s.cfg.MaxRetries || s.cfg.Retryable == nil || !s.cfg.Retryable(err, readOnly) {
+			return res, err
+		}
+		stats.retries.Add(1)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return res, err
+		case <-timer.C:
+		}
+		backoff *= 2
+		if s.cfg.MaxBackoff > 0 && backoff > s.cfg.MaxBackoff {
+			backoff = s.cfg.MaxBackoff
+		}
+	}
+}
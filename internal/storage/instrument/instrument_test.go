@@ -0,0 +1,176 @@
+package instrument
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/memory"
+	"github.com/pinbrain/gophkeeper/internal/storage/mocks"
+	"github.com/pinbrain/gophkeeper/internal/storage/storagetest"
+)
+
+var errTransient = errors.New("transient error")
+
+func retryable(err error, _ bool) bool {
+	return errors.Is(err, errTransient)
+}
+
+func TestStorage(t *testing.T) {
+	storagetest.Run(t, func(_ *testing.T) storage.Storage {
+		return New(memory.NewStorage(), Config{MaxRetries: 2, Retryable: retryable}, NewMetrics())
+	})
+}
+
+func TestRetries(t *testing.T) {
+	item := &model.VaultItem{ID: "itemID"}
+	tests := []struct {
+		name        string
+		errs        []error
+		readOnly    bool
+		wantErr     error
+		wantCalls   int64
+		wantErrors  int64
+		wantRetries int64
+	}{
+		{
+			name:      "Успешный запрос",
+			errs:      []error{nil},
+			wantCalls: 1,
+		},
+		{
+			name:        "Повтор после временной ошибки",
+			errs:        []error{errTransient, nil},
+			wantCalls:   2,
+			wantErrors:  1,
+			wantRetries: 1,
+		},
+		{
+			name:        "Повторы исчерпаны",
+			errs:        []error{errTransient, errTransient, errTransient},
+			wantErr:     errTransient,
+			wantCalls:   3,
+			wantErrors:  3,
+			wantRetries: 2,
+		},
+		{
+			name:       "Ошибка не повторяется",
+			errs:       []error{errors.New("some error")},
+			wantErr:    errors.New("some error"),
+			wantCalls:  1,
+			wantErrors: 1,
+		},
+		{
+			name:      "Ошибка хранилища не учитывается в метриках",
+			errs:      []error{storage.ErrNoData},
+			wantErr:   storage.ErrNoData,
+			wantCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			next := mocks.NewMockStorage(ctrl)
+			for _, err := range tt.errs {
+				if err != nil {
+					next.EXPECT().GetItem(gomock.Any(), "itemID", "userID").Return(nil, err)
+				} else {
+					next.EXPECT().GetItem(gomock.Any(), "itemID", "userID").Return(item, nil)
+				}
+			}
+			metrics := NewMetrics()
+			s := New(next, Config{MaxRetries: 2, Backoff: time.Millisecond, Retryable: retryable}, metrics)
+
+			got, err := s.GetItem(context.Background(), "itemID", "userID")
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, item, got)
+			}
+			stats := metrics.Stats()["GetItem"]
+			assert.Equal(t, tt.wantCalls, stats.Calls)
+			assert.Equal(t, tt.wantErrors, stats.Errors)
+			assert.Equal(t, tt.wantRetries, stats.Retries)
+		})
+	}
+}
+
+func TestRetryReadOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	next := mocks.NewMockStorage(ctrl)
+	next.EXPECT().ListFolders(gomock.Any(), "userID").Return(nil, errTransient)
+	next.EXPECT().ListFolders(gomock.Any(), "userID").Return(nil, nil)
+	next.EXPECT().DeleteFolder(gomock.Any(), "folderID", "userID").Return(errTransient)
+
+	readOnly := func(err error, readOnly bool) bool {
+		return readOnly && errors.Is(err, errTransient)
+	}
+	s := New(next, Config{MaxRetries: 2, Retryable: readOnly}, NewMetrics())
+
+	_, err := s.ListFolders(context.Background(), "userID")
+	require.NoError(t, err)
+	err = s.DeleteFolder(context.Background(), "folderID", "userID")
+	assert.ErrorIs(t, err, errTransient)
+}
+
+func TestRetryCanceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	next := mocks.NewMockStorage(ctrl)
+	ctx, cancel := context.WithCancel(context.Background())
+	next.EXPECT().DeleteItem(gomock.Any(), "itemID", "userID").DoAndReturn(
+		func(_ context.Context, _ string, _ string) error {
+			cancel()
+			return errTransient
+		},
+	)
+	s := New(next, Config{MaxRetries: 5, Backoff: time.Hour, Retryable: retryable}, NewMetrics())
+
+	err := s.DeleteItem(ctx, "itemID", "userID")
+	assert.ErrorIs(t, err, errTransient)
+}
+
+func TestWithTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	next := mocks.NewMockStorage(ctrl)
+	tx := mocks.NewMockStorage(ctrl)
+	next.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(tx storage.Storage) error) error {
+			return fn(tx)
+		},
+	).Times(2)
+	tx.EXPECT().DeleteItem(gomock.Any(), "itemID", "userID").Return(errTransient)
+	tx.EXPECT().DeleteItem(gomock.Any(), "itemID", "userID").Return(nil)
+
+	metrics := NewMetrics()
+	s := New(next, Config{MaxRetries: 2, Retryable: retryable}, metrics)
+
+	err := s.WithTx(context.Background(), func(tx storage.Storage) error {
+		return tx.DeleteItem(context.Background(), "itemID", "userID")
+	})
+	require.NoError(t, err)
+	stats := metrics.Stats()
+	assert.Equal(t, int64(2), stats["WithTx"].Calls)
+	assert.Equal(t, int64(1), stats["WithTx"].Retries)
+	assert.Equal(t, int64(2), stats["DeleteItem"].Calls)
+	assert.Equal(t, int64(0), stats["DeleteItem"].Retries)
+}
+
+func TestMetricsString(t *testing.T) {
+	metrics := NewMetrics()
+	assert.JSONEq(t, "{}", metrics.String())
+	metrics.method("GetItem").observe(time.Second, errTransient)
+	assert.JSONEq(t, `{"GetItem":{"calls":1,"errors":1,"retries":0,"latencyNs":1000000000,"maxLatencyNs":1000000000}}`,
+		metrics.String())
+}
@@ -0,0 +1,464 @@
+package instrument
+
+import (
+	"context"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// CreateUser вызывает одноименный метод обернутого хранилища.
+func (s *Storage) CreateUser(ctx context.Context, user *model.User) (string, error) {
+	return call(ctx, s, "CreateUser", false, func() (string, error) {
+		return s.next.CreateUser(ctx, user)
+	})
+}
+
+// GetUserByLogin вызывает одноименный метод обернутого хранилища.
+func (s *Storage) GetUserByLogin(ctx context.Context, login string) (*model.User, error) {
+	return call(ctx, s, "GetUserByLogin", true, func() (*model.User, error) {
+		return s.next.GetUserByLogin(ctx, login)
+	})
+}
+
+// GetUserByID вызывает одноименный метод обернутого хранилища.
+func (s *Storage) GetUserByID(ctx context.Context, id string) (*model.User, error) {
+	return call(ctx, s, "GetUserByID", true, func() (*model.User, error) {
+		return s.next.GetUserByID(ctx, id)
+	})
+}
+
+// DeleteUser вызывает одноименный метод обернутого хранилища.
+func (s *Storage) DeleteUser(ctx context.Context, id string) error {
+	return s.exec(ctx, "DeleteUser", false, func() error {
+		return s.next.DeleteUser(ctx, id)
+	})
+}
+
+// SetUserKeys вызывает одноименный метод обернутого хранилища.
+func (s *Storage) SetUserKeys(ctx context.Context, id string, publicKey []byte, encPrivateKey []byte) error {
+	return s.exec(ctx, "SetUserKeys", false, func() error {
+		return s.next.SetUserKeys(ctx, id, publicKey, encPrivateKey)
+	})
+}
+
+// ListUsers вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListUsers(ctx context.Context) ([]model.User, error) {
+	return call(ctx, s, "ListUsers", true, func() ([]model.User, error) {
+		return s.next.ListUsers(ctx)
+	})
+}
+
+// CreateItem вызывает одноименный метод обернутого хранилища.
+func (s *Storage) CreateItem(ctx context.Context, userID string, item *model.VaultItem) (string, error) {
+	return call(ctx, s, "CreateItem", false, func() (string, error) {
+		return s.next.CreateItem(ctx, userID, item)
+	})
+}
+
+// GetItem вызывает одноименный метод обернутого хранилища.
+func (s *Storage) GetItem(ctx context.Context, id string, userID string) (*model.VaultItem, error) {
+	return call(ctx, s, "GetItem", true, func() (*model.VaultItem, error) {
+		return s.next.GetItem(ctx, id, userID)
+	})
+}
+
+// DeleteItem вызывает одноименный метод обернутого хранилища.
+func (s *Storage) DeleteItem(ctx context.Context, id string, userID string) error {
+	return s.exec(ctx, "DeleteItem", false, func() error {
+		return s.next.DeleteItem(ctx, id, userID)
+	})
+}
+
+// GetItemsByType вызывает одноименный метод обернутого хранилища.
+func (s *Storage) GetItemsByType(ctx context.Context, dataType string, userID string) ([]model.VaultItem, error) {
+	return call(ctx, s, "GetItemsByType", true, func() ([]model.VaultItem, error) {
+		return s.next.GetItemsByType(ctx, dataType, userID)
+	})
+}
+
+// ListItems вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListItems(
+	ctx context.Context, userID string, params model.ListItemsParams,
+) ([]model.VaultItem, error) {
+	return call(ctx, s, "ListItems", true, func() ([]model.VaultItem, error) {
+		return s.next.ListItems(ctx, userID, params)
+	})
+}
+
+// SearchItems вызывает одноименный метод обернутого хранилища.
+func (s *Storage) SearchItems(
+	ctx context.Context, userID string, params model.SearchItemsParams,
+) ([]model.VaultItem, error) {
+	return call(ctx, s, "SearchItems", true, func() ([]model.VaultItem, error) {
+		return s.next.SearchItems(ctx, userID, params)
+	})
+}
+
+// UpdateItem вызывает одноименный метод обернутого хранилища.
+func (s *Storage) UpdateItem(ctx context.Context, id string, userID string, item *model.VaultItem) error {
+	return s.exec(ctx, "UpdateItem", false, func() error {
+		return s.next.UpdateItem(ctx, id, userID, item)
+	})
+}
+
+// ListAttachments вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListAttachments(ctx context.Context, parentID string, userID string) ([]model.VaultItem, error) {
+	return call(ctx, s, "ListAttachments", true, func() ([]model.VaultItem, error) {
+		return s.next.ListAttachments(ctx, parentID, userID)
+	})
+}
+
+// SetFavorite вызывает одноименный метод обернутого хранилища.
+func (s *Storage) SetFavorite(ctx context.Context, id string, userID string, favorite bool) error {
+	return s.exec(ctx, "SetFavorite", false, func() error {
+		return s.next.SetFavorite(ctx, id, userID, favorite)
+	})
+}
+
+// RecordAccess вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RecordAccess(ctx context.Context, id string, userID string, at time.Time) error {
+	return s.exec(ctx, "RecordAccess", false, func() error {
+		return s.next.RecordAccess(ctx, id, userID, at)
+	})
+}
+
+// GetItemHistory вызывает одноименный метод обернутого хранилища.
+func (s *Storage) GetItemHistory(ctx context.Context, id string, userID string) ([]model.VaultItemRevision, error) {
+	return call(ctx, s, "GetItemHistory", true, func() ([]model.VaultItemRevision, error) {
+		return s.next.GetItemHistory(ctx, id, userID)
+	})
+}
+
+// GetItemRevision вызывает одноименный метод обернутого хранилища.
+func (s *Storage) GetItemRevision(
+	ctx context.Context, id string, userID string, revision int64,
+) (*model.VaultItemRevision, error) {
+	return call(ctx, s, "GetItemRevision", true, func() (*model.VaultItemRevision, error) {
+		return s.next.GetItemRevision(ctx, id, userID, revision)
+	})
+}
+
+// RestoreItemRevision вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RestoreItemRevision(ctx context.Context, id string, userID string, revision int64) error {
+	return s.exec(ctx, "RestoreItemRevision", false, func() error {
+		return s.next.RestoreItemRevision(ctx, id, userID, revision)
+	})
+}
+
+// GetDeletedItems вызывает одноименный метод обернутого хранилища.
+func (s *Storage) GetDeletedItems(ctx context.Context, userID string) ([]model.VaultItem, error) {
+	return call(ctx, s, "GetDeletedItems", true, func() ([]model.VaultItem, error) {
+		return s.next.GetDeletedItems(ctx, userID)
+	})
+}
+
+// RestoreDeletedItem вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RestoreDeletedItem(ctx context.Context, id string, userID string) error {
+	return s.exec(ctx, "RestoreDeletedItem", false, func() error {
+		return s.next.RestoreDeletedItem(ctx, id, userID)
+	})
+}
+
+// PurgeDeletedItems вызывает одноименный метод обернутого хранилища.
+func (s *Storage) PurgeDeletedItems(ctx context.Context, userID string) (int64, error) {
+	return call(ctx, s, "PurgeDeletedItems", false, func() (int64, error) {
+		return s.next.PurgeDeletedItems(ctx, userID)
+	})
+}
+
+// PurgeDeletedBefore вызывает одноименный метод обернутого хранилища.
+func (s *Storage) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return call(ctx, s, "PurgeDeletedBefore", false, func() (int64, error) {
+		return s.next.PurgeDeletedBefore(ctx, before)
+	})
+}
+
+// PurgeExpiredItems вызывает одноименный метод обернутого хранилища.
+func (s *Storage) PurgeExpiredItems(ctx context.Context, now time.Time) (int64, error) {
+	return call(ctx, s, "PurgeExpiredItems", false, func() (int64, error) {
+		return s.next.PurgeExpiredItems(ctx, now)
+	})
+}
+
+// ListChunkedData вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListChunkedData(ctx context.Context, userID string) ([][]byte, error) {
+	return call(ctx, s, "ListChunkedData", true, func() ([][]byte, error) {
+		return s.next.ListChunkedData(ctx, userID)
+	})
+}
+
+// GetUsage вызывает одноименный метод обернутого хранилища.
+func (s *Storage) GetUsage(ctx context.Context, userID string) ([]model.TypeUsage, error) {
+	return call(ctx, s, "GetUsage", true, func() ([]model.TypeUsage, error) {
+		return s.next.GetUsage(ctx, userID)
+	})
+}
+
+// LockUsage вызывает одноименный метод обернутого хранилища.
+func (s *Storage) LockUsage(ctx context.Context, userID string) error {
+	return s.exec(ctx, "LockUsage", false, func() error {
+		return s.next.LockUsage(ctx, userID)
+	})
+}
+
+// ListLegacyMeta вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListLegacyMeta(ctx context.Context, limit int) ([]model.LegacyMeta, error) {
+	return call(ctx, s, "ListLegacyMeta", true, func() ([]model.LegacyMeta, error) {
+		return s.next.ListLegacyMeta(ctx, limit)
+	})
+}
+
+// EncryptLegacyMeta вызывает одноименный метод обернутого хранилища.
+func (s *Storage) EncryptLegacyMeta(ctx context.Context, meta model.LegacyMeta) error {
+	return s.exec(ctx, "EncryptLegacyMeta", false, func() error {
+		return s.next.EncryptLegacyMeta(ctx, meta)
+	})
+}
+
+// CreateFolder вызывает одноименный метод обернутого хранилища.
+func (s *Storage) CreateFolder(ctx context.Context, userID string, folder *model.Folder) (string, error) {
+	return call(ctx, s, "CreateFolder", false, func() (string, error) {
+		return s.next.CreateFolder(ctx, userID, folder)
+	})
+}
+
+// ListFolders вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListFolders(ctx context.Context, userID string) ([]model.Folder, error) {
+	return call(ctx, s, "ListFolders", true, func() ([]model.Folder, error) {
+		return s.next.ListFolders(ctx, userID)
+	})
+}
+
+// DeleteFolder вызывает одноименный метод обернутого хранилища.
+func (s *Storage) DeleteFolder(ctx context.Context, id string, userID string) error {
+	return s.exec(ctx, "DeleteFolder", false, func() error {
+		return s.next.DeleteFolder(ctx, id, userID)
+	})
+}
+
+// MoveItem вызывает одноименный метод обернутого хранилища.
+func (s *Storage) MoveItem(ctx context.Context, id string, userID string, folderID string) error {
+	return s.exec(ctx, "MoveItem", false, func() error {
+		return s.next.MoveItem(ctx, id, userID, folderID)
+	})
+}
+
+// TagItem вызывает одноименный метод обернутого хранилища.
+func (s *Storage) TagItem(ctx context.Context, id string, userID string, tag *model.Tag) error {
+	return s.exec(ctx, "TagItem", false, func() error {
+		return s.next.TagItem(ctx, id, userID, tag)
+	})
+}
+
+// UntagItem вызывает одноименный метод обернутого хранилища.
+func (s *Storage) UntagItem(ctx context.Context, id string, userID string, nameIndex string) error {
+	return s.exec(ctx, "UntagItem", false, func() error {
+		return s.next.UntagItem(ctx, id, userID, nameIndex)
+	})
+}
+
+// ListTags вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListTags(ctx context.Context, userID string) ([]model.Tag, error) {
+	return call(ctx, s, "ListTags", true, func() ([]model.Tag, error) {
+		return s.next.ListTags(ctx, userID)
+	})
+}
+
+// SetItemKey вызывает одноименный метод обернутого хранилища.
+func (s *Storage) SetItemKey(ctx context.Context, id string, userID string, key *model.ItemKey) error {
+	return s.exec(ctx, "SetItemKey", false, func() error {
+		return s.next.SetItemKey(ctx, id, userID, key)
+	})
+}
+
+// ShareItem вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ShareItem(ctx context.Context, share *model.Share) error {
+	return s.exec(ctx, "ShareItem", false, func() error {
+		return s.next.ShareItem(ctx, share)
+	})
+}
+
+// GetShare вызывает одноименный метод обернутого хранилища.
+func (s *Storage) GetShare(ctx context.Context, itemID string, recipientID string) (*model.Share, error) {
+	return call(ctx, s, "GetShare", true, func() (*model.Share, error) {
+		return s.next.GetShare(ctx, itemID, recipientID)
+	})
+}
+
+// RevokeShare вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RevokeShare(ctx context.Context, itemID string, ownerID string, recipientID string) error {
+	return s.exec(ctx, "RevokeShare", false, func() error {
+		return s.next.RevokeShare(ctx, itemID, ownerID, recipientID)
+	})
+}
+
+// ListShares вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListShares(ctx context.Context, userID string) ([]model.Share, error) {
+	return call(ctx, s, "ListShares", true, func() ([]model.Share, error) {
+		return s.next.ListShares(ctx, userID)
+	})
+}
+
+// CreateOrg вызывает одноименный метод обернутого хранилища.
+func (s *Storage) CreateOrg(ctx context.Context, org *model.Organization, owner *model.OrgMember) (string, error) {
+	return call(ctx, s, "CreateOrg", false, func() (string, error) {
+		return s.next.CreateOrg(ctx, org, owner)
+	})
+}
+
+// AddOrgMember вызывает одноименный метод обернутого хранилища.
+func (s *Storage) AddOrgMember(ctx context.Context, member *model.OrgMember) error {
+	return s.exec(ctx, "AddOrgMember", false, func() error {
+		return s.next.AddOrgMember(ctx, member)
+	})
+}
+
+// GetOrgMember вызывает одноименный метод обернутого хранилища.
+func (s *Storage) GetOrgMember(ctx context.Context, orgID string, userID string) (*model.OrgMember, error) {
+	return call(ctx, s, "GetOrgMember", true, func() (*model.OrgMember, error) {
+		return s.next.GetOrgMember(ctx, orgID, userID)
+	})
+}
+
+// SetOrgMemberRole вызывает одноименный метод обернутого хранилища.
+func (s *Storage) SetOrgMemberRole(ctx context.Context, orgID string, userID string, role model.OrgRole) error {
+	return s.exec(ctx, "SetOrgMemberRole", false, func() error {
+		return s.next.SetOrgMemberRole(ctx, orgID, userID, role)
+	})
+}
+
+// RemoveOrgMember вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RemoveOrgMember(ctx context.Context, orgID string, userID string) error {
+	return s.exec(ctx, "RemoveOrgMember", false, func() error {
+		return s.next.RemoveOrgMember(ctx, orgID, userID)
+	})
+}
+
+// ListOrgMembers вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListOrgMembers(ctx context.Context, orgID string) ([]model.OrgMember, error) {
+	return call(ctx, s, "ListOrgMembers", true, func() ([]model.OrgMember, error) {
+		return s.next.ListOrgMembers(ctx, orgID)
+	})
+}
+
+// ListUserOrgs вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListUserOrgs(ctx context.Context, userID string) ([]model.OrgMember, error) {
+	return call(ctx, s, "ListUserOrgs", true, func() ([]model.OrgMember, error) {
+		return s.next.ListUserOrgs(ctx, userID)
+	})
+}
+
+// AppendAuditEvent вызывает одноименный метод обернутого хранилища.
+func (s *Storage) AppendAuditEvent(ctx context.Context, event *model.AuditEvent, key []byte) error {
+	return s.exec(ctx, "AppendAuditEvent", false, func() error {
+		return s.next.AppendAuditEvent(ctx, event, key)
+	})
+}
+
+// GetAuditHead вызывает одноименный метод обернутого хранилища.
+func (s *Storage) GetAuditHead(ctx context.Context, userID string) (model.AuditHead, error) {
+	return call(ctx, s, "GetAuditHead", true, func() (model.AuditHead, error) {
+		return s.next.GetAuditHead(ctx, userID)
+	})
+}
+
+// ListAuditEvents вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListAuditEvents(
+	ctx context.Context, userID string, params model.ListAuditParams,
+) ([]model.AuditEvent, error) {
+	return call(ctx, s, "ListAuditEvents", true, func() ([]model.AuditEvent, error) {
+		return s.next.ListAuditEvents(ctx, userID, params)
+	})
+}
+
+// ListBackupOrgs вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListBackupOrgs(ctx context.Context) ([]model.Organization, error) {
+	return call(ctx, s, "ListBackupOrgs", true, func() ([]model.Organization, error) {
+		return s.next.ListBackupOrgs(ctx)
+	})
+}
+
+// ListBackupItems вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListBackupItems(ctx context.Context, userID string) ([]model.VaultItem, error) {
+	return call(ctx, s, "ListBackupItems", true, func() ([]model.VaultItem, error) {
+		return s.next.ListBackupItems(ctx, userID)
+	})
+}
+
+// ListBackupHistory вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListBackupHistory(ctx context.Context, itemID string) ([]model.VaultItemRevision, error) {
+	return call(ctx, s, "ListBackupHistory", true, func() ([]model.VaultItemRevision, error) {
+		return s.next.ListBackupHistory(ctx, itemID)
+	})
+}
+
+// ListBackupShares вызывает одноименный метод обернутого хранилища.
+func (s *Storage) ListBackupShares(ctx context.Context, ownerID string) ([]model.Share, error) {
+	return call(ctx, s, "ListBackupShares", true, func() ([]model.Share, error) {
+		return s.next.ListBackupShares(ctx, ownerID)
+	})
+}
+
+// RestoreUser вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RestoreUser(ctx context.Context, user *model.User) error {
+	return s.exec(ctx, "RestoreUser", false, func() error {
+		return s.next.RestoreUser(ctx, user)
+	})
+}
+
+// RestoreOrg вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RestoreOrg(ctx context.Context, org *model.Organization) error {
+	return s.exec(ctx, "RestoreOrg", false, func() error {
+		return s.next.RestoreOrg(ctx, org)
+	})
+}
+
+// RestoreOrgMember вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RestoreOrgMember(ctx context.Context, member *model.OrgMember) error {
+	return s.exec(ctx, "RestoreOrgMember", false, func() error {
+		return s.next.RestoreOrgMember(ctx, member)
+	})
+}
+
+// RestoreFolder вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RestoreFolder(ctx context.Context, folder *model.Folder) error {
+	return s.exec(ctx, "RestoreFolder", false, func() error {
+		return s.next.RestoreFolder(ctx, folder)
+	})
+}
+
+// RestoreTag вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RestoreTag(ctx context.Context, tag *model.Tag) error {
+	return s.exec(ctx, "RestoreTag", false, func() error {
+		return s.next.RestoreTag(ctx, tag)
+	})
+}
+
+// RestoreItem вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RestoreItem(ctx context.Context, item *model.VaultItem, history []model.VaultItemRevision) error {
+	return s.exec(ctx, "RestoreItem", false, func() error {
+		return s.next.RestoreItem(ctx, item, history)
+	})
+}
+
+// RestoreShare вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RestoreShare(ctx context.Context, share *model.Share) error {
+	return s.exec(ctx, "RestoreShare", false, func() error {
+		return s.next.RestoreShare(ctx, share)
+	})
+}
+
+// RestoreAuditEvent вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RestoreAuditEvent(ctx context.Context, event *model.AuditEvent) error {
+	return s.exec(ctx, "RestoreAuditEvent", false, func() error {
+		return s.next.RestoreAuditEvent(ctx, event)
+	})
+}
+
+// RestoreAuditHead вызывает одноименный метод обернутого хранилища.
+func (s *Storage) RestoreAuditHead(ctx context.Context, userID string, head model.AuditHead) error {
+	return s.exec(ctx, "RestoreAuditHead", false, func() error {
+		return s.next.RestoreAuditHead(ctx, userID, head)
+	})
+}
@@ -0,0 +1,136 @@
+package instrument
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// expectedErrors ошибки хранилища, которые описывают результат запроса (например, данные не найдены),
+// а не сбой БД, поэтому не учитываются в количестве ошибок.
+var expectedErrors = []error{
+	storage.ErrLoginTaken,
+	storage.ErrNoUser,
+	storage.ErrNoData,
+	storage.ErrNoRevision,
+	storage.ErrConflict,
+	storage.ErrNoFolder,
+	storage.ErrNoTag,
+	storage.ErrNoShare,
+	storage.ErrNoOrg,
+	storage.ErrNoMember,
+	storage.ErrIsMember,
+	storage.ErrParentInTrash,
+	context.Canceled,
+}
+
+// MethodStats описывает метрики одного метода хранилища.
+type MethodStats struct {
+	Calls      int64         `json:"calls"`   // Количество вызовов, включая повторы.
+	Errors     int64         `json:"errors"`  // Количество вызовов, завершившихся сбоем (см. expectedErrors).
+	Retries    int64         `json:"retries"` // Количество повторов после временной ошибки.
+	Latency    time.Duration `json:"latencyNs"`
+	MaxLatency time.Duration `json:"maxLatencyNs"`
+}
+
+// Metrics собирает метрики методов хранилища. Реализует expvar.Var (метрики можно опубликовать
+// через expvar.Publish) и http.Handler, возвращающий метрики в формате JSON.
+type Metrics struct {
+	mu      sync.RWMutex
+	methods map[string]*methodStats
+}
+
+// NewMetrics создает и возвращает пустой набор метрик.
+func NewMetrics() *Metrics {
+	return &Metrics{methods: make(map[string]*methodStats)}
+}
+
+// Stats возвращает текущие значения метрик по названиям методов.
+func (m *Metrics) Stats() map[string]MethodStats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	stats := make(map[string]MethodStats, len(m.methods))
+	for name, method := range m.methods {
+		stats[name] = method.snapshot()
+	}
+	return stats
+}
+
+// String возвращает метрики в формате JSON.
+func (m *Metrics) String() string {
+	b, err := json.Marshal(m.Stats())
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
+
+// ServeHTTP возвращает метрики в формате JSON.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(m.String()))
+}
+
+// method возвращает метрики метода, создавая их при первом вызове.
+func (m *Metrics) method(name string) *methodStats {
+	m.mu.RLock()
+	stats, ok := m.methods[name]
+	m.mu.RUnlock()
+	if ok {
+		return stats
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if stats, ok = m.methods[name]; !ok {
+		stats = &methodStats{}
+		m.methods[name] = stats
+	}
+	return stats
+}
+
+// methodStats описывает счетчики одного метода, изменяемые конкурентно.
+type methodStats struct {
+	calls      atomic.Int64
+	errors     atomic.Int64
+	retries    atomic.Int64
+	latency    atomic.Int64
+	maxLatency atomic.Int64
+}
+
+// observe учитывает один вызов метода.
+func (s *methodStats) observe(latency time.Duration, err error) {
+	s.calls.Add(1)
+	s.latency.Add(int64(latency))
+	for {
+		current := s.maxLatency.Load()
+		if int64(latency) <= current || s.maxLatency.CompareAndSwap(current, int64(latency)) {
+			break
+		}
+	}
+	if err == nil {
+		return
+	}
+	for _, expected := range expectedErrors {
+		if errors.Is(err, expected) {
+			return
+		}
+	}
+	s.errors.Add(1)
+}
+
+// snapshot возвращает текущие значения счетчиков.
+func (s *methodStats) snapshot() MethodStats {
+	return MethodStats{
+		Calls:      s.calls.Load(),
+		Errors:     s.errors.Load(),
+		Retries:    s.retries.Load(),
+		Latency:    time.Duration(s.latency.Load()),
+		MaxLatency: time.Duration(s.maxLatency.Load()),
+	}
+}
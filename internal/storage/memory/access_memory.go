@@ -0,0 +1,37 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// SetFavorite закрепляет данные в избранном или убирает их оттуда.
+func (m *MemStorage) SetFavorite(_ context.Context, id string, userID string, favorite bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.userItem(id, userID)
+	if !ok || item.Expired(time.Now()) {
+		return storage.ErrNoData
+	}
+	item.Favorite = favorite
+	m.items[id] = item
+	return nil
+}
+
+// RecordAccess записывает время получения данных и увеличивает счетчик получений.
+func (m *MemStorage) RecordAccess(_ context.Context, id string, userID string, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.userItem(id, userID)
+	if !ok || item.Expired(time.Now()) {
+		return storage.ErrNoData
+	}
+	item.LastAccessedAt = &at
+	item.AccessCount++
+	m.items[id] = item
+	return nil
+}
@@ -0,0 +1,36 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// ListAttachments возвращает вложения данных пользователя (без самих данных) по времени создания.
+func (m *MemStorage) ListAttachments(_ context.Context, parentID string, userID string) ([]model.VaultItem, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var items []model.VaultItem
+	now := time.Now()
+	for _, item := range m.items {
+		if item.UserID != userID || item.DeletedAt != nil || item.Expired(now) || item.ParentID != parentID ||
+			parentID == "" {
+			continue
+		}
+		attachment := listItem(item)
+		attachment.Size = item.Size
+		attachment.ParentID = item.ParentID
+		items = append(items, attachment)
+	}
+	slices.SortFunc(items, func(a, b model.VaultItem) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return items, nil
+}
@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// AppendAuditEvent добавляет событие в журнал аудита пользователя.
+func (m *MemStorage) AppendAuditEvent(_ context.Context, event *model.AuditEvent, key []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[event.UserID]; !ok {
+		return storage.ErrNoUser
+	}
+	var last *model.AuditEvent
+	if events := m.audit[event.UserID]; len(events) > 0 {
+		last = &events[len(events)-1]
+	}
+	event.Chain(last, key)
+	m.audit[event.UserID] = append(m.audit[event.UserID], *event)
+	m.heads[event.UserID] = model.AuditHead{Seq: event.Seq, Tag: event.HeadTag(key)}
+	return nil
+}
+
+// GetAuditHead возвращает последнее событие журнала аудита пользователя.
+func (m *MemStorage) GetAuditHead(_ context.Context, userID string) (model.AuditHead, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, ok := m.users[userID]; !ok {
+		return model.AuditHead{}, storage.ErrNoUser
+	}
+	return m.heads[userID], nil
+}
+
+// ListAuditEvents возвращает события журнала аудита пользователя в порядке их номеров.
+func (m *MemStorage) ListAuditEvents(
+	_ context.Context, userID string, params model.ListAuditParams,
+) ([]model.AuditEvent, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var events []model.AuditEvent
+	for _, event := range m.audit[userID] {
+		if event.Seq <= params.AfterSeq {
+			continue
+		}
+		if params.Limit > 0 && len(events) == params.Limit {
+			break
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -0,0 +1,237 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// ListBackupOrgs возвращает все организации с ключами организаций в порядке создания.
+func (m *MemStorage) ListBackupOrgs(_ context.Context) ([]model.Organization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	orgs := make([]model.Organization, 0, len(m.orgs))
+	for _, org := range m.orgs {
+		org.EncryptName = slices.Clone(org.EncryptName)
+		orgs = append(orgs, org)
+	}
+	slices.SortFunc(orgs, func(a, b model.Organization) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return orgs, nil
+}
+
+// ListBackupItems возвращает все данные пользователя, включая данные в корзине и с истекшим сроком хранения.
+func (m *MemStorage) ListBackupItems(_ context.Context, userID string) ([]model.VaultItem, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var items []model.VaultItem
+	for _, item := range m.items {
+		if item.UserID != userID {
+			continue
+		}
+		items = append(items, cloneItem(item))
+	}
+	slices.SortFunc(items, func(a, b model.VaultItem) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return items, nil
+}
+
+// ListBackupHistory возвращает предыдущие версии данных вместе с самими данными в порядке номеров.
+func (m *MemStorage) ListBackupHistory(_ context.Context, itemID string) ([]model.VaultItemRevision, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var revisions []model.VaultItemRevision
+	for _, rev := range m.history[itemID] {
+		revisions = append(revisions, cloneRevision(rev))
+	}
+	slices.SortFunc(revisions, func(a, b model.VaultItemRevision) int {
+		return cmp.Compare(a.Revision, b.Revision)
+	})
+	return revisions, nil
+}
+
+// ListBackupShares возвращает доступы, предоставленные пользователем, включая доступы к данным в корзине.
+func (m *MemStorage) ListBackupShares(_ context.Context, ownerID string) ([]model.Share, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var shares []model.Share
+	for _, share := range m.shares {
+		if share.OwnerID != ownerID {
+			continue
+		}
+		share.EncryptKey = slices.Clone(share.EncryptKey)
+		shares = append(shares, share)
+	}
+	slices.SortFunc(shares, func(a, b model.Share) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.ItemID, b.ItemID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.RecipientID, b.RecipientID)
+	})
+	return shares, nil
+}
+
+// RestoreUser сохраняет пользователя или аккаунт организации с заданным id.
+func (m *MemStorage) RestoreUser(_ context.Context, user *model.User) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, u := range m.users {
+		if u.Login == user.Login {
+			return storage.ErrLoginTaken
+		}
+	}
+	stored := *user
+	stored.PublicKey = slices.Clone(user.PublicKey)
+	stored.EncryptPrivateKey = slices.Clone(user.EncryptPrivateKey)
+	m.users[user.ID] = stored
+	return nil
+}
+
+// RestoreOrg сохраняет организацию, аккаунт которой уже сохранен.
+func (m *MemStorage) RestoreOrg(_ context.Context, org *model.Organization) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := *org
+	stored.EncryptName = slices.Clone(org.EncryptName)
+	m.orgs[org.ID] = stored
+	return nil
+}
+
+// RestoreOrgMember сохраняет участника организации со временем его добавления.
+func (m *MemStorage) RestoreOrgMember(_ context.Context, member *model.OrgMember) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.members[memberKey{orgID: member.OrgID, userID: member.UserID}] = model.OrgMember{
+		OrgID:      member.OrgID,
+		UserID:     member.UserID,
+		Role:       member.Role,
+		EncryptKey: slices.Clone(member.EncryptKey),
+		CreatedAt:  member.CreatedAt,
+	}
+	return nil
+}
+
+// RestoreFolder сохраняет папку с заданным id и временем создания.
+func (m *MemStorage) RestoreFolder(_ context.Context, folder *model.Folder) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := *folder
+	stored.EncryptName = slices.Clone(folder.EncryptName)
+	stored.Name = ""
+	m.folders[folder.ID] = stored
+	return nil
+}
+
+// RestoreTag сохраняет метку с заданным id.
+func (m *MemStorage) RestoreTag(_ context.Context, tag *model.Tag) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tags[tag.ID] = model.Tag{
+		ID:          tag.ID,
+		UserID:      tag.UserID,
+		EncryptName: slices.Clone(tag.EncryptName),
+		NameIndex:   tag.NameIndex,
+	}
+	return nil
+}
+
+// RestoreItem сохраняет данные со всеми полями, метками и предыдущими версиями.
+func (m *MemStorage) RestoreItem(_ context.Context, item *model.VaultItem, history []model.VaultItemRevision) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.items[item.ID] = cloneItem(*item)
+	revisions := make([]model.VaultItemRevision, 0, len(history))
+	for _, rev := range history {
+		rev.ItemID = item.ID
+		revisions = append(revisions, cloneRevision(rev))
+	}
+	if len(revisions) > 0 {
+		m.history[item.ID] = revisions
+	}
+	return nil
+}
+
+// RestoreShare сохраняет доступ к данным со временем его предоставления.
+func (m *MemStorage) RestoreShare(_ context.Context, share *model.Share) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.shares[shareKey{itemID: share.ItemID, recipientID: share.RecipientID}] = model.Share{
+		ItemID:      share.ItemID,
+		OwnerID:     share.OwnerID,
+		RecipientID: share.RecipientID,
+		Access:      share.Access,
+		EncryptKey:  slices.Clone(share.EncryptKey),
+		CreatedAt:   share.CreatedAt,
+	}
+	return nil
+}
+
+// RestoreAuditEvent сохраняет событие журнала аудита как есть.
+func (m *MemStorage) RestoreAuditEvent(_ context.Context, event *model.AuditEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := *event
+	stored.PrevHash = slices.Clone(event.PrevHash)
+	stored.Hash = slices.Clone(event.Hash)
+	m.audit[event.UserID] = append(m.audit[event.UserID], stored)
+	return nil
+}
+
+// RestoreAuditHead сохраняет последнее событие журнала аудита пользователя.
+func (m *MemStorage) RestoreAuditHead(_ context.Context, userID string, head model.AuditHead) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.heads[userID] = model.AuditHead{Seq: head.Seq, Tag: slices.Clone(head.Tag)}
+	return nil
+}
+
+// cloneItem возвращает копию данных, не разделяющую с ними срезы и указатели.
+func cloneItem(item model.VaultItem) model.VaultItem {
+	item.EncryptData = slices.Clone(item.EncryptData)
+	item.EncryptMeta = slices.Clone(item.EncryptMeta)
+	item.MetaIndex = slices.Clone(item.MetaIndex)
+	item.DeletedAt = cloneTime(item.DeletedAt)
+	item.ExpiresAt = cloneTime(item.ExpiresAt)
+	item.TagIDs = slices.Clone(item.TagIDs)
+	item.LastAccessedAt = cloneTime(item.LastAccessedAt)
+	item.EncryptKey = slices.Clone(item.EncryptKey)
+	item.SharedMeta = slices.Clone(item.SharedMeta)
+	return item
+}
+
+// cloneRevision возвращает копию версии данных, не разделяющую с ней срезы.
+func cloneRevision(rev model.VaultItemRevision) model.VaultItemRevision {
+	rev.EncryptData = slices.Clone(rev.EncryptData)
+	rev.EncryptMeta = slices.Clone(rev.EncryptMeta)
+	rev.MetaIndex = slices.Clone(rev.MetaIndex)
+	rev.SharedMeta = slices.Clone(rev.SharedMeta)
+	return rev
+}
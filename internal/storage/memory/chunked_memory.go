@@ -0,0 +1,29 @@
+package memory
+
+import (
+	"context"
+	"slices"
+)
+
+// ListChunkedData возвращает манифесты всех сохраненных блоками файлов пользователя,
+// включая данные в корзине и предыдущие версии.
+func (m *MemStorage) ListChunkedData(_ context.Context, userID string) ([][]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var manifests [][]byte
+	for id, item := range m.items {
+		if item.UserID != userID {
+			continue
+		}
+		if item.Chunked {
+			manifests = append(manifests, slices.Clone(item.EncryptData))
+		}
+		for _, rev := range m.history[id] {
+			if rev.Chunked {
+				manifests = append(manifests, slices.Clone(rev.EncryptData))
+			}
+		}
+	}
+	return manifests, nil
+}
@@ -0,0 +1,3 @@
+// Package memory содержит реализацию хранилища в оперативной памяти.
+// Используется для тестов и запуска сервера без БД (DSN вида memory://).
+package memory
@@ -0,0 +1,26 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// PurgeExpiredItems безвозвратно удаляет данные всех пользователей, срок хранения которых истек
+// к моменту now, вместе с их вложениями.
+func (m *MemStorage) PurgeExpiredItems(_ context.Context, now time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Истекшие данные определяются до удаления, чтобы вложения удалялись независимо от порядка обхода.
+	expired := make(map[string]bool)
+	for id, item := range m.items {
+		if item.Expired(now) {
+			expired[id] = true
+		}
+	}
+	return m.purge(func(item model.VaultItem) bool {
+		return expired[item.ID] || expired[item.ParentID]
+	}), nil
+}
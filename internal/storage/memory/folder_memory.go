@@ -0,0 +1,187 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// CreateFolder сохраняет новую папку пользователя.
+func (m *MemStorage) CreateFolder(_ context.Context, userID string, folder *model.Folder) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if folder.ParentID != "" && !m.userFolder(folder.ParentID, userID) {
+		return "", storage.ErrNoFolder
+	}
+	folder.ID = uuid.NewString()
+	stored := *folder
+	stored.UserID = userID
+	stored.EncryptName = slices.Clone(folder.EncryptName)
+	stored.CreatedAt = time.Now()
+	m.folders[folder.ID] = stored
+	return folder.ID, nil
+}
+
+// ListFolders возвращает папки пользователя в порядке их создания.
+func (m *MemStorage) ListFolders(_ context.Context, userID string) ([]model.Folder, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var folders []model.Folder
+	for _, folder := range m.folders {
+		if folder.UserID != userID {
+			continue
+		}
+		folder.EncryptName = slices.Clone(folder.EncryptName)
+		folders = append(folders, folder)
+	}
+	slices.SortFunc(folders, func(a, b model.Folder) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return folders, nil
+}
+
+// DeleteFolder удаляет папку, перемещая ее данные и вложенные папки в родительскую папку.
+func (m *MemStorage) DeleteFolder(_ context.Context, id string, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.userFolder(id, userID) {
+		return storage.ErrNoFolder
+	}
+	parentID := m.folders[id].ParentID
+	for itemID, item := range m.items {
+		if item.FolderID == id {
+			item.FolderID = parentID
+			m.items[itemID] = item
+		}
+	}
+	for folderID, folder := range m.folders {
+		if folder.ParentID == id {
+			folder.ParentID = parentID
+			m.folders[folderID] = folder
+		}
+	}
+	delete(m.folders, id)
+	return nil
+}
+
+// MoveItem перемещает данные в папку; пустой folderID - из папки на верхний уровень.
+func (m *MemStorage) MoveItem(_ context.Context, id string, userID string, folderID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.userItem(id, userID)
+	if !ok {
+		return storage.ErrNoData
+	}
+	if folderID != "" && !m.userFolder(folderID, userID) {
+		return storage.ErrNoFolder
+	}
+	item.FolderID = folderID
+	m.items[id] = item
+	return nil
+}
+
+// TagItem добавляет данным метку, создавая ее, если у пользователя еще нет метки с таким индексом названия.
+func (m *MemStorage) TagItem(_ context.Context, id string, userID string, tag *model.Tag) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.userItem(id, userID)
+	if !ok {
+		return storage.ErrNoData
+	}
+	stored, ok := m.userTag(userID, tag.NameIndex)
+	if !ok {
+		stored = model.Tag{
+			ID:          uuid.NewString(),
+			UserID:      userID,
+			EncryptName: slices.Clone(tag.EncryptName),
+			NameIndex:   tag.NameIndex,
+		}
+		m.tags[stored.ID] = stored
+	}
+	tag.ID = stored.ID
+	if !slices.Contains(item.TagIDs, stored.ID) {
+		// Срез копируется, так как может быть общим с копией данных транзакции.
+		item.TagIDs = append(slices.Clone(item.TagIDs), stored.ID)
+		m.items[id] = item
+	}
+	return nil
+}
+
+// UntagItem снимает метку с данных и удаляет метку, если она больше не используется.
+func (m *MemStorage) UntagItem(_ context.Context, id string, userID string, nameIndex string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.userItem(id, userID)
+	if !ok {
+		return storage.ErrNoData
+	}
+	tag, ok := m.userTag(userID, nameIndex)
+	if !ok || !slices.Contains(item.TagIDs, tag.ID) {
+		return storage.ErrNoTag
+	}
+	item.TagIDs = slices.DeleteFunc(slices.Clone(item.TagIDs), func(tagID string) bool {
+		return tagID == tag.ID
+	})
+	m.items[id] = item
+	for _, other := range m.items {
+		if slices.Contains(other.TagIDs, tag.ID) {
+			return nil
+		}
+	}
+	delete(m.tags, tag.ID)
+	return nil
+}
+
+// ListTags возвращает метки пользователя с количеством данных (без данных в корзине) с каждой из них.
+func (m *MemStorage) ListTags(_ context.Context, userID string) ([]model.Tag, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var tags []model.Tag
+	for _, tag := range m.tags {
+		if tag.UserID != userID {
+			continue
+		}
+		tag.EncryptName = slices.Clone(tag.EncryptName)
+		for _, item := range m.items {
+			if item.DeletedAt == nil && slices.Contains(item.TagIDs, tag.ID) {
+				tag.Items++
+			}
+		}
+		tags = append(tags, tag)
+	}
+	slices.SortFunc(tags, func(a, b model.Tag) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return tags, nil
+}
+
+// userFolder проверяет, что папка существует и принадлежит пользователю. Должна вызываться под блокировкой.
+func (m *MemStorage) userFolder(id string, userID string) bool {
+	folder, ok := m.folders[id]
+	return ok && folder.UserID == userID
+}
+
+// userTag возвращает метку пользователя по слепому индексу названия. Должна вызываться под блокировкой.
+func (m *MemStorage) userTag(userID string, nameIndex string) (model.Tag, bool) {
+	for _, tag := range m.tags {
+		if tag.UserID == userID && tag.NameIndex == nameIndex {
+			return tag, true
+		}
+	}
+	return model.Tag{}, false
+}
@@ -0,0 +1,118 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// GetItemHistory возвращает список предыдущих версий данных (без самих данных).
+func (m *MemStorage) GetItemHistory(_ context.Context, id string, userID string) ([]model.VaultItemRevision, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, ok := m.userItem(id, userID); !ok {
+		return nil, storage.ErrNoData
+	}
+	var revisions []model.VaultItemRevision
+	for _, rev := range m.history[id] {
+		rev.EncryptData = nil
+		rev.EncryptMeta = slices.Clone(rev.EncryptMeta)
+		rev.MetaIndex = nil
+		rev.SharedMeta = nil
+		revisions = append(revisions, rev)
+	}
+	return revisions, nil
+}
+
+// GetItemRevision возвращает конкретную версию данных.
+func (m *MemStorage) GetItemRevision(
+	_ context.Context, id string, userID string, revision int64,
+) (*model.VaultItemRevision, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, ok := m.userItem(id, userID); !ok {
+		return nil, storage.ErrNoData
+	}
+	rev, ok := m.revision(id, revision)
+	if !ok {
+		return nil, storage.ErrNoRevision
+	}
+	rev.EncryptData = slices.Clone(rev.EncryptData)
+	rev.EncryptMeta = slices.Clone(rev.EncryptMeta)
+	rev.MetaIndex = slices.Clone(rev.MetaIndex)
+	rev.SharedMeta = slices.Clone(rev.SharedMeta)
+	return &rev, nil
+}
+
+// RestoreItemRevision восстанавливает данные из указанной версии.
+// Текущие данные при этом сохраняются в истории как новая версия.
+func (m *MemStorage) RestoreItemRevision(_ context.Context, id string, userID string, revision int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.userItem(id, userID); !ok {
+		return storage.ErrNoData
+	}
+	rev, ok := m.revision(id, revision)
+	if !ok {
+		return storage.ErrNoRevision
+	}
+	m.updateWithHistory(id, &model.VaultItem{
+		EncryptData: rev.EncryptData,
+		EncryptMeta: rev.EncryptMeta,
+		MetaIndex:   rev.MetaIndex,
+		Meta:        rev.Meta,
+		Chunked:     rev.Chunked,
+		Size:        rev.Size,
+		SharedMeta:  rev.SharedMeta,
+	})
+	return nil
+}
+
+// revision возвращает версию данных по номеру. Должна вызываться под блокировкой.
+func (m *MemStorage) revision(id string, revision int64) (model.VaultItemRevision, bool) {
+	for _, rev := range m.history[id] {
+		if rev.Revision == revision {
+			return rev, true
+		}
+	}
+	return model.VaultItemRevision{}, false
+}
+
+// updateWithHistory переносит текущие данные в историю под их номером версии,
+// записывает новые и проставляет в item новый номер версии.
+// Должна вызываться под блокировкой на запись.
+func (m *MemStorage) updateWithHistory(id string, item *model.VaultItem) {
+	stored := m.items[id]
+	m.history[id] = append(m.history[id], model.VaultItemRevision{
+		ItemID:      id,
+		Revision:    stored.Revision,
+		EncryptData: stored.EncryptData,
+		EncryptMeta: stored.EncryptMeta,
+		MetaIndex:   stored.MetaIndex,
+		Meta:        stored.Meta,
+		Chunked:     stored.Chunked,
+		Size:        stored.Size,
+		SharedMeta:  stored.SharedMeta,
+		CreatedAt:   stored.UpdatedAt,
+	})
+	stored.EncryptData = slices.Clone(item.EncryptData)
+	stored.EncryptMeta = slices.Clone(item.EncryptMeta)
+	stored.MetaIndex = slices.Clone(item.MetaIndex)
+	stored.Meta = item.Meta
+	stored.Chunked = item.Chunked
+	stored.Size = item.Size
+	stored.SharedMeta = slices.Clone(item.SharedMeta)
+	if item.ExpiresAt != nil {
+		stored.ExpiresAt = cloneTime(item.ExpiresAt)
+	}
+	stored.Revision++
+	stored.UpdatedAt = time.Now()
+	m.items[id] = stored
+	item.Revision = stored.Revision
+}
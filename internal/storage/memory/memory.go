@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// Scheme схема DSN, при которой используется хранилище в памяти.
+const Scheme = "memory://"
+
+// MemStorage описывает структуру хранилища в памяти.
+type MemStorage struct {
+	mu      sync.RWMutex
+	inTx    bool // Хранилище является копией данных внутри транзакции.
+	users   map[string]model.User
+	items   map[string]model.VaultItem
+	history map[string][]model.VaultItemRevision // Предыдущие версии данных по id записи.
+	audit   map[string][]model.AuditEvent        // Журнал аудита по id пользователя.
+	heads   map[string]model.AuditHead           // Последние события журналов аудита по id пользователя.
+	folders map[string]model.Folder
+	tags    map[string]model.Tag
+	shares  map[shareKey]model.Share
+	orgs    map[string]model.Organization
+	members map[memberKey]model.OrgMember
+}
+
+// NewStorage создает и возвращает новое хранилище в памяти.
+func NewStorage() *MemStorage {
+	return &MemStorage{
+		users:   make(map[string]model.User),
+		items:   make(map[string]model.VaultItem),
+		history: make(map[string][]model.VaultItemRevision),
+		audit:   make(map[string][]model.AuditEvent),
+		heads:   make(map[string]model.AuditHead),
+		folders: make(map[string]model.Folder),
+		tags:    make(map[string]model.Tag),
+		shares:  make(map[shareKey]model.Share),
+		orgs:    make(map[string]model.Organization),
+		members: make(map[memberKey]model.OrgMember),
+	}
+}
+
+// Close очищает хранилище.
+// У хранилища внутри транзакции ничего не делает.
+func (m *MemStorage) Close() error {
+	if m.inTx {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.users = make(map[string]model.User)
+	m.items = make(map[string]model.VaultItem)
+	m.history = make(map[string][]model.VaultItemRevision)
+	m.audit = make(map[string][]model.AuditEvent)
+	m.heads = make(map[string]model.AuditHead)
+	m.folders = make(map[string]model.Folder)
+	m.tags = make(map[string]model.Tag)
+	m.shares = make(map[shareKey]model.Share)
+	m.orgs = make(map[string]model.Organization)
+	m.members = make(map[memberKey]model.OrgMember)
+	return nil
+}
+
+// WithTx выполняет функцию над копией данных и применяет изменения, только если она завершилась без ошибки.
+// На время транзакции остальные операции с хранилищем блокируются.
+func (m *MemStorage) WithTx(_ context.Context, fn func(tx storage.Storage) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx := &MemStorage{
+		inTx:    true,
+		users:   maps.Clone(m.users),
+		items:   maps.Clone(m.items),
+		history: make(map[string][]model.VaultItemRevision, len(m.history)),
+		audit:   make(map[string][]model.AuditEvent, len(m.audit)),
+		heads:   maps.Clone(m.heads),
+		folders: maps.Clone(m.folders),
+		tags:    maps.Clone(m.tags),
+		shares:  maps.Clone(m.shares),
+		orgs:    maps.Clone(m.orgs),
+		members: maps.Clone(m.members),
+	}
+	for id, revisions := range m.history {
+		tx.history[id] = slices.Clone(revisions)
+	}
+	for userID, events := range m.audit {
+		tx.audit[userID] = slices.Clone(events)
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	m.users, m.items, m.history, m.audit, m.heads = tx.users, tx.items, tx.history, tx.audit, tx.heads
+	m.folders, m.tags, m.shares = tx.folders, tx.tags, tx.shares
+	m.orgs, m.members = tx.orgs, tx.members
+	return nil
+}
@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/storagetest"
+)
+
+func TestStorage(t *testing.T) {
+	storagetest.Run(t, func(_ *testing.T) storage.Storage {
+		return NewStorage()
+	})
+}
@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// ListLegacyMeta возвращает мета данные текущих и предыдущих версий, сохраненные в открытом виде.
+func (m *MemStorage) ListLegacyMeta(_ context.Context, limit int) ([]model.LegacyMeta, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var metas []model.LegacyMeta
+	add := func(meta model.LegacyMeta) bool {
+		metas = append(metas, meta)
+		return limit > 0 && len(metas) >= limit
+	}
+	for id, item := range m.items {
+		if item.EncryptMeta == nil && item.Meta != "" {
+			if add(model.LegacyMeta{UserID: item.UserID, ItemID: id, Meta: item.Meta}) {
+				return metas, nil
+			}
+		}
+		for _, rev := range m.history[id] {
+			if rev.EncryptMeta == nil && rev.Meta != "" {
+				if add(model.LegacyMeta{UserID: item.UserID, ItemID: id, Revision: rev.Revision, Meta: rev.Meta}) {
+					return metas, nil
+				}
+			}
+		}
+	}
+	return metas, nil
+}
+
+// EncryptLegacyMeta заменяет сохраненные в открытом виде мета данные версии зашифрованными.
+func (m *MemStorage) EncryptLegacyMeta(_ context.Context, meta model.LegacyMeta) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if meta.Revision == 0 {
+		item, ok := m.items[meta.ItemID]
+		if !ok || item.EncryptMeta != nil {
+			return nil
+		}
+		item.EncryptMeta = slices.Clone(meta.EncryptMeta)
+		item.MetaIndex = slices.Clone(meta.MetaIndex)
+		item.Meta = ""
+		m.items[meta.ItemID] = item
+		return nil
+	}
+	for i, rev := range m.history[meta.ItemID] {
+		if rev.Revision != meta.Revision || rev.EncryptMeta != nil {
+			continue
+		}
+		rev.EncryptMeta = slices.Clone(meta.EncryptMeta)
+		rev.MetaIndex = slices.Clone(meta.MetaIndex)
+		rev.Meta = ""
+		m.history[meta.ItemID][i] = rev
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// memberKey идентифицирует участника организации.
+type memberKey struct {
+	orgID  string
+	userID string
+}
+
+// CreateOrg создает организацию с ее аккаунтом и первым участником.
+func (m *MemStorage) CreateOrg(_ context.Context, org *model.Organization, owner *model.OrgMember) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[owner.UserID]; !ok {
+		return "", storage.ErrNoUser
+	}
+	id := uuid.NewString()
+	now := time.Now()
+	m.users[id] = model.User{
+		ID:              id,
+		Login:           model.OrgLoginPrefix + id,
+		EncryptedSecret: org.EncryptedSecret,
+	}
+	m.orgs[id] = model.Organization{
+		ID:              id,
+		EncryptName:     slices.Clone(org.EncryptName),
+		EncryptedSecret: org.EncryptedSecret,
+		CreatedAt:       now,
+	}
+	m.members[memberKey{orgID: id, userID: owner.UserID}] = model.OrgMember{
+		OrgID:      id,
+		UserID:     owner.UserID,
+		Role:       owner.Role,
+		EncryptKey: slices.Clone(owner.EncryptKey),
+		CreatedAt:  now,
+	}
+	org.ID, org.CreatedAt = id, now
+	return id, nil
+}
+
+// AddOrgMember добавляет участника организации.
+func (m *MemStorage) AddOrgMember(_ context.Context, member *model.OrgMember) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.orgs[member.OrgID]; !ok {
+		return storage.ErrNoOrg
+	}
+	if _, ok := m.users[member.UserID]; !ok {
+		return storage.ErrNoUser
+	}
+	key := memberKey{orgID: member.OrgID, userID: member.UserID}
+	if _, ok := m.members[key]; ok {
+		return storage.ErrIsMember
+	}
+	m.members[key] = model.OrgMember{
+		OrgID:      member.OrgID,
+		UserID:     member.UserID,
+		Role:       member.Role,
+		EncryptKey: slices.Clone(member.EncryptKey),
+		CreatedAt:  time.Now(),
+	}
+	return nil
+}
+
+// GetOrgMember возвращает участника организации.
+func (m *MemStorage) GetOrgMember(_ context.Context, orgID string, userID string) (*model.OrgMember, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	member, ok := m.members[memberKey{orgID: orgID, userID: userID}]
+	if !ok {
+		return nil, storage.ErrNoMember
+	}
+	member.EncryptKey = slices.Clone(member.EncryptKey)
+	return &member, nil
+}
+
+// SetOrgMemberRole меняет роль участника организации.
+func (m *MemStorage) SetOrgMemberRole(_ context.Context, orgID string, userID string, role model.OrgRole) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := memberKey{orgID: orgID, userID: userID}
+	member, ok := m.members[key]
+	if !ok {
+		return storage.ErrNoMember
+	}
+	member.Role = role
+	m.members[key] = member
+	return nil
+}
+
+// RemoveOrgMember удаляет участника организации.
+func (m *MemStorage) RemoveOrgMember(_ context.Context, orgID string, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := memberKey{orgID: orgID, userID: userID}
+	if _, ok := m.members[key]; !ok {
+		return storage.ErrNoMember
+	}
+	delete(m.members, key)
+	return nil
+}
+
+// ListOrgMembers возвращает участников организации в порядке добавления.
+func (m *MemStorage) ListOrgMembers(_ context.Context, orgID string) ([]model.OrgMember, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var members []model.OrgMember
+	for key, member := range m.members {
+		if key.orgID != orgID {
+			continue
+		}
+		member.Login = m.users[member.UserID].Login
+		member.EncryptKey = slices.Clone(member.EncryptKey)
+		members = append(members, member)
+	}
+	sortMembers(members, func(member model.OrgMember) string { return member.UserID })
+	return members, nil
+}
+
+// ListUserOrgs возвращает участие пользователя в организациях в порядке добавления.
+func (m *MemStorage) ListUserOrgs(_ context.Context, userID string) ([]model.OrgMember, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var members []model.OrgMember
+	for key, member := range m.members {
+		if key.userID != userID {
+			continue
+		}
+		member.EncryptKey = slices.Clone(member.EncryptKey)
+		member.EncryptOrgName = slices.Clone(m.orgs[member.OrgID].EncryptName)
+		members = append(members, member)
+	}
+	sortMembers(members, func(member model.OrgMember) string { return member.OrgID })
+	return members, nil
+}
+
+// sortMembers сортирует участников по времени добавления, а при равном времени - по id.
+func sortMembers(members []model.OrgMember, id func(model.OrgMember) string) {
+	slices.SortFunc(members, func(a, b model.OrgMember) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(id(a), id(b))
+	})
+}
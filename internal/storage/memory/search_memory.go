@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// SearchItems ищет данные пользователя по слепым индексам мета данных (без самих данных).
+// Результат упорядочен по времени обновления, начиная с последних.
+func (m *MemStorage) SearchItems(
+	_ context.Context, userID string, params model.SearchItemsParams,
+) ([]model.VaultItem, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var items []model.VaultItem
+	now := time.Now()
+	for _, item := range m.items {
+		if item.UserID != userID || item.DeletedAt != nil || item.Expired(now) || !matchIndex(item.MetaIndex, params.Index) {
+			continue
+		}
+		items = append(items, listItem(item))
+	}
+	slices.SortFunc(items, func(a, b model.VaultItem) int {
+		if c := b.UpdatedAt.Compare(a.UpdatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	if params.Limit > 0 && len(items) > params.Limit {
+		items = items[:params.Limit]
+	}
+	return items, nil
+}
+
+// matchIndex проверяет, есть ли среди слепых индексов данных хотя бы один из искомых.
+func matchIndex(index []string, search []string) bool {
+	for _, token := range search {
+		if slices.Contains(index, token) {
+			return true
+		}
+	}
+	return false
+}
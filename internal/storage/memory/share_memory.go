@@ -0,0 +1,152 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// shareKey идентифицирует доступ получателя к данным.
+type shareKey struct {
+	itemID      string
+	recipientID string
+}
+
+// SetItemKey переводит данные на собственный ключ или заменяет его новым,
+// заменяя содержимое текущей и предыдущих версий.
+func (m *MemStorage) SetItemKey(_ context.Context, id string, userID string, key *model.ItemKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, ok := m.userItem(id, userID)
+	if !ok {
+		return storage.ErrNoData
+	}
+	if !bytes.Equal(stored.EncryptKey, key.PrevKey) || stored.Revision != key.Revision {
+		return storage.ErrConflict
+	}
+	history := slices.Clone(m.history[id])
+	if len(history) != len(key.History) {
+		return storage.ErrNoRevision
+	}
+	for i, rev := range history {
+		idx := slices.IndexFunc(key.History, func(r model.ItemKeyRevision) bool {
+			return r.Revision == rev.Revision
+		})
+		if idx < 0 {
+			return storage.ErrNoRevision
+		}
+		rev.EncryptData = slices.Clone(key.History[idx].EncryptData)
+		rev.SharedMeta = slices.Clone(key.History[idx].SharedMeta)
+		history[i] = rev
+	}
+	stored.EncryptKey = slices.Clone(key.EncryptKey)
+	stored.EncryptData = slices.Clone(key.EncryptData)
+	stored.SharedMeta = slices.Clone(key.SharedMeta)
+	m.items[id] = stored
+	m.history[id] = history
+	return nil
+}
+
+// ShareItem предоставляет получателю доступ к данным или изменяет уже предоставленный.
+func (m *MemStorage) ShareItem(_ context.Context, share *model.Share) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.userItem(share.ItemID, share.OwnerID); !ok {
+		return storage.ErrNoData
+	}
+	if _, ok := m.users[share.RecipientID]; !ok {
+		return storage.ErrNoUser
+	}
+	key := shareKey{itemID: share.ItemID, recipientID: share.RecipientID}
+	stored, ok := m.shares[key]
+	if !ok {
+		stored = model.Share{
+			ItemID:      share.ItemID,
+			OwnerID:     share.OwnerID,
+			RecipientID: share.RecipientID,
+			CreatedAt:   time.Now(),
+		}
+	}
+	stored.Access = share.Access
+	stored.EncryptKey = slices.Clone(share.EncryptKey)
+	m.shares[key] = stored
+	return nil
+}
+
+// GetShare возвращает доступ получателя к данным.
+func (m *MemStorage) GetShare(_ context.Context, itemID string, recipientID string) (*model.Share, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	share, ok := m.shares[shareKey{itemID: itemID, recipientID: recipientID}]
+	if !ok {
+		return nil, storage.ErrNoShare
+	}
+	share.EncryptKey = slices.Clone(share.EncryptKey)
+	return &share, nil
+}
+
+// RevokeShare отзывает доступ получателя к данным владельца.
+func (m *MemStorage) RevokeShare(_ context.Context, itemID string, ownerID string, recipientID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := shareKey{itemID: itemID, recipientID: recipientID}
+	share, ok := m.shares[key]
+	if !ok || share.OwnerID != ownerID {
+		return storage.ErrNoShare
+	}
+	delete(m.shares, key)
+	return nil
+}
+
+// ListShares возвращает доступы, предоставленные пользователем и предоставленные ему,
+// в порядке их предоставления.
+func (m *MemStorage) ListShares(_ context.Context, userID string) ([]model.Share, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var shares []model.Share
+	now := time.Now()
+	for _, share := range m.shares {
+		if share.OwnerID != userID && share.RecipientID != userID {
+			continue
+		}
+		item, ok := m.userItem(share.ItemID, share.OwnerID)
+		if !ok || item.Expired(now) {
+			continue
+		}
+		share.OwnerLogin = m.users[share.OwnerID].Login
+		share.RecipientLogin = m.users[share.RecipientID].Login
+		share.EncryptKey = slices.Clone(share.EncryptKey)
+		share.ItemType = item.Type
+		share.SharedMeta = slices.Clone(item.SharedMeta)
+		shares = append(shares, share)
+	}
+	slices.SortFunc(shares, func(a, b model.Share) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.ItemID, b.ItemID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.RecipientID, b.RecipientID)
+	})
+	return shares, nil
+}
+
+// deleteShares удаляет все доступы к данным. Должна вызываться под блокировкой на запись.
+func (m *MemStorage) deleteShares(itemID string) {
+	for key := range m.shares {
+		if key.itemID == itemID {
+			delete(m.shares, key)
+		}
+	}
+}
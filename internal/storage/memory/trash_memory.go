@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// GetDeletedItems возвращает данные пользователя, находящиеся в корзине.
+func (m *MemStorage) GetDeletedItems(_ context.Context, userID string) ([]model.VaultItem, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var items []model.VaultItem
+	for _, item := range m.items {
+		if item.UserID != userID || item.DeletedAt == nil {
+			continue
+		}
+		deletedAt := *item.DeletedAt
+		items = append(items, model.VaultItem{
+			ID:          item.ID,
+			UserID:      item.UserID,
+			EncryptMeta: slices.Clone(item.EncryptMeta),
+			Meta:        item.Meta,
+			Type:        item.Type,
+			CreatedAt:   item.CreatedAt,
+			UpdatedAt:   item.UpdatedAt,
+			DeletedAt:   &deletedAt,
+		})
+	}
+	slices.SortFunc(items, func(a, b model.VaultItem) int {
+		return b.DeletedAt.Compare(*a.DeletedAt)
+	})
+	return items, nil
+}
+
+// RestoreDeletedItem восстанавливает данные из корзины вместе с вложениями, удаленными одновременно с ними.
+func (m *MemStorage) RestoreDeletedItem(_ context.Context, id string, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	item, ok := m.items[id]
+	if !ok || item.UserID != userID || item.DeletedAt == nil {
+		return storage.ErrNoData
+	}
+	if item.ParentID != "" {
+		if _, ok = m.userItem(item.ParentID, userID); !ok {
+			return storage.ErrParentInTrash
+		}
+	}
+	deletedAt := *item.DeletedAt
+	for itemID, item := range m.items {
+		if itemID != id && (item.ParentID != id || item.DeletedAt == nil || !item.DeletedAt.Equal(deletedAt)) {
+			continue
+		}
+		item.DeletedAt = nil
+		m.items[itemID] = item
+	}
+	return nil
+}
+
+// PurgeDeletedItems безвозвратно удаляет все данные пользователя из корзины.
+func (m *MemStorage) PurgeDeletedItems(_ context.Context, userID string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.purge(func(item model.VaultItem) bool {
+		return item.DeletedAt != nil && item.UserID == userID
+	}), nil
+}
+
+// PurgeDeletedBefore безвозвратно удаляет данные всех пользователей,
+// перемещенные в корзину раньше указанного времени.
+func (m *MemStorage) PurgeDeletedBefore(_ context.Context, before time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.purge(func(item model.VaultItem) bool {
+		return item.DeletedAt != nil && item.DeletedAt.Before(before)
+	}), nil
+}
+
+// purge безвозвратно удаляет данные, удовлетворяющие условию, вместе с их историей и доступами к ним.
+// Должна вызываться под блокировкой на запись.
+func (m *MemStorage) purge(match func(item model.VaultItem) bool) int64 {
+	var purged int64
+	for id, item := range m.items {
+		if !match(item) {
+			continue
+		}
+		delete(m.items, id)
+		delete(m.history, id)
+		m.deleteShares(id)
+		purged++
+	}
+	return purged
+}
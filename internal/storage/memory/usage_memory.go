@@ -0,0 +1,46 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// GetUsage возвращает занятый пользователем объем по типам данных.
+// Учитываются данные в корзине, объем включает предыдущие версии данных.
+func (m *MemStorage) GetUsage(_ context.Context, userID string) ([]model.TypeUsage, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	byType := make(map[model.DataType]*model.TypeUsage)
+	for id, item := range m.items {
+		if item.UserID != userID {
+			continue
+		}
+		usage, ok := byType[item.Type]
+		if !ok {
+			usage = &model.TypeUsage{Type: item.Type}
+			byType[item.Type] = usage
+		}
+		usage.Items++
+		usage.Bytes += item.Size + int64(len(item.EncryptMeta)) + int64(len(item.Meta))
+		for _, rev := range m.history[id] {
+			usage.Bytes += rev.Size + int64(len(rev.EncryptMeta)) + int64(len(rev.Meta))
+		}
+	}
+	usages := make([]model.TypeUsage, 0, len(byType))
+	for _, usage := range byType {
+		usages = append(usages, *usage)
+	}
+	slices.SortFunc(usages, func(a, b model.TypeUsage) int {
+		return strings.Compare(string(a.Type), string(b.Type))
+	})
+	return usages, nil
+}
+
+// LockUsage ничего не делает: транзакция выполняется под блокировкой всего хранилища.
+func (m *MemStorage) LockUsage(_ context.Context, _ string) error {
+	return nil
+}
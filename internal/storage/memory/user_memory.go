@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// CreateUser создает нового пользователя.
+func (m *MemStorage) CreateUser(_ context.Context, user *model.User) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	user.Login = strings.ToLower(user.Login)
+	for _, u := range m.users {
+		if u.Login == user.Login {
+			return "", storage.ErrLoginTaken
+		}
+	}
+	user.ID = uuid.NewString()
+	stored := *user
+	stored.PublicKey = slices.Clone(user.PublicKey)
+	stored.EncryptPrivateKey = slices.Clone(user.EncryptPrivateKey)
+	m.users[user.ID] = stored
+	return user.ID, nil
+}
+
+// GetUserByLogin возвращает данные пользователя по логину.
+func (m *MemStorage) GetUserByLogin(_ context.Context, login string) (*model.User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	login = strings.ToLower(login)
+	for _, u := range m.users {
+		if u.Login == login {
+			return &u, nil
+		}
+	}
+	return nil, storage.ErrNoUser
+}
+
+// GetUserByID возвращает данные пользователя по ID.
+func (m *MemStorage) GetUserByID(_ context.Context, id string) (*model.User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	user, ok := m.users[id]
+	if !ok {
+		return nil, storage.ErrNoUser
+	}
+	return &user, nil
+}
+
+// DeleteUser удаляет пользователя, все его данные с историей изменений, папки, метки,
+// доступы к данным (предоставленные им и ему), участие в организациях и журнал аудита.
+// Если id - аккаунт организации, удаляется и сама организация со всеми участниками.
+func (m *MemStorage) DeleteUser(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.users[id]; !ok {
+		return storage.ErrNoUser
+	}
+	for itemID, item := range m.items {
+		if item.UserID == id {
+			delete(m.items, itemID)
+			delete(m.history, itemID)
+		}
+	}
+	for folderID, folder := range m.folders {
+		if folder.UserID == id {
+			delete(m.folders, folderID)
+		}
+	}
+	for tagID, tag := range m.tags {
+		if tag.UserID == id {
+			delete(m.tags, tagID)
+		}
+	}
+	for key, share := range m.shares {
+		if share.OwnerID == id || share.RecipientID == id {
+			delete(m.shares, key)
+		}
+	}
+	for key := range m.members {
+		if key.orgID == id || key.userID == id {
+			delete(m.members, key)
+		}
+	}
+	delete(m.orgs, id)
+	delete(m.audit, id)
+	delete(m.heads, id)
+	delete(m.users, id)
+	return nil
+}
+
+// SetUserKeys сохраняет пару ключей пользователя.
+func (m *MemStorage) SetUserKeys(_ context.Context, id string, publicKey []byte, encPrivateKey []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	user, ok := m.users[id]
+	if !ok {
+		return storage.ErrNoUser
+	}
+	user.PublicKey = slices.Clone(publicKey)
+	user.EncryptPrivateKey = slices.Clone(encPrivateKey)
+	m.users[id] = user
+	return nil
+}
+
+// ListUsers возвращает всех пользователей, включая аккаунты организаций, по логину.
+func (m *MemStorage) ListUsers(_ context.Context) ([]model.User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	users := make([]model.User, 0, len(m.users))
+	for _, user := range m.users {
+		user.PublicKey = slices.Clone(user.PublicKey)
+		user.EncryptPrivateKey = slices.Clone(user.EncryptPrivateKey)
+		users = append(users, user)
+	}
+	slices.SortFunc(users, func(a, b model.User) int {
+		return strings.Compare(a.Login, b.Login)
+	})
+	return users, nil
+}
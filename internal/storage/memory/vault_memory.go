@@ -0,0 +1,200 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// CreateItem сохраняет новые данные.
+func (m *MemStorage) CreateItem(_ context.Context, userID string, item *model.VaultItem) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if item.ParentID != "" {
+		parent, ok := m.userItem(item.ParentID, userID)
+		if !ok || parent.ParentID != "" {
+			return "", storage.ErrNoData
+		}
+	}
+	item.ID = uuid.NewString()
+	stored := *item
+	stored.UserID = userID
+	stored.EncryptData = slices.Clone(item.EncryptData)
+	stored.EncryptMeta = slices.Clone(item.EncryptMeta)
+	stored.MetaIndex = slices.Clone(item.MetaIndex)
+	stored.ExpiresAt = cloneTime(item.ExpiresAt)
+	stored.Favorite = false
+	stored.LastAccessedAt = nil
+	stored.AccessCount = 0
+	stored.Revision = 1
+	stored.CreatedAt, stored.UpdatedAt = item.CreateTimes(time.Now())
+	m.items[item.ID] = stored
+	return item.ID, nil
+}
+
+// GetItem возвращает данные по id.
+func (m *MemStorage) GetItem(_ context.Context, id string, userID string) (*model.VaultItem, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	item, ok := m.userItem(id, userID)
+	if !ok || item.Expired(time.Now()) {
+		return nil, storage.ErrNoData
+	}
+	item.ExpiresAt = cloneTime(item.ExpiresAt)
+	item.LastAccessedAt = cloneTime(item.LastAccessedAt)
+	item.EncryptData = slices.Clone(item.EncryptData)
+	item.EncryptMeta = slices.Clone(item.EncryptMeta)
+	item.MetaIndex = slices.Clone(item.MetaIndex)
+	item.TagIDs = nil
+	item.EncryptKey = slices.Clone(item.EncryptKey)
+	item.SharedMeta = slices.Clone(item.SharedMeta)
+	return &item, nil
+}
+
+// DeleteItem перемещает данные в корзину вместе с их вложениями.
+func (m *MemStorage) DeleteItem(_ context.Context, id string, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.userItem(id, userID); !ok {
+		return storage.ErrNoData
+	}
+	now := time.Now()
+	for itemID, item := range m.items {
+		if itemID != id && (item.ParentID != id || item.DeletedAt != nil) {
+			continue
+		}
+		item.DeletedAt = &now
+		m.items[itemID] = item
+	}
+	return nil
+}
+
+// GetItemsByType возвращает данные пользователя по типу.
+func (m *MemStorage) GetItemsByType(_ context.Context, dataType string, userID string) ([]model.VaultItem, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var items []model.VaultItem
+	now := time.Now()
+	for _, item := range m.items {
+		if item.UserID != userID || item.DeletedAt != nil || item.Expired(now) || string(item.Type) != dataType {
+			continue
+		}
+		items = append(items, model.VaultItem{
+			ID:          item.ID,
+			UserID:      item.UserID,
+			EncryptMeta: slices.Clone(item.EncryptMeta),
+			Meta:        item.Meta,
+			CreatedAt:   item.CreatedAt,
+			UpdatedAt:   item.UpdatedAt,
+		})
+	}
+	slices.SortFunc(items, func(a, b model.VaultItem) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return items, nil
+}
+
+// ListItems возвращает страницу списка данных пользователя (без самих данных).
+func (m *MemStorage) ListItems(
+	_ context.Context, userID string, params model.ListItemsParams,
+) ([]model.VaultItem, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var items []model.VaultItem
+	now := time.Now()
+	for _, item := range m.items {
+		if item.UserID != userID || item.DeletedAt != nil || item.Expired(now) {
+			continue
+		}
+		if params.Type != "" && item.Type != params.Type {
+			continue
+		}
+		if params.FolderID != "" && item.FolderID != params.FolderID {
+			continue
+		}
+		if params.TagIndex != "" && !m.hasTag(item, params.TagIndex) {
+			continue
+		}
+		items = append(items, listItem(item))
+	}
+	if params.SortBy == model.SortByMeta {
+		// Как и остальные хранилища, по мета данным не сортируем: они зашифрованы.
+		params.SortBy = model.SortByCreated
+	}
+	return model.PageItems(items, params), nil
+}
+
+// listItem возвращает данные для списка: без самих данных и слепых индексов.
+func listItem(item model.VaultItem) model.VaultItem {
+	return model.VaultItem{
+		ID:             item.ID,
+		UserID:         item.UserID,
+		EncryptMeta:    slices.Clone(item.EncryptMeta),
+		Meta:           item.Meta,
+		Type:           item.Type,
+		Revision:       item.Revision,
+		CreatedAt:      item.CreatedAt,
+		UpdatedAt:      item.UpdatedAt,
+		FolderID:       item.FolderID,
+		TagIDs:         slices.Clone(item.TagIDs),
+		ExpiresAt:      cloneTime(item.ExpiresAt),
+		Favorite:       item.Favorite,
+		LastAccessedAt: cloneTime(item.LastAccessedAt),
+		AccessCount:    item.AccessCount,
+	}
+}
+
+// hasTag проверяет, есть ли у данных метка с переданным слепым индексом названия.
+// Должна вызываться под блокировкой.
+func (m *MemStorage) hasTag(item model.VaultItem, nameIndex string) bool {
+	tag, ok := m.userTag(item.UserID, nameIndex)
+	return ok && slices.Contains(item.TagIDs, tag.ID)
+}
+
+// UpdateItem обновляет данные, сохраняя предыдущую версию в истории.
+func (m *MemStorage) UpdateItem(_ context.Context, id string, userID string, item *model.VaultItem) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, ok := m.userItem(id, userID)
+	if !ok {
+		return storage.ErrNoData
+	}
+	if item.Revision != 0 && item.Revision != stored.Revision {
+		return storage.ErrConflict
+	}
+	if !bytes.Equal(item.EncryptKey, stored.EncryptKey) {
+		return storage.ErrConflict
+	}
+	m.updateWithHistory(id, item)
+	return nil
+}
+
+// userItem возвращает данные, если они существуют, принадлежат пользователю и не находятся в корзине.
+// Должна вызываться под блокировкой.
+func (m *MemStorage) userItem(id string, userID string) (model.VaultItem, bool) {
+	item, ok := m.items[id]
+	if !ok || item.UserID != userID || item.DeletedAt != nil {
+		return model.VaultItem{}, false
+	}
+	return item, true
+}
+
+// cloneTime возвращает копию времени, чтобы вызывающий не мог изменить сохраненное значение.
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	clone := *t
+	return &clone
+}
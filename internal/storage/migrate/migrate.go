@@ -0,0 +1,129 @@
+// Package migrate содержит управление миграциями схемы БД хранилищ.
+// Миграции каждого хранилища встроены в бинарный файл сервера.
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pressly/goose/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// Command enum команд управления миграциями.
+type Command string
+
+// Команды управления миграциями.
+const (
+	Up      Command = "up"      // Применить все новые миграции.
+	Down    Command = "down"    // Откатить последнюю примененную миграцию.
+	Status  Command = "status"  // Показать состояние всех миграций.
+	Redo    Command = "redo"    // Откатить и заново применить последнюю миграцию.
+	Version Command = "version" // Показать текущую версию схемы БД.
+)
+
+// ErrNoMigrations возвращается, если в БД нет примененных миграций для отката.
+var ErrNoMigrations = errors.New("no applied migrations")
+
+// Apply применяет новые миграции при запуске хранилища.
+// Если автоматическое применение отключено, только предупреждает о неприменённых миграциях.
+func Apply(ctx context.Context, provider *goose.Provider, autoMigrate bool, log *logrus.Entry) error {
+	if !autoMigrate {
+		pending, err := provider.HasPending(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check pending migrations: %w", err)
+		}
+		if pending {
+			log.Warn("Database has pending migrations, apply them with the migrate up command")
+		}
+		return nil
+	}
+	results, err := provider.Up(ctx)
+	for _, result := range results {
+		log.WithFields(logrus.Fields{
+			"migration": result.Source.Path,
+			"duration":  result.Duration.Seconds(),
+		}).Info("Migration applied")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+	return nil
+}
+
+// Run выполняет команду над миграциями провайдера и выводит результат в out.
+func Run(ctx context.Context, provider *goose.Provider, command Command, out io.Writer) error {
+	switch command {
+	case Up:
+		results, err := provider.Up(ctx)
+		printResults(out, results...)
+		if err != nil {
+			return fmt.Errorf("failed to apply migrations: %w", err)
+		}
+		if len(results) == 0 {
+			fmt.Fprintln(out, "Нет новых миграций")
+		}
+	case Down:
+		result, err := down(ctx, provider)
+		if err != nil {
+			return err
+		}
+		printResults(out, result)
+	case Redo:
+		result, err := down(ctx, provider)
+		if err != nil {
+			return err
+		}
+		printResults(out, result)
+		result, err = provider.ApplyVersion(ctx, result.Source.Version, true)
+		if err != nil {
+			return fmt.Errorf("failed to reapply migration: %w", err)
+		}
+		printResults(out, result)
+	case Status:
+		statuses, err := provider.Status(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get migrations status: %w", err)
+		}
+		for _, status := range statuses {
+			appliedAt := "не применена"
+			if status.State == goose.StateApplied {
+				appliedAt = status.AppliedAt.Local().Format(time.DateTime)
+			}
+			fmt.Fprintf(out, "%-20s %s\n", appliedAt, status.Source.Path)
+		}
+	case Version:
+		version, err := provider.GetDBVersion(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get db version: %w", err)
+		}
+		fmt.Fprintf(out, "Версия схемы БД: %d\n", version)
+	default:
+		return fmt.Errorf("unknown migration command: %s", command)
+	}
+	return nil
+}
+
+// down откатывает последнюю примененную миграцию.
+func down(ctx context.Context, provider *goose.Provider) (*goose.MigrationResult, error) {
+	result, err := provider.Down(ctx)
+	if err != nil {
+		if errors.Is(err, goose.ErrNoNextVersion) {
+			return nil, ErrNoMigrations
+		}
+		return nil, fmt.Errorf("failed to roll back migration: %w", err)
+	}
+	return result, nil
+}
+
+// printResults выводит результаты выполнения миграций.
+func printResults(out io.Writer, results ...*goose.MigrationResult) {
+	for _, result := range results {
+		if result != nil {
+			fmt.Fprintln(out, result.String())
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package migrate_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/storage/migrate"
+	"github.com/pinbrain/gophkeeper/internal/storage/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	provider, err := sqlite.NewMigrationProvider(ctx, sqlite.Scheme+filepath.Join(t.TempDir(), "keeper.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = provider.Close() })
+	total := int64(len(provider.ListSources()))
+	require.Positive(t, total)
+
+	run := func(t *testing.T, command migrate.Command) string {
+		t.Helper()
+		var out bytes.Buffer
+		require.NoError(t, migrate.Run(ctx, provider, command, &out))
+		return out.String()
+	}
+	version := func(t *testing.T) int64 {
+		t.Helper()
+		version, err := provider.GetDBVersion(ctx)
+		require.NoError(t, err)
+		return version
+	}
+
+	t.Run("Состояние до применения миграций", func(t *testing.T) {
+		out := run(t, migrate.Status)
+		assert.Equal(t, int(total), strings.Count(out, "не применена"))
+	})
+
+	t.Run("Применение миграций", func(t *testing.T) {
+		run(t, migrate.Up)
+		assert.Equal(t, total, version(t))
+		assert.Contains(t, run(t, migrate.Up), "Нет новых миграций")
+		assert.NotContains(t, run(t, migrate.Status), "не применена")
+	})
+
+	t.Run("Повторное применение последней миграции", func(t *testing.T) {
+		out := run(t, migrate.Redo)
+		assert.Contains(t, out, "down")
+		assert.Contains(t, out, "up")
+		assert.Equal(t, total, version(t))
+	})
+
+	t.Run("Откат миграции", func(t *testing.T) {
+		run(t, migrate.Down)
+		assert.Equal(t, total-1, version(t))
+		assert.Contains(t, run(t, migrate.Version), "Версия схемы БД")
+	})
+
+	t.Run("Откат необратимой миграции", func(t *testing.T) {
+		// Миграция шифрования мета данных необратима.
+		const irreversible = 9
+		for range total - 1 - irreversible {
+			run(t, migrate.Down)
+		}
+		require.Equal(t, int64(irreversible), version(t))
+		assert.ErrorContains(t, migrate.Run(ctx, provider, migrate.Down, &bytes.Buffer{}), "irreversible")
+		assert.Equal(t, int64(irreversible), version(t))
+	})
+
+	t.Run("Откат без примененных миграций", func(t *testing.T) {
+		empty, err := sqlite.NewMigrationProvider(ctx, sqlite.Scheme+filepath.Join(t.TempDir(), "empty.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = empty.Close() })
+		assert.ErrorIs(t, migrate.Run(ctx, empty, migrate.Down, &bytes.Buffer{}), migrate.ErrNoMigrations)
+	})
+
+	t.Run("Неизвестная команда", func(t *testing.T) {
+		assert.Error(t, migrate.Run(ctx, provider, migrate.Command("unknown"), &bytes.Buffer{}))
+	})
+}
+
+func TestApply(t *testing.T) {
+	ctx := context.Background()
+	log, err := logger.NewLogger("error")
+	require.NoError(t, err)
+	provider, err := sqlite.NewMigrationProvider(ctx, sqlite.Scheme+filepath.Join(t.TempDir(), "keeper.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = provider.Close() })
+
+	// Без автоматического применения миграции только проверяются.
+	require.NoError(t, migrate.Apply(ctx, provider, false, log.WithField("instance", "test")))
+	pending, err := provider.HasPending(ctx)
+	require.NoError(t, err)
+	assert.True(t, pending)
+
+	require.NoError(t, migrate.Apply(ctx, provider, true, log.WithField("instance", "test")))
+	pending, err = provider.HasPending(ctx)
+	require.NoError(t, err)
+	assert.False(t, pending)
+}
@@ -7,9 +7,11 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/pinbrain/gophkeeper/internal/model"
+	storage "github.com/pinbrain/gophkeeper/internal/storage"
 )
 
 // MockStorage is a mock of Storage interface.
@@ -35,6 +37,34 @@ func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
 	return m.recorder
 }
 
+// AddOrgMember mocks base method.
+func (m *MockStorage) AddOrgMember(ctx context.Context, member *model.OrgMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrgMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOrgMember indicates an expected call of AddOrgMember.
+func (mr *MockStorageMockRecorder) AddOrgMember(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrgMember", reflect.TypeOf((*MockStorage)(nil).AddOrgMember), ctx, member)
+}
+
+// AppendAuditEvent mocks base method.
+func (m *MockStorage) AppendAuditEvent(ctx context.Context, event *model.AuditEvent, key []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendAuditEvent", ctx, event, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendAuditEvent indicates an expected call of AppendAuditEvent.
+func (mr *MockStorageMockRecorder) AppendAuditEvent(ctx, event, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendAuditEvent", reflect.TypeOf((*MockStorage)(nil).AppendAuditEvent), ctx, event, key)
+}
+
 // Close mocks base method.
 func (m *MockStorage) Close() error {
 	m.ctrl.T.Helper()
@@ -49,6 +79,21 @@ func (mr *MockStorageMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStorage)(nil).Close))
 }
 
+// CreateFolder mocks base method.
+func (m *MockStorage) CreateFolder(ctx context.Context, userID string, folder *model.Folder) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", ctx, userID, folder)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockStorageMockRecorder) CreateFolder(ctx, userID, folder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockStorage)(nil).CreateFolder), ctx, userID, folder)
+}
+
 // CreateItem mocks base method.
 func (m *MockStorage) CreateItem(ctx context.Context, userID string, item *model.VaultItem) (string, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +109,21 @@ func (mr *MockStorageMockRecorder) CreateItem(ctx, userID, item interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockStorage)(nil).CreateItem), ctx, userID, item)
 }
 
+// CreateOrg mocks base method.
+func (m *MockStorage) CreateOrg(ctx context.Context, org *model.Organization, owner *model.OrgMember) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrg", ctx, org, owner)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrg indicates an expected call of CreateOrg.
+func (mr *MockStorageMockRecorder) CreateOrg(ctx, org, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrg", reflect.TypeOf((*MockStorage)(nil).CreateOrg), ctx, org, owner)
+}
+
 // CreateUser mocks base method.
 func (m *MockStorage) CreateUser(ctx context.Context, user *model.User) (string, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +139,20 @@ func (mr *MockStorageMockRecorder) CreateUser(ctx, user interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStorage)(nil).CreateUser), ctx, user)
 }
 
+// DeleteFolder mocks base method.
+func (m *MockStorage) DeleteFolder(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolder", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockStorageMockRecorder) DeleteFolder(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockStorage)(nil).DeleteFolder), ctx, id, userID)
+}
+
 // DeleteItem mocks base method.
 func (m *MockStorage) DeleteItem(ctx context.Context, id, userID string) error {
 	m.ctrl.T.Helper()
@@ -93,6 +167,64 @@ func (mr *MockStorageMockRecorder) DeleteItem(ctx, id, userID interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockStorage)(nil).DeleteItem), ctx, id, userID)
 }
 
+// DeleteUser mocks base method.
+func (m *MockStorage) DeleteUser(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockStorageMockRecorder) DeleteUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStorage)(nil).DeleteUser), ctx, id)
+}
+
+// EncryptLegacyMeta mocks base method.
+func (m *MockStorage) EncryptLegacyMeta(ctx context.Context, meta model.LegacyMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptLegacyMeta", ctx, meta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EncryptLegacyMeta indicates an expected call of EncryptLegacyMeta.
+func (mr *MockStorageMockRecorder) EncryptLegacyMeta(ctx, meta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptLegacyMeta", reflect.TypeOf((*MockStorage)(nil).EncryptLegacyMeta), ctx, meta)
+}
+
+// GetAuditHead mocks base method.
+func (m *MockStorage) GetAuditHead(ctx context.Context, userID string) (model.AuditHead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditHead", ctx, userID)
+	ret0, _ := ret[0].(model.AuditHead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditHead indicates an expected call of GetAuditHead.
+func (mr *MockStorageMockRecorder) GetAuditHead(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditHead", reflect.TypeOf((*MockStorage)(nil).GetAuditHead), ctx, userID)
+}
+
+// GetDeletedItems mocks base method.
+func (m *MockStorage) GetDeletedItems(ctx context.Context, userID string) ([]model.VaultItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedItems", ctx, userID)
+	ret0, _ := ret[0].([]model.VaultItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedItems indicates an expected call of GetDeletedItems.
+func (mr *MockStorageMockRecorder) GetDeletedItems(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedItems", reflect.TypeOf((*MockStorage)(nil).GetDeletedItems), ctx, userID)
+}
+
 // GetItem mocks base method.
 func (m *MockStorage) GetItem(ctx context.Context, id, userID string) (*model.VaultItem, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +240,36 @@ func (mr *MockStorageMockRecorder) GetItem(ctx, id, userID interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockStorage)(nil).GetItem), ctx, id, userID)
 }
 
+// GetItemHistory mocks base method.
+func (m *MockStorage) GetItemHistory(ctx context.Context, id, userID string) ([]model.VaultItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemHistory", ctx, id, userID)
+	ret0, _ := ret[0].([]model.VaultItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemHistory indicates an expected call of GetItemHistory.
+func (mr *MockStorageMockRecorder) GetItemHistory(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemHistory", reflect.TypeOf((*MockStorage)(nil).GetItemHistory), ctx, id, userID)
+}
+
+// GetItemRevision mocks base method.
+func (m *MockStorage) GetItemRevision(ctx context.Context, id, userID string, revision int64) (*model.VaultItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemRevision", ctx, id, userID, revision)
+	ret0, _ := ret[0].(*model.VaultItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemRevision indicates an expected call of GetItemRevision.
+func (mr *MockStorageMockRecorder) GetItemRevision(ctx, id, userID, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemRevision", reflect.TypeOf((*MockStorage)(nil).GetItemRevision), ctx, id, userID, revision)
+}
+
 // GetItemsByType mocks base method.
 func (m *MockStorage) GetItemsByType(ctx context.Context, dataType, userID string) ([]model.VaultItem, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +285,51 @@ func (mr *MockStorageMockRecorder) GetItemsByType(ctx, dataType, userID interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemsByType", reflect.TypeOf((*MockStorage)(nil).GetItemsByType), ctx, dataType, userID)
 }
 
+// GetOrgMember mocks base method.
+func (m *MockStorage) GetOrgMember(ctx context.Context, orgID, userID string) (*model.OrgMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgMember", ctx, orgID, userID)
+	ret0, _ := ret[0].(*model.OrgMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgMember indicates an expected call of GetOrgMember.
+func (mr *MockStorageMockRecorder) GetOrgMember(ctx, orgID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgMember", reflect.TypeOf((*MockStorage)(nil).GetOrgMember), ctx, orgID, userID)
+}
+
+// GetShare mocks base method.
+func (m *MockStorage) GetShare(ctx context.Context, itemID, recipientID string) (*model.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShare", ctx, itemID, recipientID)
+	ret0, _ := ret[0].(*model.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShare indicates an expected call of GetShare.
+func (mr *MockStorageMockRecorder) GetShare(ctx, itemID, recipientID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShare", reflect.TypeOf((*MockStorage)(nil).GetShare), ctx, itemID, recipientID)
+}
+
+// GetUsage mocks base method.
+func (m *MockStorage) GetUsage(ctx context.Context, userID string) ([]model.TypeUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, userID)
+	ret0, _ := ret[0].([]model.TypeUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockStorageMockRecorder) GetUsage(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockStorage)(nil).GetUsage), ctx, userID)
+}
+
 // GetUserByID mocks base method.
 func (m *MockStorage) GetUserByID(ctx context.Context, id string) (*model.User, error) {
 	m.ctrl.T.Helper()
@@ -153,180 +360,1728 @@ func (mr *MockStorageMockRecorder) GetUserByLogin(ctx, login interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByLogin", reflect.TypeOf((*MockStorage)(nil).GetUserByLogin), ctx, login)
 }
 
-// UpdateItem mocks base method.
-func (m *MockStorage) UpdateItem(ctx context.Context, id, userID string, item *model.VaultItem) error {
+// ListAttachments mocks base method.
+func (m *MockStorage) ListAttachments(ctx context.Context, parentID, userID string) ([]model.VaultItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateItem", ctx, id, userID, item)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListAttachments", ctx, parentID, userID)
+	ret0, _ := ret[0].([]model.VaultItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateItem indicates an expected call of UpdateItem.
-func (mr *MockStorageMockRecorder) UpdateItem(ctx, id, userID, item interface{}) *gomock.Call {
+// ListAttachments indicates an expected call of ListAttachments.
+func (mr *MockStorageMockRecorder) ListAttachments(ctx, parentID, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockStorage)(nil).UpdateItem), ctx, id, userID, item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachments", reflect.TypeOf((*MockStorage)(nil).ListAttachments), ctx, parentID, userID)
 }
 
-// MockUserStorage is a mock of UserStorage interface.
-type MockUserStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockUserStorageMockRecorder
+// ListAuditEvents mocks base method.
+func (m *MockStorage) ListAuditEvents(ctx context.Context, userID string, params model.ListAuditParams) ([]model.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", ctx, userID, params)
+	ret0, _ := ret[0].([]model.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockUserStorageMockRecorder is the mock recorder for MockUserStorage.
-type MockUserStorageMockRecorder struct {
-	mock *MockUserStorage
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockStorageMockRecorder) ListAuditEvents(ctx, userID, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockStorage)(nil).ListAuditEvents), ctx, userID, params)
 }
 
-// NewMockUserStorage creates a new mock instance.
-func NewMockUserStorage(ctrl *gomock.Controller) *MockUserStorage {
-	mock := &MockUserStorage{ctrl: ctrl}
-	mock.recorder = &MockUserStorageMockRecorder{mock}
-	return mock
+// ListBackupHistory mocks base method.
+func (m *MockStorage) ListBackupHistory(ctx context.Context, itemID string) ([]model.VaultItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupHistory", ctx, itemID)
+	ret0, _ := ret[0].([]model.VaultItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUserStorage) EXPECT() *MockUserStorageMockRecorder {
-	return m.recorder
+// ListBackupHistory indicates an expected call of ListBackupHistory.
+func (mr *MockStorageMockRecorder) ListBackupHistory(ctx, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupHistory", reflect.TypeOf((*MockStorage)(nil).ListBackupHistory), ctx, itemID)
 }
 
-// CreateUser mocks base method.
-func (m *MockUserStorage) CreateUser(ctx context.Context, user *model.User) (string, error) {
+// ListBackupItems mocks base method.
+func (m *MockStorage) ListBackupItems(ctx context.Context, userID string) ([]model.VaultItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListBackupItems", ctx, userID)
+	ret0, _ := ret[0].([]model.VaultItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockUserStorageMockRecorder) CreateUser(ctx, user interface{}) *gomock.Call {
+// ListBackupItems indicates an expected call of ListBackupItems.
+func (mr *MockStorageMockRecorder) ListBackupItems(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserStorage)(nil).CreateUser), ctx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupItems", reflect.TypeOf((*MockStorage)(nil).ListBackupItems), ctx, userID)
 }
 
-// GetUserByID mocks base method.
-func (m *MockUserStorage) GetUserByID(ctx context.Context, id string) (*model.User, error) {
+// ListBackupOrgs mocks base method.
+func (m *MockStorage) ListBackupOrgs(ctx context.Context) ([]model.Organization, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
-	ret0, _ := ret[0].(*model.User)
+	ret := m.ctrl.Call(m, "ListBackupOrgs", ctx)
+	ret0, _ := ret[0].([]model.Organization)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByID indicates an expected call of GetUserByID.
-func (mr *MockUserStorageMockRecorder) GetUserByID(ctx, id interface{}) *gomock.Call {
+// ListBackupOrgs indicates an expected call of ListBackupOrgs.
+func (mr *MockStorageMockRecorder) ListBackupOrgs(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserStorage)(nil).GetUserByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupOrgs", reflect.TypeOf((*MockStorage)(nil).ListBackupOrgs), ctx)
 }
 
-// GetUserByLogin mocks base method.
-func (m *MockUserStorage) GetUserByLogin(ctx context.Context, login string) (*model.User, error) {
+// ListBackupShares mocks base method.
+func (m *MockStorage) ListBackupShares(ctx context.Context, ownerID string) ([]model.Share, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByLogin", ctx, login)
-	ret0, _ := ret[0].(*model.User)
+	ret := m.ctrl.Call(m, "ListBackupShares", ctx, ownerID)
+	ret0, _ := ret[0].([]model.Share)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByLogin indicates an expected call of GetUserByLogin.
-func (mr *MockUserStorageMockRecorder) GetUserByLogin(ctx, login interface{}) *gomock.Call {
+// ListBackupShares indicates an expected call of ListBackupShares.
+func (mr *MockStorageMockRecorder) ListBackupShares(ctx, ownerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByLogin", reflect.TypeOf((*MockUserStorage)(nil).GetUserByLogin), ctx, login)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupShares", reflect.TypeOf((*MockStorage)(nil).ListBackupShares), ctx, ownerID)
 }
 
-// MockVaultStorage is a mock of VaultStorage interface.
-type MockVaultStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockVaultStorageMockRecorder
+// ListChunkedData mocks base method.
+func (m *MockStorage) ListChunkedData(ctx context.Context, userID string) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChunkedData", ctx, userID)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockVaultStorageMockRecorder is the mock recorder for MockVaultStorage.
-type MockVaultStorageMockRecorder struct {
-	mock *MockVaultStorage
+// ListChunkedData indicates an expected call of ListChunkedData.
+func (mr *MockStorageMockRecorder) ListChunkedData(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChunkedData", reflect.TypeOf((*MockStorage)(nil).ListChunkedData), ctx, userID)
 }
 
-// NewMockVaultStorage creates a new mock instance.
-func NewMockVaultStorage(ctrl *gomock.Controller) *MockVaultStorage {
-	mock := &MockVaultStorage{ctrl: ctrl}
-	mock.recorder = &MockVaultStorageMockRecorder{mock}
-	return mock
+// ListFolders mocks base method.
+func (m *MockStorage) ListFolders(ctx context.Context, userID string) ([]model.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFolders", ctx, userID)
+	ret0, _ := ret[0].([]model.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockVaultStorage) EXPECT() *MockVaultStorageMockRecorder {
-	return m.recorder
+// ListFolders indicates an expected call of ListFolders.
+func (mr *MockStorageMockRecorder) ListFolders(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFolders", reflect.TypeOf((*MockStorage)(nil).ListFolders), ctx, userID)
 }
 
-// CreateItem mocks base method.
-func (m *MockVaultStorage) CreateItem(ctx context.Context, userID string, item *model.VaultItem) (string, error) {
+// ListItems mocks base method.
+func (m *MockStorage) ListItems(ctx context.Context, userID string, params model.ListItemsParams) ([]model.VaultItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateItem", ctx, userID, item)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListItems", ctx, userID, params)
+	ret0, _ := ret[0].([]model.VaultItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateItem indicates an expected call of CreateItem.
-func (mr *MockVaultStorageMockRecorder) CreateItem(ctx, userID, item interface{}) *gomock.Call {
+// ListItems indicates an expected call of ListItems.
+func (mr *MockStorageMockRecorder) ListItems(ctx, userID, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockVaultStorage)(nil).CreateItem), ctx, userID, item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockStorage)(nil).ListItems), ctx, userID, params)
 }
 
-// DeleteItem mocks base method.
-func (m *MockVaultStorage) DeleteItem(ctx context.Context, id, userID string) error {
+// ListLegacyMeta mocks base method.
+func (m *MockStorage) ListLegacyMeta(ctx context.Context, limit int) ([]model.LegacyMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteItem", ctx, id, userID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListLegacyMeta", ctx, limit)
+	ret0, _ := ret[0].([]model.LegacyMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteItem indicates an expected call of DeleteItem.
-func (mr *MockVaultStorageMockRecorder) DeleteItem(ctx, id, userID interface{}) *gomock.Call {
+// ListLegacyMeta indicates an expected call of ListLegacyMeta.
+func (mr *MockStorageMockRecorder) ListLegacyMeta(ctx, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockVaultStorage)(nil).DeleteItem), ctx, id, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLegacyMeta", reflect.TypeOf((*MockStorage)(nil).ListLegacyMeta), ctx, limit)
 }
 
-// GetItem mocks base method.
-func (m *MockVaultStorage) GetItem(ctx context.Context, id, userID string) (*model.VaultItem, error) {
+// ListOrgMembers mocks base method.
+func (m *MockStorage) ListOrgMembers(ctx context.Context, orgID string) ([]model.OrgMember, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetItem", ctx, id, userID)
-	ret0, _ := ret[0].(*model.VaultItem)
+	ret := m.ctrl.Call(m, "ListOrgMembers", ctx, orgID)
+	ret0, _ := ret[0].([]model.OrgMember)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetItem indicates an expected call of GetItem.
-func (mr *MockVaultStorageMockRecorder) GetItem(ctx, id, userID interface{}) *gomock.Call {
+// ListOrgMembers indicates an expected call of ListOrgMembers.
+func (mr *MockStorageMockRecorder) ListOrgMembers(ctx, orgID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockVaultStorage)(nil).GetItem), ctx, id, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgMembers", reflect.TypeOf((*MockStorage)(nil).ListOrgMembers), ctx, orgID)
 }
 
-// GetItemsByType mocks base method.
-func (m *MockVaultStorage) GetItemsByType(ctx context.Context, dataType, userID string) ([]model.VaultItem, error) {
+// ListShares mocks base method.
+func (m *MockStorage) ListShares(ctx context.Context, userID string) ([]model.Share, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetItemsByType", ctx, dataType, userID)
-	ret0, _ := ret[0].([]model.VaultItem)
+	ret := m.ctrl.Call(m, "ListShares", ctx, userID)
+	ret0, _ := ret[0].([]model.Share)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetItemsByType indicates an expected call of GetItemsByType.
-func (mr *MockVaultStorageMockRecorder) GetItemsByType(ctx, dataType, userID interface{}) *gomock.Call {
+// ListShares indicates an expected call of ListShares.
+func (mr *MockStorageMockRecorder) ListShares(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemsByType", reflect.TypeOf((*MockVaultStorage)(nil).GetItemsByType), ctx, dataType, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShares", reflect.TypeOf((*MockStorage)(nil).ListShares), ctx, userID)
 }
 
-// UpdateItem mocks base method.
-func (m *MockVaultStorage) UpdateItem(ctx context.Context, id, userID string, item *model.VaultItem) error {
+// ListTags mocks base method.
+func (m *MockStorage) ListTags(ctx context.Context, userID string) ([]model.Tag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateItem", ctx, id, userID, item)
+	ret := m.ctrl.Call(m, "ListTags", ctx, userID)
+	ret0, _ := ret[0].([]model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockStorageMockRecorder) ListTags(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockStorage)(nil).ListTags), ctx, userID)
+}
+
+// ListUserOrgs mocks base method.
+func (m *MockStorage) ListUserOrgs(ctx context.Context, userID string) ([]model.OrgMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserOrgs", ctx, userID)
+	ret0, _ := ret[0].([]model.OrgMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserOrgs indicates an expected call of ListUserOrgs.
+func (mr *MockStorageMockRecorder) ListUserOrgs(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserOrgs", reflect.TypeOf((*MockStorage)(nil).ListUserOrgs), ctx, userID)
+}
+
+// ListUsers mocks base method.
+func (m *MockStorage) ListUsers(ctx context.Context) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockStorageMockRecorder) ListUsers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockStorage)(nil).ListUsers), ctx)
+}
+
+// LockUsage mocks base method.
+func (m *MockStorage) LockUsage(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUsage", ctx, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateItem indicates an expected call of UpdateItem.
-func (mr *MockVaultStorageMockRecorder) UpdateItem(ctx, id, userID, item interface{}) *gomock.Call {
+// LockUsage indicates an expected call of LockUsage.
+func (mr *MockStorageMockRecorder) LockUsage(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockVaultStorage)(nil).UpdateItem), ctx, id, userID, item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUsage", reflect.TypeOf((*MockStorage)(nil).LockUsage), ctx, userID)
+}
+
+// MoveItem mocks base method.
+func (m *MockStorage) MoveItem(ctx context.Context, id, userID, folderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveItem", ctx, id, userID, folderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveItem indicates an expected call of MoveItem.
+func (mr *MockStorageMockRecorder) MoveItem(ctx, id, userID, folderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveItem", reflect.TypeOf((*MockStorage)(nil).MoveItem), ctx, id, userID, folderID)
+}
+
+// PurgeDeletedBefore mocks base method.
+func (m *MockStorage) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedBefore indicates an expected call of PurgeDeletedBefore.
+func (mr *MockStorageMockRecorder) PurgeDeletedBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockStorage)(nil).PurgeDeletedBefore), ctx, before)
+}
+
+// PurgeDeletedItems mocks base method.
+func (m *MockStorage) PurgeDeletedItems(ctx context.Context, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedItems", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedItems indicates an expected call of PurgeDeletedItems.
+func (mr *MockStorageMockRecorder) PurgeDeletedItems(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedItems", reflect.TypeOf((*MockStorage)(nil).PurgeDeletedItems), ctx, userID)
+}
+
+// PurgeExpiredItems mocks base method.
+func (m *MockStorage) PurgeExpiredItems(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpiredItems", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpiredItems indicates an expected call of PurgeExpiredItems.
+func (mr *MockStorageMockRecorder) PurgeExpiredItems(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpiredItems", reflect.TypeOf((*MockStorage)(nil).PurgeExpiredItems), ctx, now)
+}
+
+// RecordAccess mocks base method.
+func (m *MockStorage) RecordAccess(ctx context.Context, id, userID string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAccess", ctx, id, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAccess indicates an expected call of RecordAccess.
+func (mr *MockStorageMockRecorder) RecordAccess(ctx, id, userID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAccess", reflect.TypeOf((*MockStorage)(nil).RecordAccess), ctx, id, userID, at)
+}
+
+// RemoveOrgMember mocks base method.
+func (m *MockStorage) RemoveOrgMember(ctx context.Context, orgID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrgMember", ctx, orgID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOrgMember indicates an expected call of RemoveOrgMember.
+func (mr *MockStorageMockRecorder) RemoveOrgMember(ctx, orgID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrgMember", reflect.TypeOf((*MockStorage)(nil).RemoveOrgMember), ctx, orgID, userID)
+}
+
+// RestoreAuditEvent mocks base method.
+func (m *MockStorage) RestoreAuditEvent(ctx context.Context, event *model.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAuditEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreAuditEvent indicates an expected call of RestoreAuditEvent.
+func (mr *MockStorageMockRecorder) RestoreAuditEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAuditEvent", reflect.TypeOf((*MockStorage)(nil).RestoreAuditEvent), ctx, event)
+}
+
+// RestoreAuditHead mocks base method.
+func (m *MockStorage) RestoreAuditHead(ctx context.Context, userID string, head model.AuditHead) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAuditHead", ctx, userID, head)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreAuditHead indicates an expected call of RestoreAuditHead.
+func (mr *MockStorageMockRecorder) RestoreAuditHead(ctx, userID, head interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAuditHead", reflect.TypeOf((*MockStorage)(nil).RestoreAuditHead), ctx, userID, head)
+}
+
+// RestoreDeletedItem mocks base method.
+func (m *MockStorage) RestoreDeletedItem(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDeletedItem", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDeletedItem indicates an expected call of RestoreDeletedItem.
+func (mr *MockStorageMockRecorder) RestoreDeletedItem(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDeletedItem", reflect.TypeOf((*MockStorage)(nil).RestoreDeletedItem), ctx, id, userID)
+}
+
+// RestoreFolder mocks base method.
+func (m *MockStorage) RestoreFolder(ctx context.Context, folder *model.Folder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFolder", ctx, folder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreFolder indicates an expected call of RestoreFolder.
+func (mr *MockStorageMockRecorder) RestoreFolder(ctx, folder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFolder", reflect.TypeOf((*MockStorage)(nil).RestoreFolder), ctx, folder)
+}
+
+// RestoreItem mocks base method.
+func (m *MockStorage) RestoreItem(ctx context.Context, item *model.VaultItem, history []model.VaultItemRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreItem", ctx, item, history)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreItem indicates an expected call of RestoreItem.
+func (mr *MockStorageMockRecorder) RestoreItem(ctx, item, history interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreItem", reflect.TypeOf((*MockStorage)(nil).RestoreItem), ctx, item, history)
+}
+
+// RestoreItemRevision mocks base method.
+func (m *MockStorage) RestoreItemRevision(ctx context.Context, id, userID string, revision int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreItemRevision", ctx, id, userID, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreItemRevision indicates an expected call of RestoreItemRevision.
+func (mr *MockStorageMockRecorder) RestoreItemRevision(ctx, id, userID, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreItemRevision", reflect.TypeOf((*MockStorage)(nil).RestoreItemRevision), ctx, id, userID, revision)
+}
+
+// RestoreOrg mocks base method.
+func (m *MockStorage) RestoreOrg(ctx context.Context, org *model.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreOrg", ctx, org)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreOrg indicates an expected call of RestoreOrg.
+func (mr *MockStorageMockRecorder) RestoreOrg(ctx, org interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreOrg", reflect.TypeOf((*MockStorage)(nil).RestoreOrg), ctx, org)
+}
+
+// RestoreOrgMember mocks base method.
+func (m *MockStorage) RestoreOrgMember(ctx context.Context, member *model.OrgMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreOrgMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreOrgMember indicates an expected call of RestoreOrgMember.
+func (mr *MockStorageMockRecorder) RestoreOrgMember(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreOrgMember", reflect.TypeOf((*MockStorage)(nil).RestoreOrgMember), ctx, member)
+}
+
+// RestoreShare mocks base method.
+func (m *MockStorage) RestoreShare(ctx context.Context, share *model.Share) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreShare", ctx, share)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreShare indicates an expected call of RestoreShare.
+func (mr *MockStorageMockRecorder) RestoreShare(ctx, share interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreShare", reflect.TypeOf((*MockStorage)(nil).RestoreShare), ctx, share)
+}
+
+// RestoreTag mocks base method.
+func (m *MockStorage) RestoreTag(ctx context.Context, tag *model.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTag", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreTag indicates an expected call of RestoreTag.
+func (mr *MockStorageMockRecorder) RestoreTag(ctx, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTag", reflect.TypeOf((*MockStorage)(nil).RestoreTag), ctx, tag)
+}
+
+// RestoreUser mocks base method.
+func (m *MockStorage) RestoreUser(ctx context.Context, user *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockStorageMockRecorder) RestoreUser(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockStorage)(nil).RestoreUser), ctx, user)
+}
+
+// RevokeShare mocks base method.
+func (m *MockStorage) RevokeShare(ctx context.Context, itemID, ownerID, recipientID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShare", ctx, itemID, ownerID, recipientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeShare indicates an expected call of RevokeShare.
+func (mr *MockStorageMockRecorder) RevokeShare(ctx, itemID, ownerID, recipientID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShare", reflect.TypeOf((*MockStorage)(nil).RevokeShare), ctx, itemID, ownerID, recipientID)
+}
+
+// SearchItems mocks base method.
+func (m *MockStorage) SearchItems(ctx context.Context, userID string, params model.SearchItemsParams) ([]model.VaultItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchItems", ctx, userID, params)
+	ret0, _ := ret[0].([]model.VaultItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchItems indicates an expected call of SearchItems.
+func (mr *MockStorageMockRecorder) SearchItems(ctx, userID, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchItems", reflect.TypeOf((*MockStorage)(nil).SearchItems), ctx, userID, params)
+}
+
+// SetFavorite mocks base method.
+func (m *MockStorage) SetFavorite(ctx context.Context, id, userID string, favorite bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFavorite", ctx, id, userID, favorite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFavorite indicates an expected call of SetFavorite.
+func (mr *MockStorageMockRecorder) SetFavorite(ctx, id, userID, favorite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFavorite", reflect.TypeOf((*MockStorage)(nil).SetFavorite), ctx, id, userID, favorite)
+}
+
+// SetItemKey mocks base method.
+func (m *MockStorage) SetItemKey(ctx context.Context, id, userID string, key *model.ItemKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetItemKey", ctx, id, userID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetItemKey indicates an expected call of SetItemKey.
+func (mr *MockStorageMockRecorder) SetItemKey(ctx, id, userID, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetItemKey", reflect.TypeOf((*MockStorage)(nil).SetItemKey), ctx, id, userID, key)
+}
+
+// SetOrgMemberRole mocks base method.
+func (m *MockStorage) SetOrgMemberRole(ctx context.Context, orgID, userID string, role model.OrgRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOrgMemberRole", ctx, orgID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOrgMemberRole indicates an expected call of SetOrgMemberRole.
+func (mr *MockStorageMockRecorder) SetOrgMemberRole(ctx, orgID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrgMemberRole", reflect.TypeOf((*MockStorage)(nil).SetOrgMemberRole), ctx, orgID, userID, role)
+}
+
+// SetUserKeys mocks base method.
+func (m *MockStorage) SetUserKeys(ctx context.Context, id string, publicKey, encPrivateKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserKeys", ctx, id, publicKey, encPrivateKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserKeys indicates an expected call of SetUserKeys.
+func (mr *MockStorageMockRecorder) SetUserKeys(ctx, id, publicKey, encPrivateKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserKeys", reflect.TypeOf((*MockStorage)(nil).SetUserKeys), ctx, id, publicKey, encPrivateKey)
+}
+
+// ShareItem mocks base method.
+func (m *MockStorage) ShareItem(ctx context.Context, share *model.Share) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareItem", ctx, share)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShareItem indicates an expected call of ShareItem.
+func (mr *MockStorageMockRecorder) ShareItem(ctx, share interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareItem", reflect.TypeOf((*MockStorage)(nil).ShareItem), ctx, share)
+}
+
+// TagItem mocks base method.
+func (m *MockStorage) TagItem(ctx context.Context, id, userID string, tag *model.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagItem", ctx, id, userID, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagItem indicates an expected call of TagItem.
+func (mr *MockStorageMockRecorder) TagItem(ctx, id, userID, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagItem", reflect.TypeOf((*MockStorage)(nil).TagItem), ctx, id, userID, tag)
+}
+
+// UntagItem mocks base method.
+func (m *MockStorage) UntagItem(ctx context.Context, id, userID, nameIndex string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagItem", ctx, id, userID, nameIndex)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UntagItem indicates an expected call of UntagItem.
+func (mr *MockStorageMockRecorder) UntagItem(ctx, id, userID, nameIndex interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagItem", reflect.TypeOf((*MockStorage)(nil).UntagItem), ctx, id, userID, nameIndex)
+}
+
+// UpdateItem mocks base method.
+func (m *MockStorage) UpdateItem(ctx context.Context, id, userID string, item *model.VaultItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItem", ctx, id, userID, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateItem indicates an expected call of UpdateItem.
+func (mr *MockStorageMockRecorder) UpdateItem(ctx, id, userID, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockStorage)(nil).UpdateItem), ctx, id, userID, item)
+}
+
+// WithTx mocks base method.
+func (m *MockStorage) WithTx(ctx context.Context, fn func(storage.Storage) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockStorageMockRecorder) WithTx(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockStorage)(nil).WithTx), ctx, fn)
+}
+
+// MockUserStorage is a mock of UserStorage interface.
+type MockUserStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserStorageMockRecorder
+}
+
+// MockUserStorageMockRecorder is the mock recorder for MockUserStorage.
+type MockUserStorageMockRecorder struct {
+	mock *MockUserStorage
+}
+
+// NewMockUserStorage creates a new mock instance.
+func NewMockUserStorage(ctrl *gomock.Controller) *MockUserStorage {
+	mock := &MockUserStorage{ctrl: ctrl}
+	mock.recorder = &MockUserStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserStorage) EXPECT() *MockUserStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateUser mocks base method.
+func (m *MockUserStorage) CreateUser(ctx context.Context, user *model.User) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockUserStorageMockRecorder) CreateUser(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserStorage)(nil).CreateUser), ctx, user)
+}
+
+// DeleteUser mocks base method.
+func (m *MockUserStorage) DeleteUser(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockUserStorageMockRecorder) DeleteUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserStorage)(nil).DeleteUser), ctx, id)
+}
+
+// GetUserByID mocks base method.
+func (m *MockUserStorage) GetUserByID(ctx context.Context, id string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserStorageMockRecorder) GetUserByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserStorage)(nil).GetUserByID), ctx, id)
+}
+
+// GetUserByLogin mocks base method.
+func (m *MockUserStorage) GetUserByLogin(ctx context.Context, login string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByLogin", ctx, login)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByLogin indicates an expected call of GetUserByLogin.
+func (mr *MockUserStorageMockRecorder) GetUserByLogin(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByLogin", reflect.TypeOf((*MockUserStorage)(nil).GetUserByLogin), ctx, login)
+}
+
+// ListUsers mocks base method.
+func (m *MockUserStorage) ListUsers(ctx context.Context) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockUserStorageMockRecorder) ListUsers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserStorage)(nil).ListUsers), ctx)
+}
+
+// SetUserKeys mocks base method.
+func (m *MockUserStorage) SetUserKeys(ctx context.Context, id string, publicKey, encPrivateKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserKeys", ctx, id, publicKey, encPrivateKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserKeys indicates an expected call of SetUserKeys.
+func (mr *MockUserStorageMockRecorder) SetUserKeys(ctx, id, publicKey, encPrivateKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserKeys", reflect.TypeOf((*MockUserStorage)(nil).SetUserKeys), ctx, id, publicKey, encPrivateKey)
+}
+
+// MockVaultStorage is a mock of VaultStorage interface.
+type MockVaultStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockVaultStorageMockRecorder
+}
+
+// MockVaultStorageMockRecorder is the mock recorder for MockVaultStorage.
+type MockVaultStorageMockRecorder struct {
+	mock *MockVaultStorage
+}
+
+// NewMockVaultStorage creates a new mock instance.
+func NewMockVaultStorage(ctrl *gomock.Controller) *MockVaultStorage {
+	mock := &MockVaultStorage{ctrl: ctrl}
+	mock.recorder = &MockVaultStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVaultStorage) EXPECT() *MockVaultStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateItem mocks base method.
+func (m *MockVaultStorage) CreateItem(ctx context.Context, userID string, item *model.VaultItem) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateItem", ctx, userID, item)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateItem indicates an expected call of CreateItem.
+func (mr *MockVaultStorageMockRecorder) CreateItem(ctx, userID, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockVaultStorage)(nil).CreateItem), ctx, userID, item)
+}
+
+// DeleteItem mocks base method.
+func (m *MockVaultStorage) DeleteItem(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteItem", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteItem indicates an expected call of DeleteItem.
+func (mr *MockVaultStorageMockRecorder) DeleteItem(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteItem", reflect.TypeOf((*MockVaultStorage)(nil).DeleteItem), ctx, id, userID)
+}
+
+// EncryptLegacyMeta mocks base method.
+func (m *MockVaultStorage) EncryptLegacyMeta(ctx context.Context, meta model.LegacyMeta) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptLegacyMeta", ctx, meta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EncryptLegacyMeta indicates an expected call of EncryptLegacyMeta.
+func (mr *MockVaultStorageMockRecorder) EncryptLegacyMeta(ctx, meta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptLegacyMeta", reflect.TypeOf((*MockVaultStorage)(nil).EncryptLegacyMeta), ctx, meta)
+}
+
+// GetDeletedItems mocks base method.
+func (m *MockVaultStorage) GetDeletedItems(ctx context.Context, userID string) ([]model.VaultItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedItems", ctx, userID)
+	ret0, _ := ret[0].([]model.VaultItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedItems indicates an expected call of GetDeletedItems.
+func (mr *MockVaultStorageMockRecorder) GetDeletedItems(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedItems", reflect.TypeOf((*MockVaultStorage)(nil).GetDeletedItems), ctx, userID)
+}
+
+// GetItem mocks base method.
+func (m *MockVaultStorage) GetItem(ctx context.Context, id, userID string) (*model.VaultItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItem", ctx, id, userID)
+	ret0, _ := ret[0].(*model.VaultItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItem indicates an expected call of GetItem.
+func (mr *MockVaultStorageMockRecorder) GetItem(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockVaultStorage)(nil).GetItem), ctx, id, userID)
+}
+
+// GetItemHistory mocks base method.
+func (m *MockVaultStorage) GetItemHistory(ctx context.Context, id, userID string) ([]model.VaultItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemHistory", ctx, id, userID)
+	ret0, _ := ret[0].([]model.VaultItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemHistory indicates an expected call of GetItemHistory.
+func (mr *MockVaultStorageMockRecorder) GetItemHistory(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemHistory", reflect.TypeOf((*MockVaultStorage)(nil).GetItemHistory), ctx, id, userID)
+}
+
+// GetItemRevision mocks base method.
+func (m *MockVaultStorage) GetItemRevision(ctx context.Context, id, userID string, revision int64) (*model.VaultItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemRevision", ctx, id, userID, revision)
+	ret0, _ := ret[0].(*model.VaultItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemRevision indicates an expected call of GetItemRevision.
+func (mr *MockVaultStorageMockRecorder) GetItemRevision(ctx, id, userID, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemRevision", reflect.TypeOf((*MockVaultStorage)(nil).GetItemRevision), ctx, id, userID, revision)
+}
+
+// GetItemsByType mocks base method.
+func (m *MockVaultStorage) GetItemsByType(ctx context.Context, dataType, userID string) ([]model.VaultItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItemsByType", ctx, dataType, userID)
+	ret0, _ := ret[0].([]model.VaultItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItemsByType indicates an expected call of GetItemsByType.
+func (mr *MockVaultStorageMockRecorder) GetItemsByType(ctx, dataType, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItemsByType", reflect.TypeOf((*MockVaultStorage)(nil).GetItemsByType), ctx, dataType, userID)
+}
+
+// GetUsage mocks base method.
+func (m *MockVaultStorage) GetUsage(ctx context.Context, userID string) ([]model.TypeUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, userID)
+	ret0, _ := ret[0].([]model.TypeUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockVaultStorageMockRecorder) GetUsage(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockVaultStorage)(nil).GetUsage), ctx, userID)
+}
+
+// ListAttachments mocks base method.
+func (m *MockVaultStorage) ListAttachments(ctx context.Context, parentID, userID string) ([]model.VaultItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachments", ctx, parentID, userID)
+	ret0, _ := ret[0].([]model.VaultItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachments indicates an expected call of ListAttachments.
+func (mr *MockVaultStorageMockRecorder) ListAttachments(ctx, parentID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachments", reflect.TypeOf((*MockVaultStorage)(nil).ListAttachments), ctx, parentID, userID)
+}
+
+// ListChunkedData mocks base method.
+func (m *MockVaultStorage) ListChunkedData(ctx context.Context, userID string) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChunkedData", ctx, userID)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChunkedData indicates an expected call of ListChunkedData.
+func (mr *MockVaultStorageMockRecorder) ListChunkedData(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChunkedData", reflect.TypeOf((*MockVaultStorage)(nil).ListChunkedData), ctx, userID)
+}
+
+// ListItems mocks base method.
+func (m *MockVaultStorage) ListItems(ctx context.Context, userID string, params model.ListItemsParams) ([]model.VaultItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListItems", ctx, userID, params)
+	ret0, _ := ret[0].([]model.VaultItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListItems indicates an expected call of ListItems.
+func (mr *MockVaultStorageMockRecorder) ListItems(ctx, userID, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListItems", reflect.TypeOf((*MockVaultStorage)(nil).ListItems), ctx, userID, params)
+}
+
+// ListLegacyMeta mocks base method.
+func (m *MockVaultStorage) ListLegacyMeta(ctx context.Context, limit int) ([]model.LegacyMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLegacyMeta", ctx, limit)
+	ret0, _ := ret[0].([]model.LegacyMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLegacyMeta indicates an expected call of ListLegacyMeta.
+func (mr *MockVaultStorageMockRecorder) ListLegacyMeta(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLegacyMeta", reflect.TypeOf((*MockVaultStorage)(nil).ListLegacyMeta), ctx, limit)
+}
+
+// LockUsage mocks base method.
+func (m *MockVaultStorage) LockUsage(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUsage", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockUsage indicates an expected call of LockUsage.
+func (mr *MockVaultStorageMockRecorder) LockUsage(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUsage", reflect.TypeOf((*MockVaultStorage)(nil).LockUsage), ctx, userID)
+}
+
+// PurgeDeletedBefore mocks base method.
+func (m *MockVaultStorage) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedBefore indicates an expected call of PurgeDeletedBefore.
+func (mr *MockVaultStorageMockRecorder) PurgeDeletedBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockVaultStorage)(nil).PurgeDeletedBefore), ctx, before)
+}
+
+// PurgeDeletedItems mocks base method.
+func (m *MockVaultStorage) PurgeDeletedItems(ctx context.Context, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedItems", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedItems indicates an expected call of PurgeDeletedItems.
+func (mr *MockVaultStorageMockRecorder) PurgeDeletedItems(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedItems", reflect.TypeOf((*MockVaultStorage)(nil).PurgeDeletedItems), ctx, userID)
+}
+
+// PurgeExpiredItems mocks base method.
+func (m *MockVaultStorage) PurgeExpiredItems(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpiredItems", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpiredItems indicates an expected call of PurgeExpiredItems.
+func (mr *MockVaultStorageMockRecorder) PurgeExpiredItems(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpiredItems", reflect.TypeOf((*MockVaultStorage)(nil).PurgeExpiredItems), ctx, now)
+}
+
+// RecordAccess mocks base method.
+func (m *MockVaultStorage) RecordAccess(ctx context.Context, id, userID string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAccess", ctx, id, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAccess indicates an expected call of RecordAccess.
+func (mr *MockVaultStorageMockRecorder) RecordAccess(ctx, id, userID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAccess", reflect.TypeOf((*MockVaultStorage)(nil).RecordAccess), ctx, id, userID, at)
+}
+
+// RestoreDeletedItem mocks base method.
+func (m *MockVaultStorage) RestoreDeletedItem(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreDeletedItem", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreDeletedItem indicates an expected call of RestoreDeletedItem.
+func (mr *MockVaultStorageMockRecorder) RestoreDeletedItem(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDeletedItem", reflect.TypeOf((*MockVaultStorage)(nil).RestoreDeletedItem), ctx, id, userID)
+}
+
+// RestoreItemRevision mocks base method.
+func (m *MockVaultStorage) RestoreItemRevision(ctx context.Context, id, userID string, revision int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreItemRevision", ctx, id, userID, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreItemRevision indicates an expected call of RestoreItemRevision.
+func (mr *MockVaultStorageMockRecorder) RestoreItemRevision(ctx, id, userID, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreItemRevision", reflect.TypeOf((*MockVaultStorage)(nil).RestoreItemRevision), ctx, id, userID, revision)
+}
+
+// SearchItems mocks base method.
+func (m *MockVaultStorage) SearchItems(ctx context.Context, userID string, params model.SearchItemsParams) ([]model.VaultItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchItems", ctx, userID, params)
+	ret0, _ := ret[0].([]model.VaultItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchItems indicates an expected call of SearchItems.
+func (mr *MockVaultStorageMockRecorder) SearchItems(ctx, userID, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchItems", reflect.TypeOf((*MockVaultStorage)(nil).SearchItems), ctx, userID, params)
+}
+
+// SetFavorite mocks base method.
+func (m *MockVaultStorage) SetFavorite(ctx context.Context, id, userID string, favorite bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFavorite", ctx, id, userID, favorite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFavorite indicates an expected call of SetFavorite.
+func (mr *MockVaultStorageMockRecorder) SetFavorite(ctx, id, userID, favorite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFavorite", reflect.TypeOf((*MockVaultStorage)(nil).SetFavorite), ctx, id, userID, favorite)
+}
+
+// UpdateItem mocks base method.
+func (m *MockVaultStorage) UpdateItem(ctx context.Context, id, userID string, item *model.VaultItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItem", ctx, id, userID, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateItem indicates an expected call of UpdateItem.
+func (mr *MockVaultStorageMockRecorder) UpdateItem(ctx, id, userID, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockVaultStorage)(nil).UpdateItem), ctx, id, userID, item)
+}
+
+// MockFolderStorage is a mock of FolderStorage interface.
+type MockFolderStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockFolderStorageMockRecorder
+}
+
+// MockFolderStorageMockRecorder is the mock recorder for MockFolderStorage.
+type MockFolderStorageMockRecorder struct {
+	mock *MockFolderStorage
+}
+
+// NewMockFolderStorage creates a new mock instance.
+func NewMockFolderStorage(ctrl *gomock.Controller) *MockFolderStorage {
+	mock := &MockFolderStorage{ctrl: ctrl}
+	mock.recorder = &MockFolderStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFolderStorage) EXPECT() *MockFolderStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateFolder mocks base method.
+func (m *MockFolderStorage) CreateFolder(ctx context.Context, userID string, folder *model.Folder) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", ctx, userID, folder)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockFolderStorageMockRecorder) CreateFolder(ctx, userID, folder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockFolderStorage)(nil).CreateFolder), ctx, userID, folder)
+}
+
+// DeleteFolder mocks base method.
+func (m *MockFolderStorage) DeleteFolder(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolder", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockFolderStorageMockRecorder) DeleteFolder(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockFolderStorage)(nil).DeleteFolder), ctx, id, userID)
+}
+
+// ListFolders mocks base method.
+func (m *MockFolderStorage) ListFolders(ctx context.Context, userID string) ([]model.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFolders", ctx, userID)
+	ret0, _ := ret[0].([]model.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFolders indicates an expected call of ListFolders.
+func (mr *MockFolderStorageMockRecorder) ListFolders(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFolders", reflect.TypeOf((*MockFolderStorage)(nil).ListFolders), ctx, userID)
+}
+
+// ListTags mocks base method.
+func (m *MockFolderStorage) ListTags(ctx context.Context, userID string) ([]model.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", ctx, userID)
+	ret0, _ := ret[0].([]model.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockFolderStorageMockRecorder) ListTags(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockFolderStorage)(nil).ListTags), ctx, userID)
+}
+
+// MoveItem mocks base method.
+func (m *MockFolderStorage) MoveItem(ctx context.Context, id, userID, folderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveItem", ctx, id, userID, folderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveItem indicates an expected call of MoveItem.
+func (mr *MockFolderStorageMockRecorder) MoveItem(ctx, id, userID, folderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveItem", reflect.TypeOf((*MockFolderStorage)(nil).MoveItem), ctx, id, userID, folderID)
+}
+
+// TagItem mocks base method.
+func (m *MockFolderStorage) TagItem(ctx context.Context, id, userID string, tag *model.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagItem", ctx, id, userID, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagItem indicates an expected call of TagItem.
+func (mr *MockFolderStorageMockRecorder) TagItem(ctx, id, userID, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagItem", reflect.TypeOf((*MockFolderStorage)(nil).TagItem), ctx, id, userID, tag)
+}
+
+// UntagItem mocks base method.
+func (m *MockFolderStorage) UntagItem(ctx context.Context, id, userID, nameIndex string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagItem", ctx, id, userID, nameIndex)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UntagItem indicates an expected call of UntagItem.
+func (mr *MockFolderStorageMockRecorder) UntagItem(ctx, id, userID, nameIndex interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagItem", reflect.TypeOf((*MockFolderStorage)(nil).UntagItem), ctx, id, userID, nameIndex)
+}
+
+// MockShareStorage is a mock of ShareStorage interface.
+type MockShareStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockShareStorageMockRecorder
+}
+
+// MockShareStorageMockRecorder is the mock recorder for MockShareStorage.
+type MockShareStorageMockRecorder struct {
+	mock *MockShareStorage
+}
+
+// NewMockShareStorage creates a new mock instance.
+func NewMockShareStorage(ctrl *gomock.Controller) *MockShareStorage {
+	mock := &MockShareStorage{ctrl: ctrl}
+	mock.recorder = &MockShareStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShareStorage) EXPECT() *MockShareStorageMockRecorder {
+	return m.recorder
+}
+
+// GetShare mocks base method.
+func (m *MockShareStorage) GetShare(ctx context.Context, itemID, recipientID string) (*model.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShare", ctx, itemID, recipientID)
+	ret0, _ := ret[0].(*model.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShare indicates an expected call of GetShare.
+func (mr *MockShareStorageMockRecorder) GetShare(ctx, itemID, recipientID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShare", reflect.TypeOf((*MockShareStorage)(nil).GetShare), ctx, itemID, recipientID)
+}
+
+// ListShares mocks base method.
+func (m *MockShareStorage) ListShares(ctx context.Context, userID string) ([]model.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShares", ctx, userID)
+	ret0, _ := ret[0].([]model.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShares indicates an expected call of ListShares.
+func (mr *MockShareStorageMockRecorder) ListShares(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShares", reflect.TypeOf((*MockShareStorage)(nil).ListShares), ctx, userID)
+}
+
+// RevokeShare mocks base method.
+func (m *MockShareStorage) RevokeShare(ctx context.Context, itemID, ownerID, recipientID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShare", ctx, itemID, ownerID, recipientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeShare indicates an expected call of RevokeShare.
+func (mr *MockShareStorageMockRecorder) RevokeShare(ctx, itemID, ownerID, recipientID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShare", reflect.TypeOf((*MockShareStorage)(nil).RevokeShare), ctx, itemID, ownerID, recipientID)
+}
+
+// SetItemKey mocks base method.
+func (m *MockShareStorage) SetItemKey(ctx context.Context, id, userID string, key *model.ItemKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetItemKey", ctx, id, userID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetItemKey indicates an expected call of SetItemKey.
+func (mr *MockShareStorageMockRecorder) SetItemKey(ctx, id, userID, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetItemKey", reflect.TypeOf((*MockShareStorage)(nil).SetItemKey), ctx, id, userID, key)
+}
+
+// ShareItem mocks base method.
+func (m *MockShareStorage) ShareItem(ctx context.Context, share *model.Share) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareItem", ctx, share)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShareItem indicates an expected call of ShareItem.
+func (mr *MockShareStorageMockRecorder) ShareItem(ctx, share interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareItem", reflect.TypeOf((*MockShareStorage)(nil).ShareItem), ctx, share)
+}
+
+// MockOrgStorage is a mock of OrgStorage interface.
+type MockOrgStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrgStorageMockRecorder
+}
+
+// MockOrgStorageMockRecorder is the mock recorder for MockOrgStorage.
+type MockOrgStorageMockRecorder struct {
+	mock *MockOrgStorage
+}
+
+// NewMockOrgStorage creates a new mock instance.
+func NewMockOrgStorage(ctrl *gomock.Controller) *MockOrgStorage {
+	mock := &MockOrgStorage{ctrl: ctrl}
+	mock.recorder = &MockOrgStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrgStorage) EXPECT() *MockOrgStorageMockRecorder {
+	return m.recorder
+}
+
+// AddOrgMember mocks base method.
+func (m *MockOrgStorage) AddOrgMember(ctx context.Context, member *model.OrgMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrgMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOrgMember indicates an expected call of AddOrgMember.
+func (mr *MockOrgStorageMockRecorder) AddOrgMember(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrgMember", reflect.TypeOf((*MockOrgStorage)(nil).AddOrgMember), ctx, member)
+}
+
+// CreateOrg mocks base method.
+func (m *MockOrgStorage) CreateOrg(ctx context.Context, org *model.Organization, owner *model.OrgMember) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrg", ctx, org, owner)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrg indicates an expected call of CreateOrg.
+func (mr *MockOrgStorageMockRecorder) CreateOrg(ctx, org, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrg", reflect.TypeOf((*MockOrgStorage)(nil).CreateOrg), ctx, org, owner)
+}
+
+// GetOrgMember mocks base method.
+func (m *MockOrgStorage) GetOrgMember(ctx context.Context, orgID, userID string) (*model.OrgMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgMember", ctx, orgID, userID)
+	ret0, _ := ret[0].(*model.OrgMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgMember indicates an expected call of GetOrgMember.
+func (mr *MockOrgStorageMockRecorder) GetOrgMember(ctx, orgID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgMember", reflect.TypeOf((*MockOrgStorage)(nil).GetOrgMember), ctx, orgID, userID)
+}
+
+// ListOrgMembers mocks base method.
+func (m *MockOrgStorage) ListOrgMembers(ctx context.Context, orgID string) ([]model.OrgMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgMembers", ctx, orgID)
+	ret0, _ := ret[0].([]model.OrgMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgMembers indicates an expected call of ListOrgMembers.
+func (mr *MockOrgStorageMockRecorder) ListOrgMembers(ctx, orgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgMembers", reflect.TypeOf((*MockOrgStorage)(nil).ListOrgMembers), ctx, orgID)
+}
+
+// ListUserOrgs mocks base method.
+func (m *MockOrgStorage) ListUserOrgs(ctx context.Context, userID string) ([]model.OrgMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserOrgs", ctx, userID)
+	ret0, _ := ret[0].([]model.OrgMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserOrgs indicates an expected call of ListUserOrgs.
+func (mr *MockOrgStorageMockRecorder) ListUserOrgs(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserOrgs", reflect.TypeOf((*MockOrgStorage)(nil).ListUserOrgs), ctx, userID)
+}
+
+// RemoveOrgMember mocks base method.
+func (m *MockOrgStorage) RemoveOrgMember(ctx context.Context, orgID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrgMember", ctx, orgID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOrgMember indicates an expected call of RemoveOrgMember.
+func (mr *MockOrgStorageMockRecorder) RemoveOrgMember(ctx, orgID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrgMember", reflect.TypeOf((*MockOrgStorage)(nil).RemoveOrgMember), ctx, orgID, userID)
+}
+
+// SetOrgMemberRole mocks base method.
+func (m *MockOrgStorage) SetOrgMemberRole(ctx context.Context, orgID, userID string, role model.OrgRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOrgMemberRole", ctx, orgID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOrgMemberRole indicates an expected call of SetOrgMemberRole.
+func (mr *MockOrgStorageMockRecorder) SetOrgMemberRole(ctx, orgID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrgMemberRole", reflect.TypeOf((*MockOrgStorage)(nil).SetOrgMemberRole), ctx, orgID, userID, role)
+}
+
+// MockAuditStorage is a mock of AuditStorage interface.
+type MockAuditStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditStorageMockRecorder
+}
+
+// MockAuditStorageMockRecorder is the mock recorder for MockAuditStorage.
+type MockAuditStorageMockRecorder struct {
+	mock *MockAuditStorage
+}
+
+// NewMockAuditStorage creates a new mock instance.
+func NewMockAuditStorage(ctrl *gomock.Controller) *MockAuditStorage {
+	mock := &MockAuditStorage{ctrl: ctrl}
+	mock.recorder = &MockAuditStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditStorage) EXPECT() *MockAuditStorageMockRecorder {
+	return m.recorder
+}
+
+// AppendAuditEvent mocks base method.
+func (m *MockAuditStorage) AppendAuditEvent(ctx context.Context, event *model.AuditEvent, key []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendAuditEvent", ctx, event, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendAuditEvent indicates an expected call of AppendAuditEvent.
+func (mr *MockAuditStorageMockRecorder) AppendAuditEvent(ctx, event, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendAuditEvent", reflect.TypeOf((*MockAuditStorage)(nil).AppendAuditEvent), ctx, event, key)
+}
+
+// GetAuditHead mocks base method.
+func (m *MockAuditStorage) GetAuditHead(ctx context.Context, userID string) (model.AuditHead, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditHead", ctx, userID)
+	ret0, _ := ret[0].(model.AuditHead)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditHead indicates an expected call of GetAuditHead.
+func (mr *MockAuditStorageMockRecorder) GetAuditHead(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditHead", reflect.TypeOf((*MockAuditStorage)(nil).GetAuditHead), ctx, userID)
+}
+
+// ListAuditEvents mocks base method.
+func (m *MockAuditStorage) ListAuditEvents(ctx context.Context, userID string, params model.ListAuditParams) ([]model.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEvents", ctx, userID, params)
+	ret0, _ := ret[0].([]model.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEvents indicates an expected call of ListAuditEvents.
+func (mr *MockAuditStorageMockRecorder) ListAuditEvents(ctx, userID, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEvents", reflect.TypeOf((*MockAuditStorage)(nil).ListAuditEvents), ctx, userID, params)
+}
+
+// MockBackupStorage is a mock of BackupStorage interface.
+type MockBackupStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupStorageMockRecorder
+}
+
+// MockBackupStorageMockRecorder is the mock recorder for MockBackupStorage.
+type MockBackupStorageMockRecorder struct {
+	mock *MockBackupStorage
+}
+
+// NewMockBackupStorage creates a new mock instance.
+func NewMockBackupStorage(ctrl *gomock.Controller) *MockBackupStorage {
+	mock := &MockBackupStorage{ctrl: ctrl}
+	mock.recorder = &MockBackupStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackupStorage) EXPECT() *MockBackupStorageMockRecorder {
+	return m.recorder
+}
+
+// ListBackupHistory mocks base method.
+func (m *MockBackupStorage) ListBackupHistory(ctx context.Context, itemID string) ([]model.VaultItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupHistory", ctx, itemID)
+	ret0, _ := ret[0].([]model.VaultItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupHistory indicates an expected call of ListBackupHistory.
+func (mr *MockBackupStorageMockRecorder) ListBackupHistory(ctx, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupHistory", reflect.TypeOf((*MockBackupStorage)(nil).ListBackupHistory), ctx, itemID)
+}
+
+// ListBackupItems mocks base method.
+func (m *MockBackupStorage) ListBackupItems(ctx context.Context, userID string) ([]model.VaultItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupItems", ctx, userID)
+	ret0, _ := ret[0].([]model.VaultItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupItems indicates an expected call of ListBackupItems.
+func (mr *MockBackupStorageMockRecorder) ListBackupItems(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupItems", reflect.TypeOf((*MockBackupStorage)(nil).ListBackupItems), ctx, userID)
+}
+
+// ListBackupOrgs mocks base method.
+func (m *MockBackupStorage) ListBackupOrgs(ctx context.Context) ([]model.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupOrgs", ctx)
+	ret0, _ := ret[0].([]model.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupOrgs indicates an expected call of ListBackupOrgs.
+func (mr *MockBackupStorageMockRecorder) ListBackupOrgs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupOrgs", reflect.TypeOf((*MockBackupStorage)(nil).ListBackupOrgs), ctx)
+}
+
+// ListBackupShares mocks base method.
+func (m *MockBackupStorage) ListBackupShares(ctx context.Context, ownerID string) ([]model.Share, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupShares", ctx, ownerID)
+	ret0, _ := ret[0].([]model.Share)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupShares indicates an expected call of ListBackupShares.
+func (mr *MockBackupStorageMockRecorder) ListBackupShares(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupShares", reflect.TypeOf((*MockBackupStorage)(nil).ListBackupShares), ctx, ownerID)
+}
+
+// RestoreAuditEvent mocks base method.
+func (m *MockBackupStorage) RestoreAuditEvent(ctx context.Context, event *model.AuditEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAuditEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreAuditEvent indicates an expected call of RestoreAuditEvent.
+func (mr *MockBackupStorageMockRecorder) RestoreAuditEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAuditEvent", reflect.TypeOf((*MockBackupStorage)(nil).RestoreAuditEvent), ctx, event)
+}
+
+// RestoreAuditHead mocks base method.
+func (m *MockBackupStorage) RestoreAuditHead(ctx context.Context, userID string, head model.AuditHead) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreAuditHead", ctx, userID, head)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreAuditHead indicates an expected call of RestoreAuditHead.
+func (mr *MockBackupStorageMockRecorder) RestoreAuditHead(ctx, userID, head interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreAuditHead", reflect.TypeOf((*MockBackupStorage)(nil).RestoreAuditHead), ctx, userID, head)
+}
+
+// RestoreFolder mocks base method.
+func (m *MockBackupStorage) RestoreFolder(ctx context.Context, folder *model.Folder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFolder", ctx, folder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreFolder indicates an expected call of RestoreFolder.
+func (mr *MockBackupStorageMockRecorder) RestoreFolder(ctx, folder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFolder", reflect.TypeOf((*MockBackupStorage)(nil).RestoreFolder), ctx, folder)
+}
+
+// RestoreItem mocks base method.
+func (m *MockBackupStorage) RestoreItem(ctx context.Context, item *model.VaultItem, history []model.VaultItemRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreItem", ctx, item, history)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreItem indicates an expected call of RestoreItem.
+func (mr *MockBackupStorageMockRecorder) RestoreItem(ctx, item, history interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreItem", reflect.TypeOf((*MockBackupStorage)(nil).RestoreItem), ctx, item, history)
+}
+
+// RestoreOrg mocks base method.
+func (m *MockBackupStorage) RestoreOrg(ctx context.Context, org *model.Organization) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreOrg", ctx, org)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreOrg indicates an expected call of RestoreOrg.
+func (mr *MockBackupStorageMockRecorder) RestoreOrg(ctx, org interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreOrg", reflect.TypeOf((*MockBackupStorage)(nil).RestoreOrg), ctx, org)
+}
+
+// RestoreOrgMember mocks base method.
+func (m *MockBackupStorage) RestoreOrgMember(ctx context.Context, member *model.OrgMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreOrgMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreOrgMember indicates an expected call of RestoreOrgMember.
+func (mr *MockBackupStorageMockRecorder) RestoreOrgMember(ctx, member interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreOrgMember", reflect.TypeOf((*MockBackupStorage)(nil).RestoreOrgMember), ctx, member)
+}
+
+// RestoreShare mocks base method.
+func (m *MockBackupStorage) RestoreShare(ctx context.Context, share *model.Share) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreShare", ctx, share)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreShare indicates an expected call of RestoreShare.
+func (mr *MockBackupStorageMockRecorder) RestoreShare(ctx, share interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreShare", reflect.TypeOf((*MockBackupStorage)(nil).RestoreShare), ctx, share)
+}
+
+// RestoreTag mocks base method.
+func (m *MockBackupStorage) RestoreTag(ctx context.Context, tag *model.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTag", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreTag indicates an expected call of RestoreTag.
+func (mr *MockBackupStorageMockRecorder) RestoreTag(ctx, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTag", reflect.TypeOf((*MockBackupStorage)(nil).RestoreTag), ctx, tag)
+}
+
+// RestoreUser mocks base method.
+func (m *MockBackupStorage) RestoreUser(ctx context.Context, user *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockBackupStorageMockRecorder) RestoreUser(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockBackupStorage)(nil).RestoreUser), ctx, user)
 }
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// SetFavorite закрепляет данные в избранном или убирает их оттуда.
+func (pg *PGStorage) SetFavorite(ctx context.Context, id string, userID string, favorite bool) error {
+	res, err := pg.db.Exec(ctx,
+		`UPDATE user_data SET favorite = $1 WHERE id = $2 AND user_id = $3 AND deleted_at IS NULL AND `+notExpired+`;`,
+		favorite, id, userID,
+	)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return storage.ErrNoData
+	}
+	return nil
+}
+
+// RecordAccess записывает время получения данных и увеличивает счетчик получений.
+func (pg *PGStorage) RecordAccess(ctx context.Context, id string, userID string, at time.Time) error {
+	res, err := pg.db.Exec(ctx,
+		`UPDATE user_data SET last_accessed_at = $1, access_count = access_count + 1
+		WHERE id = $2 AND user_id = $3 AND deleted_at IS NULL AND `+notExpired+`;`,
+		at, id, userID,
+	)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return storage.ErrNoData
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// ListAttachments возвращает вложения данных пользователя (без самих данных) по времени создания.
+func (pg *PGStorage) ListAttachments(ctx context.Context, parentID string, userID string) ([]model.VaultItem, error) {
+	var items []model.VaultItem
+	rows, err := pg.reader(ctx).Query(ctx,
+		`SELECT id, encrypt_meta, COALESCE(meta::text, ''), data_type, revision, size, created_at, updated_at,
+		expires_at FROM user_data
+		WHERE parent_id = $1 AND user_id = $2 AND deleted_at IS NULL AND `+notExpired+` ORDER BY created_at, id;`,
+		parentID, userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item model.VaultItem
+		if err = rows.Scan(
+			&item.ID, &item.EncryptMeta, &item.Meta, &item.Type, &item.Revision, &item.Size, &item.CreatedAt,
+			&item.UpdatedAt, &item.ExpiresAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - attachment row: %w", err)
+		}
+		item.UserID = userID
+		item.ParentID = parentID
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	return items, nil
+}
+
+// checkParent проверяет, что к данным можно прикрепить вложение: они принадлежат пользователю,
+// не находятся в корзине и сами не являются вложением.
+func checkParent(ctx context.Context, q pgxQuerier, id string, userID string) error {
+	var found int
+	row := q.QueryRow(ctx,
+		`SELECT 1 FROM user_data WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL AND parent_id IS NULL;`,
+		id, userID,
+	)
+	if err := row.Scan(&found); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ErrNoData
+		}
+		return fmt.Errorf("failed to check parent item: %w", err)
+	}
+	return nil
+}
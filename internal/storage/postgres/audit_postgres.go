@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// AppendAuditEvent добавляет событие в журнал аудита пользователя.
+// Запись событий одного пользователя сериализуется блокировкой строки пользователя.
+func (pg *PGStorage) AppendAuditEvent(ctx context.Context, event *model.AuditEvent, key []byte) error {
+	return pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		// NO KEY UPDATE не блокирует вставку данных, ссылающихся на пользователя.
+		err := tx.QueryRow(ctx, `SELECT id FROM users WHERE id = $1 FOR NO KEY UPDATE;`, event.UserID).Scan(new(string))
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return storage.ErrNoUser
+		case err != nil:
+			return fmt.Errorf("failed to lock audit log: %w", err)
+		}
+		var last model.AuditEvent
+		err = tx.QueryRow(ctx,
+			`SELECT seq, hash FROM audit_log WHERE user_id = $1 ORDER BY seq DESC LIMIT 1;`,
+			event.UserID,
+		).Scan(&last.Seq, &last.Hash)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			event.Chain(nil, key)
+		case err != nil:
+			return fmt.Errorf("failed to get last audit event: %w", err)
+		default:
+			event.Chain(&last, key)
+		}
+		_, err = tx.Exec(ctx,
+			`INSERT INTO audit_log(user_id, seq, action, item_id, peer, code, created_at, prev_hash, hash)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9);`,
+			event.UserID, event.Seq, event.Action, event.ItemID, event.Peer, event.Code, event.CreatedAt,
+			event.PrevHash, event.Hash,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save audit event: %w", err)
+		}
+		_, err = tx.Exec(ctx,
+			`INSERT INTO audit_head(user_id, seq, tag) VALUES($1, $2, $3)
+			ON CONFLICT (user_id) DO UPDATE SET seq = EXCLUDED.seq, tag = EXCLUDED.tag;`,
+			event.UserID, event.Seq, event.HeadTag(key),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save audit head: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetAuditHead возвращает последнее событие журнала аудита пользователя.
+func (pg *PGStorage) GetAuditHead(ctx context.Context, userID string) (model.AuditHead, error) {
+	var head model.AuditHead
+	err := pg.db.QueryRow(ctx,
+		`SELECT COALESCE(h.seq, 0), h.tag FROM users u LEFT JOIN audit_head h ON h.user_id = u.id WHERE u.id = $1;`,
+		userID,
+	).Scan(&head.Seq, &head.Tag)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return model.AuditHead{}, storage.ErrNoUser
+	case err != nil:
+		return model.AuditHead{}, fmt.Errorf("failed to get audit head: %w", err)
+	}
+	return head, nil
+}
+
+// ListAuditEvents возвращает события журнала аудита пользователя в порядке их номеров.
+// События читаются с основного сервера, как и последнее событие журнала (см. GetAuditHead).
+func (pg *PGStorage) ListAuditEvents(
+	ctx context.Context, userID string, params model.ListAuditParams,
+) ([]model.AuditEvent, error) {
+	args := []any{userID, params.AfterSeq}
+	query := `SELECT seq, action, item_id, peer, code, created_at, prev_hash, hash FROM audit_log
+		WHERE user_id = $1 AND seq > $2 ORDER BY seq`
+	if params.Limit > 0 {
+		args = append(args, params.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	rows, err := pg.db.Query(ctx, query+";", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []model.AuditEvent
+	for rows.Next() {
+		event := model.AuditEvent{UserID: userID}
+		if err = rows.Scan(
+			&event.Seq, &event.Action, &event.ItemID, &event.Peer, &event.Code, &event.CreatedAt,
+			&event.PrevHash, &event.Hash,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - audit event row: %w", err)
+		}
+		event.CreatedAt = event.CreatedAt.UTC()
+		events = append(events, event)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get audit events: %w", err)
+	}
+	return events, nil
+}
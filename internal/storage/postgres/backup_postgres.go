@@ -0,0 +1,284 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// ListBackupOrgs возвращает все организации с ключами организаций в порядке создания.
+func (pg *PGStorage) ListBackupOrgs(ctx context.Context) ([]model.Organization, error) {
+	rows, err := pg.db.Query(ctx,
+		`SELECT o.id, o.encrypt_name, u.encrypt_secret, o.created_at FROM organizations o
+		JOIN users u ON u.id = o.id
+		ORDER BY o.created_at, o.id;`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	defer rows.Close()
+
+	var orgs []model.Organization
+	for rows.Next() {
+		var org model.Organization
+		if err = rows.Scan(&org.ID, &org.EncryptName, &org.EncryptedSecret, &org.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - organization row: %w", err)
+		}
+		orgs = append(orgs, org)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	return orgs, nil
+}
+
+// ListBackupItems возвращает все данные пользователя, включая данные в корзине и с истекшим сроком хранения.
+func (pg *PGStorage) ListBackupItems(ctx context.Context, userID string) ([]model.VaultItem, error) {
+	rows, err := pg.db.Query(ctx,
+		`SELECT id, encrypt_data, encrypt_meta, meta_index, COALESCE(meta::text, ''), data_type, revision, chunked,
+		size, created_at, updated_at, deleted_at, COALESCE(folder_id::text, ''), COALESCE(parent_id::text, ''),
+		expires_at, ARRAY(SELECT tag_id::text FROM item_tags WHERE item_id = user_data.id), favorite,
+		last_accessed_at, access_count, encrypt_key, shared_meta
+		FROM user_data WHERE user_id = $1 ORDER BY created_at, id;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []model.VaultItem
+	for rows.Next() {
+		var item model.VaultItem
+		if err = rows.Scan(
+			&item.ID, &item.EncryptData, &item.EncryptMeta, &item.MetaIndex, &item.Meta, &item.Type, &item.Revision,
+			&item.Chunked, &item.Size, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt, &item.FolderID,
+			&item.ParentID, &item.ExpiresAt, &item.TagIDs, &item.Favorite, &item.LastAccessedAt, &item.AccessCount,
+			&item.EncryptKey, &item.SharedMeta,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - item row: %w", err)
+		}
+		item.UserID = userID
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list items: %w", err)
+	}
+	return items, nil
+}
+
+// ListBackupHistory возвращает предыдущие версии данных вместе с самими данными в порядке номеров.
+func (pg *PGStorage) ListBackupHistory(ctx context.Context, itemID string) ([]model.VaultItemRevision, error) {
+	rows, err := pg.db.Query(ctx,
+		`SELECT revision, encrypt_data, encrypt_meta, meta_index, COALESCE(meta::text, ''), chunked, size,
+		shared_meta, created_at
+		FROM user_data_history WHERE item_id = $1 ORDER BY revision;`,
+		itemID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item history: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []model.VaultItemRevision
+	for rows.Next() {
+		rev := model.VaultItemRevision{ItemID: itemID}
+		if err = rows.Scan(
+			&rev.Revision, &rev.EncryptData, &rev.EncryptMeta, &rev.MetaIndex, &rev.Meta, &rev.Chunked, &rev.Size,
+			&rev.SharedMeta, &rev.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - revision row: %w", err)
+		}
+		revisions = append(revisions, rev)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get item history: %w", err)
+	}
+	return revisions, nil
+}
+
+// ListBackupShares возвращает доступы, предоставленные пользователем, включая доступы к данным в корзине.
+func (pg *PGStorage) ListBackupShares(ctx context.Context, ownerID string) ([]model.Share, error) {
+	rows, err := pg.db.Query(ctx,
+		`SELECT item_id, recipient_id, access, encrypt_key, created_at FROM item_shares
+		WHERE owner_id = $1 ORDER BY created_at, item_id, recipient_id;`,
+		ownerID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shares: %w", err)
+	}
+	defer rows.Close()
+
+	var shares []model.Share
+	for rows.Next() {
+		share := model.Share{OwnerID: ownerID}
+		if err = rows.Scan(
+			&share.ItemID, &share.RecipientID, &share.Access, &share.EncryptKey, &share.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - share row: %w", err)
+		}
+		shares = append(shares, share)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list shares: %w", err)
+	}
+	return shares, nil
+}
+
+// RestoreUser сохраняет пользователя или аккаунт организации с заданным id.
+func (pg *PGStorage) RestoreUser(ctx context.Context, user *model.User) error {
+	_, err := pg.db.Exec(ctx,
+		`INSERT INTO users(id, login, password_hash, encrypt_secret, public_key, encrypt_private_key)
+		VALUES($1, $2, $3, $4, $5, $6);`,
+		user.ID, user.Login, user.PasswordHash, user.EncryptedSecret, user.PublicKey, user.EncryptPrivateKey,
+	)
+	if err != nil {
+		var pgError *pgconn.PgError
+		if errors.As(err, &pgError) && pgError.Code == pgerrcode.UniqueViolation {
+			return storage.ErrLoginTaken
+		}
+		return fmt.Errorf("failed to restore user: %w", err)
+	}
+	return nil
+}
+
+// RestoreOrg сохраняет организацию, аккаунт которой уже сохранен.
+func (pg *PGStorage) RestoreOrg(ctx context.Context, org *model.Organization) error {
+	_, err := pg.db.Exec(ctx,
+		`INSERT INTO organizations(id, encrypt_name, created_at) VALUES($1, $2, $3);`,
+		org.ID, org.EncryptName, org.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore organization: %w", err)
+	}
+	return nil
+}
+
+// RestoreOrgMember сохраняет участника организации со временем его добавления.
+func (pg *PGStorage) RestoreOrgMember(ctx context.Context, member *model.OrgMember) error {
+	_, err := pg.db.Exec(ctx,
+		`INSERT INTO org_members(org_id, user_id, role, encrypt_key, created_at) VALUES($1, $2, $3, $4, $5);`,
+		member.OrgID, member.UserID, member.Role, member.EncryptKey, member.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore organization member: %w", err)
+	}
+	return nil
+}
+
+// RestoreFolder сохраняет папку с заданным id и временем создания.
+func (pg *PGStorage) RestoreFolder(ctx context.Context, folder *model.Folder) error {
+	_, err := pg.db.Exec(ctx,
+		`INSERT INTO folders(id, user_id, parent_id, encrypt_name, created_at)
+		VALUES($1, $2, NULLIF($3, '')::uuid, $4, $5);`,
+		folder.ID, folder.UserID, folder.ParentID, folder.EncryptName, folder.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore folder: %w", err)
+	}
+	return nil
+}
+
+// RestoreTag сохраняет метку с заданным id.
+func (pg *PGStorage) RestoreTag(ctx context.Context, tag *model.Tag) error {
+	_, err := pg.db.Exec(ctx,
+		`INSERT INTO tags(id, user_id, encrypt_name, name_index) VALUES($1, $2, $3, $4);`,
+		tag.ID, tag.UserID, tag.EncryptName, tag.NameIndex,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore tag: %w", err)
+	}
+	return nil
+}
+
+// RestoreItem сохраняет данные со всеми полями, метками и предыдущими версиями.
+func (pg *PGStorage) RestoreItem(ctx context.Context, item *model.VaultItem, history []model.VaultItemRevision) error {
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO user_data(
+				id, user_id, encrypt_data, encrypt_meta, meta_index, meta, data_type, revision, chunked, size,
+				created_at, updated_at, deleted_at, folder_id, parent_id, expires_at, favorite, last_accessed_at,
+				access_count, encrypt_key, shared_meta
+			)
+			VALUES($1, $2, $3, $4, $5, NULLIF($6, '')::jsonb, $7, $8, $9, $10, $11, $12, $13, NULLIF($14, '')::uuid,
+				NULLIF($15, '')::uuid, $16, $17, $18, $19, $20, $21
+			);`,
+			item.ID, item.UserID, item.EncryptData, item.EncryptMeta, metaIndex(item.MetaIndex), item.Meta,
+			item.Type, item.Revision, item.Chunked, item.Size, item.CreatedAt, item.UpdatedAt, item.DeletedAt,
+			item.FolderID, item.ParentID, item.ExpiresAt, item.Favorite, item.LastAccessedAt, item.AccessCount,
+			item.EncryptKey, item.SharedMeta,
+		)
+		if err != nil {
+			return err
+		}
+		for _, tagID := range item.TagIDs {
+			if _, err = tx.Exec(ctx,
+				`INSERT INTO item_tags(item_id, tag_id) VALUES($1, $2);`, item.ID, tagID,
+			); err != nil {
+				return err
+			}
+		}
+		for _, rev := range history {
+			if _, err = tx.Exec(ctx,
+				`INSERT INTO user_data_history(
+					item_id, revision, encrypt_data, encrypt_meta, meta_index, meta, chunked, size, shared_meta, created_at
+				)
+				VALUES($1, $2, $3, $4, $5, NULLIF($6, '')::jsonb, $7, $8, $9, $10);`,
+				item.ID, rev.Revision, rev.EncryptData, rev.EncryptMeta, metaIndex(rev.MetaIndex), rev.Meta,
+				rev.Chunked, rev.Size, rev.SharedMeta, rev.CreatedAt,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore item: %w", err)
+	}
+	return nil
+}
+
+// RestoreShare сохраняет доступ к данным со временем его предоставления.
+func (pg *PGStorage) RestoreShare(ctx context.Context, share *model.Share) error {
+	_, err := pg.db.Exec(ctx,
+		`INSERT INTO item_shares(item_id, owner_id, recipient_id, access, encrypt_key, created_at)
+		VALUES($1, $2, $3, $4, $5, $6);`,
+		share.ItemID, share.OwnerID, share.RecipientID, share.Access, share.EncryptKey, share.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore share: %w", err)
+	}
+	return nil
+}
+
+// RestoreAuditEvent сохраняет событие журнала аудита как есть.
+func (pg *PGStorage) RestoreAuditEvent(ctx context.Context, event *model.AuditEvent) error {
+	_, err := pg.db.Exec(ctx,
+		`INSERT INTO audit_log(user_id, seq, action, item_id, peer, code, created_at, prev_hash, hash)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9);`,
+		event.UserID, event.Seq, event.Action, event.ItemID, event.Peer, event.Code, event.CreatedAt,
+		event.PrevHash, event.Hash,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore audit event: %w", err)
+	}
+	return nil
+}
+
+// RestoreAuditHead сохраняет последнее событие журнала аудита пользователя.
+func (pg *PGStorage) RestoreAuditHead(ctx context.Context, userID string, head model.AuditHead) error {
+	_, err := pg.db.Exec(ctx,
+		`INSERT INTO audit_head(user_id, seq, tag) VALUES($1, $2, $3);`,
+		userID, head.Seq, head.Tag,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore audit head: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListChunkedData возвращает манифесты всех сохраненных блоками файлов пользователя,
+// включая данные в корзине и предыдущие версии.
+// Запрос всегда выполняется на основном сервере: по его результату удаляются неиспользуемые блоки,
+// и отстающая реплика привела бы к удалению блоков только что сохраненного файла.
+func (pg *PGStorage) ListChunkedData(ctx context.Context, userID string) ([][]byte, error) {
+	rows, err := pg.db.Query(ctx,
+		`SELECT encrypt_data FROM user_data WHERE user_id = $1 AND chunked
+		UNION ALL
+		SELECT h.encrypt_data FROM user_data_history h JOIN user_data d ON d.id = h.item_id
+		WHERE d.user_id = $1 AND h.chunked;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chunked data: %w", err)
+	}
+	defer rows.Close()
+
+	var manifests [][]byte
+	for rows.Next() {
+		var manifest []byte
+		if err = rows.Scan(&manifest); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - manifest row: %w", err)
+		}
+		manifests = append(manifests, manifest)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list chunked data: %w", err)
+	}
+	return manifests, nil
+}
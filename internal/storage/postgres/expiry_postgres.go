@@ -0,0 +1,15 @@
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+// notExpired условие отбора данных, срок хранения которых не истек.
+const notExpired = `(expires_at IS NULL OR expires_at > NOW())`
+
+// PurgeExpiredItems безвозвратно удаляет данные всех пользователей, срок хранения которых истек
+// к моменту now, вместе с их вложениями.
+func (pg *PGStorage) PurgeExpiredItems(ctx context.Context, now time.Time) (int64, error) {
+	return pg.purge(ctx, `(expires_at <= $1 OR parent_id IN (SELECT id FROM user_data WHERE expires_at <= $1))`, now)
+}
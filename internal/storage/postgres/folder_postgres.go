@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// CreateFolder сохраняет новую папку пользователя.
+func (pg *PGStorage) CreateFolder(ctx context.Context, userID string, folder *model.Folder) (string, error) {
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		if folder.ParentID != "" {
+			if err := checkFolder(ctx, tx, folder.ParentID, userID); err != nil {
+				return err
+			}
+		}
+		return tx.QueryRow(ctx,
+			`INSERT INTO folders(user_id, parent_id, encrypt_name) VALUES($1, NULLIF($2, '')::uuid, $3) RETURNING id;`,
+			userID, folder.ParentID, folder.EncryptName,
+		).Scan(&folder.ID)
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoFolder) {
+			return "", err
+		}
+		return "", fmt.Errorf("failed to create folder: %w", err)
+	}
+	return folder.ID, nil
+}
+
+// ListFolders возвращает папки пользователя в порядке их создания.
+func (pg *PGStorage) ListFolders(ctx context.Context, userID string) ([]model.Folder, error) {
+	rows, err := pg.reader(ctx).Query(ctx,
+		`SELECT id, COALESCE(parent_id::text, ''), encrypt_name, created_at FROM folders
+		WHERE user_id = $1 ORDER BY created_at, id;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list folders: %w", err)
+	}
+	defer rows.Close()
+
+	var folders []model.Folder
+	for rows.Next() {
+		var folder model.Folder
+		if err = rows.Scan(&folder.ID, &folder.ParentID, &folder.EncryptName, &folder.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - folder row: %w", err)
+		}
+		folder.UserID = userID
+		folders = append(folders, folder)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list folders: %w", err)
+	}
+	return folders, nil
+}
+
+// DeleteFolder удаляет папку, перемещая ее данные и вложенные папки в родительскую папку.
+func (pg *PGStorage) DeleteFolder(ctx context.Context, id string, userID string) error {
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		var parentID *string
+		err := tx.QueryRow(ctx,
+			"SELECT parent_id::text FROM folders WHERE id = $1 AND user_id = $2 FOR UPDATE;", id, userID,
+		).Scan(&parentID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return storage.ErrNoFolder
+			}
+			return err
+		}
+		if _, err = tx.Exec(ctx,
+			"UPDATE user_data SET folder_id = $1 WHERE folder_id = $2 AND user_id = $3;", parentID, id, userID,
+		); err != nil {
+			return err
+		}
+		if _, err = tx.Exec(ctx,
+			"UPDATE folders SET parent_id = $1 WHERE parent_id = $2 AND user_id = $3;", parentID, id, userID,
+		); err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, "DELETE FROM folders WHERE id = $1;", id)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoFolder) {
+			return err
+		}
+		return fmt.Errorf("failed to delete folder: %w", err)
+	}
+	return nil
+}
+
+// MoveItem перемещает данные в папку; пустой folderID - из папки на верхний уровень.
+func (pg *PGStorage) MoveItem(ctx context.Context, id string, userID string, folderID string) error {
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		if folderID != "" {
+			if err := checkFolder(ctx, tx, folderID, userID); err != nil {
+				return err
+			}
+		}
+		res, err := tx.Exec(ctx,
+			`UPDATE user_data SET folder_id = NULLIF($1, '')::uuid
+			WHERE id = $2 AND user_id = $3 AND deleted_at IS NULL;`,
+			folderID, id, userID,
+		)
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return storage.ErrNoData
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrNoFolder) {
+			return err
+		}
+		return fmt.Errorf("failed to move item: %w", err)
+	}
+	return nil
+}
+
+// TagItem добавляет данным метку, создавая ее, если у пользователя еще нет метки с таким индексом названия.
+func (pg *PGStorage) TagItem(ctx context.Context, id string, userID string, tag *model.Tag) error {
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		if err := checkItemOwner(ctx, tx, id, userID); err != nil {
+			return err
+		}
+		// Пустое обновление при конфликте нужно, чтобы RETURNING вернул id существующей метки.
+		if err := tx.QueryRow(ctx,
+			`INSERT INTO tags(user_id, encrypt_name, name_index) VALUES($1, $2, $3)
+			ON CONFLICT (user_id, name_index) DO UPDATE SET name_index = EXCLUDED.name_index RETURNING id;`,
+			userID, tag.EncryptName, tag.NameIndex,
+		).Scan(&tag.ID); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx,
+			"INSERT INTO item_tags(item_id, tag_id) VALUES($1, $2) ON CONFLICT DO NOTHING;", id, tag.ID,
+		)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) {
+			return err
+		}
+		return fmt.Errorf("failed to tag item: %w", err)
+	}
+	return nil
+}
+
+// UntagItem снимает метку с данных и удаляет метку, если она больше не используется.
+func (pg *PGStorage) UntagItem(ctx context.Context, id string, userID string, nameIndex string) error {
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		if err := checkItemOwner(ctx, tx, id, userID); err != nil {
+			return err
+		}
+		var tagID string
+		err := tx.QueryRow(ctx,
+			`DELETE FROM item_tags USING tags
+			WHERE item_tags.tag_id = tags.id AND item_tags.item_id = $1 AND tags.user_id = $2 AND tags.name_index = $3
+			RETURNING tags.id;`,
+			id, userID, nameIndex,
+		).Scan(&tagID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return storage.ErrNoTag
+			}
+			return err
+		}
+		_, err = tx.Exec(ctx,
+			"DELETE FROM tags WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM item_tags WHERE tag_id = $1);", tagID,
+		)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrNoTag) {
+			return err
+		}
+		return fmt.Errorf("failed to untag item: %w", err)
+	}
+	return nil
+}
+
+// ListTags возвращает метки пользователя с количеством данных (без данных в корзине) с каждой из них.
+func (pg *PGStorage) ListTags(ctx context.Context, userID string) ([]model.Tag, error) {
+	rows, err := pg.reader(ctx).Query(ctx,
+		`SELECT tags.id, tags.encrypt_name, tags.name_index, COUNT(user_data.id) FROM tags
+		LEFT JOIN item_tags ON item_tags.tag_id = tags.id
+		LEFT JOIN user_data ON user_data.id = item_tags.item_id AND user_data.deleted_at IS NULL
+		WHERE tags.user_id = $1
+		GROUP BY tags.id ORDER BY tags.id;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []model.Tag
+	for rows.Next() {
+		var tag model.Tag
+		if err = rows.Scan(&tag.ID, &tag.EncryptName, &tag.NameIndex, &tag.Items); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - tag row: %w", err)
+		}
+		tag.UserID = userID
+		tags = append(tags, tag)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return tags, nil
+}
+
+// checkFolder проверяет, что папка существует и принадлежит пользователю.
+func checkFolder(ctx context.Context, q pgxQuerier, id string, userID string) error {
+	var found int
+	row := q.QueryRow(ctx, `SELECT 1 FROM folders WHERE id = $1 AND user_id = $2;`, id, userID)
+	if err := row.Scan(&found); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ErrNoFolder
+		}
+		return fmt.Errorf("failed to check folder owner: %w", err)
+	}
+	return nil
+}
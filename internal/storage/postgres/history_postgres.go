@@ -0,0 +1,172 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// GetItemHistory возвращает список предыдущих версий данных (без самих данных).
+func (pg *PGStorage) GetItemHistory(ctx context.Context, id string, userID string) ([]model.VaultItemRevision, error) {
+	q := pg.reader(ctx)
+	if err := checkItemOwner(ctx, q, id, userID); err != nil {
+		return nil, err
+	}
+	rows, err := q.Query(ctx,
+		`SELECT revision, encrypt_meta, COALESCE(meta::text, ''), created_at FROM user_data_history
+		WHERE item_id = $1 ORDER BY revision;`,
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item history: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []model.VaultItemRevision
+	for rows.Next() {
+		revision := model.VaultItemRevision{ItemID: id}
+		if err = rows.Scan(&revision.Revision, &revision.EncryptMeta, &revision.Meta, &revision.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - revision row: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get item history: %w", err)
+	}
+	return revisions, nil
+}
+
+// GetItemRevision возвращает конкретную версию данных.
+func (pg *PGStorage) GetItemRevision(
+	ctx context.Context, id string, userID string, revision int64,
+) (*model.VaultItemRevision, error) {
+	q := pg.reader(ctx)
+	if err := checkItemOwner(ctx, q, id, userID); err != nil {
+		return nil, err
+	}
+	return getRevision(ctx, q, id, revision)
+}
+
+// RestoreItemRevision восстанавливает данные из указанной версии.
+// Текущие данные при этом сохраняются в истории как новая версия.
+func (pg *PGStorage) RestoreItemRevision(ctx context.Context, id string, userID string, revision int64) error {
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		if _, err := lockItem(ctx, tx, id, userID); err != nil {
+			return err
+		}
+		rev, err := getRevision(ctx, tx, id, revision)
+		if err != nil {
+			return err
+		}
+		return updateWithHistory(ctx, tx, id, userID, &model.VaultItem{
+			EncryptData: rev.EncryptData,
+			EncryptMeta: rev.EncryptMeta,
+			MetaIndex:   rev.MetaIndex,
+			Meta:        rev.Meta,
+			Chunked:     rev.Chunked,
+			Size:        rev.Size,
+			SharedMeta:  rev.SharedMeta,
+		})
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrNoRevision) {
+			return err
+		}
+		return fmt.Errorf("failed to restore item revision: %w", err)
+	}
+	return nil
+}
+
+// checkItemOwner проверяет, что данные существуют и принадлежат пользователю.
+// Отсутствие данных определяется по пустому результату, чтобы запрос к реплике повторялся на основном сервере.
+func checkItemOwner(ctx context.Context, q pgxQuerier, id string, userID string) error {
+	var found int
+	row := q.QueryRow(ctx,
+		`SELECT 1 FROM user_data WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL;`,
+		id, userID,
+	)
+	if err := row.Scan(&found); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ErrNoData
+		}
+		return fmt.Errorf("failed to check item owner: %w", err)
+	}
+	return nil
+}
+
+// getRevision возвращает версию данных по номеру.
+func getRevision(ctx context.Context, q pgxQuerier, id string, revision int64) (*model.VaultItemRevision, error) {
+	rev := model.VaultItemRevision{ItemID: id, Revision: revision}
+	row := q.QueryRow(ctx,
+		`SELECT encrypt_data, encrypt_meta, meta_index, COALESCE(meta::text, ''), chunked, size, shared_meta, created_at
+		FROM user_data_history WHERE item_id = $1 AND revision = $2;`,
+		id, revision,
+	)
+	if err := row.Scan(
+		&rev.EncryptData, &rev.EncryptMeta, &rev.MetaIndex, &rev.Meta, &rev.Chunked, &rev.Size, &rev.SharedMeta,
+		&rev.CreatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, storage.ErrNoRevision
+		}
+		return nil, fmt.Errorf("failed to get item revision: %w", err)
+	}
+	return &rev, nil
+}
+
+// lockItem блокирует строку данных до конца транзакции и возвращает текущий номер версии.
+func lockItem(ctx context.Context, tx pgx.Tx, id string, userID string) (int64, error) {
+	var revision int64
+	row := tx.QueryRow(ctx,
+		`SELECT revision FROM user_data WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL FOR UPDATE;`,
+		id, userID,
+	)
+	if err := row.Scan(&revision); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, storage.ErrNoData
+		}
+		return 0, fmt.Errorf("failed to lock item: %w", err)
+	}
+	return revision, nil
+}
+
+// updateWithHistory переносит текущие данные в историю под их номером версии,
+// записывает новые и проставляет в item новый номер версии.
+// При ненулевом item.Revision обновление выполняется, только если это текущая версия данных.
+// Должна вызываться внутри транзакции.
+func updateWithHistory(ctx context.Context, tx pgx.Tx, id string, userID string, item *model.VaultItem) error {
+	revision, err := lockItem(ctx, tx, id, userID)
+	if err != nil {
+		return err
+	}
+	if item.Revision != 0 && item.Revision != revision {
+		return storage.ErrConflict
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO user_data_history(
+			item_id, revision, encrypt_data, encrypt_meta, meta_index, meta, chunked, size, shared_meta, created_at
+		)
+		SELECT id, revision, encrypt_data, encrypt_meta, meta_index, meta, chunked, size, shared_meta, updated_at
+		FROM user_data WHERE id = $1;`,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save item revision: %w", err)
+	}
+	row := tx.QueryRow(ctx,
+		`UPDATE user_data SET encrypt_data = $1, encrypt_meta = $2, meta_index = $3, meta = NULLIF($4, '')::jsonb,
+		chunked = $5, size = $6, shared_meta = $7, revision = revision + 1, updated_at = NOW(),
+		expires_at = COALESCE($8::timestamptz, expires_at)
+		WHERE id = $9 RETURNING revision;`,
+		item.EncryptData, item.EncryptMeta, metaIndex(item.MetaIndex), item.Meta, item.Chunked, item.Size,
+		item.SharedMeta, item.ExpiresAt, id,
+	)
+	if err = row.Scan(&item.Revision); err != nil {
+		return fmt.Errorf("failed to update item: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// listColumns колонки списка данных: без самих данных, с папкой, id меток и статистикой получений.
+const listColumns = `id, encrypt_meta, COALESCE(meta::text, ''), data_type, revision, created_at, updated_at,
+	COALESCE(folder_id::text, ''), ARRAY(SELECT tag_id::text FROM item_tags WHERE item_id = user_data.id), expires_at,
+	favorite, last_accessed_at, access_count`
+
+// accessedSort выражение сортировки по времени последнего получения: данные, которые не получались,
+// сравниваются по нулевому времени, как и в позиции списка (model.ItemsCursor).
+const accessedSort = `COALESCE(last_accessed_at, '0001-01-01 00:00:00+00'::timestamptz)`
+
+// ListItems возвращает страницу списка данных пользователя (без самих данных).
+func (pg *PGStorage) ListItems(
+	ctx context.Context, userID string, params model.ListItemsParams,
+) ([]model.VaultItem, error) {
+	query, args := listItemsQuery(userID, params)
+	rows, err := pg.reader(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []model.VaultItem
+	for rows.Next() {
+		var item model.VaultItem
+		if err = rows.Scan(
+			&item.ID, &item.EncryptMeta, &item.Meta, &item.Type, &item.Revision, &item.CreatedAt, &item.UpdatedAt,
+			&item.FolderID, &item.TagIDs, &item.ExpiresAt, &item.Favorite, &item.LastAccessedAt, &item.AccessCount,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - item row: %w", err)
+		}
+		item.UserID = userID
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list items: %w", err)
+	}
+	return items, nil
+}
+
+// listItemsQuery формирует запрос выборки списка данных с keyset пагинацией.
+// Мета данные зашифрованы, поэтому при сортировке по ним данные упорядочиваются по времени создания.
+// При params.FavoritesFirst избранные данные идут в начале списка.
+func listItemsQuery(userID string, params model.ListItemsParams) (string, []any) {
+	args := []any{userID}
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	sortExpr := "created_at"
+	switch params.SortBy {
+	case model.SortByUpdated:
+		sortExpr = "updated_at"
+	case model.SortByAccessed:
+		sortExpr = accessedSort
+	}
+
+	var query strings.Builder
+	query.WriteString(`SELECT ` + listColumns + `
+		FROM user_data
+		WHERE user_id = $1 AND deleted_at IS NULL AND ` + notExpired)
+	if params.Type != "" {
+		fmt.Fprintf(&query, " AND data_type = %s", arg(params.Type))
+	}
+	if params.FolderID != "" {
+		fmt.Fprintf(&query, " AND folder_id = %s", arg(params.FolderID))
+	}
+	if params.TagIndex != "" {
+		fmt.Fprintf(&query, ` AND EXISTS (SELECT 1 FROM item_tags JOIN tags ON tags.id = item_tags.tag_id
+			WHERE item_tags.item_id = user_data.id AND tags.name_index = %s)`, arg(params.TagIndex))
+	}
+
+	order, cmp := "ASC", ">"
+	if params.Desc {
+		order, cmp = "DESC", "<"
+	}
+	if params.After != nil {
+		after := fmt.Sprintf("(%s, id) %s (%s, %s)", sortExpr, cmp, arg(params.After.Time), arg(params.After.ID))
+		if params.FavoritesFirst {
+			// Избранные данные идут первыми, поэтому после избранных следуют только неизбранные.
+			favorite := arg(params.After.Favorite)
+			after = fmt.Sprintf("(favorite < %s OR favorite = %s AND %s)", favorite, favorite, after)
+		}
+		query.WriteString(" AND " + after)
+	}
+	query.WriteString(" ORDER BY ")
+	if params.FavoritesFirst {
+		query.WriteString("favorite DESC, ")
+	}
+	fmt.Fprintf(&query, "%s %s, id %s", sortExpr, order, order)
+	if params.Limit > 0 {
+		fmt.Fprintf(&query, " LIMIT %s", arg(params.Limit))
+	}
+	return query.String() + ";", args
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// metaIndex возвращает слепые индексы для записи в колонку meta_index (NOT NULL).
+func metaIndex(index []string) []string {
+	if index == nil {
+		return []string{}
+	}
+	return index
+}
+
+// ListLegacyMeta возвращает мета данные текущих и предыдущих версий, сохраненные в открытом виде.
+func (pg *PGStorage) ListLegacyMeta(ctx context.Context, limit int) ([]model.LegacyMeta, error) {
+	query := `SELECT user_id, id, 0, meta::text FROM user_data WHERE encrypt_meta IS NULL AND meta IS NOT NULL
+		UNION ALL
+		SELECT d.user_id, h.item_id, h.revision, h.meta::text FROM user_data_history h JOIN user_data d ON d.id = h.item_id
+		WHERE h.encrypt_meta IS NULL AND h.meta IS NOT NULL`
+	var args []any
+	if limit > 0 {
+		query += " LIMIT $1"
+		args = append(args, limit)
+	}
+	rows, err := pg.db.Query(ctx, query+";", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list legacy meta: %w", err)
+	}
+	defer rows.Close()
+
+	var metas []model.LegacyMeta
+	for rows.Next() {
+		var meta model.LegacyMeta
+		if err = rows.Scan(&meta.UserID, &meta.ItemID, &meta.Revision, &meta.Meta); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - meta row: %w", err)
+		}
+		metas = append(metas, meta)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list legacy meta: %w", err)
+	}
+	return metas, nil
+}
+
+// EncryptLegacyMeta заменяет сохраненные в открытом виде мета данные версии зашифрованными.
+func (pg *PGStorage) EncryptLegacyMeta(ctx context.Context, meta model.LegacyMeta) error {
+	var err error
+	if meta.Revision == 0 {
+		_, err = pg.db.Exec(ctx,
+			`UPDATE user_data SET encrypt_meta = $1, meta_index = $2, meta = NULL
+			WHERE id = $3 AND encrypt_meta IS NULL;`,
+			meta.EncryptMeta, metaIndex(meta.MetaIndex), meta.ItemID,
+		)
+	} else {
+		_, err = pg.db.Exec(ctx,
+			`UPDATE user_data_history SET encrypt_meta = $1, meta_index = $2, meta = NULL
+			WHERE item_id = $3 AND revision = $4 AND encrypt_meta IS NULL;`,
+			meta.EncryptMeta, metaIndex(meta.MetaIndex), meta.ItemID, meta.Revision,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encrypt legacy meta: %w", err)
+	}
+	return nil
+}
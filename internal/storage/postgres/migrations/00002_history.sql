@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_data_history (
+  item_id UUID NOT NULL REFERENCES user_data (id) ON DELETE CASCADE,
+  revision BIGINT NOT NULL,
+  encrypt_data BYTEA NOT NULL,
+  meta JSONB NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (item_id, revision)
+);
+COMMENT ON TABLE user_data_history IS 'Предыдущие версии данных пользователей';
+COMMENT ON COLUMN user_data_history.revision IS 'Номер версии (последовательный в рамках записи)';
+COMMENT ON COLUMN user_data_history.encrypt_data IS 'Зашифрованные данные версии';
+COMMENT ON COLUMN user_data_history.meta IS 'Мета информация версии';
+COMMENT ON COLUMN user_data_history.created_at IS 'Timestamp сохранения данных этой версии';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_data_history;
+-- +goose StatementEnd
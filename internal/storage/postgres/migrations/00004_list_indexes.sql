@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX user_data_user_created_idx ON user_data (user_id, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX user_data_user_updated_idx ON user_data (user_id, updated_at, id) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX user_data_user_updated_idx;
+DROP INDEX user_data_user_created_idx;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+ALTER TABLE user_data ADD COLUMN search_text TEXT GENERATED ALWAYS AS (
+  lower(
+    COALESCE(meta->>'resource', '') || chr(31) ||
+    COALESCE(meta->>'login', '') || chr(31) ||
+    COALESCE(meta->>'name', '') || chr(31) ||
+    COALESCE(meta->>'bank', '') || chr(31) ||
+    COALESCE(meta->>'comment', '')
+  )
+) STORED;
+COMMENT ON COLUMN user_data.search_text IS 'Поля мета информации для поиска (в нижнем регистре, разделены символом chr(31))';
+CREATE INDEX user_data_search_text_idx ON user_data USING GIN (search_text gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX user_data_search_text_idx;
+ALTER TABLE user_data DROP COLUMN search_text;
+-- +goose StatementEnd
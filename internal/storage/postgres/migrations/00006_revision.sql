@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN revision BIGINT NOT NULL DEFAULT 1;
+COMMENT ON COLUMN user_data.revision IS 'Номер текущей версии данных (увеличивается при каждом изменении)';
+UPDATE user_data d SET revision = h.last_revision + 1
+FROM (SELECT item_id, MAX(revision) AS last_revision FROM user_data_history GROUP BY item_id) h
+WHERE h.item_id = d.id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_data DROP COLUMN revision;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN chunked BOOLEAN NOT NULL DEFAULT FALSE;
+COMMENT ON COLUMN user_data.chunked IS 'encrypt_data содержит манифест файла, сохраненного блоками в хранилище блоков';
+ALTER TABLE user_data_history ADD COLUMN chunked BOOLEAN NOT NULL DEFAULT FALSE;
+COMMENT ON COLUMN user_data_history.chunked IS 'encrypt_data версии содержит манифест файла в хранилище блоков';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_data_history DROP COLUMN chunked;
+ALTER TABLE user_data DROP COLUMN chunked;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN size BIGINT NOT NULL DEFAULT 0;
+COMMENT ON COLUMN user_data.size IS 'Размер незашифрованных данных (для файла, сохраненного блоками, - размер файла)';
+ALTER TABLE user_data_history ADD COLUMN size BIGINT NOT NULL DEFAULT 0;
+COMMENT ON COLUMN user_data_history.size IS 'Размер незашифрованных данных версии';
+-- Размер сохраненных целиком данных восстанавливается по размеру шифротекста (AES-GCM: nonce 12 байт + тег 16 байт).
+UPDATE user_data SET size = GREATEST(octet_length(encrypt_data) - 28, 0) WHERE NOT chunked;
+UPDATE user_data_history SET size = GREATEST(octet_length(encrypt_data) - 28, 0) WHERE NOT chunked;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_data_history DROP COLUMN size;
+ALTER TABLE user_data DROP COLUMN size;
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_log (
+  user_id VARCHAR NOT NULL,
+  seq BIGINT NOT NULL,
+  action VARCHAR NOT NULL,
+  item_id VARCHAR NOT NULL,
+  peer VARCHAR NOT NULL,
+  code VARCHAR NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  prev_hash BYTEA NOT NULL,
+  hash BYTEA NOT NULL,
+  PRIMARY KEY (user_id, seq)
+);
+COMMENT ON COLUMN audit_log.user_id IS 'Пользователь, выполнивший запрос';
+COMMENT ON COLUMN audit_log.seq IS 'Порядковый номер события в журнале пользователя';
+COMMENT ON COLUMN audit_log.action IS 'Вызванный метод сервера';
+COMMENT ON COLUMN audit_log.item_id IS 'Данные, к которым относится запрос';
+COMMENT ON COLUMN audit_log.peer IS 'Адрес клиента';
+COMMENT ON COLUMN audit_log.code IS 'Код результата выполнения запроса';
+COMMENT ON COLUMN audit_log.created_at IS 'Timestamp события';
+COMMENT ON COLUMN audit_log.prev_hash IS 'HMAC предыдущего события пользователя';
+COMMENT ON COLUMN audit_log.hash IS 'HMAC события на ключе журнала';
+
+CREATE TABLE audit_head (
+  user_id UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+  seq BIGINT NOT NULL,
+  tag BYTEA NOT NULL
+);
+COMMENT ON TABLE audit_head IS 'Последнее событие журнала аудита пользователя';
+COMMENT ON COLUMN audit_head.seq IS 'Номер последнего события';
+COMMENT ON COLUMN audit_head.tag IS 'Отметка последнего события на ключе журнала';
+
+-- Журнал только дополняется.
+CREATE FUNCTION audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'audit log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only BEFORE UPDATE OR DELETE ON audit_log
+  FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_head;
+DROP TABLE audit_log;
+DROP FUNCTION audit_log_append_only();
+-- +goose StatementEnd
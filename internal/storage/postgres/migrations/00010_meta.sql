@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN encrypt_meta BYTEA;
+COMMENT ON COLUMN user_data.encrypt_meta IS 'Зашифрованные мета данные (NULL - мета данные в meta в открытом виде)';
+ALTER TABLE user_data ADD COLUMN meta_index TEXT[] NOT NULL DEFAULT '{}';
+COMMENT ON COLUMN user_data.meta_index IS 'Слепые индексы полей мета данных для поиска по точному совпадению';
+ALTER TABLE user_data ALTER COLUMN meta DROP NOT NULL;
+COMMENT ON COLUMN user_data.meta IS 'Мета информация о данных, сохраненная до включения ее шифрования';
+CREATE INDEX user_data_meta_index_idx ON user_data USING GIN (meta_index);
+
+ALTER TABLE user_data_history ADD COLUMN encrypt_meta BYTEA;
+COMMENT ON COLUMN user_data_history.encrypt_meta IS 'Зашифрованные мета данные версии';
+ALTER TABLE user_data_history ADD COLUMN meta_index TEXT[] NOT NULL DEFAULT '{}';
+COMMENT ON COLUMN user_data_history.meta_index IS 'Слепые индексы полей мета данных версии';
+ALTER TABLE user_data_history ALTER COLUMN meta DROP NOT NULL;
+
+-- Колонка поиска по подстроке хранит поля мета данных в открытом виде.
+DROP INDEX user_data_search_text_idx;
+ALTER TABLE user_data DROP COLUMN search_text;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Зашифрованные мета данные средствами БД не расшифровать, поэтому миграция необратима.
+DO $$
+BEGIN
+  RAISE EXCEPTION 'migration 00010_meta is irreversible: encrypted metadata cannot be decrypted by the database';
+END
+$$;
+-- +goose StatementEnd
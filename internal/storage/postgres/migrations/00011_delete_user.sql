@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Данные удаляются вместе с пользователем (история данных удаляется каскадно вместе с ними).
+ALTER TABLE user_data DROP CONSTRAINT user_data_user_id_fkey;
+ALTER TABLE user_data ADD CONSTRAINT user_data_user_id_fkey
+  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+
+-- Журнал только дополняется, но журнал удаленного пользователя удаляется вместе с ним.
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'DELETE' AND NOT EXISTS (SELECT 1 FROM users WHERE id::text = OLD.user_id) THEN
+    RETURN OLD;
+  END IF;
+  RAISE EXCEPTION 'audit log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'audit log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE user_data DROP CONSTRAINT user_data_user_id_fkey;
+ALTER TABLE user_data ADD CONSTRAINT user_data_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id);
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE folders (
+  id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  parent_id UUID REFERENCES folders (id) ON DELETE CASCADE,
+  encrypt_name BYTEA NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+COMMENT ON COLUMN folders.parent_id IS 'Родительская папка (NULL - папка верхнего уровня)';
+COMMENT ON COLUMN folders.encrypt_name IS 'Зашифрованное название папки';
+COMMENT ON COLUMN folders.created_at IS 'Timestamp создания папки';
+
+CREATE INDEX folders_user_id_idx ON folders (user_id, created_at);
+
+CREATE TABLE tags (
+  id UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  encrypt_name BYTEA NOT NULL,
+  name_index VARCHAR NOT NULL,
+  UNIQUE (user_id, name_index)
+);
+COMMENT ON COLUMN tags.encrypt_name IS 'Зашифрованное название метки';
+COMMENT ON COLUMN tags.name_index IS 'Слепой индекс названия метки';
+
+CREATE TABLE item_tags (
+  item_id UUID NOT NULL REFERENCES user_data (id) ON DELETE CASCADE,
+  tag_id UUID NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+  PRIMARY KEY (item_id, tag_id)
+);
+
+CREATE INDEX item_tags_tag_id_idx ON item_tags (tag_id);
+
+ALTER TABLE user_data ADD COLUMN folder_id UUID REFERENCES folders (id) ON DELETE SET NULL;
+COMMENT ON COLUMN user_data.folder_id IS 'Папка данных (NULL - данные не в папке)';
+
+CREATE INDEX user_data_folder_id_idx ON user_data (folder_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_data DROP COLUMN folder_id;
+DROP TABLE item_tags;
+DROP TABLE tags;
+DROP TABLE folders;
+-- +goose StatementEnd
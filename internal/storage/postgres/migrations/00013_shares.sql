@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN public_key BYTEA;
+ALTER TABLE users ADD COLUMN encrypt_private_key BYTEA;
+COMMENT ON COLUMN users.public_key IS 'Открытый ключ X25519 пользователя (NULL - ключи еще не созданы)';
+COMMENT ON COLUMN users.encrypt_private_key IS 'Закрытый ключ X25519, зашифрованный ключом пользователя';
+
+ALTER TABLE user_data ADD COLUMN encrypt_key BYTEA;
+ALTER TABLE user_data ADD COLUMN shared_meta BYTEA;
+ALTER TABLE user_data_history ADD COLUMN shared_meta BYTEA;
+COMMENT ON COLUMN user_data.encrypt_key IS
+  'Собственный ключ данных, зашифрованный ключом владельца (NULL - данные зашифрованы ключом владельца)';
+COMMENT ON COLUMN user_data.shared_meta IS 'Мета данные, зашифрованные ключом данных';
+COMMENT ON COLUMN user_data_history.shared_meta IS 'Мета данные версии, зашифрованные ключом данных';
+
+CREATE TABLE item_shares (
+  item_id UUID NOT NULL REFERENCES user_data (id) ON DELETE CASCADE,
+  owner_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  recipient_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  access VARCHAR NOT NULL,
+  encrypt_key BYTEA NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (item_id, recipient_id)
+);
+COMMENT ON COLUMN item_shares.access IS 'Уровень доступа (READ, WRITE)';
+COMMENT ON COLUMN item_shares.encrypt_key IS 'Ключ данных, зашифрованный открытым ключом получателя';
+COMMENT ON COLUMN item_shares.created_at IS 'Timestamp предоставления доступа';
+
+CREATE INDEX item_shares_owner_id_idx ON item_shares (owner_id);
+CREATE INDEX item_shares_recipient_id_idx ON item_shares (recipient_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE item_shares;
+ALTER TABLE user_data_history DROP COLUMN shared_meta;
+ALTER TABLE user_data DROP COLUMN shared_meta;
+ALTER TABLE user_data DROP COLUMN encrypt_key;
+ALTER TABLE users DROP COLUMN encrypt_private_key;
+ALTER TABLE users DROP COLUMN public_key;
+-- +goose StatementEnd
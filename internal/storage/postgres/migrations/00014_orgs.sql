@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE organizations (
+  id UUID PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+  encrypt_name BYTEA NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+COMMENT ON TABLE organizations IS 'Организации; данными организации владеет ее аккаунт в users с тем же id';
+COMMENT ON COLUMN organizations.encrypt_name IS 'Название, зашифрованное ключом организации';
+COMMENT ON COLUMN organizations.created_at IS 'Timestamp создания организации';
+
+CREATE TABLE org_members (
+  org_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  role VARCHAR NOT NULL,
+  encrypt_key BYTEA NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (org_id, user_id)
+);
+COMMENT ON COLUMN org_members.role IS 'Роль участника (OWNER, ADMIN, MEMBER, READER)';
+COMMENT ON COLUMN org_members.encrypt_key IS 'Ключ организации, зашифрованный открытым ключом участника';
+COMMENT ON COLUMN org_members.created_at IS 'Timestamp добавления участника';
+
+CREATE INDEX org_members_user_id_idx ON org_members (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE org_members;
+DROP TABLE organizations;
+-- +goose StatementEnd
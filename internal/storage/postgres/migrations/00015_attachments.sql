@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN parent_id UUID REFERENCES user_data (id) ON DELETE CASCADE;
+COMMENT ON COLUMN user_data.parent_id IS 'Данные, к которым прикреплено вложение (NULL - данные не являются вложением)';
+
+CREATE INDEX user_data_parent_id_idx ON user_data (parent_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_data DROP COLUMN parent_id;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN expires_at TIMESTAMPTZ;
+COMMENT ON COLUMN user_data.expires_at IS 'Время, после которого данные удаляются (NULL - данные хранятся бессрочно)';
+CREATE INDEX user_data_expires_at_idx ON user_data (expires_at) WHERE expires_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX user_data_expires_at_idx;
+ALTER TABLE user_data DROP COLUMN expires_at;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN favorite BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE user_data ADD COLUMN last_accessed_at TIMESTAMPTZ;
+ALTER TABLE user_data ADD COLUMN access_count BIGINT NOT NULL DEFAULT 0;
+COMMENT ON COLUMN user_data.favorite IS 'Данные закреплены в избранном';
+COMMENT ON COLUMN user_data.last_accessed_at IS 'Время последнего получения данных (NULL - данные не получались)';
+COMMENT ON COLUMN user_data.access_count IS 'Количество получений данных';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_data DROP COLUMN access_count;
+ALTER TABLE user_data DROP COLUMN last_accessed_at;
+ALTER TABLE user_data DROP COLUMN favorite;
+-- +goose StatementEnd
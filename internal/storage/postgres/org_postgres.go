@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// CreateOrg создает организацию с ее аккаунтом и первым участником.
+func (pg *PGStorage) CreateOrg(ctx context.Context, org *model.Organization, owner *model.OrgMember) (string, error) {
+	id := uuid.NewString()
+	var createdAt time.Time
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO users(id, login, password_hash, encrypt_secret) VALUES($1, $2, '', $3);`,
+			id, model.OrgLoginPrefix+id, org.EncryptedSecret,
+		)
+		if err != nil {
+			return err
+		}
+		row := tx.QueryRow(ctx,
+			`INSERT INTO organizations(id, encrypt_name) VALUES($1, $2) RETURNING created_at;`,
+			id, org.EncryptName,
+		)
+		if err = row.Scan(&createdAt); err != nil {
+			return err
+		}
+		res, err := tx.Exec(ctx,
+			`INSERT INTO org_members(org_id, user_id, role, encrypt_key, created_at)
+			SELECT $1, id, $3, $4, $5 FROM users WHERE id = $2;`,
+			id, owner.UserID, owner.Role, owner.EncryptKey, createdAt,
+		)
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return storage.ErrNoUser
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoUser) {
+			return "", err
+		}
+		return "", fmt.Errorf("failed to create organization: %w", err)
+	}
+	org.ID, org.CreatedAt = id, createdAt
+	return id, nil
+}
+
+// AddOrgMember добавляет участника организации.
+func (pg *PGStorage) AddOrgMember(ctx context.Context, member *model.OrgMember) error {
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		var exists bool
+		row := tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM organizations WHERE id = $1);`, member.OrgID)
+		if err := row.Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return storage.ErrNoOrg
+		}
+		res, err := tx.Exec(ctx,
+			`INSERT INTO org_members(org_id, user_id, role, encrypt_key)
+			SELECT $1, id, $3, $4 FROM users WHERE id = $2;`,
+			member.OrgID, member.UserID, member.Role, member.EncryptKey,
+		)
+		if err != nil {
+			var pgError *pgconn.PgError
+			if errors.As(err, &pgError) && pgError.Code == pgerrcode.UniqueViolation {
+				return storage.ErrIsMember
+			}
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return storage.ErrNoUser
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoOrg) || errors.Is(err, storage.ErrNoUser) || errors.Is(err, storage.ErrIsMember) {
+			return err
+		}
+		return fmt.Errorf("failed to add organization member: %w", err)
+	}
+	return nil
+}
+
+// GetOrgMember возвращает участника организации.
+// Читает с основного сервера: от участия зависит доступ к данным организации.
+func (pg *PGStorage) GetOrgMember(ctx context.Context, orgID string, userID string) (*model.OrgMember, error) {
+	member := model.OrgMember{OrgID: orgID, UserID: userID}
+	row := pg.db.QueryRow(ctx,
+		`SELECT role, encrypt_key, created_at FROM org_members WHERE org_id = $1 AND user_id = $2;`,
+		orgID, userID,
+	)
+	if err := row.Scan(&member.Role, &member.EncryptKey, &member.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, storage.ErrNoMember
+		}
+		return nil, fmt.Errorf("failed to get organization member: %w", err)
+	}
+	return &member, nil
+}
+
+// SetOrgMemberRole меняет роль участника организации.
+func (pg *PGStorage) SetOrgMemberRole(ctx context.Context, orgID string, userID string, role model.OrgRole) error {
+	res, err := pg.db.Exec(ctx,
+		`UPDATE org_members SET role = $1 WHERE org_id = $2 AND user_id = $3;`,
+		role, orgID, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set organization member role: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return storage.ErrNoMember
+	}
+	return nil
+}
+
+// RemoveOrgMember удаляет участника организации.
+func (pg *PGStorage) RemoveOrgMember(ctx context.Context, orgID string, userID string) error {
+	res, err := pg.db.Exec(ctx, `DELETE FROM org_members WHERE org_id = $1 AND user_id = $2;`, orgID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return storage.ErrNoMember
+	}
+	return nil
+}
+
+// ListOrgMembers возвращает участников организации в порядке добавления.
+func (pg *PGStorage) ListOrgMembers(ctx context.Context, orgID string) ([]model.OrgMember, error) {
+	rows, err := pg.reader(ctx).Query(ctx,
+		`SELECT m.user_id, u.login, m.role, m.encrypt_key, m.created_at
+		FROM org_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.org_id = $1
+		ORDER BY m.created_at, m.user_id;`,
+		orgID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []model.OrgMember
+	for rows.Next() {
+		member := model.OrgMember{OrgID: orgID}
+		if err = rows.Scan(
+			&member.UserID, &member.Login, &member.Role, &member.EncryptKey, &member.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - organization member row: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	return members, nil
+}
+
+// ListUserOrgs возвращает участие пользователя в организациях в порядке добавления.
+func (pg *PGStorage) ListUserOrgs(ctx context.Context, userID string) ([]model.OrgMember, error) {
+	rows, err := pg.reader(ctx).Query(ctx,
+		`SELECT m.org_id, m.role, m.encrypt_key, m.created_at, o.encrypt_name
+		FROM org_members m
+		JOIN organizations o ON o.id = m.org_id
+		WHERE m.user_id = $1
+		ORDER BY m.created_at, m.org_id;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user organizations: %w", err)
+	}
+	defer rows.Close()
+
+	var members []model.OrgMember
+	for rows.Next() {
+		member := model.OrgMember{UserID: userID}
+		if err = rows.Scan(
+			&member.OrgID, &member.Role, &member.EncryptKey, &member.CreatedAt, &member.EncryptOrgName,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - organization member row: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list user organizations: %w", err)
+	}
+	return members, nil
+}
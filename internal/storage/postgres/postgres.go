@@ -2,40 +2,88 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	_ "github.com/jackc/pgx/v5/stdlib" // Драйвер database/sql для миграций.
 	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/migrate"
 	"github.com/pinbrain/gophkeeper/internal/storage/postgres/migrations"
 	"github.com/pressly/goose/v3"
 	"github.com/sirupsen/logrus"
 )
 
 // PGStorage описывает структуру хранилища БД.
+// Хранилище, созданное WithTx, выполняет все запросы в транзакции и не владеет пулом соединений.
+// Запросы на чтение вне транзакции направляются на реплики, если они настроены и доступны.
 type PGStorage struct {
-	pool *pgxpool.Pool
-	log  *logrus.Entry
+	pool     *pgxpool.Pool // Пул соединений, nil у хранилища внутри транзакции.
+	db       pgxDB         // Пул соединений или транзакция, через которые выполняются запросы.
+	replicas *replicaSet   // Реплики для запросов на чтение, nil - реплики не используются.
+	log      *logrus.Entry
+}
+
+// pgxQuerier описывает общие методы пула соединений и транзакции.
+type pgxQuerier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// pgxDB описывает пул соединений или транзакцию, в которой можно начать вложенную транзакцию.
+// Вложенная транзакция pgx выполняется через SAVEPOINT.
+type pgxDB interface {
+	pgxQuerier
+	Begin(ctx context.Context) (pgx.Tx, error)
 }
 
 // NewStorage создает и возвращает новое хранилище.
-func NewStorage(ctx context.Context, dsn string, logger *logrus.Logger) (storage.Storage, error) {
+// Запросы на чтение распределяются между репликами replicaDSNs (пустой список - реплики не используются).
+// При autoMigrate на старте применяются новые миграции БД.
+func NewStorage(
+	ctx context.Context, dsn string, replicaDSNs []string, autoMigrate bool, logger *logrus.Logger,
+) (storage.Storage, error) {
 	log := logger.WithField("instance", "pgStorage")
-	if err := runMigrations(dsn, log); err != nil {
+	if err := runMigrations(ctx, dsn, autoMigrate, log); err != nil {
 		return nil, fmt.Errorf("failed to run db migration: %w", err)
 	}
 	pool, err := initPool(ctx, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialized a db connection: %w", err)
 	}
-	return &PGStorage{pool: pool, log: log}, nil
+	pg := &PGStorage{pool: pool, db: pool, log: log}
+	if len(replicaDSNs) > 0 {
+		if pg.replicas, err = newReplicaSet(ctx, replicaDSNs, log); err != nil {
+			pool.Close()
+			return nil, err
+		}
+	}
+	return pg, nil
 }
 
-// Close закрывает пулл и все соединения с БД.
+// Close закрывает пулл и все соединения с БД и репликами.
+// У хранилища внутри транзакции ничего не делает.
 func (pg *PGStorage) Close() error {
-	pg.pool.Close()
+	if pg.replicas != nil {
+		pg.replicas.close()
+	}
+	if pg.pool != nil {
+		pg.pool.Close()
+	}
 	return nil
 }
 
+// WithTx выполняет функцию в транзакции, откатывая ее при ошибке.
+// Вызов внутри другой транзакции создает точку сохранения.
+func (pg *PGStorage) WithTx(ctx context.Context, fn func(tx storage.Storage) error) error {
+	return pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		return fn(&PGStorage{db: tx, log: pg.log})
+	})
+}
+
 // initPool инициализация пула для соединения с БД.
 func initPool(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
 	poolCfg, err := pgxpool.ParseConfig(dsn)
@@ -52,24 +100,32 @@ func initPool(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
 	return pool, nil
 }
 
-// runMigrations запускает миграции БД.
-func runMigrations(dsn string, logger *logrus.Entry) error {
-	goose.SetBaseFS(migrations.FS)
-	goose.SetLogger(logger)
-	if err := goose.SetDialect("postgres"); err != nil {
+// NewMigrationProvider создает провайдер встроенных миграций БД.
+// Закрытие провайдера закрывает его соединение с БД.
+func NewMigrationProvider(dsn string) (*goose.Provider, error) {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open db: %w", err)
+	}
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, migrations.FS)
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create migration provider: %w", err)
+	}
+	return provider, nil
+}
+
+// runMigrations применяет новые миграции БД.
+// Если автоматическое применение отключено, только предупреждает о неприменённых миграциях.
+func runMigrations(ctx context.Context, dsn string, autoMigrate bool, logger *logrus.Entry) error {
+	provider, err := NewMigrationProvider(dsn)
+	if err != nil {
 		return err
 	}
-	sqlDB, err := goose.OpenDBWithDriver("postgres", dsn)
 	defer func() {
-		if err = sqlDB.Close(); err != nil {
+		if err := provider.Close(); err != nil {
 			logger.WithField("err", err).Error("failed to close db connection while migration")
 		}
 	}()
-	if err != nil {
-		return err
-	}
-	if err = goose.Up(sqlDB, "."); err != nil {
-		return err
-	}
-	return nil
+	return migrate.Apply(ctx, provider, autoMigrate, logger)
 }
@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/storagetest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStorage запускает набор тестов соответствия на реальной БД.
+// Требует переменную окружения TEST_DATABASE_DSN; все данные в БД будут удалены.
+// Набор запускается и с той же БД в роли реплики, чтобы проверить чтение через реплики.
+func TestStorage(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is not set")
+	}
+	log, err := logger.NewLogger("error")
+	require.NoError(t, err)
+
+	for name, replicaDSNs := range map[string][]string{"Без реплик": nil, "С репликой": {dsn}} {
+		t.Run(name, func(t *testing.T) {
+			storagetest.Run(t, func(t *testing.T) storage.Storage {
+				ctx := context.Background()
+				store, err := NewStorage(ctx, dsn, replicaDSNs, true, log)
+				require.NoError(t, err)
+				pg, ok := store.(*PGStorage)
+				require.True(t, ok)
+				_, err = pg.db.Exec(ctx, "TRUNCATE users, audit_log CASCADE;")
+				require.NoError(t, err)
+				t.Cleanup(func() { _ = store.Close() })
+				return store
+			})
+		})
+	}
+}
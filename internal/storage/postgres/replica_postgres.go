@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// replicaCheckInterval период проверки доступности реплик.
+const replicaCheckInterval = 10 * time.Second
+
+// replicaDB описывает пул соединений с репликой.
+type replicaDB interface {
+	pgxQuerier
+	Ping(ctx context.Context) error
+	Close()
+}
+
+// replica описывает реплику БД и ее доступность.
+type replica struct {
+	name    string // Адрес реплики для логов (без пароля).
+	db      replicaDB
+	healthy atomic.Bool
+}
+
+// replicaSet распределяет запросы на чтение между доступными репликами по очереди
+// и периодически проверяет их доступность.
+type replicaSet struct {
+	replicas []*replica
+	next     atomic.Uint64
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	log      *logrus.Entry
+}
+
+// newReplicaSet создает пулы соединений с репликами и запускает проверку их доступности.
+// Недоступная при запуске реплика не является ошибкой: запросы к ней начнут направляться после ее восстановления.
+func newReplicaSet(ctx context.Context, dsns []string, log *logrus.Entry) (*replicaSet, error) {
+	rs := &replicaSet{log: log}
+	for _, dsn := range dsns {
+		poolCfg, err := pgxpool.ParseConfig(dsn)
+		if err != nil {
+			rs.close()
+			return nil, fmt.Errorf("failed to parse the replica DSN: %w", err)
+		}
+		pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+		if err != nil {
+			rs.close()
+			return nil, fmt.Errorf("failed to initialize a replica connection pool: %w", err)
+		}
+		name := fmt.Sprintf("%s:%d/%s", poolCfg.ConnConfig.Host, poolCfg.ConnConfig.Port, poolCfg.ConnConfig.Database)
+		rs.replicas = append(rs.replicas, &replica{name: name, db: pool})
+	}
+	rs.check(ctx)
+
+	checkCtx, cancel := context.WithCancel(context.Background())
+	rs.cancel = cancel
+	rs.wg.Add(1)
+	go func() {
+		defer rs.wg.Done()
+		rs.run(checkCtx)
+	}()
+	return rs, nil
+}
+
+// run периодически проверяет доступность реплик до отмены контекста.
+func (rs *replicaSet) run(ctx context.Context) {
+	ticker := time.NewTicker(replicaCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rs.check(ctx)
+		}
+	}
+}
+
+// check проверяет доступность всех реплик.
+func (rs *replicaSet) check(ctx context.Context) {
+	for _, r := range rs.replicas {
+		pingCtx, cancel := context.WithTimeout(ctx, replicaCheckInterval)
+		err := r.db.Ping(pingCtx)
+		cancel()
+		if err != nil {
+			rs.markDown(r, err)
+			continue
+		}
+		if !r.healthy.Swap(true) {
+			rs.log.WithField("replica", r.name).Info("replica is available")
+		}
+	}
+}
+
+// markDown исключает реплику из распределения запросов до следующей успешной проверки.
+func (rs *replicaSet) markDown(r *replica, err error) {
+	if r.healthy.Swap(false) {
+		rs.log.WithError(err).WithField("replica", r.name).Warn("replica is unavailable, reading from primary")
+	}
+}
+
+// pick возвращает следующую доступную реплику или nil, если доступных реплик нет.
+func (rs *replicaSet) pick() *replica {
+	healthy := make([]*replica, 0, len(rs.replicas))
+	for _, r := range rs.replicas {
+		if r.healthy.Load() {
+			healthy = append(healthy, r)
+		}
+	}
+	if len(healthy) == 0 {
+		return nil
+	}
+	return healthy[rs.next.Add(1)%uint64(len(healthy))]
+}
+
+// close останавливает проверку доступности и закрывает пулы соединений с репликами.
+func (rs *replicaSet) close() {
+	if rs.cancel != nil {
+		rs.cancel()
+		rs.wg.Wait()
+	}
+	for _, r := range rs.replicas {
+		r.db.Close()
+	}
+}
+
+// replicaQuerier выполняет запросы на чтение на реплике.
+// Если реплика недоступна, она исключается из распределения, а запрос повторяется на основном сервере.
+// Запрос одной строки, не нашедший данных, также повторяется на основном сервере,
+// так как реплика может отставать от него.
+type replicaQuerier struct {
+	replica *replica
+	primary pgxQuerier
+	set     *replicaSet
+}
+
+// Exec выполняет запрос на основном сервере: реплики используются только для чтения.
+func (q *replicaQuerier) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	return q.primary.Exec(ctx, sql, args...)
+}
+
+// Query выполняет запрос на реплике.
+func (q *replicaQuerier) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	rows, err := q.replica.db.Query(ctx, sql, args...)
+	if q.failed(ctx, err) {
+		return q.primary.Query(ctx, sql, args...)
+	}
+	return rows, err
+}
+
+// QueryRow выполняет запрос одной строки на реплике.
+func (q *replicaQuerier) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return &replicaRow{
+		ctx:  ctx,
+		q:    q,
+		row:  q.replica.db.QueryRow(ctx, sql, args...),
+		sql:  sql,
+		args: args,
+	}
+}
+
+// failed проверяет, что запрос не выполнен из-за недоступности реплики, и в этом случае исключает ее.
+// Ошибки самого запроса (возвращенные сервером БД) и отмена контекста недоступностью не считаются.
+func (q *replicaQuerier) failed(ctx context.Context, err error) bool {
+	var pgErr *pgconn.PgError
+	if err == nil || errors.Is(err, pgx.ErrNoRows) || errors.As(err, &pgErr) || ctx.Err() != nil {
+		return false
+	}
+	q.set.markDown(q.replica, err)
+	return true
+}
+
+// replicaRow описывает результат запроса одной строки на реплике.
+type replicaRow struct {
+	ctx  context.Context
+	q    *replicaQuerier
+	row  pgx.Row
+	sql  string
+	args []any
+}
+
+// Scan читает строку результата; при недоступности реплики или отсутствии строки повторяет запрос на основном сервере.
+func (r *replicaRow) Scan(dest ...any) error {
+	err := r.row.Scan(dest...)
+	if errors.Is(err, pgx.ErrNoRows) || r.q.failed(r.ctx, err) {
+		return r.q.primary.QueryRow(r.ctx, r.sql, r.args...).Scan(dest...)
+	}
+	return err
+}
+
+// reader возвращает соединение для запросов на чтение: доступную реплику или основной сервер.
+// Внутри транзакции, без настроенных реплик и с контекстом storage.WithPrimary запросы выполняются
+// на основном сервере.
+func (pg *PGStorage) reader(ctx context.Context) pgxQuerier {
+	if pg.replicas == nil || storage.IsPrimary(ctx) {
+		return pg.db
+	}
+	r := pg.replicas.pick()
+	if r == nil {
+		return pg.db
+	}
+	return &replicaQuerier{replica: r, primary: pg.db, set: pg.replicas}
+}
@@ -0,0 +1,199 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDB имитирует соединение с БД: возвращает заданную ошибку и считает запросы.
+type fakeDB struct {
+	err     error // Ошибка выполнения запросов.
+	pingErr error
+	queries int
+}
+
+func (db *fakeDB) Exec(_ context.Context, _ string, _ ...any) (pgconn.CommandTag, error) {
+	db.queries++
+	return pgconn.CommandTag{}, db.err
+}
+
+func (db *fakeDB) Query(_ context.Context, _ string, _ ...any) (pgx.Rows, error) {
+	db.queries++
+	return nil, db.err
+}
+
+func (db *fakeDB) QueryRow(_ context.Context, _ string, _ ...any) pgx.Row {
+	db.queries++
+	return fakeRow{err: db.err}
+}
+
+func (db *fakeDB) Begin(_ context.Context) (pgx.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+func (db *fakeDB) Ping(_ context.Context) error {
+	return db.pingErr
+}
+
+func (db *fakeDB) Close() {}
+
+// fakeRow имитирует результат запроса одной строки.
+type fakeRow struct {
+	err error
+}
+
+func (r fakeRow) Scan(_ ...any) error {
+	return r.err
+}
+
+// newTestReplicaSet создает набор реплик над переданными соединениями без фоновой проверки доступности.
+func newTestReplicaSet(t *testing.T, dbs ...*fakeDB) *replicaSet {
+	t.Helper()
+	log, err := logger.NewLogger("error")
+	require.NoError(t, err)
+	rs := &replicaSet{log: log.WithField("instance", "test")}
+	for _, db := range dbs {
+		rs.replicas = append(rs.replicas, &replica{name: "replica", db: db})
+	}
+	rs.check(context.Background())
+	return rs
+}
+
+func TestReplicaPick(t *testing.T) {
+	first, second, down := &fakeDB{}, &fakeDB{}, &fakeDB{pingErr: errors.New("connection refused")}
+	rs := newTestReplicaSet(t, first, down, second)
+
+	// Недоступная реплика пропускается, доступные выбираются по очереди.
+	picked := map[replicaDB]int{}
+	for range 4 {
+		r := rs.pick()
+		require.NotNil(t, r)
+		picked[r.db]++
+	}
+	assert.Equal(t, map[replicaDB]int{first: 2, second: 2}, picked)
+
+	first.pingErr = errors.New("connection refused")
+	second.pingErr = errors.New("connection refused")
+	rs.check(context.Background())
+	assert.Nil(t, rs.pick())
+
+	// После восстановления реплика снова используется.
+	down.pingErr = nil
+	rs.check(context.Background())
+	r := rs.pick()
+	require.NotNil(t, r)
+	assert.Equal(t, replicaDB(down), r.db)
+}
+
+func TestReader(t *testing.T) {
+	primary := &fakeDB{}
+	ctx := context.Background()
+
+	t.Run("Без реплик", func(t *testing.T) {
+		pg := &PGStorage{db: primary}
+		assert.Equal(t, pgxQuerier(primary), pg.reader(ctx))
+	})
+
+	t.Run("Все реплики недоступны", func(t *testing.T) {
+		pg := &PGStorage{db: primary, replicas: newTestReplicaSet(t, &fakeDB{pingErr: errors.New("timeout")})}
+		assert.Equal(t, pgxQuerier(primary), pg.reader(ctx))
+	})
+
+	t.Run("Доступная реплика", func(t *testing.T) {
+		pg := &PGStorage{db: primary, replicas: newTestReplicaSet(t, &fakeDB{})}
+		_, ok := pg.reader(ctx).(*replicaQuerier)
+		assert.True(t, ok)
+	})
+
+	t.Run("Чтение перед изменением", func(t *testing.T) {
+		pg := &PGStorage{db: primary, replicas: newTestReplicaSet(t, &fakeDB{})}
+		assert.Equal(t, pgxQuerier(primary), pg.reader(storage.WithPrimary(ctx)))
+	})
+}
+
+func TestReplicaQuerier(t *testing.T) {
+	connErr := errors.New("connection reset by peer")
+	tests := []struct {
+		name         string
+		replicaErr   error
+		wantPrimary  bool // Запрос повторен на основном сервере.
+		wantDown     bool // Реплика исключена из распределения.
+		wantQueryErr bool // Запрос нескольких строк возвращает ошибку реплики без повтора.
+	}{
+		{
+			name: "Успешный запрос к реплике",
+		},
+		{
+			name:        "Реплика недоступна",
+			replicaErr:  connErr,
+			wantPrimary: true,
+			wantDown:    true,
+		},
+		{
+			name:         "Ошибка запроса на реплике",
+			replicaErr:   &pgconn.PgError{Code: "42P01"},
+			wantQueryErr: true,
+		},
+		{
+			// Реплика может отставать, поэтому ненайденная строка ищется на основном сервере.
+			name:         "Строка не найдена на реплике",
+			replicaErr:   pgx.ErrNoRows,
+			wantPrimary:  true,
+			wantQueryErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			t.Run("Запрос одной строки", func(t *testing.T) {
+				primary, replicaConn := &fakeDB{}, &fakeDB{}
+				pg := &PGStorage{db: primary, replicas: newTestReplicaSet(t, replicaConn)}
+				q := pg.reader(ctx)
+				replicaConn.err = tt.replicaErr
+
+				err := q.QueryRow(ctx, "SELECT 1;").Scan()
+				assert.Equal(t, 1, replicaConn.queries)
+				if tt.wantPrimary {
+					require.NoError(t, err)
+					assert.Equal(t, 1, primary.queries)
+				} else {
+					assert.Equal(t, tt.replicaErr, err)
+					assert.Zero(t, primary.queries)
+				}
+				assert.Equal(t, tt.wantDown, pg.replicas.pick() == nil)
+			})
+
+			t.Run("Запрос нескольких строк", func(t *testing.T) {
+				primary, replicaConn := &fakeDB{}, &fakeDB{}
+				pg := &PGStorage{db: primary, replicas: newTestReplicaSet(t, replicaConn)}
+				q := pg.reader(ctx)
+				replicaConn.err = tt.replicaErr
+
+				_, err := q.Query(ctx, "SELECT 1;")
+				assert.Equal(t, 1, replicaConn.queries)
+				assert.Equal(t, tt.wantDown, primary.queries == 1)
+				assert.Equal(t, tt.wantQueryErr, err != nil)
+				assert.Equal(t, tt.wantDown, pg.replicas.pick() == nil)
+			})
+		})
+	}
+
+	t.Run("Изменения выполняются на основном сервере", func(t *testing.T) {
+		primary, replicaConn := &fakeDB{}, &fakeDB{}
+		pg := &PGStorage{db: primary, replicas: newTestReplicaSet(t, replicaConn)}
+		_, err := pg.reader(context.Background()).Exec(context.Background(), "DELETE FROM users;")
+		require.NoError(t, err)
+		assert.Equal(t, 1, primary.queries)
+		assert.Zero(t, replicaConn.queries)
+	})
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// IsTransient проверяет, что запрос к хранилищу завершился временной ошибкой БД и его можно повторить.
+// Запрос, изменяющий данные, повторяется, только если он гарантированно не был применен: соединение
+// не удалось установить или запрос не был отправлен, транзакция отменена из-за ошибки сериализации
+// или взаимной блокировки, сервер БД недоступен или перезапускается. Запрос на чтение (readOnly)
+// повторяется и при разрыве соединения во время выполнения.
+func IsTransient(err error, readOnly bool) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgerrcode.SerializationFailure, pgerrcode.DeadlockDetected,
+			pgerrcode.AdminShutdown, pgerrcode.CrashShutdown, pgerrcode.CannotConnectNow:
+			return true
+		}
+		return pgerrcode.IsConnectionException(pgErr.Code)
+	}
+	if pgconn.SafeToRetry(err) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return readOnly
+	}
+	return false
+}
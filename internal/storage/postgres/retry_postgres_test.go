@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTransient(t *testing.T) {
+	reset := &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+	tests := []struct {
+		name      string
+		err       error
+		wantRead  bool
+		wantWrite bool
+	}{
+		{
+			name:      "Ошибка сериализации",
+			err:       fmt.Errorf("failed to update item: %w", &pgconn.PgError{Code: pgerrcode.SerializationFailure}),
+			wantRead:  true,
+			wantWrite: true,
+		},
+		{
+			name:      "Взаимная блокировка",
+			err:       &pgconn.PgError{Code: pgerrcode.DeadlockDetected},
+			wantRead:  true,
+			wantWrite: true,
+		},
+		{
+			name:      "Сервер БД перезапускается",
+			err:       &pgconn.PgError{Code: pgerrcode.AdminShutdown},
+			wantRead:  true,
+			wantWrite: true,
+		},
+		{
+			name:      "Ошибка соединения на сервере БД",
+			err:       &pgconn.PgError{Code: pgerrcode.ConnectionFailure},
+			wantRead:  true,
+			wantWrite: true,
+		},
+		{
+			name: "Ошибка запроса",
+			err:  &pgconn.PgError{Code: pgerrcode.UniqueViolation},
+		},
+		{
+			name:     "Разрыв соединения во время запроса",
+			err:      fmt.Errorf("failed to get data from db: %w", reset),
+			wantRead: true,
+		},
+		{
+			name:     "Соединение закрыто",
+			err:      io.ErrUnexpectedEOF,
+			wantRead: true,
+		},
+		{
+			name: "Ошибка хранилища",
+			err:  storage.ErrNoData,
+		},
+		{
+			name: "Отмена запроса",
+			err:  fmt.Errorf("failed to list items: %w", context.Canceled),
+		},
+		{
+			name: "Нет ошибки",
+		},
+		{
+			name: "Произвольная ошибка",
+			err:  errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantRead, IsTransient(tt.err, true))
+			assert.Equal(t, tt.wantWrite, IsTransient(tt.err, false))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// SearchItems ищет данные пользователя по слепым индексам мета данных (без самих данных).
+// Поиск выполняется по колонке meta_index с GIN индексом.
+// Результат упорядочен по времени обновления, начиная с последних.
+func (pg *PGStorage) SearchItems(
+	ctx context.Context, userID string, params model.SearchItemsParams,
+) ([]model.VaultItem, error) {
+	if len(params.Index) == 0 {
+		return nil, nil
+	}
+	args := []any{userID, params.Index}
+	query := `SELECT ` + listColumns + `
+		FROM user_data
+		WHERE user_id = $1 AND deleted_at IS NULL AND ` + notExpired + ` AND meta_index && $2
+		ORDER BY updated_at DESC, id`
+	if params.Limit > 0 {
+		args = append(args, params.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	rows, err := pg.reader(ctx).Query(ctx, query+";", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []model.VaultItem
+	for rows.Next() {
+		var item model.VaultItem
+		if err = rows.Scan(
+			&item.ID, &item.EncryptMeta, &item.Meta, &item.Type, &item.Revision, &item.CreatedAt, &item.UpdatedAt,
+			&item.FolderID, &item.TagIDs, &item.ExpiresAt, &item.Favorite, &item.LastAccessedAt, &item.AccessCount,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - item row: %w", err)
+		}
+		item.UserID = userID
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search items: %w", err)
+	}
+	return items, nil
+}
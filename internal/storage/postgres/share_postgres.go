@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// SetItemKey переводит данные на собственный ключ или заменяет его новым,
+// заменяя содержимое текущей и предыдущих версий.
+func (pg *PGStorage) SetItemKey(ctx context.Context, id string, userID string, key *model.ItemKey) error {
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		res, err := tx.Exec(ctx,
+			`UPDATE user_data SET encrypt_key = $1, encrypt_data = $2, shared_meta = $3
+			WHERE id = $4 AND user_id = $5 AND deleted_at IS NULL AND revision = $6
+			AND encrypt_key IS NOT DISTINCT FROM $7;`,
+			key.EncryptKey, key.EncryptData, key.SharedMeta, id, userID, key.Revision, key.PrevKey,
+		)
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			if err = checkItemOwner(ctx, tx, id, userID); err != nil {
+				return err
+			}
+			return storage.ErrConflict
+		}
+		var revisions int
+		row := tx.QueryRow(ctx, `SELECT COUNT(*) FROM user_data_history WHERE item_id = $1;`, id)
+		if err = row.Scan(&revisions); err != nil {
+			return err
+		}
+		if revisions != len(key.History) {
+			return storage.ErrNoRevision
+		}
+		for _, rev := range key.History {
+			res, err = tx.Exec(ctx,
+				`UPDATE user_data_history SET encrypt_data = $1, shared_meta = $2 WHERE item_id = $3 AND revision = $4;`,
+				rev.EncryptData, rev.SharedMeta, id, rev.Revision,
+			)
+			if err != nil {
+				return err
+			}
+			if res.RowsAffected() == 0 {
+				return storage.ErrNoRevision
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrConflict) ||
+			errors.Is(err, storage.ErrNoRevision) {
+			return err
+		}
+		return fmt.Errorf("failed to set item key: %w", err)
+	}
+	return nil
+}
+
+// ShareItem предоставляет получателю доступ к данным или изменяет уже предоставленный.
+func (pg *PGStorage) ShareItem(ctx context.Context, share *model.Share) error {
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		if err := checkItemOwner(ctx, tx, share.ItemID, share.OwnerID); err != nil {
+			return err
+		}
+		res, err := tx.Exec(ctx,
+			`INSERT INTO item_shares(item_id, owner_id, recipient_id, access, encrypt_key)
+			SELECT $1, $2, id, $4, $5 FROM users WHERE id = $3
+			ON CONFLICT (item_id, recipient_id) DO UPDATE SET access = excluded.access, encrypt_key = excluded.encrypt_key;`,
+			share.ItemID, share.OwnerID, share.RecipientID, share.Access, share.EncryptKey,
+		)
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected() == 0 {
+			return storage.ErrNoUser
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrNoUser) {
+			return err
+		}
+		return fmt.Errorf("failed to share item: %w", err)
+	}
+	return nil
+}
+
+// GetShare возвращает доступ получателя к данным.
+func (pg *PGStorage) GetShare(ctx context.Context, itemID string, recipientID string) (*model.Share, error) {
+	share := model.Share{ItemID: itemID, RecipientID: recipientID}
+	row := pg.reader(ctx).QueryRow(ctx,
+		`SELECT owner_id, access, encrypt_key, created_at FROM item_shares WHERE item_id = $1 AND recipient_id = $2;`,
+		itemID, recipientID,
+	)
+	if err := row.Scan(&share.OwnerID, &share.Access, &share.EncryptKey, &share.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, storage.ErrNoShare
+		}
+		return nil, fmt.Errorf("failed to get share: %w", err)
+	}
+	return &share, nil
+}
+
+// RevokeShare отзывает доступ получателя к данным владельца.
+func (pg *PGStorage) RevokeShare(ctx context.Context, itemID string, ownerID string, recipientID string) error {
+	res, err := pg.db.Exec(ctx,
+		`DELETE FROM item_shares WHERE item_id = $1 AND owner_id = $2 AND recipient_id = $3;`,
+		itemID, ownerID, recipientID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke share: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return storage.ErrNoShare
+	}
+	return nil
+}
+
+// ListShares возвращает доступы, предоставленные пользователем и предоставленные ему,
+// в порядке их предоставления.
+func (pg *PGStorage) ListShares(ctx context.Context, userID string) ([]model.Share, error) {
+	rows, err := pg.reader(ctx).Query(ctx,
+		`SELECT s.item_id, s.owner_id, o.login, s.recipient_id, r.login, s.access, s.encrypt_key, s.created_at,
+		d.data_type, d.shared_meta
+		FROM item_shares s
+		JOIN user_data d ON d.id = s.item_id
+		JOIN users o ON o.id = s.owner_id
+		JOIN users r ON r.id = s.recipient_id
+		WHERE (s.owner_id = $1 OR s.recipient_id = $1) AND d.deleted_at IS NULL
+		AND (d.expires_at IS NULL OR d.expires_at > NOW())
+		ORDER BY s.created_at, s.item_id, s.recipient_id;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shares: %w", err)
+	}
+	defer rows.Close()
+
+	var shares []model.Share
+	for rows.Next() {
+		var share model.Share
+		if err = rows.Scan(
+			&share.ItemID, &share.OwnerID, &share.OwnerLogin, &share.RecipientID, &share.RecipientLogin,
+			&share.Access, &share.EncryptKey, &share.CreatedAt, &share.ItemType, &share.SharedMeta,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - share row: %w", err)
+		}
+		shares = append(shares, share)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list shares: %w", err)
+	}
+	return shares, nil
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// GetDeletedItems возвращает данные пользователя, находящиеся в корзине.
+func (pg *PGStorage) GetDeletedItems(ctx context.Context, userID string) ([]model.VaultItem, error) {
+	var items []model.VaultItem
+	rows, err := pg.reader(ctx).Query(ctx,
+		`SELECT id, encrypt_meta, COALESCE(meta::text, ''), data_type, created_at, updated_at, deleted_at FROM user_data
+		WHERE user_id = $1 AND deleted_at IS NOT NULL ORDER BY deleted_at DESC;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item model.VaultItem
+		if err = rows.Scan(
+			&item.ID, &item.EncryptMeta, &item.Meta, &item.Type, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - item row: %w", err)
+		}
+		item.UserID = userID
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get deleted items: %w", err)
+	}
+	return items, nil
+}
+
+// RestoreDeletedItem восстанавливает данные из корзины вместе с вложениями, удаленными одновременно с ними.
+func (pg *PGStorage) RestoreDeletedItem(ctx context.Context, id string, userID string) error {
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		var parentID string
+		var deletedAt time.Time
+		row := tx.QueryRow(ctx,
+			`SELECT COALESCE(parent_id::text, ''), deleted_at FROM user_data
+			WHERE id = $1 AND user_id = $2 AND deleted_at IS NOT NULL FOR UPDATE;`,
+			id, userID,
+		)
+		if err := row.Scan(&parentID, &deletedAt); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return storage.ErrNoData
+			}
+			return err
+		}
+		if parentID != "" {
+			if err := checkItemOwner(ctx, tx, parentID, userID); err != nil {
+				if errors.Is(err, storage.ErrNoData) {
+					return storage.ErrParentInTrash
+				}
+				return err
+			}
+		}
+		_, err := tx.Exec(ctx,
+			`UPDATE user_data SET deleted_at = NULL
+			WHERE user_id = $1 AND (id = $2 OR (parent_id = $2 AND deleted_at = $3));`,
+			userID, id, deletedAt,
+		)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrParentInTrash) {
+			return err
+		}
+		return fmt.Errorf("failed to restore deleted item: %w", err)
+	}
+	return nil
+}
+
+// PurgeDeletedItems безвозвратно удаляет все данные пользователя из корзины.
+func (pg *PGStorage) PurgeDeletedItems(ctx context.Context, userID string) (int64, error) {
+	return pg.purge(ctx, `user_id = $1 AND deleted_at IS NOT NULL`, userID)
+}
+
+// PurgeDeletedBefore безвозвратно удаляет данные всех пользователей,
+// перемещенные в корзину раньше указанного времени.
+func (pg *PGStorage) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return pg.purge(ctx, `deleted_at < $1`, before)
+}
+
+// purge безвозвратно удаляет данные, удовлетворяющие условию where, и возвращает их количество.
+// Сначала удаляются вложения: удаленные каскадно строки не учитываются в количестве затронутых.
+func (pg *PGStorage) purge(ctx context.Context, where string, args ...any) (int64, error) {
+	var purged int64
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		for _, query := range []string{
+			`DELETE FROM user_data WHERE ` + where + ` AND parent_id IS NOT NULL;`,
+			`DELETE FROM user_data WHERE ` + where + `;`,
+		} {
+			res, err := tx.Exec(ctx, query, args...)
+			if err != nil {
+				return err
+			}
+			purged += res.RowsAffected()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge items: %w", err)
+	}
+	return purged, nil
+}
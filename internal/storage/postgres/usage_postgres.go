@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// GetUsage возвращает занятый пользователем объем по типам данных.
+// Учитываются данные в корзине, объем включает предыдущие версии данных.
+func (pg *PGStorage) GetUsage(ctx context.Context, userID string) ([]model.TypeUsage, error) {
+	rows, err := pg.reader(ctx).Query(ctx,
+		`SELECT d.data_type, COUNT(*),
+		SUM(d.size + COALESCE(octet_length(d.encrypt_meta), 0) + COALESCE(octet_length(d.meta::text), 0)
+		+ COALESCE(h.bytes, 0))::BIGINT
+		FROM user_data d
+		LEFT JOIN LATERAL (
+			SELECT SUM(size + COALESCE(octet_length(encrypt_meta), 0) + COALESCE(octet_length(meta::text), 0)) AS bytes
+			FROM user_data_history WHERE item_id = d.id
+		) h ON TRUE
+		WHERE d.user_id = $1 GROUP BY d.data_type ORDER BY d.data_type;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage: %w", err)
+	}
+	defer rows.Close()
+
+	var usages []model.TypeUsage
+	for rows.Next() {
+		var usage model.TypeUsage
+		if err = rows.Scan(&usage.Type, &usage.Items, &usage.Bytes); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - usage row: %w", err)
+		}
+		usages = append(usages, usage)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get usage: %w", err)
+	}
+	return usages, nil
+}
+
+// LockUsage блокирует строку пользователя до конца транзакции.
+// Блокировка FOR NO KEY UPDATE не мешает сохранению данных, ссылающихся на пользователя.
+func (pg *PGStorage) LockUsage(ctx context.Context, userID string) error {
+	var locked int
+	err := pg.db.QueryRow(ctx, "SELECT 1 FROM users WHERE id = $1 FOR NO KEY UPDATE;", userID).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ErrNoUser
+		}
+		return fmt.Errorf("failed to lock user usage: %w", err)
+	}
+	return nil
+}
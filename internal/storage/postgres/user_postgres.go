@@ -10,27 +10,23 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/pinbrain/gophkeeper/internal/model"
-)
-
-// Ошибки, возвращаемые хранилищем.
-var (
-	ErrLoginTaken = errors.New("login or email is already taken")
-	ErrNoUser     = errors.New("user not found in db")
+	"github.com/pinbrain/gophkeeper/internal/storage"
 )
 
 // CreateUser создает нового пользователя.
 func (pg *PGStorage) CreateUser(ctx context.Context, user *model.User) (string, error) {
 	user.Login = strings.ToLower(user.Login)
-	row := pg.pool.QueryRow(
+	row := pg.db.QueryRow(
 		ctx,
-		"INSERT INTO users(login, password_hash, encrypt_secret) VALUES($1, $2, $3) RETURNING id;",
-		user.Login, user.PasswordHash, user.EncryptedSecret,
+		`INSERT INTO users(login, password_hash, encrypt_secret, public_key, encrypt_private_key)
+		VALUES($1, $2, $3, $4, $5) RETURNING id;`,
+		user.Login, user.PasswordHash, user.EncryptedSecret, user.PublicKey, user.EncryptPrivateKey,
 	)
 	if err := row.Scan(&user.ID); err != nil {
 		var pgError *pgconn.PgError
 		if errors.As(err, &pgError) {
 			if pgError.Code == pgerrcode.UniqueViolation {
-				return "", ErrLoginTaken
+				return "", storage.ErrLoginTaken
 			}
 		}
 		return "", fmt.Errorf("failed to create new user: %w", err)
@@ -41,14 +37,17 @@ func (pg *PGStorage) CreateUser(ctx context.Context, user *model.User) (string,
 // GetUserByLogin возвращает данные пользователя по логину.
 func (pg *PGStorage) GetUserByLogin(ctx context.Context, login string) (*model.User, error) {
 	var user model.User
-	row := pg.pool.QueryRow(
+	login = strings.ToLower(login)
+	row := pg.reader(ctx).QueryRow(
 		ctx,
-		"SELECT id, password_hash, encrypt_secret FROM users WHERE login = $1;",
+		"SELECT id, password_hash, encrypt_secret, public_key, encrypt_private_key FROM users WHERE login = $1;",
 		login,
 	)
-	if err := row.Scan(&user.ID, &user.PasswordHash, &user.EncryptedSecret); err != nil {
+	if err := row.Scan(
+		&user.ID, &user.PasswordHash, &user.EncryptedSecret, &user.PublicKey, &user.EncryptPrivateKey,
+	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrNoUser
+			return nil, storage.ErrNoUser
 		}
 		return nil, fmt.Errorf("failed to get user from db: %w", err)
 	}
@@ -59,17 +58,82 @@ func (pg *PGStorage) GetUserByLogin(ctx context.Context, login string) (*model.U
 // GetUserByID возвращает данные пользователя по ID.
 func (pg *PGStorage) GetUserByID(ctx context.Context, id string) (*model.User, error) {
 	var user model.User
-	row := pg.pool.QueryRow(
+	row := pg.reader(ctx).QueryRow(
 		ctx,
-		"SELECT login, password_hash, encrypt_secret FROM users WHERE id = $1;",
+		"SELECT login, password_hash, encrypt_secret, public_key, encrypt_private_key FROM users WHERE id = $1;",
 		id,
 	)
-	if err := row.Scan(&user.Login, &user.PasswordHash, &user.EncryptedSecret); err != nil {
+	if err := row.Scan(
+		&user.Login, &user.PasswordHash, &user.EncryptedSecret, &user.PublicKey, &user.EncryptPrivateKey,
+	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrNoUser
+			return nil, storage.ErrNoUser
 		}
 		return nil, fmt.Errorf("failed to get user from db: %w", err)
 	}
 	user.ID = id
 	return &user, nil
 }
+
+// DeleteUser удаляет пользователя и его журнал аудита.
+// Данные пользователя с историей, папки, метки, доступы к данным и участие в организациях удаляются
+// каскадно (для аккаунта организации - и сама организация с участниками).
+func (pg *PGStorage) DeleteUser(ctx context.Context, id string) error {
+	return pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		res, err := tx.Exec(ctx, "DELETE FROM users WHERE id = $1;", id)
+		if err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		if res.RowsAffected() == 0 {
+			return storage.ErrNoUser
+		}
+		// Журнал удаляется после пользователя: триггер запрещает удалять журнал существующего пользователя.
+		if _, err = tx.Exec(ctx, "DELETE FROM audit_log WHERE user_id = $1;", id); err != nil {
+			return fmt.Errorf("failed to delete user audit log: %w", err)
+		}
+		return nil
+	})
+}
+
+// SetUserKeys сохраняет пару ключей пользователя.
+func (pg *PGStorage) SetUserKeys(ctx context.Context, id string, publicKey []byte, encPrivateKey []byte) error {
+	res, err := pg.db.Exec(ctx,
+		`UPDATE users SET public_key = $1, encrypt_private_key = $2 WHERE id = $3;`,
+		publicKey, encPrivateKey, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set user keys: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return storage.ErrNoUser
+	}
+	return nil
+}
+
+// ListUsers возвращает всех пользователей, включая аккаунты организаций, по логину.
+// Запрос выполняется на основном сервере.
+func (pg *PGStorage) ListUsers(ctx context.Context) ([]model.User, error) {
+	rows, err := pg.db.Query(ctx,
+		`SELECT id, login, password_hash, encrypt_secret, public_key, encrypt_private_key FROM users
+		ORDER BY login;`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []model.User
+	for rows.Next() {
+		var user model.User
+		if err = rows.Scan(
+			&user.ID, &user.Login, &user.PasswordHash, &user.EncryptedSecret, &user.PublicKey, &user.EncryptPrivateKey,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - user row: %w", err)
+		}
+		users = append(users, user)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	return users, nil
+}
@@ -1,27 +1,49 @@
 package postgres
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/pinbrain/gophkeeper/internal/model"
-)
-
-// Ошибки, возвращаемые хранилищем.
-var (
-	ErrNoData = errors.New("data not found in db")
+	"github.com/pinbrain/gophkeeper/internal/storage"
 )
 
 // CreateItem сохраняет новые данные.
 func (pg *PGStorage) CreateItem(ctx context.Context, userID string, item *model.VaultItem) (string, error) {
-	row := pg.pool.QueryRow(
-		ctx,
-		`INSERT INTO user_data(user_id, encrypt_data, meta, data_type) VALUES($1, $2, $3, $4) RETURNING id;`,
-		userID, item.EncryptData, item.Meta, item.Type,
-	)
-	if err := row.Scan(&item.ID); err != nil {
+	// Незаданное время создания заполняется временем базы данных.
+	var created, updated *time.Time
+	if !item.CreatedAt.IsZero() {
+		c, u := item.CreateTimes(item.CreatedAt)
+		created, updated = &c, &u
+	}
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		if item.ParentID != "" {
+			if err := checkParent(ctx, tx, item.ParentID, userID); err != nil {
+				return err
+			}
+		}
+		row := tx.QueryRow(
+			ctx,
+			`INSERT INTO user_data(
+				user_id, encrypt_data, encrypt_meta, meta_index, meta, data_type, chunked, size, parent_id, expires_at,
+				created_at, updated_at
+			)
+			VALUES($1, $2, $3, $4, NULLIF($5, '')::jsonb, $6, $7, $8, NULLIF($9, '')::uuid, $10,
+				COALESCE($11, now()), COALESCE($12, now())
+			) RETURNING id;`,
+			userID, item.EncryptData, item.EncryptMeta, metaIndex(item.MetaIndex), item.Meta, item.Type, item.Chunked,
+			item.Size, item.ParentID, item.ExpiresAt, created, updated,
+		)
+		return row.Scan(&item.ID)
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) {
+			return "", err
+		}
 		return "", fmt.Errorf("failed to create new item: %w", err)
 	}
 	return item.ID, nil
@@ -30,16 +52,20 @@ func (pg *PGStorage) CreateItem(ctx context.Context, userID string, item *model.
 // GetItem возвращает данные по id.
 func (pg *PGStorage) GetItem(ctx context.Context, id string, userID string) (*model.VaultItem, error) {
 	var item model.VaultItem
-	row := pg.pool.QueryRow(
+	row := pg.reader(ctx).QueryRow(
 		ctx,
-		`SELECT encrypt_data, meta, data_type, created_at, updated_at FROM user_data WHERE id = $1 AND user_id = $2;`,
+		`SELECT encrypt_data, encrypt_meta, meta_index, COALESCE(meta::text, ''), data_type, revision, chunked, size,
+		created_at, updated_at, encrypt_key, shared_meta, COALESCE(parent_id::text, ''), expires_at
+		FROM user_data WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL AND `+notExpired+`;`,
 		id, userID,
 	)
 	if err := row.Scan(
-		&item.EncryptData, &item.Meta, &item.Type, &item.CreatedAt, &item.UpdatedAt,
+		&item.EncryptData, &item.EncryptMeta, &item.MetaIndex, &item.Meta, &item.Type, &item.Revision,
+		&item.Chunked, &item.Size, &item.CreatedAt, &item.UpdatedAt, &item.EncryptKey, &item.SharedMeta,
+		&item.ParentID, &item.ExpiresAt,
 	); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, ErrNoData
+			return nil, storage.ErrNoData
 		}
 		return nil, fmt.Errorf("failed to get data from db: %w", err)
 	}
@@ -48,14 +74,20 @@ func (pg *PGStorage) GetItem(ctx context.Context, id string, userID string) (*mo
 	return &item, nil
 }
 
-// DeleteItem удаляет данные.
+// DeleteItem перемещает данные в корзину вместе с их вложениями.
+// Вложения не бывают в хранилище без данных, к которым прикреплены, поэтому затронутые строки
+// есть, только если сами данные были не в корзине.
 func (pg *PGStorage) DeleteItem(ctx context.Context, id string, userID string) error {
-	res, err := pg.pool.Exec(ctx, `DELETE FROM user_data WHERE id = $1 AND user_id = $2;`, id, userID)
+	res, err := pg.db.Exec(ctx,
+		`UPDATE user_data SET deleted_at = NOW()
+		WHERE (id = $1 OR parent_id = $1) AND user_id = $2 AND deleted_at IS NULL;`,
+		id, userID,
+	)
 	if err != nil {
 		return err
 	}
 	if res.RowsAffected() == 0 {
-		return ErrNoData
+		return storage.ErrNoData
 	}
 	return nil
 }
@@ -63,8 +95,9 @@ func (pg *PGStorage) DeleteItem(ctx context.Context, id string, userID string) e
 // GetItemsByType возвращает данные пользователя по типу.
 func (pg *PGStorage) GetItemsByType(ctx context.Context, dataType string, userID string) ([]model.VaultItem, error) {
 	var items []model.VaultItem
-	rows, err := pg.pool.Query(ctx,
-		`SELECT id, meta, created_at, updated_at FROM user_data WHERE user_id = $1 AND data_type = $2;`,
+	rows, err := pg.reader(ctx).Query(ctx,
+		`SELECT id, encrypt_meta, COALESCE(meta::text, ''), created_at, updated_at FROM user_data
+		WHERE user_id = $1 AND data_type = $2 AND deleted_at IS NULL AND `+notExpired+`;`,
 		userID, dataType,
 	)
 	if err != nil {
@@ -74,7 +107,7 @@ func (pg *PGStorage) GetItemsByType(ctx context.Context, dataType string, userID
 
 	for rows.Next() {
 		var item model.VaultItem
-		if err = rows.Scan(&item.ID, &item.Meta, &item.CreatedAt, &item.UpdatedAt); err != nil {
+		if err = rows.Scan(&item.ID, &item.EncryptMeta, &item.Meta, &item.CreatedAt, &item.UpdatedAt); err != nil {
 			return nil, fmt.Errorf("failed to read data from db - item row: %w", err)
 		}
 		item.UserID = userID
@@ -86,17 +119,30 @@ func (pg *PGStorage) GetItemsByType(ctx context.Context, dataType string, userID
 	return items, nil
 }
 
-// UpdateItem обновляет данные.
+// UpdateItem обновляет данные, сохраняя предыдущую версию в истории.
 func (pg *PGStorage) UpdateItem(ctx context.Context, id string, userID string, item *model.VaultItem) error {
-	res, err := pg.pool.Exec(ctx,
-		`UPDATE user_data SET encrypt_data = $1, meta = $2, updated_at = NOW() WHERE id = $3 AND user_id = $4;`,
-		item.EncryptData, item.Meta, id, userID,
-	)
+	err := pgx.BeginFunc(ctx, pg.db, func(tx pgx.Tx) error {
+		var encKey []byte
+		row := tx.QueryRow(ctx,
+			`SELECT encrypt_key FROM user_data WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL FOR UPDATE;`,
+			id, userID,
+		)
+		if err := row.Scan(&encKey); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return storage.ErrNoData
+			}
+			return fmt.Errorf("failed to get item key: %w", err)
+		}
+		if !bytes.Equal(encKey, item.EncryptKey) {
+			return storage.ErrConflict
+		}
+		return updateWithHistory(ctx, tx, id, userID, item)
+	})
 	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrConflict) {
+			return err
+		}
 		return fmt.Errorf("failed to update item: %w", err)
 	}
-	if res.RowsAffected() == 0 {
-		return ErrNoData
-	}
 	return nil
 }
@@ -0,0 +1,31 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+)
+
+// SetFavorite закрепляет данные в избранном или убирает их оттуда.
+func (s *SQLiteStorage) SetFavorite(ctx context.Context, id string, userID string, favorite bool) error {
+	res, err := s.q.ExecContext(ctx,
+		`UPDATE user_data SET favorite = ? WHERE id = ? AND user_id = ? AND deleted_at IS NULL AND `+notExpired+`;`,
+		favorite, id, userID, time.Now().UTC(),
+	)
+	if err != nil {
+		return err
+	}
+	return checkAffected(res)
+}
+
+// RecordAccess записывает время получения данных и увеличивает счетчик получений.
+func (s *SQLiteStorage) RecordAccess(ctx context.Context, id string, userID string, at time.Time) error {
+	res, err := s.q.ExecContext(ctx,
+		`UPDATE user_data SET last_accessed_at = ?, access_count = access_count + 1
+		WHERE id = ? AND user_id = ? AND deleted_at IS NULL AND `+notExpired+`;`,
+		at.UTC(), id, userID, time.Now().UTC(),
+	)
+	if err != nil {
+		return err
+	}
+	return checkAffected(res)
+}
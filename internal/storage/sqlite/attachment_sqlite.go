@@ -0,0 +1,65 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// ListAttachments возвращает вложения данных пользователя (без самих данных) по времени создания.
+func (s *SQLiteStorage) ListAttachments(
+	ctx context.Context, parentID string, userID string,
+) ([]model.VaultItem, error) {
+	var items []model.VaultItem
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT id, encrypt_meta, meta, data_type, revision, size, created_at, updated_at, expires_at FROM user_data
+		WHERE parent_id = ? AND user_id = ? AND deleted_at IS NULL AND `+notExpired+` ORDER BY created_at, id;`,
+		parentID, userID, time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item model.VaultItem
+		if err = rows.Scan(
+			&item.ID, &item.EncryptMeta, &item.Meta, &item.Type, &item.Revision, &item.Size, &item.CreatedAt,
+			&item.UpdatedAt, &item.ExpiresAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - attachment row: %w", err)
+		}
+		item.UserID = userID
+		item.ParentID = parentID
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	return items, nil
+}
+
+// checkParent проверяет, что к данным можно прикрепить вложение: они принадлежат пользователю,
+// не находятся в корзине и сами не являются вложением.
+func checkParent(ctx context.Context, q sqlQuerier, id string, userID string) error {
+	var parentID sql.NullString
+	row := q.QueryRowContext(ctx,
+		`SELECT parent_id FROM user_data WHERE id = ? AND user_id = ? AND deleted_at IS NULL;`,
+		id, userID,
+	)
+	if err := row.Scan(&parentID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.ErrNoData
+		}
+		return fmt.Errorf("failed to check parent item: %w", err)
+	}
+	if parentID.Valid {
+		return storage.ErrNoData
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// AppendAuditEvent добавляет событие в журнал аудита пользователя.
+func (s *SQLiteStorage) AppendAuditEvent(ctx context.Context, event *model.AuditEvent, key []byte) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, `SELECT id FROM users WHERE id = ?;`, event.UserID).Scan(new(string))
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return storage.ErrNoUser
+		case err != nil:
+			return fmt.Errorf("failed to get audit log user: %w", err)
+		}
+		var last model.AuditEvent
+		err = tx.QueryRowContext(ctx,
+			`SELECT seq, hash FROM audit_log WHERE user_id = ? ORDER BY seq DESC LIMIT 1;`,
+			event.UserID,
+		).Scan(&last.Seq, &last.Hash)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			event.Chain(nil, key)
+		case err != nil:
+			return fmt.Errorf("failed to get last audit event: %w", err)
+		default:
+			event.Chain(&last, key)
+		}
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO audit_log(user_id, seq, action, item_id, peer, code, created_at, prev_hash, hash)
+			VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?);`,
+			event.UserID, event.Seq, event.Action, event.ItemID, event.Peer, event.Code, event.CreatedAt,
+			event.PrevHash, event.Hash,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save audit event: %w", err)
+		}
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO audit_head(user_id, seq, tag) VALUES(?, ?, ?)
+			ON CONFLICT (user_id) DO UPDATE SET seq = excluded.seq, tag = excluded.tag;`,
+			event.UserID, event.Seq, event.HeadTag(key),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to save audit head: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetAuditHead возвращает последнее событие журнала аудита пользователя.
+func (s *SQLiteStorage) GetAuditHead(ctx context.Context, userID string) (model.AuditHead, error) {
+	var head model.AuditHead
+	err := s.q.QueryRowContext(ctx,
+		`SELECT COALESCE(h.seq, 0), h.tag FROM users u LEFT JOIN audit_head h ON h.user_id = u.id WHERE u.id = ?;`,
+		userID,
+	).Scan(&head.Seq, &head.Tag)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return model.AuditHead{}, storage.ErrNoUser
+	case err != nil:
+		return model.AuditHead{}, fmt.Errorf("failed to get audit head: %w", err)
+	}
+	return head, nil
+}
+
+// ListAuditEvents возвращает события журнала аудита пользователя в порядке их номеров.
+func (s *SQLiteStorage) ListAuditEvents(
+	ctx context.Context, userID string, params model.ListAuditParams,
+) ([]model.AuditEvent, error) {
+	args := []any{userID, params.AfterSeq}
+	query := `SELECT seq, action, item_id, peer, code, created_at, prev_hash, hash FROM audit_log
+		WHERE user_id = ? AND seq > ? ORDER BY seq`
+	if params.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, params.Limit)
+	}
+
+	rows, err := s.q.QueryContext(ctx, query+";", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []model.AuditEvent
+	for rows.Next() {
+		event := model.AuditEvent{UserID: userID}
+		if err = rows.Scan(
+			&event.Seq, &event.Action, &event.ItemID, &event.Peer, &event.Code, &event.CreatedAt,
+			&event.PrevHash, &event.Hash,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - audit event row: %w", err)
+		}
+		event.CreatedAt = event.CreatedAt.UTC()
+		events = append(events, event)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get audit events: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,283 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// ListBackupOrgs возвращает все организации с ключами организаций в порядке создания.
+func (s *SQLiteStorage) ListBackupOrgs(ctx context.Context) ([]model.Organization, error) {
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT o.id, o.encrypt_name, u.encrypt_secret, o.created_at FROM organizations o
+		JOIN users u ON u.id = o.id
+		ORDER BY o.created_at, o.id;`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	defer rows.Close()
+
+	var orgs []model.Organization
+	for rows.Next() {
+		var org model.Organization
+		if err = rows.Scan(&org.ID, &org.EncryptName, &org.EncryptedSecret, &org.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - organization row: %w", err)
+		}
+		orgs = append(orgs, org)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	return orgs, nil
+}
+
+// ListBackupItems возвращает все данные пользователя, включая данные в корзине и с истекшим сроком хранения.
+func (s *SQLiteStorage) ListBackupItems(ctx context.Context, userID string) ([]model.VaultItem, error) {
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT id, encrypt_data, encrypt_meta, meta_index, meta, data_type, revision, chunked, size, created_at,
+		updated_at, deleted_at, COALESCE(folder_id, ''), COALESCE(parent_id, ''), expires_at,
+		(SELECT json_group_array(tag_id) FROM item_tags WHERE item_id = user_data.id), favorite, last_accessed_at,
+		access_count, encrypt_key, shared_meta
+		FROM user_data WHERE user_id = ? ORDER BY created_at, id;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []model.VaultItem
+	for rows.Next() {
+		var item model.VaultItem
+		if err = rows.Scan(
+			&item.ID, &item.EncryptData, &item.EncryptMeta, (*stringList)(&item.MetaIndex), &item.Meta, &item.Type,
+			&item.Revision, &item.Chunked, &item.Size, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt,
+			&item.FolderID, &item.ParentID, &item.ExpiresAt, (*stringList)(&item.TagIDs), &item.Favorite,
+			&item.LastAccessedAt, &item.AccessCount, &item.EncryptKey, &item.SharedMeta,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - item row: %w", err)
+		}
+		item.UserID = userID
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list items: %w", err)
+	}
+	return items, nil
+}
+
+// ListBackupHistory возвращает предыдущие версии данных вместе с самими данными в порядке номеров.
+func (s *SQLiteStorage) ListBackupHistory(ctx context.Context, itemID string) ([]model.VaultItemRevision, error) {
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT revision, encrypt_data, encrypt_meta, meta_index, meta, chunked, size, shared_meta, created_at
+		FROM user_data_history WHERE item_id = ? ORDER BY revision;`,
+		itemID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item history: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []model.VaultItemRevision
+	for rows.Next() {
+		rev := model.VaultItemRevision{ItemID: itemID}
+		if err = rows.Scan(
+			&rev.Revision, &rev.EncryptData, &rev.EncryptMeta, (*stringList)(&rev.MetaIndex), &rev.Meta,
+			&rev.Chunked, &rev.Size, &rev.SharedMeta, &rev.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - revision row: %w", err)
+		}
+		revisions = append(revisions, rev)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get item history: %w", err)
+	}
+	return revisions, nil
+}
+
+// ListBackupShares возвращает доступы, предоставленные пользователем, включая доступы к данным в корзине.
+func (s *SQLiteStorage) ListBackupShares(ctx context.Context, ownerID string) ([]model.Share, error) {
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT item_id, recipient_id, access, encrypt_key, created_at FROM item_shares
+		WHERE owner_id = ? ORDER BY created_at, item_id, recipient_id;`,
+		ownerID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shares: %w", err)
+	}
+	defer rows.Close()
+
+	var shares []model.Share
+	for rows.Next() {
+		share := model.Share{OwnerID: ownerID}
+		if err = rows.Scan(
+			&share.ItemID, &share.RecipientID, &share.Access, &share.EncryptKey, &share.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - share row: %w", err)
+		}
+		shares = append(shares, share)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list shares: %w", err)
+	}
+	return shares, nil
+}
+
+// RestoreUser сохраняет пользователя или аккаунт организации с заданным id.
+func (s *SQLiteStorage) RestoreUser(ctx context.Context, user *model.User) error {
+	_, err := s.q.ExecContext(ctx,
+		`INSERT INTO users(id, login, password_hash, encrypt_secret, public_key, encrypt_private_key)
+		VALUES(?, ?, ?, ?, ?, ?);`,
+		user.ID, user.Login, user.PasswordHash, user.EncryptedSecret, user.PublicKey, user.EncryptPrivateKey,
+	)
+	if err != nil {
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
+			return storage.ErrLoginTaken
+		}
+		return fmt.Errorf("failed to restore user: %w", err)
+	}
+	return nil
+}
+
+// RestoreOrg сохраняет организацию, аккаунт которой уже сохранен.
+func (s *SQLiteStorage) RestoreOrg(ctx context.Context, org *model.Organization) error {
+	_, err := s.q.ExecContext(ctx,
+		`INSERT INTO organizations(id, encrypt_name, created_at) VALUES(?, ?, ?);`,
+		org.ID, org.EncryptName, org.CreatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore organization: %w", err)
+	}
+	return nil
+}
+
+// RestoreOrgMember сохраняет участника организации со временем его добавления.
+func (s *SQLiteStorage) RestoreOrgMember(ctx context.Context, member *model.OrgMember) error {
+	_, err := s.q.ExecContext(ctx,
+		`INSERT INTO org_members(org_id, user_id, role, encrypt_key, created_at) VALUES(?, ?, ?, ?, ?);`,
+		member.OrgID, member.UserID, member.Role, member.EncryptKey, member.CreatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore organization member: %w", err)
+	}
+	return nil
+}
+
+// RestoreFolder сохраняет папку с заданным id и временем создания.
+func (s *SQLiteStorage) RestoreFolder(ctx context.Context, folder *model.Folder) error {
+	_, err := s.q.ExecContext(ctx,
+		`INSERT INTO folders(id, user_id, parent_id, encrypt_name, created_at) VALUES(?, ?, ?, ?, ?);`,
+		folder.ID, folder.UserID, sql.NullString{String: folder.ParentID, Valid: folder.ParentID != ""},
+		folder.EncryptName, folder.CreatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore folder: %w", err)
+	}
+	return nil
+}
+
+// RestoreTag сохраняет метку с заданным id.
+func (s *SQLiteStorage) RestoreTag(ctx context.Context, tag *model.Tag) error {
+	_, err := s.q.ExecContext(ctx,
+		`INSERT INTO tags(id, user_id, encrypt_name, name_index) VALUES(?, ?, ?, ?);`,
+		tag.ID, tag.UserID, tag.EncryptName, tag.NameIndex,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore tag: %w", err)
+	}
+	return nil
+}
+
+// RestoreItem сохраняет данные со всеми полями, метками и предыдущими версиями.
+func (s *SQLiteStorage) RestoreItem(
+	ctx context.Context, item *model.VaultItem, history []model.VaultItemRevision,
+) error {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO user_data(
+				id, user_id, encrypt_data, encrypt_meta, meta_index, meta, data_type, revision, chunked, size,
+				created_at, updated_at, deleted_at, folder_id, parent_id, expires_at, favorite, last_accessed_at,
+				access_count, encrypt_key, shared_meta
+			)
+			VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`,
+			item.ID, item.UserID, item.EncryptData, item.EncryptMeta, stringList(item.MetaIndex), item.Meta,
+			item.Type, item.Revision, item.Chunked, item.Size, item.CreatedAt.UTC(), item.UpdatedAt.UTC(),
+			nullTime(item.DeletedAt), sql.NullString{String: item.FolderID, Valid: item.FolderID != ""},
+			sql.NullString{String: item.ParentID, Valid: item.ParentID != ""}, nullTime(item.ExpiresAt),
+			item.Favorite, nullTime(item.LastAccessedAt), item.AccessCount, item.EncryptKey, item.SharedMeta,
+		)
+		if err != nil {
+			return err
+		}
+		for _, tagID := range item.TagIDs {
+			if _, err = tx.ExecContext(ctx,
+				"INSERT INTO item_tags(item_id, tag_id) VALUES(?, ?);", item.ID, tagID,
+			); err != nil {
+				return err
+			}
+		}
+		for _, rev := range history {
+			if _, err = tx.ExecContext(ctx,
+				`INSERT INTO user_data_history(
+					item_id, revision, encrypt_data, encrypt_meta, meta_index, meta, chunked, size, shared_meta, created_at
+				)
+				VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`,
+				item.ID, rev.Revision, rev.EncryptData, rev.EncryptMeta, stringList(rev.MetaIndex), rev.Meta,
+				rev.Chunked, rev.Size, rev.SharedMeta, rev.CreatedAt.UTC(),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore item: %w", err)
+	}
+	return nil
+}
+
+// RestoreShare сохраняет доступ к данным со временем его предоставления.
+func (s *SQLiteStorage) RestoreShare(ctx context.Context, share *model.Share) error {
+	_, err := s.q.ExecContext(ctx,
+		`INSERT INTO item_shares(item_id, owner_id, recipient_id, access, encrypt_key, created_at)
+		VALUES(?, ?, ?, ?, ?, ?);`,
+		share.ItemID, share.OwnerID, share.RecipientID, share.Access, share.EncryptKey, share.CreatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore share: %w", err)
+	}
+	return nil
+}
+
+// RestoreAuditEvent сохраняет событие журнала аудита как есть.
+func (s *SQLiteStorage) RestoreAuditEvent(ctx context.Context, event *model.AuditEvent) error {
+	_, err := s.q.ExecContext(ctx,
+		`INSERT INTO audit_log(user_id, seq, action, item_id, peer, code, created_at, prev_hash, hash)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?);`,
+		event.UserID, event.Seq, event.Action, event.ItemID, event.Peer, event.Code, event.CreatedAt,
+		event.PrevHash, event.Hash,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore audit event: %w", err)
+	}
+	return nil
+}
+
+// RestoreAuditHead сохраняет последнее событие журнала аудита пользователя.
+func (s *SQLiteStorage) RestoreAuditHead(ctx context.Context, userID string, head model.AuditHead) error {
+	_, err := s.q.ExecContext(ctx,
+		`INSERT INTO audit_head(user_id, seq, tag) VALUES(?, ?, ?);`,
+		userID, head.Seq, head.Tag,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore audit head: %w", err)
+	}
+	return nil
+}
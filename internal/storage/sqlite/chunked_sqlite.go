@@ -0,0 +1,35 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListChunkedData возвращает манифесты всех сохраненных блоками файлов пользователя,
+// включая данные в корзине и предыдущие версии.
+func (s *SQLiteStorage) ListChunkedData(ctx context.Context, userID string) ([][]byte, error) {
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT encrypt_data FROM user_data WHERE user_id = ? AND chunked
+		UNION ALL
+		SELECT h.encrypt_data FROM user_data_history h JOIN user_data d ON d.id = h.item_id
+		WHERE d.user_id = ? AND h.chunked;`,
+		userID, userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chunked data: %w", err)
+	}
+	defer rows.Close()
+
+	var manifests [][]byte
+	for rows.Next() {
+		var manifest []byte
+		if err = rows.Scan(&manifest); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - manifest row: %w", err)
+		}
+		manifests = append(manifests, manifest)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list chunked data: %w", err)
+	}
+	return manifests, nil
+}
@@ -0,0 +1,2 @@
+// Package sqlite содержит реализацию хранилища во встраиваемой БД SQLite (данные хранятся в одном файле).
+package sqlite
@@ -0,0 +1,28 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+)
+
+// notExpired условие отбора данных, срок хранения которых не истек к моменту, переданному параметром.
+const notExpired = `(expires_at IS NULL OR expires_at > ?)`
+
+// PurgeExpiredItems безвозвратно удаляет данные всех пользователей, срок хранения которых истек
+// к моменту now, вместе с их вложениями.
+func (s *SQLiteStorage) PurgeExpiredItems(ctx context.Context, now time.Time) (int64, error) {
+	now = now.UTC()
+	return s.purge(ctx,
+		`(expires_at <= ? OR parent_id IN (SELECT id FROM user_data WHERE expires_at <= ?))`,
+		now, now,
+	)
+}
+
+// nullTime возвращает время в UTC для записи в БД или nil, если время не задано.
+// Время хранится в UTC, чтобы сравнение в запросах совпадало с хронологическим порядком.
+func nullTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
@@ -0,0 +1,238 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// CreateFolder сохраняет новую папку пользователя.
+func (s *SQLiteStorage) CreateFolder(ctx context.Context, userID string, folder *model.Folder) (string, error) {
+	id := uuid.NewString()
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		if folder.ParentID != "" {
+			if err := checkFolder(ctx, tx, folder.ParentID, userID); err != nil {
+				return err
+			}
+		}
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO folders(id, user_id, parent_id, encrypt_name, created_at) VALUES(?, ?, ?, ?, ?);`,
+			id, userID, sql.NullString{String: folder.ParentID, Valid: folder.ParentID != ""}, folder.EncryptName,
+			time.Now().UTC(),
+		)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoFolder) {
+			return "", err
+		}
+		return "", fmt.Errorf("failed to create folder: %w", err)
+	}
+	folder.ID = id
+	return id, nil
+}
+
+// ListFolders возвращает папки пользователя в порядке их создания.
+func (s *SQLiteStorage) ListFolders(ctx context.Context, userID string) ([]model.Folder, error) {
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT id, COALESCE(parent_id, ''), encrypt_name, created_at FROM folders
+		WHERE user_id = ? ORDER BY created_at, id;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list folders: %w", err)
+	}
+	defer rows.Close()
+
+	var folders []model.Folder
+	for rows.Next() {
+		var folder model.Folder
+		if err = rows.Scan(&folder.ID, &folder.ParentID, &folder.EncryptName, &folder.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - folder row: %w", err)
+		}
+		folder.UserID = userID
+		folders = append(folders, folder)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list folders: %w", err)
+	}
+	return folders, nil
+}
+
+// DeleteFolder удаляет папку, перемещая ее данные и вложенные папки в родительскую папку.
+func (s *SQLiteStorage) DeleteFolder(ctx context.Context, id string, userID string) error {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		var parentID sql.NullString
+		err := tx.QueryRowContext(ctx,
+			"SELECT parent_id FROM folders WHERE id = ? AND user_id = ?;", id, userID,
+		).Scan(&parentID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrNoFolder
+			}
+			return err
+		}
+		if _, err = tx.ExecContext(ctx,
+			"UPDATE user_data SET folder_id = ? WHERE folder_id = ? AND user_id = ?;", parentID, id, userID,
+		); err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx,
+			"UPDATE folders SET parent_id = ? WHERE parent_id = ? AND user_id = ?;", parentID, id, userID,
+		); err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM folders WHERE id = ?;", id)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoFolder) {
+			return err
+		}
+		return fmt.Errorf("failed to delete folder: %w", err)
+	}
+	return nil
+}
+
+// MoveItem перемещает данные в папку; пустой folderID - из папки на верхний уровень.
+func (s *SQLiteStorage) MoveItem(ctx context.Context, id string, userID string, folderID string) error {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		if folderID != "" {
+			if err := checkFolder(ctx, tx, folderID, userID); err != nil {
+				return err
+			}
+		}
+		res, err := tx.ExecContext(ctx,
+			"UPDATE user_data SET folder_id = ? WHERE id = ? AND user_id = ? AND deleted_at IS NULL;",
+			sql.NullString{String: folderID, Valid: folderID != ""}, id, userID,
+		)
+		if err != nil {
+			return err
+		}
+		return checkAffected(res)
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrNoFolder) {
+			return err
+		}
+		return fmt.Errorf("failed to move item: %w", err)
+	}
+	return nil
+}
+
+// TagItem добавляет данным метку, создавая ее, если у пользователя еще нет метки с таким индексом названия.
+func (s *SQLiteStorage) TagItem(ctx context.Context, id string, userID string, tag *model.Tag) error {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		if err := checkItemOwner(ctx, tx, id, userID); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO tags(id, user_id, encrypt_name, name_index) VALUES(?, ?, ?, ?)
+			ON CONFLICT (user_id, name_index) DO NOTHING;`,
+			uuid.NewString(), userID, tag.EncryptName, tag.NameIndex,
+		); err != nil {
+			return err
+		}
+		if err := tx.QueryRowContext(ctx,
+			"SELECT id FROM tags WHERE user_id = ? AND name_index = ?;", userID, tag.NameIndex,
+		).Scan(&tag.ID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO item_tags(item_id, tag_id) VALUES(?, ?) ON CONFLICT DO NOTHING;", id, tag.ID,
+		)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) {
+			return err
+		}
+		return fmt.Errorf("failed to tag item: %w", err)
+	}
+	return nil
+}
+
+// UntagItem снимает метку с данных и удаляет метку, если она больше не используется.
+func (s *SQLiteStorage) UntagItem(ctx context.Context, id string, userID string, nameIndex string) error {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		if err := checkItemOwner(ctx, tx, id, userID); err != nil {
+			return err
+		}
+		var tagID string
+		err := tx.QueryRowContext(ctx,
+			"SELECT id FROM tags WHERE user_id = ? AND name_index = ?;", userID, nameIndex,
+		).Scan(&tagID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrNoTag
+			}
+			return err
+		}
+		res, err := tx.ExecContext(ctx, "DELETE FROM item_tags WHERE item_id = ? AND tag_id = ?;", id, tagID)
+		if err != nil {
+			return err
+		}
+		if err = checkAffected(res); err != nil {
+			return storage.ErrNoTag
+		}
+		_, err = tx.ExecContext(ctx,
+			"DELETE FROM tags WHERE id = ? AND NOT EXISTS (SELECT 1 FROM item_tags WHERE tag_id = ?);", tagID, tagID,
+		)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrNoTag) {
+			return err
+		}
+		return fmt.Errorf("failed to untag item: %w", err)
+	}
+	return nil
+}
+
+// ListTags возвращает метки пользователя с количеством данных (без данных в корзине) с каждой из них.
+func (s *SQLiteStorage) ListTags(ctx context.Context, userID string) ([]model.Tag, error) {
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT id, encrypt_name, name_index, (
+			SELECT COUNT(*) FROM item_tags JOIN user_data ON user_data.id = item_tags.item_id
+			WHERE item_tags.tag_id = tags.id AND user_data.deleted_at IS NULL
+		) FROM tags WHERE user_id = ? ORDER BY id;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []model.Tag
+	for rows.Next() {
+		var tag model.Tag
+		if err = rows.Scan(&tag.ID, &tag.EncryptName, &tag.NameIndex, &tag.Items); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - tag row: %w", err)
+		}
+		tag.UserID = userID
+		tags = append(tags, tag)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return tags, nil
+}
+
+// checkFolder проверяет, что папка существует и принадлежит пользователю.
+func checkFolder(ctx context.Context, q sqlQuerier, id string, userID string) error {
+	var exists bool
+	row := q.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM folders WHERE id = ? AND user_id = ?);`, id, userID)
+	if err := row.Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check folder owner: %w", err)
+	}
+	if !exists {
+		return storage.ErrNoFolder
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// GetItemHistory возвращает список предыдущих версий данных (без самих данных).
+func (s *SQLiteStorage) GetItemHistory(ctx context.Context, id string, userID string) ([]model.VaultItemRevision, error) {
+	if err := checkItemOwner(ctx, s.q, id, userID); err != nil {
+		return nil, err
+	}
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT revision, encrypt_meta, meta, created_at FROM user_data_history WHERE item_id = ? ORDER BY revision;`,
+		id,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item history: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []model.VaultItemRevision
+	for rows.Next() {
+		revision := model.VaultItemRevision{ItemID: id}
+		if err = rows.Scan(&revision.Revision, &revision.EncryptMeta, &revision.Meta, &revision.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - revision row: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get item history: %w", err)
+	}
+	return revisions, nil
+}
+
+// GetItemRevision возвращает конкретную версию данных.
+func (s *SQLiteStorage) GetItemRevision(
+	ctx context.Context, id string, userID string, revision int64,
+) (*model.VaultItemRevision, error) {
+	if err := checkItemOwner(ctx, s.q, id, userID); err != nil {
+		return nil, err
+	}
+	return getRevision(ctx, s.q, id, revision)
+}
+
+// RestoreItemRevision восстанавливает данные из указанной версии.
+// Текущие данные при этом сохраняются в истории как новая версия.
+func (s *SQLiteStorage) RestoreItemRevision(ctx context.Context, id string, userID string, revision int64) error {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		if err := checkItemOwner(ctx, tx, id, userID); err != nil {
+			return err
+		}
+		rev, err := getRevision(ctx, tx, id, revision)
+		if err != nil {
+			return err
+		}
+		return updateWithHistory(ctx, tx, id, userID, &model.VaultItem{
+			EncryptData: rev.EncryptData,
+			EncryptMeta: rev.EncryptMeta,
+			MetaIndex:   rev.MetaIndex,
+			Meta:        rev.Meta,
+			Chunked:     rev.Chunked,
+			Size:        rev.Size,
+			SharedMeta:  rev.SharedMeta,
+		})
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrNoRevision) {
+			return err
+		}
+		return fmt.Errorf("failed to restore item revision: %w", err)
+	}
+	return nil
+}
+
+// checkItemOwner проверяет, что данные существуют и принадлежат пользователю.
+func checkItemOwner(ctx context.Context, q sqlQuerier, id string, userID string) error {
+	var exists bool
+	row := q.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM user_data WHERE id = ? AND user_id = ? AND deleted_at IS NULL);`,
+		id, userID,
+	)
+	if err := row.Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check item owner: %w", err)
+	}
+	if !exists {
+		return storage.ErrNoData
+	}
+	return nil
+}
+
+// getItemKey возвращает зашифрованный ключ данных пользователя (nil - данные на ключе владельца).
+func getItemKey(ctx context.Context, q sqlQuerier, id string, userID string) ([]byte, error) {
+	var encKey []byte
+	row := q.QueryRowContext(ctx,
+		`SELECT encrypt_key FROM user_data WHERE id = ? AND user_id = ? AND deleted_at IS NULL;`,
+		id, userID,
+	)
+	if err := row.Scan(&encKey); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNoData
+		}
+		return nil, fmt.Errorf("failed to get item key: %w", err)
+	}
+	return encKey, nil
+}
+
+// getRevision возвращает версию данных по номеру.
+func getRevision(ctx context.Context, q sqlQuerier, id string, revision int64) (*model.VaultItemRevision, error) {
+	rev := model.VaultItemRevision{ItemID: id, Revision: revision}
+	row := q.QueryRowContext(ctx,
+		`SELECT encrypt_data, encrypt_meta, meta_index, meta, chunked, size, shared_meta, created_at
+		FROM user_data_history WHERE item_id = ? AND revision = ?;`,
+		id, revision,
+	)
+	if err := row.Scan(
+		&rev.EncryptData, &rev.EncryptMeta, (*stringList)(&rev.MetaIndex), &rev.Meta, &rev.Chunked, &rev.Size,
+		&rev.SharedMeta, &rev.CreatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNoRevision
+		}
+		return nil, fmt.Errorf("failed to get item revision: %w", err)
+	}
+	return &rev, nil
+}
+
+// updateWithHistory переносит текущие данные в историю под их номером версии,
+// записывает новые и проставляет в item новый номер версии.
+// При ненулевом item.Revision обновление выполняется, только если это текущая версия данных.
+// Должна вызываться внутри транзакции.
+func updateWithHistory(ctx context.Context, tx *sql.Tx, id string, userID string, item *model.VaultItem) error {
+	var revision int64
+	row := tx.QueryRowContext(ctx,
+		`SELECT revision FROM user_data WHERE id = ? AND user_id = ? AND deleted_at IS NULL;`,
+		id, userID,
+	)
+	if err := row.Scan(&revision); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.ErrNoData
+		}
+		return fmt.Errorf("failed to get item revision: %w", err)
+	}
+	if item.Revision != 0 && item.Revision != revision {
+		return storage.ErrConflict
+	}
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO user_data_history(
+			item_id, revision, encrypt_data, encrypt_meta, meta_index, meta, chunked, size, shared_meta, created_at
+		)
+		SELECT id, revision, encrypt_data, encrypt_meta, meta_index, meta, chunked, size, shared_meta, updated_at
+		FROM user_data WHERE id = ?;`,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save item revision: %w", err)
+	}
+	_, err = tx.ExecContext(ctx,
+		`UPDATE user_data SET encrypt_data = ?, encrypt_meta = ?, meta_index = ?, meta = ?, chunked = ?, size = ?,
+		shared_meta = ?, revision = ?, updated_at = ?, expires_at = COALESCE(?, expires_at)
+		WHERE id = ?;`,
+		item.EncryptData, item.EncryptMeta, stringList(item.MetaIndex), item.Meta, item.Chunked, item.Size,
+		item.SharedMeta, revision+1, time.Now().UTC(), nullTime(item.ExpiresAt), id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update item: %w", err)
+	}
+	item.Revision = revision + 1
+	return nil
+}
@@ -0,0 +1,105 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// listColumns колонки списка данных: без самих данных, с папкой, id меток и статистикой получений.
+const listColumns = `id, encrypt_meta, meta, data_type, revision, created_at, updated_at, COALESCE(folder_id, ''),
+	(SELECT json_group_array(tag_id) FROM item_tags WHERE item_id = user_data.id), expires_at,
+	favorite, last_accessed_at, access_count`
+
+// accessedSort выражение сортировки по времени последнего получения: данные, которые не получались,
+// сравниваются по нулевому времени, как и в позиции списка (model.ItemsCursor).
+const accessedSort = `COALESCE(last_accessed_at, '0001-01-01 00:00:00+00:00')`
+
+// ListItems возвращает страницу списка данных пользователя (без самих данных).
+func (s *SQLiteStorage) ListItems(
+	ctx context.Context, userID string, params model.ListItemsParams,
+) ([]model.VaultItem, error) {
+	query, args := listItemsQuery(userID, params)
+	rows, err := s.q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []model.VaultItem
+	for rows.Next() {
+		var item model.VaultItem
+		if err = rows.Scan(
+			&item.ID, &item.EncryptMeta, &item.Meta, &item.Type, &item.Revision, &item.CreatedAt, &item.UpdatedAt,
+			&item.FolderID, (*stringList)(&item.TagIDs), &item.ExpiresAt, &item.Favorite, &item.LastAccessedAt,
+			&item.AccessCount,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - item row: %w", err)
+		}
+		item.UserID = userID
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list items: %w", err)
+	}
+	return items, nil
+}
+
+// listItemsQuery формирует запрос выборки списка данных с keyset пагинацией.
+// Мета данные зашифрованы, поэтому при сортировке по ним данные упорядочиваются по времени создания.
+// При params.FavoritesFirst избранные данные идут в начале списка.
+func listItemsQuery(userID string, params model.ListItemsParams) (string, []any) {
+	sortExpr := "created_at"
+	switch params.SortBy {
+	case model.SortByUpdated:
+		sortExpr = "updated_at"
+	case model.SortByAccessed:
+		sortExpr = accessedSort
+	}
+
+	var query strings.Builder
+	args := []any{userID, time.Now().UTC()}
+	query.WriteString(`SELECT ` + listColumns + ` FROM user_data
+		WHERE user_id = ? AND deleted_at IS NULL AND ` + notExpired)
+	if params.Type != "" {
+		query.WriteString(" AND data_type = ?")
+		args = append(args, params.Type)
+	}
+	if params.FolderID != "" {
+		query.WriteString(" AND folder_id = ?")
+		args = append(args, params.FolderID)
+	}
+	if params.TagIndex != "" {
+		query.WriteString(` AND EXISTS (SELECT 1 FROM item_tags JOIN tags ON tags.id = item_tags.tag_id
+			WHERE item_tags.item_id = user_data.id AND tags.name_index = ?)`)
+		args = append(args, params.TagIndex)
+	}
+
+	order, cmp := "ASC", ">"
+	if params.Desc {
+		order, cmp = "DESC", "<"
+	}
+	if params.After != nil {
+		after := fmt.Sprintf("(%s, id) %s (?, ?)", sortExpr, cmp)
+		if params.FavoritesFirst {
+			// Избранные данные идут первыми, поэтому после избранных следуют только неизбранные.
+			after = "(favorite < ? OR favorite = ? AND " + after + ")"
+			args = append(args, params.After.Favorite, params.After.Favorite)
+		}
+		query.WriteString(" AND " + after)
+		args = append(args, params.After.Time.UTC(), params.After.ID)
+	}
+	query.WriteString(" ORDER BY ")
+	if params.FavoritesFirst {
+		query.WriteString("favorite DESC, ")
+	}
+	fmt.Fprintf(&query, "%s %s, id %s", sortExpr, order, order)
+	if params.Limit > 0 {
+		query.WriteString(" LIMIT ?")
+		args = append(args, params.Limit)
+	}
+	return query.String() + ";", args
+}
@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// stringList описывает список строк, хранящийся в колонке как JSON массив
+// (слепые индексы мета данных в meta_index, id меток данных).
+type stringList []string
+
+// Value возвращает значение для записи в БД.
+func (m stringList) Value() (driver.Value, error) {
+	if m == nil {
+		return "[]", nil
+	}
+	value, err := json.Marshal([]string(m))
+	if err != nil {
+		return nil, err
+	}
+	return string(value), nil
+}
+
+// Scan читает значение из БД.
+func (m *stringList) Scan(src any) error {
+	var raw []byte
+	switch v := src.(type) {
+	case string:
+		raw = []byte(v)
+	case []byte:
+		raw = v
+	default:
+		return errors.New("unsupported string list type")
+	}
+	var index []string
+	if err := json.Unmarshal(raw, &index); err != nil {
+		return err
+	}
+	if len(index) == 0 {
+		index = nil
+	}
+	*m = index
+	return nil
+}
+
+// ListLegacyMeta возвращает мета данные текущих и предыдущих версий, сохраненные в открытом виде.
+func (s *SQLiteStorage) ListLegacyMeta(ctx context.Context, limit int) ([]model.LegacyMeta, error) {
+	query := `SELECT user_id, id, 0, meta FROM user_data WHERE encrypt_meta IS NULL AND meta != ''
+		UNION ALL
+		SELECT d.user_id, h.item_id, h.revision, h.meta FROM user_data_history h JOIN user_data d ON d.id = h.item_id
+		WHERE h.encrypt_meta IS NULL AND h.meta != ''`
+	var args []any
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+	rows, err := s.q.QueryContext(ctx, query+";", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list legacy meta: %w", err)
+	}
+	defer rows.Close()
+
+	var metas []model.LegacyMeta
+	for rows.Next() {
+		var meta model.LegacyMeta
+		if err = rows.Scan(&meta.UserID, &meta.ItemID, &meta.Revision, &meta.Meta); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - meta row: %w", err)
+		}
+		metas = append(metas, meta)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list legacy meta: %w", err)
+	}
+	return metas, nil
+}
+
+// EncryptLegacyMeta заменяет сохраненные в открытом виде мета данные версии зашифрованными.
+func (s *SQLiteStorage) EncryptLegacyMeta(ctx context.Context, meta model.LegacyMeta) error {
+	var err error
+	if meta.Revision == 0 {
+		_, err = s.q.ExecContext(ctx,
+			`UPDATE user_data SET encrypt_meta = ?, meta_index = ?, meta = ''
+			WHERE id = ? AND encrypt_meta IS NULL;`,
+			meta.EncryptMeta, stringList(meta.MetaIndex), meta.ItemID,
+		)
+	} else {
+		_, err = s.q.ExecContext(ctx,
+			`UPDATE user_data_history SET encrypt_meta = ?, meta_index = ?, meta = ''
+			WHERE item_id = ? AND revision = ? AND encrypt_meta IS NULL;`,
+			meta.EncryptMeta, stringList(meta.MetaIndex), meta.ItemID, meta.Revision,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encrypt legacy meta: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE users (
+  id TEXT PRIMARY KEY,
+  login TEXT UNIQUE NOT NULL, -- Логин пользователя
+  password_hash TEXT NOT NULL, -- Хэш пароля пользователя
+  encrypt_secret TEXT NOT NULL -- Зашифрованный ключ пользователя (для данных)
+);
+
+CREATE TABLE user_data (
+  id TEXT PRIMARY KEY,
+  user_id TEXT NOT NULL REFERENCES users (id),
+  encrypt_data BLOB NOT NULL, -- Зашифрованные данные
+  meta TEXT NOT NULL, -- Мета информация о данных (JSON)
+  data_type TEXT NOT NULL, -- Тип данных
+  created_at TIMESTAMP NOT NULL, -- Timestamp создания записи
+  updated_at TIMESTAMP NOT NULL -- Timestamp обновления записи
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_data;
+DROP TABLE users;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_data_history (
+  item_id TEXT NOT NULL REFERENCES user_data (id) ON DELETE CASCADE,
+  revision INTEGER NOT NULL, -- Номер версии (последовательный в рамках записи)
+  encrypt_data BLOB NOT NULL, -- Зашифрованные данные версии
+  meta TEXT NOT NULL, -- Мета информация версии
+  created_at TIMESTAMP NOT NULL, -- Timestamp сохранения данных этой версии
+  PRIMARY KEY (item_id, revision)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_data_history;
+-- +goose StatementEnd
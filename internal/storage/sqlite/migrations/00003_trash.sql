@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN deleted_at TIMESTAMP; -- Timestamp перемещения в корзину (NULL - данные не удалены)
+CREATE INDEX user_data_deleted_at_idx ON user_data (deleted_at) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX user_data_deleted_at_idx;
+ALTER TABLE user_data DROP COLUMN deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN revision INTEGER NOT NULL DEFAULT 1; -- Номер текущей версии данных (увеличивается при каждом изменении)
+UPDATE user_data SET revision = 1 + (
+  SELECT COALESCE(MAX(revision), 0) FROM user_data_history WHERE item_id = user_data.id
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_data DROP COLUMN revision;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN chunked BOOLEAN NOT NULL DEFAULT FALSE; -- encrypt_data содержит манифест файла в хранилище блоков
+ALTER TABLE user_data_history ADD COLUMN chunked BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_data_history DROP COLUMN chunked;
+ALTER TABLE user_data DROP COLUMN chunked;
+-- +goose StatementEnd
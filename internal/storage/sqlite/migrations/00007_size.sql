@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN size INTEGER NOT NULL DEFAULT 0; -- Размер незашифрованных данных
+ALTER TABLE user_data_history ADD COLUMN size INTEGER NOT NULL DEFAULT 0;
+-- Размер сохраненных целиком данных восстанавливается по размеру шифротекста (AES-GCM: nonce 12 байт + тег 16 байт).
+UPDATE user_data SET size = MAX(length(encrypt_data) - 28, 0) WHERE NOT chunked;
+UPDATE user_data_history SET size = MAX(length(encrypt_data) - 28, 0) WHERE NOT chunked;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_data_history DROP COLUMN size;
+ALTER TABLE user_data DROP COLUMN size;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_log (
+  user_id TEXT NOT NULL, -- Пользователь, выполнивший запрос
+  seq INTEGER NOT NULL, -- Порядковый номер события в журнале пользователя
+  action TEXT NOT NULL, -- Вызванный метод сервера
+  item_id TEXT NOT NULL, -- Данные, к которым относится запрос
+  peer TEXT NOT NULL, -- Адрес клиента
+  code TEXT NOT NULL, -- Код результата выполнения запроса
+  created_at TIMESTAMP NOT NULL, -- Timestamp события
+  prev_hash BLOB NOT NULL, -- HMAC предыдущего события пользователя
+  hash BLOB NOT NULL, -- HMAC события на ключе журнала
+  PRIMARY KEY (user_id, seq)
+);
+
+-- Журнал только дополняется.
+CREATE TRIGGER audit_log_no_update BEFORE UPDATE ON audit_log
+BEGIN
+  SELECT RAISE(ABORT, 'audit log is append-only');
+END;
+
+CREATE TRIGGER audit_log_no_delete BEFORE DELETE ON audit_log
+BEGIN
+  SELECT RAISE(ABORT, 'audit log is append-only');
+END;
+
+-- Последнее событие журнала аудита пользователя.
+CREATE TABLE audit_head (
+  user_id TEXT PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+  seq INTEGER NOT NULL, -- Номер последнего события
+  tag BLOB NOT NULL -- Отметка последнего события на ключе журнала
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_head;
+DROP TABLE audit_log;
+-- +goose StatementEnd
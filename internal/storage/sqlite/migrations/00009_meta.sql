@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN encrypt_meta BLOB; -- Зашифрованные мета данные (NULL - мета данные в meta в открытом виде)
+ALTER TABLE user_data ADD COLUMN meta_index TEXT NOT NULL DEFAULT '[]'; -- Слепые индексы полей мета данных (JSON массив)
+ALTER TABLE user_data_history ADD COLUMN encrypt_meta BLOB;
+ALTER TABLE user_data_history ADD COLUMN meta_index TEXT NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Зашифрованные мета данные средствами БД не расшифровать, поэтому миграция необратима.
+CREATE TEMP TABLE irreversible_migration (id INTEGER);
+CREATE TEMP TRIGGER irreversible_migration_abort BEFORE INSERT ON irreversible_migration
+BEGIN
+  SELECT RAISE(ABORT, 'migration 00009_meta is irreversible: encrypted metadata cannot be decrypted by the database');
+END;
+INSERT INTO irreversible_migration VALUES (1);
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Журнал только дополняется, но журнал удаленного пользователя удаляется вместе с ним.
+DROP TRIGGER audit_log_no_delete;
+CREATE TRIGGER audit_log_no_delete BEFORE DELETE ON audit_log
+WHEN EXISTS (SELECT 1 FROM users WHERE id = OLD.user_id)
+BEGIN
+  SELECT RAISE(ABORT, 'audit log is append-only');
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER audit_log_no_delete;
+CREATE TRIGGER audit_log_no_delete BEFORE DELETE ON audit_log
+BEGIN
+  SELECT RAISE(ABORT, 'audit log is append-only');
+END;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE folders (
+  id TEXT PRIMARY KEY,
+  user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  parent_id TEXT REFERENCES folders (id) ON DELETE CASCADE, -- Родительская папка (NULL - папка верхнего уровня)
+  encrypt_name BLOB NOT NULL, -- Зашифрованное название папки
+  created_at TIMESTAMP NOT NULL -- Timestamp создания папки
+);
+
+CREATE INDEX folders_user_id_idx ON folders (user_id, created_at);
+
+CREATE TABLE tags (
+  id TEXT PRIMARY KEY,
+  user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  encrypt_name BLOB NOT NULL, -- Зашифрованное название метки
+  name_index TEXT NOT NULL, -- Слепой индекс названия метки
+  UNIQUE (user_id, name_index)
+);
+
+CREATE TABLE item_tags (
+  item_id TEXT NOT NULL REFERENCES user_data (id) ON DELETE CASCADE,
+  tag_id TEXT NOT NULL REFERENCES tags (id) ON DELETE CASCADE,
+  PRIMARY KEY (item_id, tag_id)
+);
+
+CREATE INDEX item_tags_tag_id_idx ON item_tags (tag_id);
+
+-- Папка данных (NULL - данные не в папке). Данные удаляемой папки переносятся в родительскую папку хранилищем.
+ALTER TABLE user_data ADD COLUMN folder_id TEXT;
+
+CREATE INDEX user_data_folder_id_idx ON user_data (folder_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX user_data_folder_id_idx;
+ALTER TABLE user_data DROP COLUMN folder_id;
+DROP TABLE item_tags;
+DROP TABLE tags;
+DROP TABLE folders;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Пара ключей X25519 пользователя для совместного доступа к данным (NULL - ключи еще не созданы).
+ALTER TABLE users ADD COLUMN public_key BLOB;
+ALTER TABLE users ADD COLUMN encrypt_private_key BLOB; -- Закрытый ключ, зашифрованный ключом пользователя
+
+-- Собственный ключ данных, зашифрованный ключом владельца (NULL - данные зашифрованы ключом владельца).
+ALTER TABLE user_data ADD COLUMN encrypt_key BLOB;
+-- Мета данные, зашифрованные ключом данных, для пользователей с доступом к ним.
+ALTER TABLE user_data ADD COLUMN shared_meta BLOB;
+ALTER TABLE user_data_history ADD COLUMN shared_meta BLOB;
+
+CREATE TABLE item_shares (
+  item_id TEXT NOT NULL REFERENCES user_data (id) ON DELETE CASCADE,
+  owner_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  recipient_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  access TEXT NOT NULL, -- Уровень доступа (READ, WRITE)
+  encrypt_key BLOB NOT NULL, -- Ключ данных, зашифрованный открытым ключом получателя
+  created_at TIMESTAMP NOT NULL, -- Timestamp предоставления доступа
+  PRIMARY KEY (item_id, recipient_id)
+);
+
+CREATE INDEX item_shares_owner_id_idx ON item_shares (owner_id);
+CREATE INDEX item_shares_recipient_id_idx ON item_shares (recipient_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE item_shares;
+ALTER TABLE user_data_history DROP COLUMN shared_meta;
+ALTER TABLE user_data DROP COLUMN shared_meta;
+ALTER TABLE user_data DROP COLUMN encrypt_key;
+ALTER TABLE users DROP COLUMN encrypt_private_key;
+ALTER TABLE users DROP COLUMN public_key;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Организация владеет данными через собственный аккаунт в таблице users с тем же id.
+CREATE TABLE organizations (
+  id TEXT PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+  encrypt_name BLOB NOT NULL, -- Название, зашифрованное ключом организации
+  created_at TIMESTAMP NOT NULL -- Timestamp создания организации
+);
+
+CREATE TABLE org_members (
+  org_id TEXT NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+  user_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  role TEXT NOT NULL, -- Роль участника (OWNER, ADMIN, MEMBER, READER)
+  encrypt_key BLOB NOT NULL, -- Ключ организации, зашифрованный открытым ключом участника
+  created_at TIMESTAMP NOT NULL, -- Timestamp добавления участника
+  PRIMARY KEY (org_id, user_id)
+);
+
+CREATE INDEX org_members_user_id_idx ON org_members (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE org_members;
+DROP TABLE organizations;
+-- +goose StatementEnd
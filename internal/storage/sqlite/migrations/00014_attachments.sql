@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Данные, к которым прикреплено вложение (NULL - данные не являются вложением).
+ALTER TABLE user_data ADD COLUMN parent_id TEXT REFERENCES user_data (id) ON DELETE CASCADE;
+
+CREATE INDEX user_data_parent_id_idx ON user_data (parent_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX user_data_parent_id_idx;
+ALTER TABLE user_data DROP COLUMN parent_id;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_data ADD COLUMN favorite BOOLEAN NOT NULL DEFAULT FALSE; -- Данные закреплены в избранном
+ALTER TABLE user_data ADD COLUMN last_accessed_at TIMESTAMP; -- Время последнего получения данных (NULL - не получались)
+ALTER TABLE user_data ADD COLUMN access_count INTEGER NOT NULL DEFAULT 0; -- Количество получений данных
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_data DROP COLUMN access_count;
+ALTER TABLE user_data DROP COLUMN last_accessed_at;
+ALTER TABLE user_data DROP COLUMN favorite;
+-- +goose StatementEnd
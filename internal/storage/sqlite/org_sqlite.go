@@ -0,0 +1,205 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mattn/go-sqlite3"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// CreateOrg создает организацию с ее аккаунтом и первым участником.
+func (s *SQLiteStorage) CreateOrg(
+	ctx context.Context, org *model.Organization, owner *model.OrgMember,
+) (string, error) {
+	id := uuid.NewString()
+	now := time.Now().UTC()
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		if err := checkUser(ctx, tx, owner.UserID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO users(id, login, password_hash, encrypt_secret) VALUES(?, ?, '', ?);`,
+			id, model.OrgLoginPrefix+id, org.EncryptedSecret,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO organizations(id, encrypt_name, created_at) VALUES(?, ?, ?);`,
+			id, org.EncryptName, now,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO org_members(org_id, user_id, role, encrypt_key, created_at) VALUES(?, ?, ?, ?, ?);`,
+			id, owner.UserID, owner.Role, owner.EncryptKey, now,
+		)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoUser) {
+			return "", err
+		}
+		return "", fmt.Errorf("failed to create organization: %w", err)
+	}
+	org.ID, org.CreatedAt = id, now
+	return id, nil
+}
+
+// AddOrgMember добавляет участника организации.
+func (s *SQLiteStorage) AddOrgMember(ctx context.Context, member *model.OrgMember) error {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		var exists bool
+		row := tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM organizations WHERE id = ?);`, member.OrgID)
+		if err := row.Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return storage.ErrNoOrg
+		}
+		if err := checkUser(ctx, tx, member.UserID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO org_members(org_id, user_id, role, encrypt_key, created_at) VALUES(?, ?, ?, ?, ?);`,
+			member.OrgID, member.UserID, member.Role, member.EncryptKey, time.Now().UTC(),
+		)
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey {
+			return storage.ErrIsMember
+		}
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoOrg) || errors.Is(err, storage.ErrNoUser) || errors.Is(err, storage.ErrIsMember) {
+			return err
+		}
+		return fmt.Errorf("failed to add organization member: %w", err)
+	}
+	return nil
+}
+
+// GetOrgMember возвращает участника организации.
+func (s *SQLiteStorage) GetOrgMember(ctx context.Context, orgID string, userID string) (*model.OrgMember, error) {
+	member := model.OrgMember{OrgID: orgID, UserID: userID}
+	row := s.q.QueryRowContext(ctx,
+		`SELECT role, encrypt_key, created_at FROM org_members WHERE org_id = ? AND user_id = ?;`,
+		orgID, userID,
+	)
+	if err := row.Scan(&member.Role, &member.EncryptKey, &member.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNoMember
+		}
+		return nil, fmt.Errorf("failed to get organization member: %w", err)
+	}
+	return &member, nil
+}
+
+// SetOrgMemberRole меняет роль участника организации.
+func (s *SQLiteStorage) SetOrgMemberRole(ctx context.Context, orgID string, userID string, role model.OrgRole) error {
+	res, err := s.q.ExecContext(ctx,
+		`UPDATE org_members SET role = ? WHERE org_id = ? AND user_id = ?;`,
+		role, orgID, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set organization member role: %w", err)
+	}
+	if err = checkAffected(res); errors.Is(err, storage.ErrNoData) {
+		return storage.ErrNoMember
+	}
+	return err
+}
+
+// RemoveOrgMember удаляет участника организации.
+func (s *SQLiteStorage) RemoveOrgMember(ctx context.Context, orgID string, userID string) error {
+	res, err := s.q.ExecContext(ctx, `DELETE FROM org_members WHERE org_id = ? AND user_id = ?;`, orgID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+	if err = checkAffected(res); errors.Is(err, storage.ErrNoData) {
+		return storage.ErrNoMember
+	}
+	return err
+}
+
+// ListOrgMembers возвращает участников организации в порядке добавления.
+func (s *SQLiteStorage) ListOrgMembers(ctx context.Context, orgID string) ([]model.OrgMember, error) {
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT m.user_id, u.login, m.role, m.encrypt_key, m.created_at
+		FROM org_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.org_id = ?
+		ORDER BY m.created_at, m.user_id;`,
+		orgID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []model.OrgMember
+	for rows.Next() {
+		member := model.OrgMember{OrgID: orgID}
+		if err = rows.Scan(
+			&member.UserID, &member.Login, &member.Role, &member.EncryptKey, &member.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - organization member row: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	return members, nil
+}
+
+// ListUserOrgs возвращает участие пользователя в организациях в порядке добавления.
+func (s *SQLiteStorage) ListUserOrgs(ctx context.Context, userID string) ([]model.OrgMember, error) {
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT m.org_id, m.role, m.encrypt_key, m.created_at, o.encrypt_name
+		FROM org_members m
+		JOIN organizations o ON o.id = m.org_id
+		WHERE m.user_id = ?
+		ORDER BY m.created_at, m.org_id;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user organizations: %w", err)
+	}
+	defer rows.Close()
+
+	var members []model.OrgMember
+	for rows.Next() {
+		member := model.OrgMember{UserID: userID}
+		if err = rows.Scan(
+			&member.OrgID, &member.Role, &member.EncryptKey, &member.CreatedAt, &member.EncryptOrgName,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - organization member row: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list user organizations: %w", err)
+	}
+	return members, nil
+}
+
+// checkUser проверяет, что пользователь существует.
+func checkUser(ctx context.Context, q sqlQuerier, id string) error {
+	var exists bool
+	row := q.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM users WHERE id = ?);`, id)
+	if err := row.Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return storage.ErrNoUser
+	}
+	return nil
+}
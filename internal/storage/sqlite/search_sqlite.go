@@ -0,0 +1,57 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// SearchItems ищет данные пользователя по слепым индексам мета данных (без самих данных).
+// Результат упорядочен по времени обновления, начиная с последних.
+func (s *SQLiteStorage) SearchItems(
+	ctx context.Context, userID string, params model.SearchItemsParams,
+) ([]model.VaultItem, error) {
+	if len(params.Index) == 0 {
+		return nil, nil
+	}
+	args := []any{userID, time.Now().UTC()}
+	for _, token := range params.Index {
+		args = append(args, token)
+	}
+	query := `SELECT ` + listColumns + ` FROM user_data
+		WHERE user_id = ? AND deleted_at IS NULL AND ` + notExpired + ` AND EXISTS (
+			SELECT 1 FROM json_each(meta_index) WHERE value IN (?` + strings.Repeat(", ?", len(params.Index)-1) + `)
+		)
+		ORDER BY updated_at DESC, id`
+	if params.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, params.Limit)
+	}
+
+	rows, err := s.q.QueryContext(ctx, query+";", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []model.VaultItem
+	for rows.Next() {
+		var item model.VaultItem
+		if err = rows.Scan(
+			&item.ID, &item.EncryptMeta, &item.Meta, &item.Type, &item.Revision, &item.CreatedAt, &item.UpdatedAt,
+			&item.FolderID, (*stringList)(&item.TagIDs), &item.ExpiresAt, &item.Favorite, &item.LastAccessedAt,
+			&item.AccessCount,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - item row: %w", err)
+		}
+		item.UserID = userID
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search items: %w", err)
+	}
+	return items, nil
+}
@@ -0,0 +1,162 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// SetItemKey переводит данные на собственный ключ или заменяет его новым,
+// заменяя содержимое текущей и предыдущих версий.
+func (s *SQLiteStorage) SetItemKey(ctx context.Context, id string, userID string, key *model.ItemKey) error {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx,
+			`UPDATE user_data SET encrypt_key = ?, encrypt_data = ?, shared_meta = ?
+			WHERE id = ? AND user_id = ? AND deleted_at IS NULL AND revision = ? AND encrypt_key IS ?;`,
+			key.EncryptKey, key.EncryptData, key.SharedMeta, id, userID, key.Revision, key.PrevKey,
+		)
+		if err != nil {
+			return err
+		}
+		if err = checkAffected(res); err != nil {
+			if !errors.Is(err, storage.ErrNoData) {
+				return err
+			}
+			if err = checkItemOwner(ctx, tx, id, userID); err != nil {
+				return err
+			}
+			return storage.ErrConflict
+		}
+		var revisions int
+		row := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM user_data_history WHERE item_id = ?;`, id)
+		if err = row.Scan(&revisions); err != nil {
+			return err
+		}
+		if revisions != len(key.History) {
+			return storage.ErrNoRevision
+		}
+		for _, rev := range key.History {
+			res, err = tx.ExecContext(ctx,
+				`UPDATE user_data_history SET encrypt_data = ?, shared_meta = ? WHERE item_id = ? AND revision = ?;`,
+				rev.EncryptData, rev.SharedMeta, id, rev.Revision,
+			)
+			if err != nil {
+				return err
+			}
+			if err = checkAffected(res); err != nil {
+				if errors.Is(err, storage.ErrNoData) {
+					return storage.ErrNoRevision
+				}
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrConflict) ||
+			errors.Is(err, storage.ErrNoRevision) {
+			return err
+		}
+		return fmt.Errorf("failed to set item key: %w", err)
+	}
+	return nil
+}
+
+// ShareItem предоставляет получателю доступ к данным или изменяет уже предоставленный.
+func (s *SQLiteStorage) ShareItem(ctx context.Context, share *model.Share) error {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		if err := checkItemOwner(ctx, tx, share.ItemID, share.OwnerID); err != nil {
+			return err
+		}
+		if err := checkUser(ctx, tx, share.RecipientID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO item_shares(item_id, owner_id, recipient_id, access, encrypt_key, created_at)
+			VALUES(?, ?, ?, ?, ?, ?)
+			ON CONFLICT (item_id, recipient_id) DO UPDATE SET access = excluded.access, encrypt_key = excluded.encrypt_key;`,
+			share.ItemID, share.OwnerID, share.RecipientID, share.Access, share.EncryptKey, time.Now().UTC(),
+		)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrNoUser) {
+			return err
+		}
+		return fmt.Errorf("failed to share item: %w", err)
+	}
+	return nil
+}
+
+// GetShare возвращает доступ получателя к данным.
+func (s *SQLiteStorage) GetShare(ctx context.Context, itemID string, recipientID string) (*model.Share, error) {
+	share := model.Share{ItemID: itemID, RecipientID: recipientID}
+	row := s.q.QueryRowContext(ctx,
+		`SELECT owner_id, access, encrypt_key, created_at FROM item_shares WHERE item_id = ? AND recipient_id = ?;`,
+		itemID, recipientID,
+	)
+	if err := row.Scan(&share.OwnerID, &share.Access, &share.EncryptKey, &share.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNoShare
+		}
+		return nil, fmt.Errorf("failed to get share: %w", err)
+	}
+	return &share, nil
+}
+
+// RevokeShare отзывает доступ получателя к данным владельца.
+func (s *SQLiteStorage) RevokeShare(ctx context.Context, itemID string, ownerID string, recipientID string) error {
+	res, err := s.q.ExecContext(ctx,
+		`DELETE FROM item_shares WHERE item_id = ? AND owner_id = ? AND recipient_id = ?;`,
+		itemID, ownerID, recipientID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke share: %w", err)
+	}
+	if err = checkAffected(res); errors.Is(err, storage.ErrNoData) {
+		return storage.ErrNoShare
+	}
+	return err
+}
+
+// ListShares возвращает доступы, предоставленные пользователем и предоставленные ему,
+// в порядке их предоставления.
+func (s *SQLiteStorage) ListShares(ctx context.Context, userID string) ([]model.Share, error) {
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT s.item_id, s.owner_id, o.login, s.recipient_id, r.login, s.access, s.encrypt_key, s.created_at,
+		d.data_type, d.shared_meta
+		FROM item_shares s
+		JOIN user_data d ON d.id = s.item_id
+		JOIN users o ON o.id = s.owner_id
+		JOIN users r ON r.id = s.recipient_id
+		WHERE (s.owner_id = ? OR s.recipient_id = ?) AND d.deleted_at IS NULL
+		AND (d.expires_at IS NULL OR d.expires_at > ?)
+		ORDER BY s.created_at, s.item_id, s.recipient_id;`,
+		userID, userID, time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shares: %w", err)
+	}
+	defer rows.Close()
+
+	var shares []model.Share
+	for rows.Next() {
+		var share model.Share
+		if err = rows.Scan(
+			&share.ItemID, &share.OwnerID, &share.OwnerLogin, &share.RecipientID, &share.RecipientLogin,
+			&share.Access, &share.EncryptKey, &share.CreatedAt, &share.ItemType, &share.SharedMeta,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - share row: %w", err)
+		}
+		shares = append(shares, share)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list shares: %w", err)
+	}
+	return shares, nil
+}
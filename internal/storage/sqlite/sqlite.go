@@ -0,0 +1,185 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/migrate"
+	"github.com/pinbrain/gophkeeper/internal/storage/sqlite/migrations"
+	"github.com/pressly/goose/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// Scheme схема DSN, при которой используется хранилище SQLite (sqlite://путь/к/файлу.db).
+const Scheme = "sqlite://"
+
+// Параметры подключения к файлу БД.
+const connParams = "?_foreign_keys=on&_busy_timeout=5000&_journal_mode=WAL"
+
+// SQLiteStorage описывает структуру хранилища SQLite.
+// Хранилище, созданное WithTx, выполняет все запросы в транзакции tx.
+type SQLiteStorage struct {
+	db  *sql.DB
+	tx  *sql.Tx    // Текущая транзакция, nil вне транзакции.
+	q   sqlQuerier // Соединение с БД или транзакция, через которые выполняются запросы.
+	log *logrus.Entry
+}
+
+// sqlQuerier описывает общие методы соединения с БД и транзакции.
+type sqlQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// NewStorage создает и возвращает новое хранилище.
+// При autoMigrate на старте применяются новые миграции БД.
+func NewStorage(ctx context.Context, dsn string, autoMigrate bool, logger *logrus.Logger) (storage.Storage, error) {
+	log := logger.WithField("instance", "sqliteStorage")
+	db, err := initDB(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialized a db connection: %w", err)
+	}
+	if err = runMigrations(ctx, db, autoMigrate, log); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to run db migration: %w", err)
+	}
+	return &SQLiteStorage{db: db, q: db, log: log}, nil
+}
+
+// Close закрывает соединение с БД.
+// У хранилища внутри транзакции ничего не делает.
+func (s *SQLiteStorage) Close() error {
+	if s.tx != nil {
+		return nil
+	}
+	return s.db.Close()
+}
+
+// WithTx выполняет функцию в транзакции, откатывая ее при ошибке.
+// Вызов внутри другой транзакции создает точку сохранения.
+func (s *SQLiteStorage) WithTx(ctx context.Context, fn func(tx storage.Storage) error) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		return fn(&SQLiteStorage{db: s.db, tx: tx, q: tx, log: s.log})
+	})
+}
+
+// initDB открывает файл БД.
+func initDB(ctx context.Context, dsn string) (*sql.DB, error) {
+	path := strings.TrimPrefix(dsn, Scheme)
+	if path == "" {
+		return nil, fmt.Errorf("empty db file path in DSN: %s", dsn)
+	}
+	db := sql.OpenDB(&connector{
+		dsn: "file:" + path + connParams,
+		driver: &sqlite3.SQLiteDriver{
+			ConnectHook: registerFunctions,
+		},
+	})
+	// SQLite допускает только одного писателя, поэтому используем одно соединение.
+	db.SetMaxOpenConns(1)
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to ping the DB: %w", err)
+	}
+	return db, nil
+}
+
+// connector открывает соединения с БД через драйвер с зарегистрированными функциями приложения.
+type connector struct {
+	dsn    string
+	driver *sqlite3.SQLiteDriver
+}
+
+// Connect открывает новое соединение с БД.
+func (c *connector) Connect(_ context.Context) (driver.Conn, error) {
+	return c.driver.Open(c.dsn)
+}
+
+// Driver возвращает драйвер БД.
+func (c *connector) Driver() driver.Driver {
+	return c.driver
+}
+
+// registerFunctions регистрирует в соединении функции, которых нет во встроенном SQLite.
+func registerFunctions(conn *sqlite3.SQLiteConn) error {
+	// Встроенная lower() в SQLite переводит в нижний регистр только ASCII символы.
+	return conn.RegisterFunc("unicode_lower", strings.ToLower, true)
+}
+
+// inTx выполняет функцию в транзакции, откатывая ее при ошибке.
+// Если хранилище уже работает в транзакции, функция выполняется в ней через точку сохранения.
+func (s *SQLiteStorage) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	if s.tx != nil {
+		return inSavepoint(ctx, s.tx, fn)
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err = fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// inSavepoint выполняет функцию во вложенной транзакции, откатывая до точки сохранения при ошибке.
+func inSavepoint(ctx context.Context, tx *sql.Tx, fn func(tx *sql.Tx) error) error {
+	// Точки сохранения в SQLite образуют стек, поэтому одно имя подходит для любой вложенности.
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT nested_tx;"); err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		_, _ = tx.ExecContext(ctx, "ROLLBACK TO nested_tx;")
+		_, _ = tx.ExecContext(ctx, "RELEASE nested_tx;")
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "RELEASE nested_tx;"); err != nil {
+		return fmt.Errorf("failed to release savepoint: %w", err)
+	}
+	return nil
+}
+
+// NewMigrationProvider создает провайдер встроенных миграций БД.
+// Закрытие провайдера закрывает его соединение с БД.
+func NewMigrationProvider(ctx context.Context, dsn string) (*goose.Provider, error) {
+	db, err := initDB(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open db: %w", err)
+	}
+	provider, err := newMigrationProvider(db)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return provider, nil
+}
+
+// newMigrationProvider создает провайдер встроенных миграций для открытой БД.
+func newMigrationProvider(db *sql.DB) (*goose.Provider, error) {
+	provider, err := goose.NewProvider(goose.DialectSQLite3, db, migrations.FS)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration provider: %w", err)
+	}
+	return provider, nil
+}
+
+// runMigrations применяет новые миграции БД.
+// Если автоматическое применение отключено, только предупреждает о неприменённых миграциях.
+func runMigrations(ctx context.Context, db *sql.DB, autoMigrate bool, logger *logrus.Entry) error {
+	// Провайдер не закрывается: соединение с БД используется хранилищем.
+	provider, err := newMigrationProvider(db)
+	if err != nil {
+		return err
+	}
+	return migrate.Apply(ctx, provider, autoMigrate, logger)
+}
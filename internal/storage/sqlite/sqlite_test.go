@@ -0,0 +1,42 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/logger"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/pinbrain/gophkeeper/internal/storage/storagetest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage(t *testing.T) {
+	storagetest.Run(t, newTestStorage)
+}
+
+func newTestStorage(t *testing.T) storage.Storage {
+	t.Helper()
+	log, err := logger.NewLogger("error")
+	require.NoError(t, err)
+	store, err := NewStorage(context.Background(), Scheme+filepath.Join(t.TempDir(), "keeper.db"), true, log)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func TestAuditAppendOnly(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStorage(t)
+	userID, err := store.CreateUser(ctx, &model.User{Login: "user"})
+	require.NoError(t, err)
+	require.NoError(t, store.AppendAuditEvent(ctx, &model.AuditEvent{UserID: userID, Action: "GetData"}, []byte("key")))
+
+	sqliteStore, ok := store.(*SQLiteStorage)
+	require.True(t, ok)
+	_, err = sqliteStore.db.ExecContext(ctx, "UPDATE audit_log SET action = 'ListItems';")
+	require.ErrorContains(t, err, "append-only")
+	_, err = sqliteStore.db.ExecContext(ctx, "DELETE FROM audit_log WHERE user_id = ?;", userID)
+	require.ErrorContains(t, err, "append-only")
+}
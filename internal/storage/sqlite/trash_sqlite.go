@@ -0,0 +1,119 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// GetDeletedItems возвращает данные пользователя, находящиеся в корзине.
+func (s *SQLiteStorage) GetDeletedItems(ctx context.Context, userID string) ([]model.VaultItem, error) {
+	var items []model.VaultItem
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT id, encrypt_meta, meta, data_type, created_at, updated_at, deleted_at FROM user_data
+		WHERE user_id = ? AND deleted_at IS NOT NULL ORDER BY deleted_at DESC;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item model.VaultItem
+		if err = rows.Scan(
+			&item.ID, &item.EncryptMeta, &item.Meta, &item.Type, &item.CreatedAt, &item.UpdatedAt, &item.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - item row: %w", err)
+		}
+		item.UserID = userID
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get deleted items: %w", err)
+	}
+	return items, nil
+}
+
+// RestoreDeletedItem восстанавливает данные из корзины вместе с вложениями, удаленными одновременно с ними.
+func (s *SQLiteStorage) RestoreDeletedItem(ctx context.Context, id string, userID string) error {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		var parentID string
+		var deletedAt time.Time
+		row := tx.QueryRowContext(ctx,
+			`SELECT COALESCE(parent_id, ''), deleted_at FROM user_data
+			WHERE id = ? AND user_id = ? AND deleted_at IS NOT NULL;`,
+			id, userID,
+		)
+		if err := row.Scan(&parentID, &deletedAt); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return storage.ErrNoData
+			}
+			return err
+		}
+		if parentID != "" {
+			if err := checkItemOwner(ctx, tx, parentID, userID); err != nil {
+				if errors.Is(err, storage.ErrNoData) {
+					return storage.ErrParentInTrash
+				}
+				return err
+			}
+		}
+		_, err := tx.ExecContext(ctx,
+			`UPDATE user_data SET deleted_at = NULL
+			WHERE user_id = ? AND (id = ? OR (parent_id = ? AND deleted_at = ?));`,
+			userID, id, id, deletedAt,
+		)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrParentInTrash) {
+			return err
+		}
+		return fmt.Errorf("failed to restore deleted item: %w", err)
+	}
+	return nil
+}
+
+// PurgeDeletedItems безвозвратно удаляет все данные пользователя из корзины.
+func (s *SQLiteStorage) PurgeDeletedItems(ctx context.Context, userID string) (int64, error) {
+	return s.purge(ctx, `user_id = ? AND deleted_at IS NOT NULL`, userID)
+}
+
+// PurgeDeletedBefore безвозвратно удаляет данные всех пользователей,
+// перемещенные в корзину раньше указанного времени.
+func (s *SQLiteStorage) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return s.purge(ctx, `deleted_at < ?`, before.UTC())
+}
+
+// purge безвозвратно удаляет данные, удовлетворяющие условию where, и возвращает их количество.
+// Сначала удаляются вложения: удаленные каскадно строки не учитываются в количестве затронутых.
+func (s *SQLiteStorage) purge(ctx context.Context, where string, args ...any) (int64, error) {
+	var purged int64
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		for _, query := range []string{
+			`DELETE FROM user_data WHERE ` + where + ` AND parent_id IS NOT NULL;`,
+			`DELETE FROM user_data WHERE ` + where + `;`,
+		} {
+			res, err := tx.ExecContext(ctx, query, args...)
+			if err != nil {
+				return err
+			}
+			affected, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+			purged += affected
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge items: %w", err)
+	}
+	return purged, nil
+}
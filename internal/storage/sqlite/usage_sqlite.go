@@ -0,0 +1,46 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+)
+
+// GetUsage возвращает занятый пользователем объем по типам данных.
+// Учитываются данные в корзине, объем включает предыдущие версии данных.
+func (s *SQLiteStorage) GetUsage(ctx context.Context, userID string) ([]model.TypeUsage, error) {
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT d.data_type, COUNT(*),
+		SUM(d.size + COALESCE(length(d.encrypt_meta), 0) + length(CAST(d.meta AS BLOB))
+		+ COALESCE((
+			SELECT SUM(h.size + COALESCE(length(h.encrypt_meta), 0) + length(CAST(h.meta AS BLOB)))
+			FROM user_data_history h WHERE h.item_id = d.id
+		), 0))
+		FROM user_data d
+		WHERE d.user_id = ? GROUP BY d.data_type ORDER BY d.data_type;`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage: %w", err)
+	}
+	defer rows.Close()
+
+	var usages []model.TypeUsage
+	for rows.Next() {
+		var usage model.TypeUsage
+		if err = rows.Scan(&usage.Type, &usage.Items, &usage.Bytes); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - usage row: %w", err)
+		}
+		usages = append(usages, usage)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get usage: %w", err)
+	}
+	return usages, nil
+}
+
+// LockUsage ничего не делает: транзакции выполняются последовательно в единственном соединении с БД.
+func (s *SQLiteStorage) LockUsage(_ context.Context, _ string) error {
+	return nil
+}
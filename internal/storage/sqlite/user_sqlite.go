@@ -0,0 +1,145 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mattn/go-sqlite3"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// CreateUser создает нового пользователя.
+func (s *SQLiteStorage) CreateUser(ctx context.Context, user *model.User) (string, error) {
+	user.Login = strings.ToLower(user.Login)
+	id := uuid.NewString()
+	_, err := s.q.ExecContext(
+		ctx,
+		`INSERT INTO users(id, login, password_hash, encrypt_secret, public_key, encrypt_private_key)
+		VALUES(?, ?, ?, ?, ?, ?);`,
+		id, user.Login, user.PasswordHash, user.EncryptedSecret, user.PublicKey, user.EncryptPrivateKey,
+	)
+	if err != nil {
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
+			return "", storage.ErrLoginTaken
+		}
+		return "", fmt.Errorf("failed to create new user: %w", err)
+	}
+	user.ID = id
+	return user.ID, nil
+}
+
+// GetUserByLogin возвращает данные пользователя по логину.
+func (s *SQLiteStorage) GetUserByLogin(ctx context.Context, login string) (*model.User, error) {
+	var user model.User
+	login = strings.ToLower(login)
+	row := s.q.QueryRowContext(
+		ctx,
+		"SELECT id, password_hash, encrypt_secret, public_key, encrypt_private_key FROM users WHERE login = ?;",
+		login,
+	)
+	if err := row.Scan(
+		&user.ID, &user.PasswordHash, &user.EncryptedSecret, &user.PublicKey, &user.EncryptPrivateKey,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNoUser
+		}
+		return nil, fmt.Errorf("failed to get user from db: %w", err)
+	}
+	user.Login = login
+	return &user, nil
+}
+
+// GetUserByID возвращает данные пользователя по ID.
+func (s *SQLiteStorage) GetUserByID(ctx context.Context, id string) (*model.User, error) {
+	var user model.User
+	row := s.q.QueryRowContext(
+		ctx,
+		"SELECT login, password_hash, encrypt_secret, public_key, encrypt_private_key FROM users WHERE id = ?;",
+		id,
+	)
+	if err := row.Scan(
+		&user.Login, &user.PasswordHash, &user.EncryptedSecret, &user.PublicKey, &user.EncryptPrivateKey,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNoUser
+		}
+		return nil, fmt.Errorf("failed to get user from db: %w", err)
+	}
+	user.ID = id
+	return &user, nil
+}
+
+// DeleteUser удаляет пользователя, все его данные (история, папки, метки, доступы к данным, участие
+// в организациях и организация, если это ее аккаунт, удаляются каскадно)
+// и журнал аудита.
+func (s *SQLiteStorage) DeleteUser(ctx context.Context, id string) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM user_data WHERE user_id = ?;", id); err != nil {
+			return fmt.Errorf("failed to delete user data: %w", err)
+		}
+		res, err := tx.ExecContext(ctx, "DELETE FROM users WHERE id = ?;", id)
+		if err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		deleted, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		if deleted == 0 {
+			return storage.ErrNoUser
+		}
+		// Журнал удаляется после пользователя: триггер запрещает удалять журнал существующего пользователя.
+		if _, err = tx.ExecContext(ctx, "DELETE FROM audit_log WHERE user_id = ?;", id); err != nil {
+			return fmt.Errorf("failed to delete user audit log: %w", err)
+		}
+		return nil
+	})
+}
+
+// SetUserKeys сохраняет пару ключей пользователя.
+func (s *SQLiteStorage) SetUserKeys(ctx context.Context, id string, publicKey []byte, encPrivateKey []byte) error {
+	res, err := s.q.ExecContext(ctx,
+		`UPDATE users SET public_key = ?, encrypt_private_key = ? WHERE id = ?;`,
+		publicKey, encPrivateKey, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set user keys: %w", err)
+	}
+	if err = checkAffected(res); errors.Is(err, storage.ErrNoData) {
+		return storage.ErrNoUser
+	}
+	return err
+}
+
+// ListUsers возвращает всех пользователей, включая аккаунты организаций, по логину.
+func (s *SQLiteStorage) ListUsers(ctx context.Context) ([]model.User, error) {
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT id, login, password_hash, encrypt_secret, public_key, encrypt_private_key FROM users
+		ORDER BY login;`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []model.User
+	for rows.Next() {
+		var user model.User
+		if err = rows.Scan(
+			&user.ID, &user.Login, &user.PasswordHash, &user.EncryptedSecret, &user.PublicKey, &user.EncryptPrivateKey,
+		); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - user row: %w", err)
+		}
+		users = append(users, user)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	return users, nil
+}
@@ -0,0 +1,146 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+)
+
+// CreateItem сохраняет новые данные.
+func (s *SQLiteStorage) CreateItem(ctx context.Context, userID string, item *model.VaultItem) (string, error) {
+	id := uuid.NewString()
+	created, updated := item.CreateTimes(time.Now())
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		if item.ParentID != "" {
+			if err := checkParent(ctx, tx, item.ParentID, userID); err != nil {
+				return err
+			}
+		}
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO user_data(
+				id, user_id, encrypt_data, encrypt_meta, meta_index, meta, data_type, chunked, size, created_at,
+				updated_at, parent_id, expires_at
+			)
+			VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`,
+			id, userID, item.EncryptData, item.EncryptMeta, stringList(item.MetaIndex), item.Meta, item.Type,
+			item.Chunked, item.Size, created.UTC(), updated.UTC(),
+			sql.NullString{String: item.ParentID, Valid: item.ParentID != ""}, nullTime(item.ExpiresAt),
+		)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) {
+			return "", err
+		}
+		return "", fmt.Errorf("failed to create new item: %w", err)
+	}
+	item.ID = id
+	return item.ID, nil
+}
+
+// GetItem возвращает данные по id.
+func (s *SQLiteStorage) GetItem(ctx context.Context, id string, userID string) (*model.VaultItem, error) {
+	var item model.VaultItem
+	row := s.q.QueryRowContext(
+		ctx,
+		`SELECT encrypt_data, encrypt_meta, meta_index, meta, data_type, revision, chunked, size, created_at, updated_at,
+		encrypt_key, shared_meta, COALESCE(parent_id, ''), expires_at
+		FROM user_data WHERE id = ? AND user_id = ? AND deleted_at IS NULL AND `+notExpired+`;`,
+		id, userID, time.Now().UTC(),
+	)
+	if err := row.Scan(
+		&item.EncryptData, &item.EncryptMeta, (*stringList)(&item.MetaIndex), &item.Meta, &item.Type, &item.Revision,
+		&item.Chunked, &item.Size, &item.CreatedAt, &item.UpdatedAt, &item.EncryptKey, &item.SharedMeta,
+		&item.ParentID, &item.ExpiresAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNoData
+		}
+		return nil, fmt.Errorf("failed to get data from db: %w", err)
+	}
+	item.ID = id
+	item.UserID = userID
+	return &item, nil
+}
+
+// DeleteItem перемещает данные в корзину вместе с их вложениями.
+// Вложения не бывают в хранилище без данных, к которым прикреплены, поэтому затронутые строки
+// есть, только если сами данные были не в корзине.
+func (s *SQLiteStorage) DeleteItem(ctx context.Context, id string, userID string) error {
+	res, err := s.q.ExecContext(ctx,
+		`UPDATE user_data SET deleted_at = ? WHERE (id = ? OR parent_id = ?) AND user_id = ? AND deleted_at IS NULL;`,
+		time.Now().UTC(), id, id, userID,
+	)
+	if err != nil {
+		return err
+	}
+	return checkAffected(res)
+}
+
+// GetItemsByType возвращает данные пользователя по типу.
+func (s *SQLiteStorage) GetItemsByType(ctx context.Context, dataType string, userID string) ([]model.VaultItem, error) {
+	var items []model.VaultItem
+	rows, err := s.q.QueryContext(ctx,
+		`SELECT id, encrypt_meta, meta, created_at, updated_at FROM user_data
+		WHERE user_id = ? AND data_type = ? AND deleted_at IS NULL AND `+notExpired+`;`,
+		userID, dataType, time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get items by type: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item model.VaultItem
+		if err = rows.Scan(&item.ID, &item.EncryptMeta, &item.Meta, &item.CreatedAt, &item.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read data from db - item row: %w", err)
+		}
+		item.UserID = userID
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get items by type: %w", err)
+	}
+	return items, nil
+}
+
+// UpdateItem обновляет данные, сохраняя предыдущую версию в истории.
+func (s *SQLiteStorage) UpdateItem(ctx context.Context, id string, userID string, item *model.VaultItem) error {
+	err := s.inTx(ctx, func(tx *sql.Tx) error {
+		encKey, err := getItemKey(ctx, tx, id, userID)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(encKey, item.EncryptKey) {
+			return storage.ErrConflict
+		}
+		return updateWithHistory(ctx, tx, id, userID, item)
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNoData) || errors.Is(err, storage.ErrConflict) {
+			return err
+		}
+		return fmt.Errorf("failed to update item: %w", err)
+	}
+	return nil
+}
+
+// checkAffected возвращает storage.ErrNoData, если запрос не затронул ни одной строки.
+func checkAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return storage.ErrNoData
+	}
+	return nil
+}
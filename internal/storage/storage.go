@@ -3,16 +3,60 @@ package storage
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/pinbrain/gophkeeper/internal/model"
 )
 
+// Ошибки, возвращаемые хранилищем. Все реализации Storage обязаны возвращать именно их,
+// чтобы вызывающий код не зависел от конкретного хранилища.
+var (
+	ErrLoginTaken    = errors.New("login is already taken")
+	ErrNoUser        = errors.New("user not found")
+	ErrNoData        = errors.New("data not found")
+	ErrNoRevision    = errors.New("revision not found")
+	ErrConflict      = errors.New("data was modified concurrently")
+	ErrNoFolder      = errors.New("folder not found")
+	ErrNoTag         = errors.New("tag not found")
+	ErrNoShare       = errors.New("share not found")
+	ErrNoOrg         = errors.New("organization not found")
+	ErrNoMember      = errors.New("organization member not found")
+	ErrIsMember      = errors.New("user is already an organization member")
+	ErrParentInTrash = errors.New("parent item is in trash")
+)
+
+// primaryCtxKey ключ контекста, по которому сохраняется признак чтения с основного сервера БД.
+type primaryCtxKey struct{}
+
+// WithPrimary возвращает копию контекста, запросы на чтение с которым выполняются на основном сервере БД,
+// а не на репликах. Нужен, когда прочитанные данные (версия, ключ данных) передаются в последующее изменение:
+// отставшая реплика вернула бы устаревшие данные и изменение завершилось бы ложным ErrConflict.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryCtxKey{}, true)
+}
+
+// IsPrimary проверяет, что запросы на чтение с контекстом должны выполняться на основном сервере БД.
+func IsPrimary(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryCtxKey{}).(bool)
+	return primary
+}
+
 // Storage описывает интерфейс хранилища приложения.
 type Storage interface {
 	Close() error
+	// WithTx выполняет fn атомарно: все вызовы переданного в fn хранилища либо применяются вместе,
+	// либо откатываются, если fn вернула ошибку (эта ошибка и возвращается из WithTx).
+	// Хранилище внутри транзакции нельзя использовать после выхода из fn и из нескольких горутин.
+	WithTx(ctx context.Context, fn func(tx Storage) error) error
 
 	UserStorage
 	VaultStorage
+	FolderStorage
+	ShareStorage
+	OrgStorage
+	AuditStorage
+	BackupStorage
 }
 
 // UserStorage описывает методы хранилища в части работы с пользователем.
@@ -20,13 +64,193 @@ type UserStorage interface {
 	CreateUser(ctx context.Context, user *model.User) (id string, err error)
 	GetUserByLogin(ctx context.Context, login string) (user *model.User, err error)
 	GetUserByID(ctx context.Context, id string) (user *model.User, err error)
+	// DeleteUser удаляет пользователя вместе со всеми его данными (включая корзину и предыдущие версии),
+	// папками, метками, доступами к данным, участием в организациях и журналом аудита;
+	// если пользователя нет, возвращает ErrNoUser.
+	DeleteUser(ctx context.Context, id string) error
+	// SetUserKeys сохраняет пару ключей пользователя для совместного доступа к данным.
+	SetUserKeys(ctx context.Context, id string, publicKey []byte, encPrivateKey []byte) error
+	// ListUsers возвращает всех пользователей, включая аккаунты организаций, по логину.
+	ListUsers(ctx context.Context) ([]model.User, error)
 }
 
 // VaultStorage описывает методы хранилища в части работы с данными.
 type VaultStorage interface {
+	// CreateItem сохраняет заданные item.CreatedAt и item.UpdatedAt (например, при импорте данных),
+	// незаданное время создания заменяется текущим. С непустым item.ParentID прикрепляет новые данные
+	// вложением к данным пользователя и возвращает ErrNoData, если таких данных нет, они в корзине
+	// или сами являются вложением.
 	CreateItem(ctx context.Context, userID string, item *model.VaultItem) (string, error)
+	// GetItem возвращает ErrNoData, если данных нет, они в корзине или их срок хранения (item.ExpiresAt) истек.
+	// Остальные методы чтения также не возвращают данные в корзине и с истекшим сроком хранения.
 	GetItem(ctx context.Context, id string, userID string) (*model.VaultItem, error)
+	// DeleteItem перемещает данные в корзину вместе с их вложениями.
 	DeleteItem(ctx context.Context, id string, userID string) error
 	GetItemsByType(ctx context.Context, dataType string, userID string) ([]model.VaultItem, error)
+	// ListItems возвращает данные с признаком избранного, статистикой получений, папкой и id меток.
+	ListItems(ctx context.Context, userID string, params model.ListItemsParams) ([]model.VaultItem, error)
+	// SearchItems находит данные, у которых есть хотя бы один из переданных слепых индексов мета данных,
+	// и возвращает их с признаком избранного, статистикой получений, папкой и id меток.
+	SearchItems(ctx context.Context, userID string, params model.SearchItemsParams) ([]model.VaultItem, error)
+	// UpdateItem при ненулевом item.Revision обновляет данные, только если это их текущая версия,
+	// иначе возвращает ErrConflict; после обновления в item.Revision записывается новая версия.
+	// Также возвращает ErrConflict, если item.EncryptKey не совпадает с ключом данных в хранилище,
+	// т.е. ключ данных изменен после того, как вызывающий их прочитал.
+	// Срок хранения меняется, только если item.ExpiresAt задан.
 	UpdateItem(ctx context.Context, id string, userID string, item *model.VaultItem) error
+	// ListAttachments возвращает вложения данных (без самих данных) по времени создания.
+	ListAttachments(ctx context.Context, parentID string, userID string) ([]model.VaultItem, error)
+	// SetFavorite закрепляет данные в избранном или убирает их оттуда; возвращает ErrNoData, если данных нет,
+	// они в корзине или их срок хранения истек. Версию и время обновления данных не меняет.
+	SetFavorite(ctx context.Context, id string, userID string, favorite bool) error
+	// RecordAccess записывает время получения данных и увеличивает счетчик получений; возвращает ErrNoData,
+	// если данных нет, они в корзине или их срок хранения истек. Версию и время обновления данных не меняет.
+	RecordAccess(ctx context.Context, id string, userID string, at time.Time) error
+
+	GetItemHistory(ctx context.Context, id string, userID string) ([]model.VaultItemRevision, error)
+	GetItemRevision(ctx context.Context, id string, userID string, revision int64) (*model.VaultItemRevision, error)
+	RestoreItemRevision(ctx context.Context, id string, userID string, revision int64) error
+
+	GetDeletedItems(ctx context.Context, userID string) ([]model.VaultItem, error)
+	// RestoreDeletedItem восстанавливает данные из корзины вместе с вложениями, удаленными вместе с ними;
+	// вложение, данные которого в корзине, не восстанавливается (ErrParentInTrash).
+	RestoreDeletedItem(ctx context.Context, id string, userID string) error
+	// PurgeDeletedItems безвозвратно удаляет данные из корзины пользователя вместе с их вложениями.
+	PurgeDeletedItems(ctx context.Context, userID string) (int64, error)
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+	// PurgeExpiredItems безвозвратно удаляет данные всех пользователей (в том числе из корзины),
+	// срок хранения которых истек к моменту now, вместе с их вложениями.
+	PurgeExpiredItems(ctx context.Context, now time.Time) (int64, error)
+
+	// ListChunkedData возвращает зашифрованные манифесты всех сохраненных блоками файлов пользователя,
+	// включая данные в корзине и предыдущие версии.
+	ListChunkedData(ctx context.Context, userID string) ([][]byte, error)
+
+	// GetUsage возвращает занятый пользователем объем по типам данных (только типы, данные которых есть).
+	// Учитываются данные в корзине; объем включает предыдущие версии данных, количество - нет.
+	GetUsage(ctx context.Context, userID string) ([]model.TypeUsage, error)
+	// LockUsage вызывается в транзакции (WithTx) перед проверкой объема данных пользователя:
+	// другие транзакции, вызвавшие LockUsage для того же пользователя, ждут завершения этой транзакции,
+	// поэтому параллельные запросы не могут вместе превысить ограничения.
+	LockUsage(ctx context.Context, userID string) error
+
+	// ListLegacyMeta возвращает мета данные текущих и предыдущих версий,
+	// сохраненные в открытом виде до включения шифрования.
+	ListLegacyMeta(ctx context.Context, limit int) ([]model.LegacyMeta, error)
+	// EncryptLegacyMeta заменяет мета данные в открытом виде зашифрованными
+	// (если мета данные к этому времени уже зашифрованы, ничего не меняется).
+	EncryptLegacyMeta(ctx context.Context, meta model.LegacyMeta) error
+}
+
+// FolderStorage описывает методы хранилища в части папок и меток данных.
+type FolderStorage interface {
+	// CreateFolder сохраняет папку с зашифрованным названием;
+	// возвращает ErrNoFolder, если родительской папки нет у пользователя.
+	CreateFolder(ctx context.Context, userID string, folder *model.Folder) (string, error)
+	ListFolders(ctx context.Context, userID string) ([]model.Folder, error)
+	// DeleteFolder перемещает данные и вложенные папки удаляемой папки в ее родительскую папку.
+	DeleteFolder(ctx context.Context, id string, userID string) error
+	// MoveItem возвращает ErrNoFolder, если целевой папки нет у пользователя.
+	MoveItem(ctx context.Context, id string, userID string, folderID string) error
+
+	// TagItem добавляет данным метку с зашифрованным названием, определяемую слепым индексом названия,
+	// создавая ее при первом использовании (в tag.ID записывается id метки).
+	TagItem(ctx context.Context, id string, userID string, tag *model.Tag) error
+	// UntagItem снимает метку с данных (ErrNoTag, если ее у данных нет) и удаляет метку,
+	// если она больше не используется.
+	UntagItem(ctx context.Context, id string, userID string, nameIndex string) error
+	// ListTags возвращает метки пользователя с количеством данных с каждой из них.
+	ListTags(ctx context.Context, userID string) ([]model.Tag, error)
+}
+
+// ShareStorage описывает методы хранилища в части совместного доступа к данным.
+type ShareStorage interface {
+	// SetItemKey переводит данные владельца на собственный ключ или заменяет его новым, заменяя содержимое
+	// текущей и предыдущих версий перешифрованным; возвращает ErrConflict, если ключ данных не key.PrevKey
+	// или их текущая версия не key.Revision, и ErrNoRevision, если в key.History нет какой-либо
+	// из предыдущих версий.
+	SetItemKey(ctx context.Context, id string, userID string, key *model.ItemKey) error
+	// ShareItem предоставляет доступ к данным владельца (ErrNoData, если их нет, ErrNoUser, если нет
+	// получателя) или изменяет уже предоставленный.
+	ShareItem(ctx context.Context, share *model.Share) error
+	// GetShare возвращает доступ получателя к данным или ErrNoShare, если доступа нет.
+	GetShare(ctx context.Context, itemID string, recipientID string) (*model.Share, error)
+	// RevokeShare отзывает доступ получателя к данным или возвращает ErrNoShare, если доступа нет.
+	RevokeShare(ctx context.Context, itemID string, ownerID string, recipientID string) error
+	// ListShares возвращает доступы, предоставленные пользователем и предоставленные ему,
+	// кроме доступов к данным в корзине.
+	ListShares(ctx context.Context, userID string) ([]model.Share, error)
+}
+
+// OrgStorage описывает методы хранилища в части организаций.
+type OrgStorage interface {
+	// CreateOrg создает аккаунт организации (с логином model.OrgLoginPrefix + id и ключом org.EncryptedSecret),
+	// саму организацию и ее первого участника owner; возвращает ErrNoUser, если пользователя owner нет.
+	// Данными организации владеет ее аккаунт (id организации совпадает с id аккаунта), поэтому они хранятся
+	// так же, как данные пользователя, а организация удаляется вместе с данными и участниками
+	// методом UserStorage.DeleteUser.
+	CreateOrg(ctx context.Context, org *model.Organization, owner *model.OrgMember) (string, error)
+	// AddOrgMember возвращает ErrNoOrg, если организации нет, ErrNoUser, если нет пользователя,
+	// и ErrIsMember, если он уже участник.
+	AddOrgMember(ctx context.Context, member *model.OrgMember) error
+	// GetOrgMember возвращает ErrNoMember, если пользователь не участник организации.
+	GetOrgMember(ctx context.Context, orgID string, userID string) (*model.OrgMember, error)
+	// SetOrgMemberRole возвращает ErrNoMember, если пользователь не участник организации.
+	SetOrgMemberRole(ctx context.Context, orgID string, userID string, role model.OrgRole) error
+	// RemoveOrgMember возвращает ErrNoMember, если пользователь не участник организации.
+	RemoveOrgMember(ctx context.Context, orgID string, userID string) error
+	// ListOrgMembers возвращает участников с логинами в порядке добавления.
+	ListOrgMembers(ctx context.Context, orgID string) ([]model.OrgMember, error)
+	// ListUserOrgs возвращает участие пользователя в организациях с зашифрованными названиями организаций
+	// в порядке добавления.
+	ListUserOrgs(ctx context.Context, userID string) ([]model.OrgMember, error)
+}
+
+// AuditStorage описывает методы хранилища в части журнала аудита.
+type AuditStorage interface {
+	// AppendAuditEvent связывает событие с последним событием пользователя на ключе журнала
+	// (см. model.AuditEvent.Chain), сохраняет его и обновляет последнее событие журнала (см. model.AuditHead);
+	// события одного пользователя записываются строго последовательно. Если пользователя нет, возвращает ErrNoUser.
+	// Журнал только дополняется: записанные события не изменяются и не удаляются, журнал удаляется
+	// только целиком вместе с пользователем (см. UserStorage.DeleteUser).
+	AppendAuditEvent(ctx context.Context, event *model.AuditEvent, key []byte) error
+	// GetAuditHead возвращает последнее событие журнала пользователя (нулевое, если событий нет)
+	// или ErrNoUser, если пользователя нет.
+	GetAuditHead(ctx context.Context, userID string) (model.AuditHead, error)
+	// ListAuditEvents возвращает события пользователя в порядке их номеров. Журнал читается с основного
+	// сервера БД, чтобы события и последнее событие журнала были согласованы.
+	ListAuditEvents(ctx context.Context, userID string, params model.ListAuditParams) ([]model.AuditEvent, error)
+}
+
+// BackupStorage описывает методы хранилища для резервного копирования: чтение всех объектов, включая данные
+// в корзине и с истекшим сроком хранения, и загрузку объектов с исходными id, номерами версий и временем.
+// Методы загрузки предназначены для пустого хранилища и не проверяют ссылки между объектами: вызывающий
+// загружает объект после тех, на которые он ссылается. Чтение выполняется на основном сервере БД.
+type BackupStorage interface {
+	// ListBackupOrgs возвращает все организации с ключами организаций в порядке создания.
+	ListBackupOrgs(ctx context.Context) ([]model.Organization, error)
+	// ListBackupItems возвращает все данные пользователя, включая данные в корзине и с истекшим сроком хранения,
+	// с самими данными, папкой, id меток, признаком избранного и статистикой получений в порядке создания.
+	ListBackupItems(ctx context.Context, userID string) ([]model.VaultItem, error)
+	// ListBackupHistory возвращает предыдущие версии данных вместе с самими данными в порядке номеров.
+	ListBackupHistory(ctx context.Context, itemID string) ([]model.VaultItemRevision, error)
+	// ListBackupShares возвращает доступы, предоставленные пользователем, включая доступы к данным в корзине
+	// и с истекшим сроком хранения, в порядке их предоставления.
+	ListBackupShares(ctx context.Context, ownerID string) ([]model.Share, error)
+
+	// RestoreUser сохраняет пользователя или аккаунт организации с id user.ID;
+	// возвращает ErrLoginTaken, если логин занят.
+	RestoreUser(ctx context.Context, user *model.User) error
+	// RestoreOrg сохраняет организацию, аккаунт которой уже сохранен методом RestoreUser.
+	RestoreOrg(ctx context.Context, org *model.Organization) error
+	RestoreOrgMember(ctx context.Context, member *model.OrgMember) error
+	RestoreFolder(ctx context.Context, folder *model.Folder) error
+	RestoreTag(ctx context.Context, tag *model.Tag) error
+	// RestoreItem сохраняет данные со всеми полями, метками item.TagIDs и предыдущими версиями history.
+	RestoreItem(ctx context.Context, item *model.VaultItem, history []model.VaultItemRevision) error
+	RestoreShare(ctx context.Context, share *model.Share) error
+	// RestoreAuditEvent сохраняет событие журнала аудита как есть: хэши события не вычисляются,
+	// последнее событие журнала не меняется (его сохраняет RestoreAuditHead).
+	RestoreAuditEvent(ctx context.Context, event *model.AuditEvent) error
+	RestoreAuditHead(ctx context.Context, userID string, head model.AuditHead) error
 }
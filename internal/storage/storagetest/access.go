@@ -0,0 +1,117 @@
+package storagetest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accessTests возвращает тесты избранного и статистики получения данных.
+func accessTests() []testCase {
+	return []testCase{
+		{name: "Избранные данные", fn: testFavorite},
+		{name: "Постраничный список с избранными данными в начале", fn: testListFavoritesFirst},
+		{name: "Статистика получения данных", fn: testRecordAccess},
+		{name: "Постраничный список по времени получения", fn: testListPagesByAccessed},
+	}
+}
+
+func testFavorite(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	other := createUser(t, s, "other")
+	id := createItem(t, s, userID, model.Password, `{"resource":"site"}`)
+	assert.False(t, listedItem(t, s, userID, id).Favorite)
+
+	require.NoError(t, s.SetFavorite(ctx, id, userID, true))
+	assert.True(t, listedItem(t, s, userID, id).Favorite)
+	// Избранное не меняет версию данных и сохраняется при их изменении.
+	got, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), got.Revision)
+	updateItem(t, s, id, userID, "data", `{"resource":"site"}`)
+	assert.True(t, listedItem(t, s, userID, id).Favorite)
+
+	require.NoError(t, s.SetFavorite(ctx, id, userID, false))
+	assert.False(t, listedItem(t, s, userID, id).Favorite)
+
+	require.ErrorIs(t, s.SetFavorite(ctx, unknownID(), userID, true), storage.ErrNoData)
+	require.ErrorIs(t, s.SetFavorite(ctx, id, other, true), storage.ErrNoData)
+	require.NoError(t, s.DeleteItem(ctx, id, userID))
+	require.ErrorIs(t, s.SetFavorite(ctx, id, userID, true), storage.ErrNoData)
+}
+
+func testListFavoritesFirst(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	for i := range 6 {
+		createItem(t, s, userID, model.Text, fmt.Sprintf(`{"name":"%d"}`, i))
+	}
+	created := listAll(t, s, userID, model.ListItemsParams{SortBy: model.SortByCreated, Limit: 10})
+	require.NoError(t, s.SetFavorite(ctx, created[1], userID, true))
+	require.NoError(t, s.SetFavorite(ctx, created[4], userID, true))
+
+	asc := listAll(t, s, userID, model.ListItemsParams{SortBy: model.SortByCreated, FavoritesFirst: true, Limit: 2})
+	assert.Equal(t, []string{created[1], created[4], created[0], created[2], created[3], created[5]}, asc)
+
+	desc := listAll(t, s, userID, model.ListItemsParams{
+		SortBy: model.SortByCreated, Desc: true, FavoritesFirst: true, Limit: 3,
+	})
+	assert.Equal(t, []string{created[4], created[1], created[5], created[3], created[2], created[0]}, desc)
+}
+
+func testRecordAccess(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	other := createUser(t, s, "other")
+	id := createItem(t, s, userID, model.Password, `{"resource":"site"}`)
+	item := listedItem(t, s, userID, id)
+	assert.Nil(t, item.LastAccessedAt)
+	assert.Zero(t, item.AccessCount)
+
+	accessed := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	require.NoError(t, s.RecordAccess(ctx, id, userID, accessed.Add(-time.Minute)))
+	require.NoError(t, s.RecordAccess(ctx, id, userID, accessed))
+	item = listedItem(t, s, userID, id)
+	require.NotNil(t, item.LastAccessedAt)
+	assert.True(t, accessed.Equal(*item.LastAccessedAt))
+	assert.Equal(t, int64(2), item.AccessCount)
+	// Получение данных не считается их изменением.
+	assert.Equal(t, int64(1), item.Revision)
+	assert.Equal(t, item.CreatedAt, item.UpdatedAt)
+
+	require.ErrorIs(t, s.RecordAccess(ctx, unknownID(), userID, accessed), storage.ErrNoData)
+	require.ErrorIs(t, s.RecordAccess(ctx, id, other, accessed), storage.ErrNoData)
+	require.NoError(t, s.DeleteItem(ctx, id, userID))
+	require.ErrorIs(t, s.RecordAccess(ctx, id, userID, accessed), storage.ErrNoData)
+}
+
+func testListPagesByAccessed(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	never := createItem(t, s, userID, model.Text, `{"name":"never"}`)
+	old := createItem(t, s, userID, model.Text, `{"name":"old"}`)
+	recent := createItem(t, s, userID, model.Text, `{"name":"recent"}`)
+	favorite := createItem(t, s, userID, model.Text, `{"name":"favorite"}`)
+	now := time.Now()
+	require.NoError(t, s.RecordAccess(ctx, old, userID, now.Add(-2*time.Hour)))
+	require.NoError(t, s.RecordAccess(ctx, recent, userID, now.Add(-time.Minute)))
+	require.NoError(t, s.RecordAccess(ctx, favorite, userID, now.Add(-time.Hour)))
+
+	ids := listAll(t, s, userID, model.ListItemsParams{SortBy: model.SortByAccessed, Desc: true, Limit: 1})
+	assert.Equal(t, []string{recent, favorite, old, never}, ids)
+	ids = listAll(t, s, userID, model.ListItemsParams{SortBy: model.SortByAccessed, Limit: 3})
+	assert.Equal(t, []string{never, old, favorite, recent}, ids)
+
+	require.NoError(t, s.SetFavorite(ctx, favorite, userID, true))
+	ids = listAll(t, s, userID, model.ListItemsParams{
+		SortBy: model.SortByAccessed, Desc: true, FavoritesFirst: true, Limit: 2,
+	})
+	assert.Equal(t, []string{favorite, recent, old, never}, ids)
+}
@@ -0,0 +1,125 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// attachmentTests возвращает тесты вложений данных.
+func attachmentTests() []testCase {
+	return []testCase{
+		{name: "Создание вложений", fn: testCreateAttachment},
+		{name: "Вложения удаляются и восстанавливаются вместе с данными", fn: testAttachmentsTrash},
+		{name: "Безвозвратное удаление данных с вложениями", fn: testPurgeAttachments},
+	}
+}
+
+// attachmentIDs возвращает id вложений данных в порядке, возвращенном хранилищем.
+func attachmentIDs(t *testing.T, s storage.Storage, userID string, parentID string) []string {
+	t.Helper()
+	items, err := s.ListAttachments(context.Background(), parentID, userID)
+	require.NoError(t, err)
+	ids := []string{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func testCreateAttachment(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	other := createUser(t, s, "other")
+	parent := createItem(t, s, userID, model.Password, `{"resource":"site"}`)
+	first := createItem(t, s, userID, model.File, `{"name":"codes.txt"}`, withParent(parent), withSize(100))
+	second := createItem(t, s, userID, model.File, `{"name":"contract.pdf"}`, withParent(parent))
+
+	got, err := s.GetItem(ctx, first, userID)
+	require.NoError(t, err)
+	assert.Equal(t, parent, got.ParentID)
+	got, err = s.GetItem(ctx, parent, userID)
+	require.NoError(t, err)
+	assert.Empty(t, got.ParentID)
+
+	items, err := s.ListAttachments(ctx, parent, userID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, first, items[0].ID)
+	assert.Equal(t, model.File, items[0].Type)
+	assert.Equal(t, parent, items[0].ParentID)
+	assert.JSONEq(t, `{"name":"codes.txt"}`, string(items[0].EncryptMeta))
+	assert.Equal(t, int64(100), items[0].Size)
+	assert.Nil(t, items[0].EncryptData)
+	assert.Equal(t, second, items[1].ID)
+	assert.Empty(t, attachmentIDs(t, s, userID, first))
+	assert.Empty(t, attachmentIDs(t, s, other, parent))
+
+	// Вложение можно прикрепить только к своим данным, которые не в корзине и сами не являются вложением.
+	for _, parentID := range []string{unknownID(), first, createItem(t, s, other, model.Text, `{}`)} {
+		_, err = s.CreateItem(ctx, userID, &model.VaultItem{Type: model.File, ParentID: parentID})
+		require.ErrorIs(t, err, storage.ErrNoData)
+	}
+	deleted := createItem(t, s, userID, model.Text, `{}`)
+	require.NoError(t, s.DeleteItem(ctx, deleted, userID))
+	_, err = s.CreateItem(ctx, userID, &model.VaultItem{Type: model.File, ParentID: deleted})
+	require.ErrorIs(t, err, storage.ErrNoData)
+}
+
+func testAttachmentsTrash(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	parent := createItem(t, s, userID, model.Password, `{"resource":"site"}`)
+	earlier := createItem(t, s, userID, model.File, `{"name":"old.txt"}`, withParent(parent))
+	attachment := createItem(t, s, userID, model.File, `{"name":"codes.txt"}`, withParent(parent))
+	require.NoError(t, s.DeleteItem(ctx, earlier, userID))
+	assert.Equal(t, []string{attachment}, attachmentIDs(t, s, userID, parent))
+
+	// Ранее удаленное вложение остается в корзине при восстановлении данных.
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, s.DeleteItem(ctx, parent, userID))
+	_, err := s.GetItem(ctx, attachment, userID)
+	require.ErrorIs(t, err, storage.ErrNoData)
+	trash, err := s.GetDeletedItems(ctx, userID)
+	require.NoError(t, err)
+	assert.Len(t, trash, 3)
+	require.ErrorIs(t, s.RestoreDeletedItem(ctx, attachment, userID), storage.ErrParentInTrash)
+
+	require.NoError(t, s.RestoreDeletedItem(ctx, parent, userID))
+	assert.Equal(t, []string{attachment}, attachmentIDs(t, s, userID, parent))
+	trash, err = s.GetDeletedItems(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+	assert.Equal(t, earlier, trash[0].ID)
+
+	require.NoError(t, s.RestoreDeletedItem(ctx, earlier, userID))
+	assert.Equal(t, []string{earlier, attachment}, attachmentIDs(t, s, userID, parent))
+}
+
+func testPurgeAttachments(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	parent := createItem(t, s, userID, model.Password, `{"resource":"site"}`)
+	attachment := createItem(t, s, userID, model.File, `{"name":"codes.txt"}`, withParent(parent))
+	keep := createItem(t, s, userID, model.Text, `{}`)
+	kept := createItem(t, s, userID, model.File, `{"name":"kept.txt"}`, withParent(keep))
+	require.NoError(t, s.DeleteItem(ctx, parent, userID))
+
+	purged, err := s.PurgeDeletedItems(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+	require.ErrorIs(t, s.RestoreDeletedItem(ctx, attachment, userID), storage.ErrNoData)
+	assert.Equal(t, []string{kept}, attachmentIDs(t, s, userID, keep))
+
+	require.NoError(t, s.DeleteItem(ctx, keep, userID))
+	purged, err = s.PurgeDeletedBefore(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+	_, err = s.GetItem(ctx, kept, userID)
+	require.ErrorIs(t, err, storage.ErrNoData)
+}
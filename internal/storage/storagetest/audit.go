@@ -0,0 +1,168 @@
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// auditTests возвращает тесты журнала аудита.
+func auditTests() []testCase {
+	return []testCase{
+		{name: "Пустой журнал аудита", fn: testAuditEmpty},
+		{name: "Цепочка событий журнала аудита", fn: testAuditChain},
+		{name: "Журналы аудита пользователей независимы", fn: testAuditIsolation},
+		{name: "Постраничная выдача журнала аудита", fn: testAuditPaging},
+		{name: "Событие аудита откатывается с транзакцией", fn: testAuditTx},
+		{name: "Последнее событие журнала аудита", fn: testAuditHead},
+		{name: "Журнал аудита неизвестного пользователя", fn: testAuditNoUser},
+	}
+}
+
+// auditKey ключ журнала аудита в тестах.
+var auditKey = []byte("audit key")
+
+// appendAuditEvent добавляет в журнал событие пользователя и возвращает его.
+func appendAuditEvent(t *testing.T, s storage.Storage, userID string, action string) model.AuditEvent {
+	t.Helper()
+	event := &model.AuditEvent{
+		UserID:    userID,
+		Action:    action,
+		ItemID:    "item",
+		Peer:      "127.0.0.1:5000",
+		Code:      "OK",
+		CreatedAt: time.Now(),
+	}
+	require.NoError(t, s.AppendAuditEvent(context.Background(), event, auditKey))
+	return *event
+}
+
+func testAuditEmpty(t *testing.T, s storage.Storage) {
+	userID := createUser(t, s, "user")
+	events, err := s.ListAuditEvents(context.Background(), userID, model.ListAuditParams{})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func testAuditChain(t *testing.T, s storage.Storage) {
+	userID := createUser(t, s, "user")
+	first := appendAuditEvent(t, s, userID, "GetData")
+	second := appendAuditEvent(t, s, userID, "DeleteData")
+	assert.Equal(t, int64(1), first.Seq)
+	assert.Empty(t, first.PrevHash)
+	assert.Equal(t, int64(2), second.Seq)
+	assert.Equal(t, first.Hash, second.PrevHash)
+
+	events, err := s.ListAuditEvents(context.Background(), userID, model.ListAuditParams{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	// Сохраненные события совпадают с записанными, поэтому их хэши проходят проверку.
+	require.NoError(t, model.VerifyAuditChain(events, auditKey))
+	assert.Equal(t, userID, events[0].UserID)
+	assert.Equal(t, "GetData", events[0].Action)
+	assert.Equal(t, "item", events[0].ItemID)
+	assert.Equal(t, "127.0.0.1:5000", events[0].Peer)
+	assert.Equal(t, "OK", events[0].Code)
+	assert.True(t, first.CreatedAt.Equal(events[0].CreatedAt))
+	assert.Equal(t, second.Hash, events[1].Hash)
+
+	events[0].Action = "ListItems"
+	assert.True(t, errors.Is(model.VerifyAuditChain(events, auditKey), model.ErrAuditChainBroken))
+}
+
+func testAuditIsolation(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	otherID := createUser(t, s, "other")
+	appendAuditEvent(t, s, userID, "GetData")
+	other := appendAuditEvent(t, s, otherID, "GetData")
+	assert.Equal(t, int64(1), other.Seq)
+
+	events, err := s.ListAuditEvents(ctx, userID, model.ListAuditParams{})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, userID, events[0].UserID)
+}
+
+func testAuditPaging(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	for range 5 {
+		appendAuditEvent(t, s, userID, "GetData")
+	}
+
+	page, err := s.ListAuditEvents(ctx, userID, model.ListAuditParams{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, int64(1), page[0].Seq)
+
+	page, err = s.ListAuditEvents(ctx, userID, model.ListAuditParams{AfterSeq: 2, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, int64(3), page[0].Seq)
+	assert.Equal(t, int64(4), page[1].Seq)
+
+	page, err = s.ListAuditEvents(ctx, userID, model.ListAuditParams{AfterSeq: 4})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, int64(5), page[0].Seq)
+}
+
+func testAuditTx(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	appendAuditEvent(t, s, userID, "GetData")
+
+	errRollback := errors.New("rollback")
+	err := s.WithTx(ctx, func(tx storage.Storage) error {
+		appendAuditEvent(t, tx, userID, "DeleteData")
+		return errRollback
+	})
+	require.ErrorIs(t, err, errRollback)
+
+	// Номер откатанного события используется повторно, цепочка не прерывается.
+	event := appendAuditEvent(t, s, userID, "DeleteData")
+	assert.Equal(t, int64(2), event.Seq)
+	events, err := s.ListAuditEvents(ctx, userID, model.ListAuditParams{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.NoError(t, model.VerifyAuditChain(events, auditKey))
+	head, err := s.GetAuditHead(ctx, userID)
+	require.NoError(t, err)
+	require.NoError(t, head.Verify(event, auditKey))
+}
+
+func testAuditHead(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	head, err := s.GetAuditHead(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, model.AuditHead{}, head)
+
+	appendAuditEvent(t, s, userID, "GetData")
+	last := appendAuditEvent(t, s, userID, "DeleteData")
+	head, err = s.GetAuditHead(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), head.Seq)
+	require.NoError(t, head.Verify(last, auditKey))
+
+	events, err := s.ListAuditEvents(ctx, userID, model.ListAuditParams{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	// Предыдущее событие нельзя выдать за последнее.
+	assert.ErrorIs(t, head.Verify(events[0], auditKey), model.ErrAuditChainBroken)
+}
+
+func testAuditNoUser(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	err := s.AppendAuditEvent(ctx, &model.AuditEvent{UserID: unknownID(), Action: "Login"}, auditKey)
+	require.ErrorIs(t, err, storage.ErrNoUser)
+	_, err = s.GetAuditHead(ctx, unknownID())
+	require.ErrorIs(t, err, storage.ErrNoUser)
+}
@@ -0,0 +1,272 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// backupTests возвращает тесты чтения и загрузки объектов резервной копии.
+func backupTests() []testCase {
+	return []testCase{
+		{name: "Чтение данных для резервной копии", fn: testBackupRead},
+		{name: "Загрузка данных с исходными id и версиями", fn: testBackupRestore},
+		{name: "Загрузка организации и журнала аудита", fn: testBackupRestoreOrg},
+	}
+}
+
+func testBackupRead(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	ownerID := createUser(t, s, "owner")
+	recipientID := createUser(t, s, "recipient")
+	orgID := createOrg(t, s, ownerID, "team")
+
+	updated := createItem(t, s, ownerID, model.Text, "updated", withIndex("idx"))
+	updateItem(t, s, updated, ownerID, "data_v2", "updated_v2")
+	accessed := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	require.NoError(t, s.SetFavorite(ctx, updated, ownerID, true))
+	require.NoError(t, s.RecordAccess(ctx, updated, ownerID, accessed))
+	require.NoError(t, s.RecordAccess(ctx, updated, ownerID, accessed))
+
+	trashed := createItem(t, s, ownerID, model.Password, "trashed")
+	tagID := tagItem(t, s, ownerID, trashed, "tag")
+	shareItem(t, s, trashed, ownerID, recipientID, model.ShareRead)
+	require.NoError(t, s.DeleteItem(ctx, trashed, ownerID))
+	expired := createItem(t, s, ownerID, model.Text, "expired", withExpiry(time.Now().Add(-time.Hour)))
+
+	items, err := s.ListBackupItems(ctx, ownerID)
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	byID := make(map[string]model.VaultItem, len(items))
+	for _, item := range items {
+		assert.Equal(t, ownerID, item.UserID)
+		byID[item.ID] = item
+	}
+
+	got := byID[updated]
+	assert.Equal(t, []byte("data_v2"), got.EncryptData)
+	assert.Equal(t, []byte("updated_v2"), got.EncryptMeta)
+	assert.Equal(t, int64(2), got.Revision)
+	assert.True(t, got.Favorite)
+	assert.Equal(t, int64(2), got.AccessCount)
+	require.NotNil(t, got.LastAccessedAt)
+	assert.True(t, accessed.Equal(*got.LastAccessedAt))
+	assert.Nil(t, got.DeletedAt)
+
+	got = byID[trashed]
+	assert.NotNil(t, got.DeletedAt)
+	assert.Equal(t, []string{tagID}, got.TagIDs)
+	assert.Equal(t, []byte("data_trashed"), got.EncryptData)
+	assert.NotNil(t, byID[expired].ExpiresAt)
+
+	history, err := s.ListBackupHistory(ctx, updated)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, updated, history[0].ItemID)
+	assert.Equal(t, int64(1), history[0].Revision)
+	assert.Equal(t, []byte("data_updated"), history[0].EncryptData)
+	assert.Equal(t, []byte("updated"), history[0].EncryptMeta)
+	assert.Equal(t, []string{"idx"}, history[0].MetaIndex)
+
+	shares, err := s.ListBackupShares(ctx, ownerID)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	assert.Equal(t, trashed, shares[0].ItemID)
+	assert.Equal(t, ownerID, shares[0].OwnerID)
+	assert.Equal(t, recipientID, shares[0].RecipientID)
+	assert.Equal(t, []byte("sealed_"+recipientID), shares[0].EncryptKey)
+	assert.False(t, shares[0].CreatedAt.IsZero())
+	shares, err = s.ListBackupShares(ctx, recipientID)
+	require.NoError(t, err)
+	assert.Empty(t, shares)
+
+	orgs, err := s.ListBackupOrgs(ctx)
+	require.NoError(t, err)
+	require.Len(t, orgs, 1)
+	assert.Equal(t, orgID, orgs[0].ID)
+	assert.Equal(t, []byte("team"), orgs[0].EncryptName)
+	assert.Equal(t, "secret_team", orgs[0].EncryptedSecret)
+	assert.False(t, orgs[0].CreatedAt.IsZero())
+}
+
+func testBackupRestore(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	created := time.Now().Add(-48 * time.Hour).UTC().Truncate(time.Millisecond)
+	deleted := created.Add(24 * time.Hour)
+
+	owner := &model.User{ID: unknownID(), Login: "owner", PasswordHash: "hash", EncryptedSecret: "secret"}
+	recipient := &model.User{
+		ID: unknownID(), Login: "recipient", PasswordHash: "hash", EncryptedSecret: "secret",
+		PublicKey: []byte("public"), EncryptPrivateKey: []byte("private"),
+	}
+	require.NoError(t, s.RestoreUser(ctx, owner))
+	require.NoError(t, s.RestoreUser(ctx, recipient))
+	err := s.RestoreUser(ctx, &model.User{ID: unknownID(), Login: "owner", EncryptedSecret: "secret"})
+	require.ErrorIs(t, err, storage.ErrLoginTaken)
+
+	parent := &model.Folder{ID: unknownID(), UserID: owner.ID, EncryptName: []byte("parent"), CreatedAt: created}
+	child := &model.Folder{
+		ID: unknownID(), UserID: owner.ID, ParentID: parent.ID, EncryptName: []byte("child"),
+		CreatedAt: created.Add(time.Minute),
+	}
+	require.NoError(t, s.RestoreFolder(ctx, parent))
+	require.NoError(t, s.RestoreFolder(ctx, child))
+	tag := &model.Tag{ID: unknownID(), UserID: owner.ID, EncryptName: []byte("tag"), NameIndex: "tag"}
+	require.NoError(t, s.RestoreTag(ctx, tag))
+
+	accessed := created.Add(time.Hour)
+	item := &model.VaultItem{
+		ID: unknownID(), UserID: owner.ID, EncryptData: []byte("data_v3"), EncryptMeta: []byte("meta_v3"),
+		MetaIndex: []string{"idx"}, Type: model.Text, Revision: 3, Size: 7, CreatedAt: created,
+		UpdatedAt: created.Add(2 * time.Hour), FolderID: child.ID, TagIDs: []string{tag.ID}, Favorite: true,
+		LastAccessedAt: &accessed, AccessCount: 5, EncryptKey: []byte("key"), SharedMeta: []byte("shared_v3"),
+	}
+	history := []model.VaultItemRevision{
+		{Revision: 1, EncryptData: []byte("data_v1"), EncryptMeta: []byte("meta_v1"), Size: 7, CreatedAt: created},
+		{
+			Revision: 2, EncryptData: []byte("data_v2"), EncryptMeta: []byte("meta_v2"), Size: 7,
+			SharedMeta: []byte("shared_v2"), CreatedAt: created.Add(time.Hour),
+		},
+	}
+	require.NoError(t, s.RestoreItem(ctx, item, history))
+	attachment := &model.VaultItem{
+		ID: unknownID(), UserID: owner.ID, EncryptData: []byte("file"), EncryptMeta: []byte("file"),
+		Type: model.File, Revision: 1, CreatedAt: created, UpdatedAt: created, ParentID: item.ID,
+	}
+	require.NoError(t, s.RestoreItem(ctx, attachment, nil))
+	trashed := &model.VaultItem{
+		ID: unknownID(), UserID: owner.ID, EncryptData: []byte("trashed"), EncryptMeta: []byte("trashed"),
+		Type: model.Password, Revision: 1, CreatedAt: created, UpdatedAt: created, DeletedAt: &deleted,
+	}
+	require.NoError(t, s.RestoreItem(ctx, trashed, nil))
+	share := &model.Share{
+		ItemID: item.ID, OwnerID: owner.ID, RecipientID: recipient.ID, Access: model.ShareWrite,
+		EncryptKey: []byte("sealed"), CreatedAt: created,
+	}
+	require.NoError(t, s.RestoreShare(ctx, share))
+
+	user, err := s.GetUserByLogin(ctx, "recipient")
+	require.NoError(t, err)
+	assert.Equal(t, recipient.ID, user.ID)
+	assert.Equal(t, []byte("private"), user.EncryptPrivateKey)
+
+	folders, err := s.ListFolders(ctx, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, folders, 2)
+	assert.Equal(t, parent.ID, folders[0].ID)
+	assert.Equal(t, parent.ID, folders[1].ParentID)
+	assert.True(t, child.CreatedAt.Equal(folders[1].CreatedAt))
+
+	got, err := s.GetItem(ctx, item.ID, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data_v3"), got.EncryptData)
+	assert.Equal(t, int64(3), got.Revision)
+	assert.Equal(t, []byte("key"), got.EncryptKey)
+	assert.Equal(t, []byte("shared_v3"), got.SharedMeta)
+	assert.True(t, created.Equal(got.CreatedAt))
+	assert.True(t, item.UpdatedAt.Equal(got.UpdatedAt))
+
+	listed := listedItem(t, s, owner.ID, item.ID)
+	assert.Equal(t, child.ID, listed.FolderID)
+	assert.Equal(t, []string{tag.ID}, listed.TagIDs)
+	assert.True(t, listed.Favorite)
+	assert.Equal(t, int64(5), listed.AccessCount)
+	require.NotNil(t, listed.LastAccessedAt)
+	assert.True(t, accessed.Equal(*listed.LastAccessedAt))
+	assert.Equal(t, []string{attachment.ID}, attachmentIDs(t, s, owner.ID, item.ID))
+
+	revisions, err := s.GetItemHistory(ctx, item.ID, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	rev, err := s.GetItemRevision(ctx, item.ID, owner.ID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data_v2"), rev.EncryptData)
+	assert.Equal(t, []byte("shared_v2"), rev.SharedMeta)
+	assert.True(t, history[1].CreatedAt.Equal(rev.CreatedAt))
+
+	deletedItems, err := s.GetDeletedItems(ctx, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, deletedItems, 1)
+	assert.Equal(t, trashed.ID, deletedItems[0].ID)
+	require.NoError(t, s.RestoreDeletedItem(ctx, trashed.ID, owner.ID))
+
+	restored, err := s.GetShare(ctx, item.ID, recipient.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.ShareWrite, restored.Access)
+	assert.True(t, created.Equal(restored.CreatedAt))
+
+	// Загруженные данные изменяются обычными методами: версия продолжает загруженную.
+	require.NoError(t, s.UpdateItem(ctx, item.ID, owner.ID, &model.VaultItem{
+		EncryptData: []byte("data_v4"), EncryptMeta: []byte("meta_v4"), EncryptKey: []byte("key"),
+	}))
+	got, err = s.GetItem(ctx, item.ID, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), got.Revision)
+}
+
+func testBackupRestoreOrg(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	created := time.Now().Add(-time.Hour).UTC().Truncate(time.Millisecond)
+	owner := &model.User{ID: unknownID(), Login: "owner", PasswordHash: "hash", EncryptedSecret: "secret"}
+	require.NoError(t, s.RestoreUser(ctx, owner))
+	orgID := unknownID()
+	require.NoError(t, s.RestoreUser(ctx, &model.User{
+		ID: orgID, Login: model.OrgLoginPrefix + orgID, EncryptedSecret: "secret_team",
+	}))
+	require.NoError(t, s.RestoreOrg(ctx, &model.Organization{
+		ID: orgID, EncryptName: []byte("team"), EncryptedSecret: "secret_team", CreatedAt: created,
+	}))
+	require.NoError(t, s.RestoreOrgMember(ctx, &model.OrgMember{
+		OrgID: orgID, UserID: owner.ID, Role: model.OrgRoleOwner, EncryptKey: []byte("sealed"), CreatedAt: created,
+	}))
+
+	members, err := s.ListOrgMembers(ctx, orgID)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, owner.ID, members[0].UserID)
+	assert.Equal(t, "owner", members[0].Login)
+	assert.True(t, created.Equal(members[0].CreatedAt))
+	orgs, err := s.ListUserOrgs(ctx, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, orgs, 1)
+	assert.Equal(t, []byte("team"), orgs[0].EncryptOrgName)
+
+	// События загружаются как есть, без пересчета хэшей.
+	events := []model.AuditEvent{
+		{
+			UserID: owner.ID, Seq: 1, Action: "GetData", ItemID: "item", Peer: "peer", Code: "OK",
+			CreatedAt: created, PrevHash: []byte{}, Hash: []byte("hash_1"),
+		},
+		{
+			UserID: owner.ID, Seq: 2, Action: "DeleteData", Peer: "peer", Code: "OK",
+			CreatedAt: created.Add(time.Minute), PrevHash: []byte("hash_1"), Hash: []byte("hash_2"),
+		},
+	}
+	for i := range events {
+		require.NoError(t, s.RestoreAuditEvent(ctx, &events[i]))
+	}
+	head := model.AuditHead{Seq: 2, Tag: []byte("tag")}
+	require.NoError(t, s.RestoreAuditHead(ctx, owner.ID, head))
+
+	got, err := s.ListAuditEvents(ctx, owner.ID, model.ListAuditParams{})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	for i := range got {
+		assert.True(t, events[i].CreatedAt.Equal(got[i].CreatedAt))
+		got[i].CreatedAt = events[i].CreatedAt
+	}
+	assert.Equal(t, events, got)
+	gotHead, err := s.GetAuditHead(ctx, owner.ID)
+	require.NoError(t, err)
+	assert.Equal(t, head, gotHead)
+
+	// Следующее событие продолжает загруженный журнал.
+	next := appendAuditEvent(t, s, owner.ID, "GetData")
+	assert.Equal(t, int64(3), next.Seq)
+	assert.Equal(t, []byte("hash_2"), next.PrevHash)
+}
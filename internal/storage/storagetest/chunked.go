@@ -0,0 +1,68 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chunkedTests возвращает тесты данных, сохраненных блоками.
+func chunkedTests() []testCase {
+	return []testCase{
+		{name: "Признак хранения блоками сохраняется", fn: testChunkedFlag},
+		{name: "Список манифестов пользователя", fn: testListChunkedData},
+	}
+}
+
+func testChunkedFlag(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.File, `{"name":"file"}`, withManifest("manifest_1"))
+
+	item, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.True(t, item.Chunked)
+
+	// Обычное обновление заменяет манифест данными, в истории остается версия с манифестом.
+	updateItem(t, s, id, userID, "inline", `{"name":"file"}`)
+	item, err = s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.False(t, item.Chunked)
+
+	rev, err := s.GetItemRevision(ctx, id, userID, 1)
+	require.NoError(t, err)
+	assert.True(t, rev.Chunked)
+
+	require.NoError(t, s.RestoreItemRevision(ctx, id, userID, 1))
+	item, err = s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.True(t, item.Chunked)
+	assert.Equal(t, []byte("manifest_1"), item.EncryptData)
+}
+
+func testListChunkedData(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	otherID := createUser(t, s, "other")
+
+	updated := createItem(t, s, userID, model.File, `{"name":"file"}`, withManifest("manifest_1"))
+	require.NoError(t, s.UpdateItem(ctx, updated, userID, &model.VaultItem{
+		EncryptData: []byte("manifest_2"),
+		EncryptMeta: []byte(`{"name":"file"}`),
+		Chunked:     true,
+	}))
+	deleted := createItem(t, s, userID, model.File, `{"name":"file"}`, withManifest("manifest_3"))
+	require.NoError(t, s.DeleteItem(ctx, deleted, userID))
+	createItem(t, s, userID, model.File, `{"name":"inline"}`)
+	createItem(t, s, otherID, model.File, `{"name":"file"}`, withManifest("manifest_other"))
+
+	manifests, err := s.ListChunkedData(ctx, userID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, [][]byte{
+		[]byte("manifest_1"), []byte("manifest_2"), []byte("manifest_3"),
+	}, manifests)
+}
@@ -0,0 +1,125 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// expiryTests возвращает тесты срока хранения данных.
+func expiryTests() []testCase {
+	return []testCase{
+		{name: "Данные с истекшим сроком хранения скрыты", fn: testExpiredHidden},
+		{name: "Изменение срока хранения данных", fn: testUpdateExpiry},
+		{name: "Удаление данных с истекшим сроком хранения", fn: testPurgeExpired},
+	}
+}
+
+func testExpiredHidden(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	recipientID := createUser(t, s, "recipient")
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	expiredAt := time.Now().Add(-time.Minute)
+	active := createItem(t, s, userID, model.Password, `{}`, withIndex("temporary"), withExpiry(expiresAt))
+	expired := createItem(t, s, userID, model.Password, `{}`, withIndex("temporary"), withExpiry(expiredAt))
+	permanent := createItem(t, s, userID, model.Password, `{"resource":"site"}`)
+	expiredAttachment := createItem(t, s, userID, model.File, `{}`, withParent(permanent), withExpiry(expiredAt))
+	attachment := createItem(t, s, userID, model.File, `{"name":"codes.txt"}`, withParent(permanent))
+	for _, id := range []string{active, expired} {
+		require.NoError(t, s.ShareItem(ctx, &model.Share{
+			ItemID: id, OwnerID: userID, RecipientID: recipientID, Access: model.ShareRead, EncryptKey: []byte("sealed"),
+		}))
+	}
+
+	got, err := s.GetItem(ctx, active, userID)
+	require.NoError(t, err)
+	require.NotNil(t, got.ExpiresAt)
+	assert.WithinDuration(t, expiresAt, *got.ExpiresAt, time.Millisecond)
+	got, err = s.GetItem(ctx, permanent, userID)
+	require.NoError(t, err)
+	assert.Nil(t, got.ExpiresAt)
+	for _, id := range []string{expired, expiredAttachment} {
+		_, err = s.GetItem(ctx, id, userID)
+		require.ErrorIs(t, err, storage.ErrNoData)
+	}
+
+	assert.ElementsMatch(t, []string{active, permanent, attachment}, listIDs(t, s, userID, model.ListItemsParams{}))
+	listed := listedItem(t, s, userID, active)
+	require.NotNil(t, listed.ExpiresAt)
+	assert.WithinDuration(t, expiresAt, *listed.ExpiresAt, time.Millisecond)
+
+	byType, err := s.GetItemsByType(ctx, string(model.Password), userID)
+	require.NoError(t, err)
+	assert.Len(t, byType, 2)
+	found, err := s.SearchItems(ctx, userID, model.SearchItemsParams{Index: []string{"temporary"}})
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, active, found[0].ID)
+	assert.Equal(t, []string{attachment}, attachmentIDs(t, s, userID, permanent))
+
+	shares, err := s.ListShares(ctx, recipientID)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	assert.Equal(t, active, shares[0].ItemID)
+}
+
+func testUpdateExpiry(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	id := createItem(t, s, userID, model.Password, `{"resource":"temporary"}`, withExpiry(expiresAt))
+
+	// Без нового срока хранения изменение данных сохраняет прежний.
+	updateItem(t, s, id, userID, "data", `{"resource":"updated"}`)
+	got, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	require.NotNil(t, got.ExpiresAt)
+	assert.WithinDuration(t, expiresAt, *got.ExpiresAt, time.Millisecond)
+
+	extended := expiresAt.Add(24 * time.Hour)
+	require.NoError(t, s.UpdateItem(ctx, id, userID, &model.VaultItem{
+		EncryptData: []byte("data"), EncryptMeta: []byte(`{}`), ExpiresAt: &extended,
+	}))
+	got, err = s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	require.NotNil(t, got.ExpiresAt)
+	assert.WithinDuration(t, extended, *got.ExpiresAt, time.Millisecond)
+}
+
+func testPurgeExpired(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	other := createUser(t, s, "other")
+	now := time.Now()
+	expiredAt := now.Add(-time.Minute)
+	expired := createItem(t, s, userID, model.Password, `{}`, withExpiry(expiredAt))
+	createItem(t, s, userID, model.File, `{"name":"codes.txt"}`, withParent(expired))
+	deleted := createItem(t, s, other, model.Password, `{}`, withExpiry(expiredAt))
+	require.NoError(t, s.DeleteItem(ctx, deleted, other))
+	active := createItem(t, s, userID, model.Password, `{}`, withExpiry(now.Add(time.Hour)))
+	permanent := createItem(t, s, userID, model.Text, `{}`)
+	createItem(t, s, userID, model.File, `{}`, withParent(permanent), withExpiry(expiredAt))
+	kept := createItem(t, s, userID, model.File, `{"name":"kept.txt"}`, withParent(permanent))
+
+	purged, err := s.PurgeExpiredItems(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), purged)
+	require.ErrorIs(t, s.RestoreDeletedItem(ctx, deleted, other), storage.ErrNoData)
+	assert.ElementsMatch(t, []string{active, permanent, kept}, listIDs(t, s, userID, model.ListItemsParams{}))
+
+	purged, err = s.PurgeExpiredItems(ctx, now)
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+
+	purged, err = s.PurgeExpiredItems(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	_, err = s.GetItem(ctx, active, userID)
+	require.ErrorIs(t, err, storage.ErrNoData)
+}
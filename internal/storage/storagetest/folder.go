@@ -0,0 +1,262 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// folderTests возвращает тесты папок и меток данных.
+func folderTests() []testCase {
+	return []testCase{
+		{name: "Создание и список папок", fn: testCreateFolders},
+		{name: "Перемещение данных в папку", fn: testMoveItem},
+		{name: "Удаление папки", fn: testDeleteFolder},
+		{name: "Добавление метки", fn: testTagItem},
+		{name: "Снятие метки", fn: testUntagItem},
+		{name: "Удаление пользователя с папками и метками", fn: testDeleteUserFolders},
+	}
+}
+
+// createFolder создает папку пользователя и возвращает ее id.
+func createFolder(t *testing.T, s storage.Storage, userID string, name string, parentID string) string {
+	t.Helper()
+	id, err := s.CreateFolder(context.Background(), userID, &model.Folder{
+		ParentID:    parentID,
+		EncryptName: []byte(name),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, id)
+	return id
+}
+
+// tagItem добавляет данным метку с переданным индексом и возвращает id метки.
+func tagItem(t *testing.T, s storage.Storage, userID string, itemID string, nameIndex string) string {
+	t.Helper()
+	tag := &model.Tag{EncryptName: []byte("name_" + nameIndex), NameIndex: nameIndex}
+	require.NoError(t, s.TagItem(context.Background(), itemID, userID, tag))
+	require.NotEmpty(t, tag.ID)
+	return tag.ID
+}
+
+// listIDs возвращает id данных пользователя, удовлетворяющих параметрам выборки.
+func listIDs(t *testing.T, s storage.Storage, userID string, params model.ListItemsParams) []string {
+	t.Helper()
+	items, err := s.ListItems(context.Background(), userID, params)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+// listedItem возвращает данные из списка данных пользователя.
+func listedItem(t *testing.T, s storage.Storage, userID string, id string) model.VaultItem {
+	t.Helper()
+	items, err := s.ListItems(context.Background(), userID, model.ListItemsParams{})
+	require.NoError(t, err)
+	for _, item := range items {
+		if item.ID == id {
+			return item
+		}
+	}
+	require.FailNow(t, "item not listed", id)
+	return model.VaultItem{}
+}
+
+func testCreateFolders(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	otherID := createUser(t, s, "other")
+	work := createFolder(t, s, userID, "work", "")
+	projects := createFolder(t, s, userID, "projects", work)
+	otherFolder := createFolder(t, s, otherID, "other", "")
+
+	folders, err := s.ListFolders(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, folders, 2)
+	assert.Equal(t, work, folders[0].ID)
+	assert.Empty(t, folders[0].ParentID)
+	assert.Equal(t, []byte("work"), folders[0].EncryptName)
+	assert.False(t, folders[0].CreatedAt.IsZero())
+	assert.Equal(t, projects, folders[1].ID)
+	assert.Equal(t, work, folders[1].ParentID)
+
+	_, err = s.CreateFolder(ctx, userID, &model.Folder{ParentID: unknownID(), EncryptName: []byte("x")})
+	require.ErrorIs(t, err, storage.ErrNoFolder)
+	// Папка другого пользователя не может быть родительской.
+	_, err = s.CreateFolder(ctx, userID, &model.Folder{ParentID: otherFolder, EncryptName: []byte("x")})
+	require.ErrorIs(t, err, storage.ErrNoFolder)
+
+	folders, err = s.ListFolders(ctx, otherID)
+	require.NoError(t, err)
+	require.Len(t, folders, 1)
+	assert.Equal(t, otherFolder, folders[0].ID)
+}
+
+func testMoveItem(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	otherID := createUser(t, s, "other")
+	folder := createFolder(t, s, userID, "work", "")
+	otherFolder := createFolder(t, s, otherID, "other", "")
+	moved := createItem(t, s, userID, model.Password, "moved")
+	root := createItem(t, s, userID, model.Password, "root")
+
+	require.NoError(t, s.MoveItem(ctx, moved, userID, folder))
+	assert.Equal(t, folder, listedItem(t, s, userID, moved).FolderID)
+	assert.Empty(t, listedItem(t, s, userID, root).FolderID)
+	assert.Equal(t, []string{moved}, listIDs(t, s, userID, model.ListItemsParams{FolderID: folder}))
+	// Перемещение не изменяет версию данных.
+	assert.Equal(t, int64(1), listedItem(t, s, userID, moved).Revision)
+
+	require.NoError(t, s.MoveItem(ctx, moved, userID, ""))
+	assert.Empty(t, listedItem(t, s, userID, moved).FolderID)
+	assert.Empty(t, listIDs(t, s, userID, model.ListItemsParams{FolderID: folder}))
+
+	require.ErrorIs(t, s.MoveItem(ctx, moved, userID, unknownID()), storage.ErrNoFolder)
+	require.ErrorIs(t, s.MoveItem(ctx, moved, userID, otherFolder), storage.ErrNoFolder)
+	require.ErrorIs(t, s.MoveItem(ctx, unknownID(), userID, folder), storage.ErrNoData)
+	require.ErrorIs(t, s.MoveItem(ctx, moved, otherID, otherFolder), storage.ErrNoData)
+	require.NoError(t, s.DeleteItem(ctx, root, userID))
+	require.ErrorIs(t, s.MoveItem(ctx, root, userID, folder), storage.ErrNoData)
+}
+
+func testDeleteFolder(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	otherID := createUser(t, s, "other")
+	work := createFolder(t, s, userID, "work", "")
+	projects := createFolder(t, s, userID, "projects", work)
+	archive := createFolder(t, s, userID, "archive", projects)
+	item := createItem(t, s, userID, model.Password, "item")
+	trashed := createItem(t, s, userID, model.Text, "trashed")
+	require.NoError(t, s.MoveItem(ctx, item, userID, projects))
+	require.NoError(t, s.MoveItem(ctx, trashed, userID, projects))
+	require.NoError(t, s.DeleteItem(ctx, trashed, userID))
+
+	require.ErrorIs(t, s.DeleteFolder(ctx, projects, otherID), storage.ErrNoFolder)
+	require.NoError(t, s.DeleteFolder(ctx, projects, userID))
+	require.ErrorIs(t, s.DeleteFolder(ctx, projects, userID), storage.ErrNoFolder)
+
+	// Данные и вложенные папки переходят в родительскую папку удаленной.
+	folders, err := s.ListFolders(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, folders, 2)
+	assert.Equal(t, work, folders[0].ID)
+	assert.Equal(t, archive, folders[1].ID)
+	assert.Equal(t, work, folders[1].ParentID)
+	assert.Equal(t, work, listedItem(t, s, userID, item).FolderID)
+	require.NoError(t, s.RestoreDeletedItem(ctx, trashed, userID))
+	assert.Equal(t, work, listedItem(t, s, userID, trashed).FolderID)
+
+	// Данные папки верхнего уровня переходят на верхний уровень.
+	require.NoError(t, s.DeleteFolder(ctx, work, userID))
+	assert.Empty(t, listedItem(t, s, userID, item).FolderID)
+	folders, err = s.ListFolders(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, folders, 1)
+	assert.Empty(t, folders[0].ParentID)
+
+	require.ErrorIs(t, s.DeleteFolder(ctx, unknownID(), userID), storage.ErrNoFolder)
+}
+
+func testTagItem(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	otherID := createUser(t, s, "other")
+	first := createItem(t, s, userID, model.Password, "first")
+	second := createItem(t, s, userID, model.Password, "second")
+	trashed := createItem(t, s, userID, model.Text, "trashed")
+	otherItem := createItem(t, s, otherID, model.Text, "other")
+
+	work := tagItem(t, s, userID, first, "work")
+	// Повторное добавление метки не создает новую метку и не дублирует ее у данных.
+	assert.Equal(t, work, tagItem(t, s, userID, first, "work"))
+	assert.Equal(t, work, tagItem(t, s, userID, second, "work"))
+	personal := tagItem(t, s, userID, second, "personal")
+	assert.Equal(t, work, tagItem(t, s, userID, trashed, "work"))
+	require.NoError(t, s.DeleteItem(ctx, trashed, userID))
+	// У другого пользователя метка с тем же индексом своя.
+	assert.NotEqual(t, work, tagItem(t, s, otherID, otherItem, "work"))
+
+	assert.Equal(t, []string{work}, listedItem(t, s, userID, first).TagIDs)
+	assert.ElementsMatch(t, []string{work, personal}, listedItem(t, s, userID, second).TagIDs)
+	assert.ElementsMatch(t, []string{first, second}, listIDs(t, s, userID, model.ListItemsParams{TagIndex: "work"}))
+	assert.Equal(t, []string{second}, listIDs(t, s, userID, model.ListItemsParams{TagIndex: "personal"}))
+	assert.Empty(t, listIDs(t, s, userID, model.ListItemsParams{TagIndex: "unknown"}))
+
+	tags, err := s.ListTags(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	counts := make(map[string]int64, len(tags))
+	for _, tag := range tags {
+		assert.Equal(t, []byte("name_"+tag.NameIndex), tag.EncryptName)
+		counts[tag.ID] = tag.Items
+	}
+	// Данные в корзине не учитываются.
+	assert.Equal(t, map[string]int64{work: 2, personal: 1}, counts)
+
+	require.ErrorIs(t, s.TagItem(ctx, trashed, userID, &model.Tag{NameIndex: "work"}), storage.ErrNoData)
+	require.ErrorIs(t, s.TagItem(ctx, unknownID(), userID, &model.Tag{NameIndex: "work"}), storage.ErrNoData)
+	require.ErrorIs(t, s.TagItem(ctx, otherItem, userID, &model.Tag{NameIndex: "work"}), storage.ErrNoData)
+}
+
+func testUntagItem(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	first := createItem(t, s, userID, model.Password, "first")
+	second := createItem(t, s, userID, model.Password, "second")
+	work := tagItem(t, s, userID, first, "work")
+	tagItem(t, s, userID, second, "work")
+	tagItem(t, s, userID, first, "personal")
+
+	require.NoError(t, s.UntagItem(ctx, first, userID, "work"))
+	require.ErrorIs(t, s.UntagItem(ctx, first, userID, "work"), storage.ErrNoTag)
+	require.ErrorIs(t, s.UntagItem(ctx, first, userID, "unknown"), storage.ErrNoTag)
+	require.ErrorIs(t, s.UntagItem(ctx, unknownID(), userID, "work"), storage.ErrNoData)
+	assert.Equal(t, []string{second}, listIDs(t, s, userID, model.ListItemsParams{TagIndex: "work"}))
+
+	// Метка, которой больше нет ни у одних данных, удаляется.
+	require.NoError(t, s.UntagItem(ctx, first, userID, "personal"))
+	assert.Empty(t, listedItem(t, s, userID, first).TagIDs)
+	tags, err := s.ListTags(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, work, tags[0].ID)
+	assert.Equal(t, int64(1), tags[0].Items)
+}
+
+func testDeleteUserFolders(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	otherID := createUser(t, s, "other")
+	folder := createFolder(t, s, userID, "work", "")
+	item := createItem(t, s, userID, model.Password, "item")
+	require.NoError(t, s.MoveItem(ctx, item, userID, folder))
+	tagItem(t, s, userID, item, "work")
+	otherItem := createItem(t, s, otherID, model.Password, "other")
+	createFolder(t, s, otherID, "work", "")
+	tagItem(t, s, otherID, otherItem, "work")
+
+	require.NoError(t, s.DeleteUser(ctx, userID))
+
+	folders, err := s.ListFolders(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, folders)
+	tags, err := s.ListTags(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	folders, err = s.ListFolders(ctx, otherID)
+	require.NoError(t, err)
+	assert.Len(t, folders, 1)
+	tags, err = s.ListTags(ctx, otherID)
+	require.NoError(t, err)
+	assert.Len(t, tags, 1)
+}
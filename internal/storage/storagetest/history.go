@@ -0,0 +1,144 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// historyTests возвращает тесты истории версий данных.
+func historyTests() []testCase {
+	return []testCase{
+		{name: "Обновление сохраняет предыдущую версию", fn: testUpdateKeepsRevision},
+		{name: "Восстановление версии", fn: testRestoreRevision},
+		{name: "Версия не найдена", fn: testRevisionNotFound},
+		{name: "Изоляция истории пользователей", fn: testHistoryIsolation},
+		{name: "Очистка корзины удаляет историю", fn: testPurgeRemovesHistory},
+	}
+}
+
+// updateItem обновляет данные пользователя.
+func updateItem(t *testing.T, s storage.Storage, id, userID, data, meta string) {
+	t.Helper()
+	err := s.UpdateItem(context.Background(), id, userID, &model.VaultItem{
+		EncryptData: []byte(data),
+		EncryptMeta: []byte(meta),
+	})
+	require.NoError(t, err)
+}
+
+func testUpdateKeepsRevision(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Text, `{"v":0}`)
+
+	history, err := s.GetItemHistory(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+
+	updateItem(t, s, id, userID, "data_1", `{"v":1}`)
+	updateItem(t, s, id, userID, "data_2", `{"v":2}`)
+
+	history, err = s.GetItemHistory(ctx, id, userID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, int64(1), history[0].Revision)
+	assert.JSONEq(t, `{"v":0}`, string(history[0].EncryptMeta))
+	assert.Equal(t, int64(2), history[1].Revision)
+	assert.JSONEq(t, `{"v":1}`, string(history[1].EncryptMeta))
+	assert.False(t, history[0].CreatedAt.IsZero())
+
+	rev, err := s.GetItemRevision(ctx, id, userID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`data_{"v":0}`), rev.EncryptData)
+	assert.JSONEq(t, `{"v":0}`, string(rev.EncryptMeta))
+
+	item, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data_2"), item.EncryptData)
+}
+
+func testRestoreRevision(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Password, `{"v":0}`)
+	updateItem(t, s, id, userID, "data_1", `{"v":1}`)
+
+	require.NoError(t, s.RestoreItemRevision(ctx, id, userID, 1))
+
+	item, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`data_{"v":0}`), item.EncryptData)
+	assert.JSONEq(t, `{"v":0}`, string(item.EncryptMeta))
+	assert.Equal(t, model.Password, item.Type)
+
+	// Перезаписанные восстановлением данные тоже попадают в историю.
+	history, err := s.GetItemHistory(ctx, id, userID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	rev, err := s.GetItemRevision(ctx, id, userID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data_1"), rev.EncryptData)
+}
+
+func testRevisionNotFound(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Text, `{"v":0}`)
+	updateItem(t, s, id, userID, "data_1", `{"v":1}`)
+
+	_, err := s.GetItemRevision(ctx, id, userID, 5)
+	require.ErrorIs(t, err, storage.ErrNoRevision)
+	require.ErrorIs(t, s.RestoreItemRevision(ctx, id, userID, 5), storage.ErrNoRevision)
+
+	_, err = s.GetItemHistory(ctx, unknownID(), userID)
+	require.ErrorIs(t, err, storage.ErrNoData)
+	_, err = s.GetItemRevision(ctx, unknownID(), userID, 1)
+	require.ErrorIs(t, err, storage.ErrNoData)
+	require.ErrorIs(t, s.RestoreItemRevision(ctx, unknownID(), userID, 1), storage.ErrNoData)
+}
+
+func testHistoryIsolation(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	owner := createUser(t, s, "owner")
+	other := createUser(t, s, "other")
+	id := createItem(t, s, owner, model.Text, `{"v":0}`)
+	updateItem(t, s, id, owner, "data_1", `{"v":1}`)
+
+	_, err := s.GetItemHistory(ctx, id, other)
+	require.ErrorIs(t, err, storage.ErrNoData)
+	_, err = s.GetItemRevision(ctx, id, other, 1)
+	require.ErrorIs(t, err, storage.ErrNoData)
+	require.ErrorIs(t, s.RestoreItemRevision(ctx, id, other, 1), storage.ErrNoData)
+
+	item, err := s.GetItem(ctx, id, owner)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data_1"), item.EncryptData)
+}
+
+func testPurgeRemovesHistory(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Text, `{"v":0}`)
+	updateItem(t, s, id, userID, "data_1", `{"v":1}`)
+
+	// История данных в корзине недоступна, но сохраняется до восстановления.
+	require.NoError(t, s.DeleteItem(ctx, id, userID))
+	_, err := s.GetItemHistory(ctx, id, userID)
+	require.ErrorIs(t, err, storage.ErrNoData)
+	require.NoError(t, s.RestoreDeletedItem(ctx, id, userID))
+	history, err := s.GetItemHistory(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Len(t, history, 1)
+
+	require.NoError(t, s.DeleteItem(ctx, id, userID))
+	purged, err := s.PurgeDeletedItems(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+	_, err = s.GetItemHistory(ctx, id, userID)
+	require.ErrorIs(t, err, storage.ErrNoData)
+}
@@ -0,0 +1,151 @@
+package storagetest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listTests возвращает тесты постраничного получения списка данных.
+func listTests() []testCase {
+	return []testCase{
+		{name: "Список данных всех типов", fn: testListAllTypes},
+		{name: "Список данных с фильтром по типу", fn: testListByType},
+		{name: "Постраничный список по времени создания", fn: testListPagesByCreated},
+		{name: "Постраничный список по времени обновления", fn: testListPagesByUpdated},
+		{name: "Сортировка по мета данным не выполняется хранилищем", fn: testListByMeta},
+		{name: "Список без удаленных и чужих данных", fn: testListIsolation},
+	}
+}
+
+// listAll получает весь список данных постранично и возвращает id в порядке выдачи.
+func listAll(t *testing.T, s storage.Storage, userID string, params model.ListItemsParams) []string {
+	t.Helper()
+	var ids []string
+	params.After = nil
+	for {
+		items, err := s.ListItems(context.Background(), userID, params)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(items), params.Limit)
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+		if len(items) < params.Limit {
+			return ids
+		}
+		params.After = model.NewItemsCursor(items[len(items)-1], params)
+	}
+}
+
+func testListAllTypes(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	createItem(t, s, userID, model.Password, `{"resource":"a"}`)
+	createItem(t, s, userID, model.Text, `{"name":"b"}`)
+	createItem(t, s, userID, model.File, `{"name":"c"}`)
+
+	items, err := s.ListItems(ctx, userID, model.ListItemsParams{SortBy: model.SortByCreated, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	for _, item := range items {
+		assert.NotEmpty(t, item.Type)
+		assert.NotEmpty(t, item.EncryptMeta)
+		assert.Empty(t, item.EncryptData)
+		assert.False(t, item.CreatedAt.IsZero())
+		assert.False(t, item.UpdatedAt.IsZero())
+	}
+}
+
+func testListByType(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Password, `{"resource":"a"}`)
+	createItem(t, s, userID, model.Text, `{"name":"b"}`)
+
+	items, err := s.ListItems(ctx, userID, model.ListItemsParams{
+		Type:   model.Password,
+		SortBy: model.SortByCreated,
+		Limit:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, id, items[0].ID)
+	assert.Equal(t, model.Password, items[0].Type)
+}
+
+func testListPagesByCreated(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	for i := range 7 {
+		createItem(t, s, userID, model.Text, fmt.Sprintf(`{"name":"%d"}`, i))
+	}
+
+	items, err := s.ListItems(ctx, userID, model.ListItemsParams{SortBy: model.SortByCreated, Limit: 100})
+	require.NoError(t, err)
+	require.Len(t, items, 7)
+	var expected []string
+	for i, item := range items {
+		expected = append(expected, item.ID)
+		if i > 0 {
+			assert.False(t, item.CreatedAt.Before(items[i-1].CreatedAt))
+		}
+	}
+
+	asc := listAll(t, s, userID, model.ListItemsParams{SortBy: model.SortByCreated, Limit: 3})
+	assert.Equal(t, expected, asc)
+
+	desc := listAll(t, s, userID, model.ListItemsParams{SortBy: model.SortByCreated, Desc: true, Limit: 2})
+	for i, j := 0, len(desc)-1; i < j; i, j = i+1, j-1 {
+		desc[i], desc[j] = desc[j], desc[i]
+	}
+	assert.Equal(t, expected, desc)
+}
+
+func testListPagesByUpdated(t *testing.T, s storage.Storage) {
+	userID := createUser(t, s, "user")
+	first := createItem(t, s, userID, model.Text, `{"name":"first"}`)
+	second := createItem(t, s, userID, model.Text, `{"name":"second"}`)
+	updateItem(t, s, first, userID, "data", `{"name":"first"}`)
+
+	ids := listAll(t, s, userID, model.ListItemsParams{SortBy: model.SortByUpdated, Desc: true, Limit: 1})
+	assert.Equal(t, []string{first, second}, ids)
+}
+
+func testListByMeta(t *testing.T, s storage.Storage) {
+	userID := createUser(t, s, "user")
+	createItem(t, s, userID, model.Password, `{"resource":"b"}`)
+	createItem(t, s, userID, model.Password, `{"resource":"a"}`)
+	createItem(t, s, userID, model.Password, `{"resource":"c"}`)
+
+	// Мета данные зашифрованы, поэтому хранилище упорядочивает данные по времени создания.
+	expected := listAll(t, s, userID, model.ListItemsParams{SortBy: model.SortByCreated, Limit: 10})
+	items, err := s.ListItems(context.Background(), userID, model.ListItemsParams{
+		SortBy:    model.SortByMeta,
+		MetaField: "resource",
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	assert.Equal(t, expected, ids)
+}
+
+func testListIsolation(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	owner := createUser(t, s, "owner")
+	other := createUser(t, s, "other")
+	keep := createItem(t, s, owner, model.Text, `{"name":"keep"}`)
+	deleted := createItem(t, s, owner, model.Text, `{"name":"deleted"}`)
+	createItem(t, s, other, model.Text, `{"name":"other"}`)
+	require.NoError(t, s.DeleteItem(ctx, deleted, owner))
+
+	ids := listAll(t, s, owner, model.ListItemsParams{SortBy: model.SortByCreated, Limit: 10})
+	assert.Equal(t, []string{keep}, ids)
+}
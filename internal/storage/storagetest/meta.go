@@ -0,0 +1,111 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyMetaTests возвращает тесты шифрования мета данных, сохраненных в открытом виде.
+func legacyMetaTests() []testCase {
+	return []testCase{
+		{name: "Список мета данных в открытом виде", fn: testListLegacyMeta},
+		{name: "Шифрование мета данных в открытом виде", fn: testEncryptLegacyMeta},
+	}
+}
+
+func testListLegacyMeta(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	otherID := createUser(t, s, "other")
+	// Изменение сохраняет в истории предыдущую версию, тоже с мета данными в открытом виде.
+	id := createItem(t, s, userID, model.Password, `{"resource":"old"}`, withLegacyMeta())
+	require.NoError(t, s.UpdateItem(ctx, id, userID, &model.VaultItem{
+		EncryptData: []byte("data"),
+		Meta:        `{"resource":"new"}`,
+	}))
+	otherItem := createItem(t, s, otherID, model.Text, `{"name":"other"}`, withLegacyMeta())
+	createItem(t, s, userID, model.Text, `{"name":"encrypted"}`)
+
+	metas, err := s.ListLegacyMeta(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, metas, 3)
+	revisions := make(map[int64]bool)
+	for _, meta := range metas {
+		switch {
+		case meta.ItemID == id && meta.Revision == 0:
+			assert.JSONEq(t, `{"resource":"new"}`, meta.Meta)
+		case meta.ItemID == id && meta.Revision == 1:
+			assert.JSONEq(t, `{"resource":"old"}`, meta.Meta)
+		case meta.ItemID == otherItem:
+			assert.Equal(t, otherID, meta.UserID)
+			assert.JSONEq(t, `{"name":"other"}`, meta.Meta)
+			continue
+		default:
+			t.Errorf("unexpected legacy meta: %+v", meta)
+		}
+		assert.Equal(t, userID, meta.UserID)
+		revisions[meta.Revision] = true
+	}
+	assert.Len(t, revisions, 2)
+
+	metas, err = s.ListLegacyMeta(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, metas, 1)
+}
+
+func testEncryptLegacyMeta(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	// Изменение сохраняет в истории предыдущую версию, тоже с мета данными в открытом виде.
+	id := createItem(t, s, userID, model.Password, `{"resource":"old"}`, withLegacyMeta())
+	require.NoError(t, s.UpdateItem(ctx, id, userID, &model.VaultItem{
+		EncryptData: []byte("data"),
+		Meta:        `{"resource":"new"}`,
+	}))
+
+	require.NoError(t, s.EncryptLegacyMeta(ctx, model.LegacyMeta{
+		UserID:      userID,
+		ItemID:      id,
+		EncryptMeta: []byte("encrypted_new"),
+		MetaIndex:   []string{"resource_new"},
+	}))
+	require.NoError(t, s.EncryptLegacyMeta(ctx, model.LegacyMeta{
+		UserID:      userID,
+		ItemID:      id,
+		Revision:    1,
+		EncryptMeta: []byte("encrypted_old"),
+		MetaIndex:   []string{"resource_old"},
+	}))
+	// Уже зашифрованные мета данные не заменяются.
+	require.NoError(t, s.EncryptLegacyMeta(ctx, model.LegacyMeta{
+		UserID:      userID,
+		ItemID:      id,
+		EncryptMeta: []byte("encrypted_again"),
+	}))
+
+	item, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("encrypted_new"), item.EncryptMeta)
+	assert.Equal(t, []string{"resource_new"}, item.MetaIndex)
+	assert.Empty(t, item.Meta)
+
+	rev, err := s.GetItemRevision(ctx, id, userID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("encrypted_old"), rev.EncryptMeta)
+	assert.Equal(t, []string{"resource_old"}, rev.MetaIndex)
+	assert.Empty(t, rev.Meta)
+
+	items, err := s.SearchItems(ctx, userID, model.SearchItemsParams{Index: []string{"resource_new"}})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, id, items[0].ID)
+
+	metas, err := s.ListLegacyMeta(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, metas)
+}
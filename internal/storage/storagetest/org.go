@@ -0,0 +1,190 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// orgTests возвращает тесты организаций.
+func orgTests() []testCase {
+	return []testCase{
+		{name: "Создание организации", fn: testCreateOrg},
+		{name: "Участники организации", fn: testOrgMembers},
+		{name: "Удаление организации и участника", fn: testDeleteOrg},
+	}
+}
+
+// createOrg создает организацию с владельцем ownerID и возвращает ее id.
+func createOrg(t *testing.T, s storage.Storage, ownerID string, name string) string {
+	t.Helper()
+	id, err := s.CreateOrg(context.Background(), &model.Organization{
+		EncryptName:     []byte(name),
+		EncryptedSecret: "secret_" + name,
+	}, &model.OrgMember{
+		UserID:     ownerID,
+		Role:       model.OrgRoleOwner,
+		EncryptKey: []byte("sealed_" + ownerID),
+	})
+	require.NoError(t, err)
+	return id
+}
+
+// addOrgMember добавляет участника организации.
+func addOrgMember(t *testing.T, s storage.Storage, orgID string, userID string, role model.OrgRole) {
+	t.Helper()
+	err := s.AddOrgMember(context.Background(), &model.OrgMember{
+		OrgID:      orgID,
+		UserID:     userID,
+		Role:       role,
+		EncryptKey: []byte("sealed_" + userID),
+	})
+	require.NoError(t, err)
+}
+
+func testCreateOrg(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	ownerID := createUser(t, s, "owner")
+
+	org := &model.Organization{EncryptName: []byte("team"), EncryptedSecret: "secret_team"}
+	orgID, err := s.CreateOrg(ctx, org, &model.OrgMember{
+		UserID: ownerID, Role: model.OrgRoleOwner, EncryptKey: []byte("sealed"),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, orgID)
+	assert.Equal(t, orgID, org.ID)
+	assert.False(t, org.CreatedAt.IsZero())
+
+	// Аккаунт организации хранит ключ организации и владеет ее данными, как пользователь.
+	account, err := s.GetUserByID(ctx, orgID)
+	require.NoError(t, err)
+	assert.Equal(t, model.OrgLoginPrefix+orgID, account.Login)
+	assert.Equal(t, "secret_team", account.EncryptedSecret)
+	itemID := createItem(t, s, orgID, model.Password, `{"resource":"team"}`)
+	item, err := s.GetItem(ctx, itemID, orgID)
+	require.NoError(t, err)
+	assert.Equal(t, orgID, item.UserID)
+
+	member, err := s.GetOrgMember(ctx, orgID, ownerID)
+	require.NoError(t, err)
+	assert.Equal(t, model.OrgRoleOwner, member.Role)
+	assert.Equal(t, []byte("sealed"), member.EncryptKey)
+
+	orgs, err := s.ListUserOrgs(ctx, ownerID)
+	require.NoError(t, err)
+	require.Len(t, orgs, 1)
+	assert.Equal(t, orgID, orgs[0].OrgID)
+	assert.Equal(t, model.OrgRoleOwner, orgs[0].Role)
+	assert.Equal(t, []byte("team"), orgs[0].EncryptOrgName)
+
+	_, err = s.CreateOrg(ctx, &model.Organization{EncryptName: []byte("none")}, &model.OrgMember{
+		UserID: unknownID(), Role: model.OrgRoleOwner, EncryptKey: []byte("sealed"),
+	})
+	require.ErrorIs(t, err, storage.ErrNoUser)
+	orgs, err = s.ListUserOrgs(ctx, ownerID)
+	require.NoError(t, err)
+	assert.Len(t, orgs, 1)
+}
+
+func testOrgMembers(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	ownerID := createUser(t, s, "owner")
+	memberID := createUser(t, s, "member")
+	otherID := createUser(t, s, "other")
+	orgID := createOrg(t, s, ownerID, "team")
+	otherOrgID := createOrg(t, s, otherID, "other")
+
+	addOrgMember(t, s, orgID, memberID, model.OrgRoleReader)
+	err := s.AddOrgMember(ctx, &model.OrgMember{
+		OrgID: orgID, UserID: memberID, Role: model.OrgRoleAdmin, EncryptKey: []byte("sealed"),
+	})
+	require.ErrorIs(t, err, storage.ErrIsMember)
+	err = s.AddOrgMember(ctx, &model.OrgMember{
+		OrgID: unknownID(), UserID: memberID, Role: model.OrgRoleMember, EncryptKey: []byte("sealed"),
+	})
+	require.ErrorIs(t, err, storage.ErrNoOrg)
+	err = s.AddOrgMember(ctx, &model.OrgMember{
+		OrgID: orgID, UserID: unknownID(), Role: model.OrgRoleMember, EncryptKey: []byte("sealed"),
+	})
+	require.ErrorIs(t, err, storage.ErrNoUser)
+
+	members, err := s.ListOrgMembers(ctx, orgID)
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, ownerID, members[0].UserID)
+	assert.Equal(t, "owner", members[0].Login)
+	assert.Equal(t, model.OrgRoleOwner, members[0].Role)
+	assert.Equal(t, memberID, members[1].UserID)
+	assert.Equal(t, "member", members[1].Login)
+	assert.Equal(t, model.OrgRoleReader, members[1].Role)
+	assert.Equal(t, []byte("sealed_"+memberID), members[1].EncryptKey)
+
+	require.NoError(t, s.SetOrgMemberRole(ctx, orgID, memberID, model.OrgRoleAdmin))
+	member, err := s.GetOrgMember(ctx, orgID, memberID)
+	require.NoError(t, err)
+	assert.Equal(t, model.OrgRoleAdmin, member.Role)
+	assert.Equal(t, []byte("sealed_"+memberID), member.EncryptKey)
+
+	// Участие в одной организации не дает доступа к другой.
+	_, err = s.GetOrgMember(ctx, otherOrgID, memberID)
+	require.ErrorIs(t, err, storage.ErrNoMember)
+	require.ErrorIs(t, s.SetOrgMemberRole(ctx, otherOrgID, memberID, model.OrgRoleOwner), storage.ErrNoMember)
+	require.ErrorIs(t, s.RemoveOrgMember(ctx, otherOrgID, memberID), storage.ErrNoMember)
+
+	addOrgMember(t, s, otherOrgID, memberID, model.OrgRoleMember)
+	orgs, err := s.ListUserOrgs(ctx, memberID)
+	require.NoError(t, err)
+	require.Len(t, orgs, 2)
+	assert.Equal(t, orgID, orgs[0].OrgID)
+	assert.Equal(t, []byte("team"), orgs[0].EncryptOrgName)
+	assert.Equal(t, otherOrgID, orgs[1].OrgID)
+	assert.Equal(t, model.OrgRoleMember, orgs[1].Role)
+
+	require.NoError(t, s.RemoveOrgMember(ctx, orgID, memberID))
+	_, err = s.GetOrgMember(ctx, orgID, memberID)
+	require.ErrorIs(t, err, storage.ErrNoMember)
+	orgs, err = s.ListUserOrgs(ctx, memberID)
+	require.NoError(t, err)
+	require.Len(t, orgs, 1)
+	assert.Equal(t, otherOrgID, orgs[0].OrgID)
+}
+
+func testDeleteOrg(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	ownerID := createUser(t, s, "owner")
+	memberID := createUser(t, s, "member")
+	orgID := createOrg(t, s, ownerID, "team")
+	keptOrgID := createOrg(t, s, ownerID, "kept")
+	addOrgMember(t, s, orgID, memberID, model.OrgRoleMember)
+	addOrgMember(t, s, keptOrgID, memberID, model.OrgRoleMember)
+	itemID := createItem(t, s, orgID, model.Password, `{"resource":"team"}`)
+
+	// Удаление аккаунта организации удаляет организацию, ее данные и участников.
+	require.NoError(t, s.DeleteUser(ctx, orgID))
+	_, err := s.GetItem(ctx, itemID, orgID)
+	require.ErrorIs(t, err, storage.ErrNoData)
+	_, err = s.GetOrgMember(ctx, orgID, memberID)
+	require.ErrorIs(t, err, storage.ErrNoMember)
+	members, err := s.ListOrgMembers(ctx, orgID)
+	require.NoError(t, err)
+	assert.Empty(t, members)
+	err = s.AddOrgMember(ctx, &model.OrgMember{
+		OrgID: orgID, UserID: memberID, Role: model.OrgRoleMember, EncryptKey: []byte("sealed"),
+	})
+	require.ErrorIs(t, err, storage.ErrNoOrg)
+
+	// Удаление пользователя удаляет его участие в организациях, но не сами организации.
+	require.NoError(t, s.DeleteUser(ctx, memberID))
+	members, err = s.ListOrgMembers(ctx, keptOrgID)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, ownerID, members[0].UserID)
+	orgs, err := s.ListUserOrgs(ctx, ownerID)
+	require.NoError(t, err)
+	require.Len(t, orgs, 1)
+	assert.Equal(t, keptOrgID, orgs[0].OrgID)
+}
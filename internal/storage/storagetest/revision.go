@@ -0,0 +1,105 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// revisionTests возвращает тесты номеров версий данных и оптимистичной блокировки.
+func revisionTests() []testCase {
+	return []testCase{
+		{name: "Номер версии растет при изменении", fn: testRevisionIncrements},
+		{name: "Обновление с ожидаемой версией", fn: testUpdateExpectedRevision},
+		{name: "Конфликт версий", fn: testUpdateRevisionConflict},
+		{name: "Номер версии в списке данных", fn: testListItemsRevision},
+	}
+}
+
+func testRevisionIncrements(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Text, `{"v":0}`)
+
+	item, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), item.Revision)
+
+	updateItem(t, s, id, userID, "data_1", `{"v":1}`)
+	require.NoError(t, s.RestoreItemRevision(ctx, id, userID, 1))
+
+	item, err = s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), item.Revision)
+
+	// Номер версии в истории совпадает с номером, который данные имели до изменения.
+	history, err := s.GetItemHistory(ctx, id, userID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, int64(2), history[1].Revision)
+	assert.JSONEq(t, `{"v":1}`, string(history[1].EncryptMeta))
+}
+
+func testUpdateExpectedRevision(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Text, `{"v":0}`)
+
+	update := &model.VaultItem{EncryptData: []byte("data_1"), EncryptMeta: []byte(`{"v":1}`), Revision: 1}
+	require.NoError(t, s.UpdateItem(ctx, id, userID, update))
+	assert.Equal(t, int64(2), update.Revision)
+
+	item, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), item.Revision)
+	assert.Equal(t, []byte("data_1"), item.EncryptData)
+}
+
+func testUpdateRevisionConflict(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Text, `{"v":0}`)
+	updateItem(t, s, id, userID, "data_1", `{"v":1}`)
+
+	err := s.UpdateItem(ctx, id, userID, &model.VaultItem{
+		EncryptData: []byte("data_2"), EncryptMeta: []byte(`{"v":2}`), Revision: 1,
+	})
+	require.ErrorIs(t, err, storage.ErrConflict)
+
+	item, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), item.Revision)
+	assert.Equal(t, []byte("data_1"), item.EncryptData)
+
+	history, err := s.GetItemHistory(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Len(t, history, 1)
+
+	err = s.UpdateItem(ctx, unknownID(), userID, &model.VaultItem{Revision: 1})
+	require.ErrorIs(t, err, storage.ErrNoData)
+}
+
+func testListItemsRevision(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Password, "meta", withIndex("resource_site"))
+	require.NoError(t, s.UpdateItem(ctx, id, userID, &model.VaultItem{
+		EncryptData: []byte("data_1"),
+		EncryptMeta: []byte("meta"),
+		MetaIndex:   []string{"resource_site"},
+	}))
+
+	items, err := s.ListItems(ctx, userID, model.ListItemsParams{})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, int64(2), items[0].Revision)
+
+	items, err = s.SearchItems(ctx, userID, model.SearchItemsParams{Index: []string{"resource_site"}})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, int64(2), items[0].Revision)
+}
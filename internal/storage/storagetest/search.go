@@ -0,0 +1,92 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// searchTests возвращает тесты поиска данных по слепым индексам мета данных.
+func searchTests() []testCase {
+	return []testCase{
+		{name: "Поиск по слепому индексу", fn: testSearchIndex},
+		{name: "Поиск по нескольким индексам", fn: testSearchAnyIndex},
+		{name: "Порядок и ограничение результатов поиска", fn: testSearchOrder},
+		{name: "Поиск без удаленных и чужих данных", fn: testSearchIsolation},
+	}
+}
+
+// searchIDs выполняет поиск и возвращает множество найденных id.
+func searchIDs(t *testing.T, s storage.Storage, userID string, index ...string) map[string]bool {
+	t.Helper()
+	items, err := s.SearchItems(context.Background(), userID, model.SearchItemsParams{Index: index, Limit: 100})
+	require.NoError(t, err)
+	ids := make(map[string]bool, len(items))
+	for _, item := range items {
+		assert.Empty(t, item.EncryptData)
+		assert.Equal(t, []byte("meta"), item.EncryptMeta)
+		ids[item.ID] = true
+	}
+	return ids
+}
+
+func testSearchIndex(t *testing.T, s storage.Storage) {
+	userID := createUser(t, s, "user")
+	github := createItem(t, s, userID, model.Password, "meta", withIndex("resource_github", "login_dev"))
+	gitlab := createItem(t, s, userID, model.Password, "meta", withIndex("resource_gitlab", "login_dev"))
+	createItem(t, s, userID, model.Password, "meta")
+
+	assert.Equal(t, map[string]bool{github: true}, searchIDs(t, s, userID, "resource_github"))
+	assert.Equal(t, map[string]bool{github: true, gitlab: true}, searchIDs(t, s, userID, "login_dev"))
+	// Индекс ищется только целиком.
+	assert.Empty(t, searchIDs(t, s, userID, "resource_git"))
+	assert.Empty(t, searchIDs(t, s, userID))
+}
+
+func testSearchAnyIndex(t *testing.T, s storage.Storage) {
+	userID := createUser(t, s, "user")
+	resource := createItem(t, s, userID, model.Password, "meta", withIndex("resource_key"))
+	login := createItem(t, s, userID, model.Password, "meta", withIndex("login_key"))
+	createItem(t, s, userID, model.Password, "meta", withIndex("name_other"))
+
+	assert.Equal(t,
+		map[string]bool{resource: true, login: true},
+		searchIDs(t, s, userID, "resource_key", "login_key", "bank_key"),
+	)
+}
+
+func testSearchOrder(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	first := createItem(t, s, userID, model.Password, "meta", withIndex("key"))
+	second := createItem(t, s, userID, model.Password, "meta", withIndex("key"))
+	third := createItem(t, s, userID, model.Password, "meta", withIndex("key"))
+	require.NoError(t, s.UpdateItem(ctx, first, userID, &model.VaultItem{
+		EncryptData: []byte("data"),
+		EncryptMeta: []byte("meta"),
+		MetaIndex:   []string{"key"},
+	}))
+
+	items, err := s.SearchItems(ctx, userID, model.SearchItemsParams{Index: []string{"key"}, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	// Первыми возвращаются последние обновленные данные.
+	assert.Equal(t, first, items[0].ID)
+	assert.Contains(t, []string{second, third}, items[1].ID)
+}
+
+func testSearchIsolation(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	owner := createUser(t, s, "owner")
+	other := createUser(t, s, "other")
+	keep := createItem(t, s, owner, model.Password, "meta", withIndex("resource_site"))
+	deleted := createItem(t, s, owner, model.Password, "meta", withIndex("resource_site"))
+	createItem(t, s, other, model.Password, "meta", withIndex("resource_site"))
+	require.NoError(t, s.DeleteItem(ctx, deleted, owner))
+
+	assert.Equal(t, map[string]bool{keep: true}, searchIDs(t, s, owner, "resource_site"))
+}
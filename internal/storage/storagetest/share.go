@@ -0,0 +1,270 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// shareTests возвращает тесты совместного доступа к данным.
+func shareTests() []testCase {
+	return []testCase{
+		{name: "Ключи пользователя", fn: testUserKeys},
+		{name: "Перевод данных на собственный ключ", fn: testSetItemKey},
+		{name: "Обновление данных на собственном ключе", fn: testUpdateItemKey},
+		{name: "Предоставление и отзыв доступа", fn: testShareItem},
+		{name: "Доступ к удаленным данным и пользователям", fn: testShareCleanup},
+	}
+}
+
+// setItemKey переводит данные пользователя без истории изменений на собственный ключ.
+func setItemKey(t *testing.T, s storage.Storage, userID string, itemID string) {
+	t.Helper()
+	err := s.SetItemKey(context.Background(), itemID, userID, &model.ItemKey{
+		EncryptKey:  []byte("key"),
+		Revision:    1,
+		EncryptData: []byte("shared_data"),
+		SharedMeta:  []byte("shared_meta"),
+	})
+	require.NoError(t, err)
+}
+
+// shareItem предоставляет получателю доступ к данным владельца.
+func shareItem(t *testing.T, s storage.Storage, itemID, ownerID, recipientID string, access model.ShareAccess) {
+	t.Helper()
+	err := s.ShareItem(context.Background(), &model.Share{
+		ItemID:      itemID,
+		OwnerID:     ownerID,
+		RecipientID: recipientID,
+		Access:      access,
+		EncryptKey:  []byte("sealed_" + recipientID),
+	})
+	require.NoError(t, err)
+}
+
+func testUserKeys(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+
+	user, err := s.GetUserByID(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, user.PublicKey)
+	assert.Empty(t, user.EncryptPrivateKey)
+
+	require.NoError(t, s.SetUserKeys(ctx, userID, []byte("public"), []byte("private")))
+	user, err = s.GetUserByLogin(ctx, "user")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("public"), user.PublicKey)
+	assert.Equal(t, []byte("private"), user.EncryptPrivateKey)
+	user, err = s.GetUserByID(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("public"), user.PublicKey)
+
+	err = s.SetUserKeys(ctx, unknownID(), []byte("public"), []byte("private"))
+	assert.ErrorIs(t, err, storage.ErrNoUser)
+}
+
+func testSetItemKey(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	otherID := createUser(t, s, "other")
+	id := createItem(t, s, userID, model.Password, `{"v":0}`)
+	updateItem(t, s, id, userID, "data_1", `{"v":1}`)
+
+	key := &model.ItemKey{
+		EncryptKey:  []byte("key"),
+		Revision:    2,
+		EncryptData: []byte("shared_2"),
+		SharedMeta:  []byte("meta_2"),
+	}
+	// Не передана предыдущая версия: ничего не меняется.
+	err := s.SetItemKey(ctx, id, userID, key)
+	require.ErrorIs(t, err, storage.ErrNoRevision)
+	item, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Nil(t, item.EncryptKey)
+	assert.Equal(t, []byte("data_1"), item.EncryptData)
+
+	key.History = []model.ItemKeyRevision{{Revision: 1, EncryptData: []byte("shared_1"), SharedMeta: []byte("meta_1")}}
+	err = s.SetItemKey(ctx, id, otherID, key)
+	require.ErrorIs(t, err, storage.ErrNoData)
+	key.Revision = 1
+	err = s.SetItemKey(ctx, id, userID, key)
+	require.ErrorIs(t, err, storage.ErrConflict)
+	key.Revision = 2
+	require.NoError(t, s.SetItemKey(ctx, id, userID, key))
+
+	item, err = s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key"), item.EncryptKey)
+	assert.Equal(t, []byte("shared_2"), item.EncryptData)
+	assert.Equal(t, []byte("meta_2"), item.SharedMeta)
+	assert.JSONEq(t, `{"v":1}`, string(item.EncryptMeta))
+	assert.Equal(t, int64(2), item.Revision)
+	rev, err := s.GetItemRevision(ctx, id, userID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("shared_1"), rev.EncryptData)
+	assert.Equal(t, []byte("meta_1"), rev.SharedMeta)
+
+	// Повторно перевести данные на другой ключ нельзя.
+	err = s.SetItemKey(ctx, id, userID, key)
+	require.ErrorIs(t, err, storage.ErrConflict)
+
+	// Ключ заменяется новым, только если передан текущий ключ данных.
+	rotated := &model.ItemKey{
+		EncryptKey:  []byte("key_2"),
+		PrevKey:     []byte("other"),
+		Revision:    2,
+		EncryptData: []byte("rotated_2"),
+		SharedMeta:  []byte("rotated_meta_2"),
+		History: []model.ItemKeyRevision{
+			{Revision: 1, EncryptData: []byte("rotated_1"), SharedMeta: []byte("rotated_meta_1")},
+		},
+	}
+	err = s.SetItemKey(ctx, id, userID, rotated)
+	require.ErrorIs(t, err, storage.ErrConflict)
+	rotated.PrevKey = []byte("key")
+	require.NoError(t, s.SetItemKey(ctx, id, userID, rotated))
+
+	item, err = s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key_2"), item.EncryptKey)
+	assert.Equal(t, []byte("rotated_2"), item.EncryptData)
+	assert.Equal(t, []byte("rotated_meta_2"), item.SharedMeta)
+	rev, err = s.GetItemRevision(ctx, id, userID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("rotated_1"), rev.EncryptData)
+	assert.Equal(t, []byte("rotated_meta_1"), rev.SharedMeta)
+}
+
+func testUpdateItemKey(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Text, `{"v":0}`)
+	setItemKey(t, s, userID, id)
+
+	// Данные, зашифрованные ключом владельца, не должны перезаписать данные на собственном ключе.
+	err := s.UpdateItem(ctx, id, userID, &model.VaultItem{EncryptData: []byte("data_1"), EncryptMeta: []byte(`{}`)})
+	require.ErrorIs(t, err, storage.ErrConflict)
+
+	item := &model.VaultItem{
+		EncryptData: []byte("shared_data_1"),
+		EncryptMeta: []byte(`{"v":1}`),
+		EncryptKey:  []byte("key"),
+		SharedMeta:  []byte("shared_meta_1"),
+	}
+	require.NoError(t, s.UpdateItem(ctx, id, userID, item))
+	stored, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key"), stored.EncryptKey)
+	assert.Equal(t, []byte("shared_meta_1"), stored.SharedMeta)
+
+	// Мета данные для получателей восстанавливаются вместе с версией.
+	require.NoError(t, s.RestoreItemRevision(ctx, id, userID, 1))
+	stored, err = s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("key"), stored.EncryptKey)
+	assert.Equal(t, []byte("shared_data"), stored.EncryptData)
+	assert.Equal(t, []byte("shared_meta"), stored.SharedMeta)
+	rev, err := s.GetItemRevision(ctx, id, userID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("shared_meta_1"), rev.SharedMeta)
+}
+
+func testShareItem(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	ownerID := createUser(t, s, "owner")
+	recipientID := createUser(t, s, "recipient")
+	otherID := createUser(t, s, "other")
+	id := createItem(t, s, ownerID, model.Password, "owner")
+	setItemKey(t, s, ownerID, id)
+
+	_, err := s.GetShare(ctx, id, recipientID)
+	require.ErrorIs(t, err, storage.ErrNoShare)
+
+	shareItem(t, s, id, ownerID, recipientID, model.ShareRead)
+	share, err := s.GetShare(ctx, id, recipientID)
+	require.NoError(t, err)
+	assert.Equal(t, ownerID, share.OwnerID)
+	assert.Equal(t, model.ShareRead, share.Access)
+	assert.Equal(t, []byte("sealed_"+recipientID), share.EncryptKey)
+	assert.False(t, share.CreatedAt.IsZero())
+
+	// Повторное предоставление доступа изменяет его уровень.
+	shareItem(t, s, id, ownerID, recipientID, model.ShareWrite)
+	share, err = s.GetShare(ctx, id, recipientID)
+	require.NoError(t, err)
+	assert.Equal(t, model.ShareWrite, share.Access)
+
+	err = s.ShareItem(ctx, &model.Share{ItemID: id, OwnerID: otherID, RecipientID: recipientID,
+		Access: model.ShareRead, EncryptKey: []byte("sealed")})
+	require.ErrorIs(t, err, storage.ErrNoData)
+	err = s.ShareItem(ctx, &model.Share{ItemID: id, OwnerID: ownerID, RecipientID: unknownID(),
+		Access: model.ShareRead, EncryptKey: []byte("sealed")})
+	require.ErrorIs(t, err, storage.ErrNoUser)
+
+	for _, userID := range []string{ownerID, recipientID} {
+		shares, err := s.ListShares(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, shares, 1)
+		assert.Equal(t, id, shares[0].ItemID)
+		assert.Equal(t, "owner", shares[0].OwnerLogin)
+		assert.Equal(t, "recipient", shares[0].RecipientLogin)
+		assert.Equal(t, model.ShareWrite, shares[0].Access)
+		assert.Equal(t, model.Password, shares[0].ItemType)
+		assert.Equal(t, []byte("shared_meta"), shares[0].SharedMeta)
+	}
+	shares, err := s.ListShares(ctx, otherID)
+	require.NoError(t, err)
+	assert.Empty(t, shares)
+
+	// Отозвать доступ может только владелец.
+	err = s.RevokeShare(ctx, id, recipientID, recipientID)
+	require.ErrorIs(t, err, storage.ErrNoShare)
+	require.NoError(t, s.RevokeShare(ctx, id, ownerID, recipientID))
+	_, err = s.GetShare(ctx, id, recipientID)
+	require.ErrorIs(t, err, storage.ErrNoShare)
+	err = s.RevokeShare(ctx, id, ownerID, recipientID)
+	require.ErrorIs(t, err, storage.ErrNoShare)
+}
+
+func testShareCleanup(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	ownerID := createUser(t, s, "owner")
+	recipientID := createUser(t, s, "recipient")
+	otherID := createUser(t, s, "other")
+	deleted := createItem(t, s, ownerID, model.Password, "deleted")
+	kept := createItem(t, s, ownerID, model.Text, "kept")
+	shareItem(t, s, deleted, ownerID, recipientID, model.ShareRead)
+	shareItem(t, s, kept, ownerID, recipientID, model.ShareRead)
+	shareItem(t, s, kept, ownerID, otherID, model.ShareRead)
+
+	// Доступ к данным в корзине не показывается, а после очистки корзины удаляется.
+	require.NoError(t, s.DeleteItem(ctx, deleted, ownerID))
+	shares, err := s.ListShares(ctx, recipientID)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	assert.Equal(t, kept, shares[0].ItemID)
+	_, err = s.PurgeDeletedItems(ctx, ownerID)
+	require.NoError(t, err)
+	_, err = s.GetShare(ctx, deleted, recipientID)
+	require.ErrorIs(t, err, storage.ErrNoShare)
+
+	// Удаление получателя удаляет только его доступы.
+	require.NoError(t, s.DeleteUser(ctx, recipientID))
+	_, err = s.GetShare(ctx, kept, recipientID)
+	require.ErrorIs(t, err, storage.ErrNoShare)
+	shares, err = s.ListShares(ctx, ownerID)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	assert.Equal(t, otherID, shares[0].RecipientID)
+
+	// Удаление владельца удаляет доступы к его данным.
+	require.NoError(t, s.DeleteUser(ctx, ownerID))
+	_, err = s.GetShare(ctx, kept, otherID)
+	require.ErrorIs(t, err, storage.ErrNoShare)
+}
@@ -0,0 +1,156 @@
+// Package storagetest содержит набор тестов соответствия, который должна проходить
+// любая реализация storage.Storage. Тесты проверяют CRUD операции, изоляцию данных
+// пользователей, семантику ошибок «не найдено», регистронезависимость логина,
+// историю версий, корзину, постраничную выдачу списка данных, поиск по слепым индексам,
+// шифрование сохраненных в открытом виде мета данных, подсчет занятого объема, журнал аудита,
+// папки и метки данных, совместный доступ к данным, организации и их участников, вложения данных,
+// срок их хранения, избранное, статистику получения данных, а также чтение и загрузку объектов
+// резервной копии с исходными id.
+//
+// Пример использования в пакете хранилища:
+//
+//	func TestStorage(t *testing.T) {
+//		storagetest.Run(t, func(t *testing.T) storage.Storage {
+//			return NewStorage()
+//		})
+//	}
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/require"
+)
+
+// NewStorageFunc создает новое пустое хранилище для одного теста.
+// Освобождение ресурсов хранилища следует регистрировать через t.Cleanup.
+type NewStorageFunc func(t *testing.T) storage.Storage
+
+// testCase описывает один тест набора.
+type testCase struct {
+	name string
+	fn   func(t *testing.T, s storage.Storage)
+}
+
+// Run запускает набор тестов соответствия; для каждого теста создается отдельное хранилище.
+func Run(t *testing.T, newStorage NewStorageFunc) {
+	t.Helper()
+
+	var tests []testCase
+	tests = append(tests, userTests()...)
+	tests = append(tests, vaultTests()...)
+	tests = append(tests, historyTests()...)
+	tests = append(tests, trashTests()...)
+	tests = append(tests, listTests()...)
+	tests = append(tests, searchTests()...)
+	tests = append(tests, revisionTests()...)
+	tests = append(tests, txTests()...)
+	tests = append(tests, chunkedTests()...)
+	tests = append(tests, usageTests()...)
+	tests = append(tests, auditTests()...)
+	tests = append(tests, legacyMetaTests()...)
+	tests = append(tests, folderTests()...)
+	tests = append(tests, shareTests()...)
+	tests = append(tests, orgTests()...)
+	tests = append(tests, attachmentTests()...)
+	tests = append(tests, expiryTests()...)
+	tests = append(tests, accessTests()...)
+	tests = append(tests, backupTests()...)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newStorage(t))
+		})
+	}
+}
+
+// unknownID возвращает идентификатор, которого гарантированно нет в хранилище.
+// Используется UUID, так как часть хранилищ хранит идентификаторы в этом формате.
+func unknownID() string {
+	return uuid.NewString()
+}
+
+// createUser создает пользователя с переданным логином и возвращает его id.
+func createUser(t *testing.T, s storage.Storage, login string) string {
+	t.Helper()
+	id, err := s.CreateUser(context.Background(), &model.User{
+		Login:           login,
+		PasswordHash:    "hash_" + login,
+		EncryptedSecret: "secret_" + login,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, id)
+	return id
+}
+
+// itemOption задает дополнительные поля данных, создаваемых createItem.
+type itemOption func(item *model.VaultItem)
+
+// withParent создает данные вложением к данным parentID.
+func withParent(parentID string) itemOption {
+	return func(item *model.VaultItem) {
+		item.ParentID = parentID
+	}
+}
+
+// withSize задает размер данных.
+func withSize(size int64) itemOption {
+	return func(item *model.VaultItem) {
+		item.Size = size
+	}
+}
+
+// withIndex задает слепые индексы мета данных.
+func withIndex(index ...string) itemOption {
+	return func(item *model.VaultItem) {
+		item.MetaIndex = index
+	}
+}
+
+// withExpiry задает срок хранения данных.
+func withExpiry(expiresAt time.Time) itemOption {
+	return func(item *model.VaultItem) {
+		item.ExpiresAt = &expiresAt
+	}
+}
+
+// withManifest создает файл, сохраненный блоками, с переданным манифестом вместо содержимого.
+func withManifest(manifest string) itemOption {
+	return func(item *model.VaultItem) {
+		item.EncryptData = []byte(manifest)
+		item.Chunked = true
+	}
+}
+
+// withLegacyMeta сохраняет мета данные в открытом виде (как до включения шифрования).
+func withLegacyMeta() itemOption {
+	return func(item *model.VaultItem) {
+		item.Meta = string(item.EncryptMeta)
+		item.EncryptMeta = nil
+	}
+}
+
+// createItem создает данные пользователя и возвращает их id.
+// Хранилище не расшифровывает мета данные, поэтому вместо зашифрованных сохраняется их текст.
+func createItem(
+	t *testing.T, s storage.Storage, userID string, dataType model.DataType, meta string, opts ...itemOption,
+) string {
+	t.Helper()
+	item := &model.VaultItem{
+		EncryptData: []byte("data_" + meta),
+		EncryptMeta: []byte(meta),
+		Type:        dataType,
+	}
+	for _, opt := range opts {
+		opt(item)
+	}
+	id, err := s.CreateItem(context.Background(), userID, item)
+	require.NoError(t, err)
+	require.NotEmpty(t, id)
+	return id
+}
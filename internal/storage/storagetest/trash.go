@@ -0,0 +1,134 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// trashTests возвращает тесты корзины.
+func trashTests() []testCase {
+	return []testCase{
+		{name: "Удаленные данные попадают в корзину", fn: testDeletedItemsInTrash},
+		{name: "Восстановление из корзины", fn: testRestoreDeletedItem},
+		{name: "Очистка корзины пользователя", fn: testPurgeDeletedItems},
+		{name: "Очистка корзины по сроку хранения", fn: testPurgeDeletedBefore},
+		{name: "Изоляция корзины пользователей", fn: testTrashIsolation},
+	}
+}
+
+func testDeletedItemsInTrash(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Password, `{"resource":"site"}`)
+	createItem(t, s, userID, model.Password, `{"resource":"keep"}`)
+
+	trash, err := s.GetDeletedItems(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+
+	require.NoError(t, s.DeleteItem(ctx, id, userID))
+
+	items, err := s.GetItemsByType(ctx, string(model.Password), userID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.NotEqual(t, id, items[0].ID)
+	err = s.UpdateItem(ctx, id, userID, &model.VaultItem{EncryptData: []byte("data"), EncryptMeta: []byte("{}")})
+	require.ErrorIs(t, err, storage.ErrNoData)
+
+	trash, err = s.GetDeletedItems(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+	assert.Equal(t, id, trash[0].ID)
+	assert.Equal(t, model.Password, trash[0].Type)
+	assert.JSONEq(t, `{"resource":"site"}`, string(trash[0].EncryptMeta))
+	require.NotNil(t, trash[0].DeletedAt)
+	assert.False(t, trash[0].DeletedAt.Before(trash[0].CreatedAt))
+}
+
+func testRestoreDeletedItem(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Text, `{"name":"text"}`)
+
+	require.ErrorIs(t, s.RestoreDeletedItem(ctx, id, userID), storage.ErrNoData)
+	require.ErrorIs(t, s.RestoreDeletedItem(ctx, unknownID(), userID), storage.ErrNoData)
+
+	require.NoError(t, s.DeleteItem(ctx, id, userID))
+	require.NoError(t, s.RestoreDeletedItem(ctx, id, userID))
+
+	got, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`data_{"name":"text"}`), got.EncryptData)
+	assert.Nil(t, got.DeletedAt)
+
+	trash, err := s.GetDeletedItems(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+}
+
+func testPurgeDeletedItems(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	first := createItem(t, s, userID, model.Text, `{"n":1}`)
+	second := createItem(t, s, userID, model.Text, `{"n":2}`)
+	keep := createItem(t, s, userID, model.Text, `{"n":3}`)
+	require.NoError(t, s.DeleteItem(ctx, first, userID))
+	require.NoError(t, s.DeleteItem(ctx, second, userID))
+
+	purged, err := s.PurgeDeletedItems(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), purged)
+
+	trash, err := s.GetDeletedItems(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+	require.ErrorIs(t, s.RestoreDeletedItem(ctx, first, userID), storage.ErrNoData)
+
+	_, err = s.GetItem(ctx, keep, userID)
+	require.NoError(t, err)
+}
+
+func testPurgeDeletedBefore(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Text, `{"n":1}`)
+	require.NoError(t, s.DeleteItem(ctx, id, userID))
+
+	purged, err := s.PurgeDeletedBefore(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), purged)
+
+	purged, err = s.PurgeDeletedBefore(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	trash, err := s.GetDeletedItems(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+}
+
+func testTrashIsolation(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	owner := createUser(t, s, "owner")
+	other := createUser(t, s, "other")
+	id := createItem(t, s, owner, model.Text, `{"n":1}`)
+	require.NoError(t, s.DeleteItem(ctx, id, owner))
+
+	trash, err := s.GetDeletedItems(ctx, other)
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+	require.ErrorIs(t, s.RestoreDeletedItem(ctx, id, other), storage.ErrNoData)
+	purged, err := s.PurgeDeletedItems(ctx, other)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), purged)
+
+	trash, err = s.GetDeletedItems(ctx, owner)
+	require.NoError(t, err)
+	assert.Len(t, trash, 1)
+}
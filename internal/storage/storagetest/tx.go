@@ -0,0 +1,101 @@
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// txTests возвращает тесты транзакций.
+func txTests() []testCase {
+	return []testCase{
+		{name: "Транзакция применяет все изменения", fn: testTxCommit},
+		{name: "Ошибка откатывает транзакцию", fn: testTxRollback},
+		{name: "Вложенная транзакция откатывается отдельно", fn: testTxNested},
+	}
+}
+
+func testTxCommit(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	existing := createItem(t, s, userID, model.Text, `{"v":0}`)
+
+	var created string
+	err := s.WithTx(ctx, func(tx storage.Storage) error {
+		created = createItem(t, tx, userID, model.Password, `{"v":1}`)
+		updateItem(t, tx, existing, userID, "data_1", `{"v":1}`)
+
+		// Внутри транзакции видны ее собственные изменения.
+		item, err := tx.GetItem(ctx, existing, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("data_1"), item.EncryptData)
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, err = s.GetItem(ctx, created, userID)
+	require.NoError(t, err)
+	item, err := s.GetItem(ctx, existing, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data_1"), item.EncryptData)
+	history, err := s.GetItemHistory(ctx, existing, userID)
+	require.NoError(t, err)
+	assert.Len(t, history, 1)
+}
+
+func testTxRollback(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	existing := createItem(t, s, userID, model.Text, `{"v":0}`)
+	errAbort := errors.New("abort")
+
+	var created, newUserID string
+	err := s.WithTx(ctx, func(tx storage.Storage) error {
+		newUserID = createUser(t, tx, "new_user")
+		created = createItem(t, tx, userID, model.Password, `{"v":1}`)
+		updateItem(t, tx, existing, userID, "data_1", `{"v":1}`)
+		require.NoError(t, tx.DeleteItem(ctx, existing, userID))
+		return errAbort
+	})
+	require.ErrorIs(t, err, errAbort)
+
+	_, err = s.GetUserByID(ctx, newUserID)
+	require.ErrorIs(t, err, storage.ErrNoUser)
+	_, err = s.GetItem(ctx, created, userID)
+	require.ErrorIs(t, err, storage.ErrNoData)
+	item, err := s.GetItem(ctx, existing, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`data_{"v":0}`), item.EncryptData)
+	assert.Equal(t, int64(1), item.Revision)
+	history, err := s.GetItemHistory(ctx, existing, userID)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
+
+func testTxNested(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	errAbort := errors.New("abort")
+
+	var outer, inner string
+	err := s.WithTx(ctx, func(tx storage.Storage) error {
+		outer = createItem(t, tx, userID, model.Text, `{"v":"outer"}`)
+		nestedErr := tx.WithTx(ctx, func(nested storage.Storage) error {
+			inner = createItem(t, nested, userID, model.Text, `{"v":"inner"}`)
+			return errAbort
+		})
+		require.ErrorIs(t, nestedErr, errAbort)
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, err = s.GetItem(ctx, outer, userID)
+	require.NoError(t, err)
+	_, err = s.GetItem(ctx, inner, userID)
+	require.ErrorIs(t, err, storage.ErrNoData)
+}
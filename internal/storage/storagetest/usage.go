@@ -0,0 +1,116 @@
+package storagetest
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// usageTests возвращает тесты подсчета занятого пользователем объема.
+func usageTests() []testCase {
+	return []testCase{
+		{name: "Объем пустого хранилища пользователя", fn: testUsageEmpty},
+		{name: "Объем по типам данных", fn: testUsageByType},
+		{name: "Размер данных сохраняется в истории", fn: testUsageSizeHistory},
+		{name: "Параллельная проверка объема перед сохранением", fn: testUsageLock},
+	}
+}
+
+func testUsageEmpty(t *testing.T, s storage.Storage) {
+	userID := createUser(t, s, "user")
+	usages, err := s.GetUsage(context.Background(), userID)
+	require.NoError(t, err)
+	assert.Empty(t, usages)
+}
+
+func testUsageByType(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	otherID := createUser(t, s, "other")
+
+	createItem(t, s, userID, model.File, "meta", withSize(1000))
+	deleted := createItem(t, s, userID, model.File, "meta", withSize(500))
+	require.NoError(t, s.DeleteItem(ctx, deleted, userID))
+	updated := createItem(t, s, userID, model.Password, "meta", withSize(10))
+	require.NoError(t, s.UpdateItem(ctx, updated, userID, &model.VaultItem{
+		EncryptData: []byte("new data"),
+		EncryptMeta: []byte("new meta"),
+		Size:        20,
+	}))
+	createItem(t, s, otherID, model.Text, "meta", withSize(100))
+
+	usages, err := s.GetUsage(ctx, userID)
+	require.NoError(t, err)
+	// Учитываются данные в корзине и предыдущие версии; размер мета данных прибавляется к размеру данных.
+	assert.Equal(t, []model.TypeUsage{
+		{Type: model.File, Items: 2, Bytes: 1000 + 500 + 2*int64(len("meta"))},
+		{Type: model.Password, Items: 1, Bytes: 20 + int64(len("new meta")) + 10 + int64(len("meta"))},
+	}, usages)
+}
+
+func testUsageSizeHistory(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Text, "meta", withSize(10))
+	require.NoError(t, s.UpdateItem(ctx, id, userID, &model.VaultItem{
+		EncryptData: []byte("new data"),
+		EncryptMeta: []byte("meta"),
+		Size:        20,
+	}))
+
+	item, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), item.Size)
+	rev, err := s.GetItemRevision(ctx, id, userID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), rev.Size)
+
+	require.NoError(t, s.RestoreItemRevision(ctx, id, userID, 1))
+	item, err = s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), item.Size)
+}
+
+func testUsageLock(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+
+	// Каждый запрос сохраняет данные, только если у пользователя их еще нет.
+	const requests = 5
+	errs := make([]error, requests)
+	var wg sync.WaitGroup
+	for i := range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = s.WithTx(ctx, func(tx storage.Storage) error {
+				if err := tx.LockUsage(ctx, userID); err != nil {
+					return err
+				}
+				usages, err := tx.GetUsage(ctx, userID)
+				if err != nil || len(usages) > 0 {
+					return err
+				}
+				_, err = tx.CreateItem(ctx, userID, &model.VaultItem{
+					EncryptData: []byte("data"),
+					EncryptMeta: []byte("meta"),
+					Type:        model.Text,
+				})
+				return err
+			})
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	usages, err := s.GetUsage(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []model.TypeUsage{{Type: model.Text, Items: 1, Bytes: int64(len("meta"))}}, usages)
+}
@@ -0,0 +1,144 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// userTests возвращает тесты работы с пользователями.
+func userTests() []testCase {
+	return []testCase{
+		{name: "Создание пользователя", fn: testCreateUser},
+		{name: "Логин занят без учета регистра", fn: testLoginTaken},
+		{name: "Поиск пользователя по логину без учета регистра", fn: testGetUserByLogin},
+		{name: "Поиск пользователя по id", fn: testGetUserByID},
+		{name: "Пользователь не найден", fn: testUserNotFound},
+		{name: "Удаление пользователя со всеми данными", fn: testDeleteUser},
+		{name: "Удаление несуществующего пользователя", fn: testDeleteUnknownUser},
+		{name: "Список пользователей", fn: testListUsers},
+	}
+}
+
+func testCreateUser(t *testing.T, s storage.Storage) {
+	user := &model.User{Login: "User", PasswordHash: "hash", EncryptedSecret: "secret"}
+	id, err := s.CreateUser(context.Background(), user)
+	require.NoError(t, err)
+	assert.NotEmpty(t, id)
+	assert.Equal(t, id, user.ID)
+	assert.Equal(t, "user", user.Login)
+}
+
+func testLoginTaken(t *testing.T, s storage.Storage) {
+	createUser(t, s, "user")
+	for _, login := range []string{"user", "USER", "User"} {
+		_, err := s.CreateUser(context.Background(), &model.User{Login: login})
+		require.ErrorIs(t, err, storage.ErrLoginTaken, login)
+	}
+	createUser(t, s, "other")
+}
+
+func testGetUserByLogin(t *testing.T, s storage.Storage) {
+	id := createUser(t, s, "User")
+	for _, login := range []string{"user", "USER", "uSeR"} {
+		user, err := s.GetUserByLogin(context.Background(), login)
+		require.NoError(t, err, login)
+		assert.Equal(t, id, user.ID)
+		assert.Equal(t, "user", user.Login)
+		assert.Equal(t, "hash_User", user.PasswordHash)
+		assert.Equal(t, "secret_User", user.EncryptedSecret)
+	}
+}
+
+func testGetUserByID(t *testing.T, s storage.Storage) {
+	id := createUser(t, s, "user")
+	createUser(t, s, "other")
+	user, err := s.GetUserByID(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, id, user.ID)
+	assert.Equal(t, "user", user.Login)
+	assert.Equal(t, "hash_user", user.PasswordHash)
+	assert.Equal(t, "secret_user", user.EncryptedSecret)
+}
+
+func testUserNotFound(t *testing.T, s storage.Storage) {
+	createUser(t, s, "user")
+	_, err := s.GetUserByLogin(context.Background(), "unknown")
+	require.ErrorIs(t, err, storage.ErrNoUser)
+	_, err = s.GetUserByID(context.Background(), unknownID())
+	require.ErrorIs(t, err, storage.ErrNoUser)
+}
+
+func testDeleteUser(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	otherID := createUser(t, s, "other")
+	itemID := createItem(t, s, userID, model.Password, `{"v":1}`)
+	require.NoError(t, s.UpdateItem(ctx, itemID, userID, &model.VaultItem{EncryptData: []byte("data")}))
+	deletedID := createItem(t, s, userID, model.Text, `{"v":2}`)
+	require.NoError(t, s.DeleteItem(ctx, deletedID, userID))
+	appendAuditEvent(t, s, userID, "GetData")
+	otherItem := createItem(t, s, otherID, model.Text, `{"v":3}`)
+	appendAuditEvent(t, s, otherID, "GetData")
+
+	require.NoError(t, s.DeleteUser(ctx, userID))
+
+	_, err := s.GetUserByID(ctx, userID)
+	require.ErrorIs(t, err, storage.ErrNoUser)
+	_, err = s.GetUserByLogin(ctx, "user")
+	require.ErrorIs(t, err, storage.ErrNoUser)
+	items, err := s.ListItems(ctx, userID, model.ListItemsParams{})
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	deleted, err := s.GetDeletedItems(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+	_, err = s.GetItemRevision(ctx, itemID, userID, 1)
+	require.ErrorIs(t, err, storage.ErrNoData)
+	events, err := s.ListAuditEvents(ctx, userID, model.ListAuditParams{})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	metas, err := s.ListLegacyMeta(ctx, 0)
+	require.NoError(t, err)
+	assert.Empty(t, metas)
+
+	// Данные и журнал другого пользователя не затрагиваются.
+	_, err = s.GetItem(ctx, otherItem, otherID)
+	require.NoError(t, err)
+	events, err = s.ListAuditEvents(ctx, otherID, model.ListAuditParams{})
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	// Логин удаленного пользователя снова свободен.
+	createUser(t, s, "user")
+}
+
+func testDeleteUnknownUser(t *testing.T, s storage.Storage) {
+	createUser(t, s, "user")
+	require.ErrorIs(t, s.DeleteUser(context.Background(), unknownID()), storage.ErrNoUser)
+}
+
+func testListUsers(t *testing.T, s storage.Storage) {
+	users, err := s.ListUsers(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, users)
+
+	bobID := createUser(t, s, "Bob")
+	aliceID := createUser(t, s, "alice")
+	require.NoError(t, s.SetUserKeys(context.Background(), bobID, []byte("public"), []byte("private")))
+
+	users, err = s.ListUsers(context.Background())
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, model.User{
+		ID: aliceID, Login: "alice", PasswordHash: "hash_alice", EncryptedSecret: "secret_alice",
+	}, users[0])
+	assert.Equal(t, model.User{
+		ID: bobID, Login: "bob", PasswordHash: "hash_Bob", EncryptedSecret: "secret_Bob",
+		PublicKey: []byte("public"), EncryptPrivateKey: []byte("private"),
+	}, users[1])
+}
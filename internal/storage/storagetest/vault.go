@@ -0,0 +1,169 @@
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pinbrain/gophkeeper/internal/model"
+	"github.com/pinbrain/gophkeeper/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// vaultTests возвращает тесты работы с данными.
+func vaultTests() []testCase {
+	return []testCase{
+		{name: "Создание и получение данных", fn: testCreateGetItem},
+		{name: "Создание данных с заданным временем", fn: testCreateItemTimes},
+		{name: "Получение списка данных по типу", fn: testGetItemsByType},
+		{name: "Обновление данных", fn: testUpdateItem},
+		{name: "Удаление данных", fn: testDeleteItem},
+		{name: "Данные не найдены", fn: testItemNotFound},
+		{name: "Изоляция данных пользователей", fn: testItemIsolation},
+	}
+}
+
+func testCreateGetItem(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	item := &model.VaultItem{
+		EncryptData: []byte("encrypted"),
+		EncryptMeta: []byte(`{"resource":"site"}`),
+		Type:        model.Password,
+	}
+	id, err := s.CreateItem(ctx, userID, item)
+	require.NoError(t, err)
+	assert.Equal(t, id, item.ID)
+
+	got, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, id, got.ID)
+	assert.Equal(t, userID, got.UserID)
+	assert.Equal(t, []byte("encrypted"), got.EncryptData)
+	assert.JSONEq(t, `{"resource":"site"}`, string(got.EncryptMeta))
+	assert.Equal(t, model.Password, got.Type)
+	assert.False(t, got.CreatedAt.IsZero())
+	assert.False(t, got.UpdatedAt.IsZero())
+}
+
+func testCreateItemTimes(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Hour)
+	id, err := s.CreateItem(ctx, userID, &model.VaultItem{
+		EncryptData: []byte("encrypted"),
+		Type:        model.Text,
+		CreatedAt:   created,
+		UpdatedAt:   updated,
+	})
+	require.NoError(t, err)
+	got, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.True(t, created.Equal(got.CreatedAt), got.CreatedAt)
+	assert.True(t, updated.Equal(got.UpdatedAt), got.UpdatedAt)
+	assert.Equal(t, int64(1), got.Revision)
+
+	// Без времени обновления данные считаются не изменявшимися после создания.
+	id, err = s.CreateItem(ctx, userID, &model.VaultItem{
+		EncryptData: []byte("data"),
+		Type:        model.Text,
+		CreatedAt:   created,
+	})
+	require.NoError(t, err)
+	got, err = s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.True(t, created.Equal(got.UpdatedAt), got.UpdatedAt)
+}
+
+func testGetItemsByType(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	ids := map[string]bool{
+		createItem(t, s, userID, model.Password, `{"n":1}`): true,
+		createItem(t, s, userID, model.Password, `{"n":2}`): true,
+	}
+	createItem(t, s, userID, model.Text, `{"n":3}`)
+
+	items, err := s.GetItemsByType(ctx, string(model.Password), userID)
+	require.NoError(t, err)
+	require.Len(t, items, len(ids))
+	for _, item := range items {
+		assert.True(t, ids[item.ID], item.ID)
+		assert.NotEmpty(t, item.EncryptMeta)
+		assert.False(t, item.CreatedAt.IsZero())
+	}
+
+	items, err = s.GetItemsByType(ctx, string(model.File), userID)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+}
+
+func testUpdateItem(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Text, `{"name":"old"}`)
+
+	err := s.UpdateItem(ctx, id, userID, &model.VaultItem{
+		EncryptData: []byte("new data"),
+		EncryptMeta: []byte(`{"name":"new"}`),
+	})
+	require.NoError(t, err)
+
+	got, err := s.GetItem(ctx, id, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("new data"), got.EncryptData)
+	assert.JSONEq(t, `{"name":"new"}`, string(got.EncryptMeta))
+	assert.Equal(t, model.Text, got.Type)
+	assert.False(t, got.UpdatedAt.Before(got.CreatedAt))
+}
+
+func testDeleteItem(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	id := createItem(t, s, userID, model.Text, `{"name":"text"}`)
+	keep := createItem(t, s, userID, model.Text, `{"name":"keep"}`)
+
+	require.NoError(t, s.DeleteItem(ctx, id, userID))
+	_, err := s.GetItem(ctx, id, userID)
+	require.ErrorIs(t, err, storage.ErrNoData)
+	require.ErrorIs(t, s.DeleteItem(ctx, id, userID), storage.ErrNoData)
+
+	_, err = s.GetItem(ctx, keep, userID)
+	require.NoError(t, err)
+}
+
+func testItemNotFound(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "user")
+	createItem(t, s, userID, model.Text, `{"name":"text"}`)
+
+	_, err := s.GetItem(ctx, unknownID(), userID)
+	require.ErrorIs(t, err, storage.ErrNoData)
+	err = s.UpdateItem(ctx, unknownID(), userID, &model.VaultItem{EncryptData: []byte("data"), EncryptMeta: []byte("{}")})
+	require.ErrorIs(t, err, storage.ErrNoData)
+	require.ErrorIs(t, s.DeleteItem(ctx, unknownID(), userID), storage.ErrNoData)
+}
+
+func testItemIsolation(t *testing.T, s storage.Storage) {
+	ctx := context.Background()
+	owner := createUser(t, s, "owner")
+	other := createUser(t, s, "other")
+	id := createItem(t, s, owner, model.Password, `{"resource":"site"}`)
+
+	_, err := s.GetItem(ctx, id, other)
+	require.ErrorIs(t, err, storage.ErrNoData)
+
+	items, err := s.GetItemsByType(ctx, string(model.Password), other)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	err = s.UpdateItem(ctx, id, other, &model.VaultItem{EncryptData: []byte("hacked"), EncryptMeta: []byte("{}")})
+	require.ErrorIs(t, err, storage.ErrNoData)
+	require.ErrorIs(t, s.DeleteItem(ctx, id, other), storage.ErrNoData)
+
+	got, err := s.GetItem(ctx, id, owner)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data_{\"resource\":\"site\"}"), got.EncryptData)
+}